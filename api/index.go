@@ -13,7 +13,7 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 	log := config.NewLogrus(viper)
 	db := config.NewDatabase(viper, log)
 	redis := config.NewRedisClient(viper, log)
-	jwt := config.NewJWT(viper)
+	jwt := config.NewJWT(viper, log)
 	validate := config.NewValidator()
 	xendit := config.NewXendit(viper)
 	app, log := config.NewEcho()
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -25,26 +26,30 @@ func main() {
 	log := config.NewLogrus(viper)
 	db := config.NewDatabase(viper, log)
 	redis := config.NewRedisClient(viper, log)
-	jwt := config.NewJWT(viper)
+	jwt := config.NewJWT(viper, log)
 	validate := config.NewValidator()
 	xendit := config.NewXendit(viper)
 	app, log := config.NewEcho()
 	gomail := config.NewGomail(viper, log)
+	scheduler := config.NewScheduler(log)
 	err := config.Bootstrap(&config.BootstrapConfig{
-		DB:       db,
-		Cache:    redis,
-		App:      app,
-		Log:      log,
-		Validate: validate,
-		JWT:      jwt,
-		Viper:    viper,
-		Xendit:   xendit,
-		Gomail:   gomail,
+		DB:        db,
+		Cache:     redis,
+		App:       app,
+		Log:       log,
+		Validate:  validate,
+		JWT:       jwt,
+		Viper:     viper,
+		Xendit:    xendit,
+		Gomail:    gomail,
+		Scheduler: scheduler,
 	})
 	if err != nil {
 		log.Fatalf("Failed to bootstrap application: %v", err)
 	}
 
+	scheduler.Start(context.Background())
+
 	port := viper.GetString("APP_PORT")
 	go func() {
 		if err := app.Start(fmt.Sprintf(":%s", port)); err != nil {
@@ -53,4 +58,5 @@ func main() {
 	}()
 
 	config.GracefulShutdown(app, log, 10*time.Second)
+	scheduler.Stop()
 }
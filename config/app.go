@@ -1,32 +1,77 @@
 package config
 
 import (
+	"time"
+
 	"github.com/TrinityKnights/Backend/internal/builder"
 	graphql "github.com/TrinityKnights/Backend/internal/delivery/graph/handler"
 	resolvers "github.com/TrinityKnights/Backend/internal/delivery/graph/resolvers"
+	handlerAllocation "github.com/TrinityKnights/Backend/internal/delivery/http/handler/allocation"
+	handlerAttendee "github.com/TrinityKnights/Backend/internal/delivery/http/handler/attendee"
+	handlerCart "github.com/TrinityKnights/Backend/internal/delivery/http/handler/cart"
 	handlerEvent "github.com/TrinityKnights/Backend/internal/delivery/http/handler/event"
+	handlerExchange "github.com/TrinityKnights/Backend/internal/delivery/http/handler/exchange"
+	handlerGroup "github.com/TrinityKnights/Backend/internal/delivery/http/handler/group"
 	handlerOrder "github.com/TrinityKnights/Backend/internal/delivery/http/handler/order"
+	handlerPass "github.com/TrinityKnights/Backend/internal/delivery/http/handler/pass"
 	handlerPayment "github.com/TrinityKnights/Backend/internal/delivery/http/handler/payment"
+	handlerProduct "github.com/TrinityKnights/Backend/internal/delivery/http/handler/product"
+	handlerSeries "github.com/TrinityKnights/Backend/internal/delivery/http/handler/series"
+	handlerSlot "github.com/TrinityKnights/Backend/internal/delivery/http/handler/slot"
 	handlerTicket "github.com/TrinityKnights/Backend/internal/delivery/http/handler/ticket"
 	handlerUser "github.com/TrinityKnights/Backend/internal/delivery/http/handler/user"
 	handlerVenue "github.com/TrinityKnights/Backend/internal/delivery/http/handler/venue"
+	handlerWaitingRoom "github.com/TrinityKnights/Backend/internal/delivery/http/handler/waitingroom"
+	handlerWaitlist "github.com/TrinityKnights/Backend/internal/delivery/http/handler/waitlist"
+	handlerWellKnown "github.com/TrinityKnights/Backend/internal/delivery/http/handler/wellknown"
 	"github.com/TrinityKnights/Backend/internal/delivery/http/middleware"
 	"github.com/TrinityKnights/Backend/internal/delivery/http/route"
+	repositoryAllocation "github.com/TrinityKnights/Backend/internal/repository/allocation"
+	repositoryAttendee "github.com/TrinityKnights/Backend/internal/repository/attendee"
 	repositoryEvent "github.com/TrinityKnights/Backend/internal/repository/event"
+	repositoryExchange "github.com/TrinityKnights/Backend/internal/repository/exchange"
+	repositoryGroup "github.com/TrinityKnights/Backend/internal/repository/group"
 	repositoryOrder "github.com/TrinityKnights/Backend/internal/repository/order"
+	repositoryPass "github.com/TrinityKnights/Backend/internal/repository/pass"
 	repositoryPayment "github.com/TrinityKnights/Backend/internal/repository/payment"
+	repositoryProduct "github.com/TrinityKnights/Backend/internal/repository/product"
+	repositoryRecoveryCode "github.com/TrinityKnights/Backend/internal/repository/recoverycode"
+	repositoryRolePolicy "github.com/TrinityKnights/Backend/internal/repository/rolepolicy"
+	repositorySeries "github.com/TrinityKnights/Backend/internal/repository/series"
+	repositorySession "github.com/TrinityKnights/Backend/internal/repository/session"
+	repositorySlot "github.com/TrinityKnights/Backend/internal/repository/slot"
 	repositoryTicket "github.com/TrinityKnights/Backend/internal/repository/ticket"
 	repositoryUser "github.com/TrinityKnights/Backend/internal/repository/user"
 	repositoryVenue "github.com/TrinityKnights/Backend/internal/repository/venue"
+	repositoryWaitingRoom "github.com/TrinityKnights/Backend/internal/repository/waitingroom"
+	repositoryWaitlist "github.com/TrinityKnights/Backend/internal/repository/waitlist"
+	serviceAllocation "github.com/TrinityKnights/Backend/internal/service/allocation"
+	serviceAttendee "github.com/TrinityKnights/Backend/internal/service/attendee"
+	serviceCart "github.com/TrinityKnights/Backend/internal/service/cart"
 	serviceEvent "github.com/TrinityKnights/Backend/internal/service/event"
+	serviceExchange "github.com/TrinityKnights/Backend/internal/service/exchange"
+	serviceGroup "github.com/TrinityKnights/Backend/internal/service/group"
 	serviceOrder "github.com/TrinityKnights/Backend/internal/service/order"
+	servicePass "github.com/TrinityKnights/Backend/internal/service/pass"
 	servicePayment "github.com/TrinityKnights/Backend/internal/service/payment"
+	serviceProduct "github.com/TrinityKnights/Backend/internal/service/product"
+	serviceReservation "github.com/TrinityKnights/Backend/internal/service/reservation"
+	serviceSeries "github.com/TrinityKnights/Backend/internal/service/series"
+	serviceSlot "github.com/TrinityKnights/Backend/internal/service/slot"
 	serviceTicket "github.com/TrinityKnights/Backend/internal/service/ticket"
 	serviceUser "github.com/TrinityKnights/Backend/internal/service/user"
 	serviceVenue "github.com/TrinityKnights/Backend/internal/service/venue"
+	serviceWaitingRoom "github.com/TrinityKnights/Backend/internal/service/waitingroom"
+	serviceWaitlist "github.com/TrinityKnights/Backend/internal/service/waitlist"
 	"github.com/TrinityKnights/Backend/pkg/cache"
+	"github.com/TrinityKnights/Backend/pkg/challenge"
 	"github.com/TrinityKnights/Backend/pkg/gomail"
 	"github.com/TrinityKnights/Backend/pkg/jwt"
+	"github.com/TrinityKnights/Backend/pkg/lockout"
+	"github.com/TrinityKnights/Backend/pkg/reservation"
+	"github.com/TrinityKnights/Backend/pkg/scheduler"
+	"github.com/TrinityKnights/Backend/pkg/tokenstore"
+	"github.com/TrinityKnights/Backend/pkg/waitingroom"
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/sirupsen/logrus"
@@ -36,20 +81,24 @@ import (
 )
 
 type BootstrapConfig struct {
-	DB       *gorm.DB
-	Cache    *cache.ImplCache
-	App      *echo.Echo
-	Log      *logrus.Logger
-	Validate *validator.Validate
-	JWT      *jwt.JWTConfig
-	Viper    *viper.Viper
-	Xendit   *xendit.APIClient
-	Gomail   *gomail.ImplGomail
+	DB        *gorm.DB
+	Cache     *cache.ImplCache
+	App       *echo.Echo
+	Log       *logrus.Logger
+	Validate  *validator.Validate
+	JWT       *jwt.JWTConfig
+	Viper     *viper.Viper
+	Xendit    *xendit.APIClient
+	Gomail    *gomail.ImplGomail
+	Scheduler *scheduler.ImplScheduler
 }
 
 func Bootstrap(config *BootstrapConfig) error {
 	// Initialize JWT service
-	jwtService := jwt.NewJWTService(config.JWT)
+	jwtService, err := jwt.NewJWTService(config.JWT)
+	if err != nil {
+		return err
+	}
 
 	// Initialize repository
 	userRepository := repositoryUser.NewUserRepository(config.DB, config.Log)
@@ -58,14 +107,50 @@ func Bootstrap(config *BootstrapConfig) error {
 	ticketRepository := repositoryTicket.NewTicketRepository(config.DB, config.Log)
 	paymentRepository := repositoryPayment.NewPaymentRepository(config.DB, config.Log)
 	orderRepository := repositoryOrder.NewOrderRepository(config.DB, config.Log)
+	waitlistRepository := repositoryWaitlist.NewWaitlistRepository(config.DB, config.Log)
+	attendeeRepository := repositoryAttendee.NewAttendeeRepository(config.DB, config.Log)
+	exchangeRepository := repositoryExchange.NewExchangeRepository(config.DB, config.Log)
+	allocationRepository := repositoryAllocation.NewAllocationRepository(config.DB, config.Log)
+	productRepository := repositoryProduct.NewProductRepository(config.DB, config.Log)
+	groupRepository := repositoryGroup.NewGroupRepository(config.DB, config.Log)
+	passRepository := repositoryPass.NewPassRepository(config.DB, config.Log)
+	slotRepository := repositorySlot.NewSlotRepository(config.DB, config.Log)
+	seriesRepository := repositorySeries.NewSeriesRepository(config.DB, config.Log)
+	waitingRoomRepository := repositoryWaitingRoom.NewWaitingRoomRepository(config.DB, config.Log)
+	sessionRepository := repositorySession.NewSessionRepository(config.DB, config.Log)
+	recoveryCodeRepository := repositoryRecoveryCode.NewRecoveryCodeRepository(config.DB, config.Log)
+	rolePolicyRepository := repositoryRolePolicy.NewRolePolicyRepository(config.DB, config.Log)
 
 	// Initialize service
-	userService := serviceUser.NewUserServiceImpl(config.DB, config.Log, config.Validate, userRepository, jwtService, config.Gomail)
-	venueService := serviceVenue.NewVenueServiceImpl(config.DB, config.Cache, config.Log, config.Validate, venueRepository)
-	eventService := serviceEvent.NewEventServiceImpl(config.DB, config.Cache, config.Log, config.Validate, eventRepository)
+	accountLockout := lockout.NewLockout(config.Cache.Client(), lockout.Policy{
+		LockAttempts: config.Viper.GetInt64("LOCKOUT_ATTEMPTS"),
+		LockDuration: config.Viper.GetDuration("LOCKOUT_DURATION"),
+	})
+	// An IP may front many users, so it gets more room before it is slowed down
+	ipLockout := lockout.NewLockout(config.Cache.Client(), lockout.Policy{
+		FreeAttempts: 10,
+		LockAttempts: config.Viper.GetInt64("LOCKOUT_IP_ATTEMPTS"),
+		LockDuration: config.Viper.GetDuration("LOCKOUT_DURATION"),
+	})
+	tokenStore := tokenstore.NewTokenStore(config.Cache.Client(), config.JWT.AccessExpiry)
+	userService := serviceUser.NewUserServiceImpl(config.DB, config.Log, config.Validate, userRepository, orderRepository, sessionRepository, recoveryCodeRepository, rolePolicyRepository, jwtService, config.Gomail, accountLockout, ipLockout, tokenStore, NewEncryption(config.Viper, config.Log))
+	venueService := serviceVenue.NewVenueServiceImpl(config.DB, config.Cache, config.Log, config.Validate, venueRepository, config.Viper.GetDuration("VENUE_TURNOVER_BUFFER"))
+	eventService := serviceEvent.NewEventServiceImpl(config.DB, config.Cache, config.Log, config.Validate, eventRepository, config.Gomail, config.Viper.GetDuration("VENUE_TURNOVER_BUFFER"))
 	ticketService := serviceTicket.NewTicketServiceImpl(config.DB, config.Cache, config.Log, config.Validate, ticketRepository)
-	paymentService := servicePayment.NewPaymentServiceImpl(config.DB, config.Cache, config.Log, config.Validate, paymentRepository, config.Xendit)
-	orderService := serviceOrder.NewOrderServiceImpl(config.DB, config.Cache, config.Log, config.Validate, orderRepository, ticketRepository, paymentService)
+	waitlistService := serviceWaitlist.NewWaitlistServiceImpl(config.DB, config.Cache, config.Log, config.Validate, waitlistRepository, ticketRepository, config.Gomail, config.Viper.GetDuration("WAITLIST_OFFER_TTL"))
+	reservationService := serviceReservation.NewReservationServiceImpl(config.DB, config.Log, reservation.NewReservation(config.Cache.Client()), eventRepository, ticketRepository, slotRepository, config.Viper.GetDuration("SEAT_CLAIM_TTL"))
+	paymentService := servicePayment.NewPaymentServiceImpl(config.DB, config.Cache, config.Log, config.Validate, paymentRepository, ticketRepository, exchangeRepository, productRepository, groupRepository, slotRepository, eventRepository, orderRepository, waitlistService, reservationService, config.Xendit)
+	productService := serviceProduct.NewProductServiceImpl(config.DB, config.Cache, config.Log, config.Validate, productRepository)
+	attendeeService := serviceAttendee.NewAttendeeServiceImpl(config.DB, config.Cache, config.Log, config.Validate, attendeeRepository, ticketRepository)
+	exchangeService := serviceExchange.NewExchangeServiceImpl(config.DB, config.Cache, config.Log, config.Validate, exchangeRepository, ticketRepository, paymentService, waitlistService, reservationService)
+	waitingRoomService := serviceWaitingRoom.NewWaitingRoomServiceImpl(config.DB, config.Cache, config.Log, config.Validate, waitingRoomRepository, eventRepository, waitingroom.NewWaitingRoom(config.Cache.Client()), jwtService, config.Viper.GetDuration("WAITING_ROOM_ADMISSION_TTL"))
+	orderService := serviceOrder.NewOrderServiceImpl(config.DB, config.Cache, config.Log, config.Validate, orderRepository, ticketRepository, waitlistRepository, paymentService, attendeeService, productService, reservationService, waitingRoomService, config.Gomail, config.Viper.GetDuration("GUEST_LINK_TTL"))
+	cartService := serviceCart.NewCartServiceImpl(config.DB, config.Cache, config.Log, config.Validate, orderRepository, ticketRepository, paymentService, waitingRoomService, reservationService, config.Viper.GetDuration("CART_TTL"))
+	groupService := serviceGroup.NewGroupServiceImpl(config.DB, config.Cache, config.Log, config.Validate, groupRepository, orderRepository, ticketRepository, userRepository, paymentService, waitlistService, waitingRoomService, reservationService, config.Gomail)
+	passService := servicePass.NewPassServiceImpl(config.DB, config.Cache, config.Log, config.Validate, passRepository, orderRepository, ticketRepository, paymentService, waitingRoomService, reservationService)
+	slotService := serviceSlot.NewSlotServiceImpl(config.DB, config.Cache, config.Log, config.Validate, slotRepository, ticketRepository, orderRepository, paymentService, waitingRoomService, reservationService)
+	seriesService := serviceSeries.NewSeriesServiceImpl(config.DB, config.Cache, config.Log, config.Validate, seriesRepository, eventRepository, ticketRepository, eventService)
+	allocationService := serviceAllocation.NewAllocationServiceImpl(config.DB, config.Cache, config.Log, config.Validate, allocationRepository, ticketRepository, orderRepository, waitlistService, reservationService, config.Gomail)
 
 	// Initialize handler
 	userHandler := handlerUser.NewUserHandler(config.Log, userService)
@@ -74,41 +159,112 @@ func Bootstrap(config *BootstrapConfig) error {
 	ticketHandler := handlerTicket.NewTicketHandler(config.Log, ticketService)
 	orderHandler := handlerOrder.NewOrderHandler(config.Log, orderService)
 	paymentHandler := handlerPayment.NewPaymentHandler(config.Viper, config.Log, paymentService)
+	waitlistHandler := handlerWaitlist.NewWaitlistHandler(config.Log, waitlistService)
+	attendeeHandler := handlerAttendee.NewAttendeeHandler(config.Log, attendeeService)
+	exchangeHandler := handlerExchange.NewExchangeHandler(config.Log, exchangeService)
+	allocationHandler := handlerAllocation.NewAllocationHandler(config.Log, allocationService)
+	productHandler := handlerProduct.NewProductHandler(config.Log, productService)
+	cartHandler := handlerCart.NewCartHandler(config.Log, cartService)
+	groupHandler := handlerGroup.NewGroupHandler(config.Log, groupService)
+	passHandler := handlerPass.NewPassHandler(config.Log, passService)
+	slotHandler := handlerSlot.NewSlotHandler(config.Log, slotService)
+	seriesHandler := handlerSeries.NewSeriesHandler(config.Log, seriesService)
+	waitingRoomHandler := handlerWaitingRoom.NewWaitingRoomHandler(config.Log, waitingRoomService)
+	wellKnownHandler := handlerWellKnown.NewWellKnownHandler(config.Log, jwtService)
 
 	// Initialize graphql
 	resolver := resolvers.NewResolver(userService, eventService, ticketService, venueService, paymentService)
-	graphqlHandler := graphql.NewGraphQLHandler(resolver, jwtService)
+	graphqlHandler := graphql.NewGraphQLHandler(resolver, jwtService, tokenStore, config.Log)
 
-	// Initialize middleware
-	authMiddleware := middleware.AuthMiddleware(jwtService)
+	// Initialize middleware, rate limits and lockouts key on the client IP
+	config.App.IPExtractor = NewIPExtractor(config.Viper, config.Log)
+	authMiddleware := middleware.AuthMiddleware(jwtService, tokenStore, config.Log)
+	challengeMiddleware := middleware.NewChallengeMiddleware(NewChallengeVerifier(config.Viper, config.Log), challenge.NewRisk(config.Cache.Client()), middleware.ChallengePolicy{
+		VelocityLimit: config.Viper.GetInt64("CHALLENGE_VELOCITY_LIMIT"),
+		FailureLimit:  config.Viper.GetInt64("CHALLENGE_FAILURE_LIMIT"),
+	}, config.Log)
 
 	// Initialize route
 	routeConfig := route.Config{
-		App:            config.App,
-		GraphQLHandler: graphqlHandler,
-		UserHandler:    userHandler,
-		VenueHandler:   venueHandler.(*handlerVenue.VenueHandlerImpl),
-		EventHandler:   eventHandler.(*handlerEvent.EventHandlerImpl),
-		TicketHandler:  ticketHandler.(*handlerTicket.TicketHandlerImpl),
-		OrderHandler:   orderHandler.(*handlerOrder.OrderHandlerImpl),
-		PaymentHandler: paymentHandler.(*handlerPayment.PaymentHandlerImpl),
+		App:                config.App,
+		GraphQLHandler:     graphqlHandler,
+		UserHandler:        userHandler,
+		VenueHandler:       venueHandler.(*handlerVenue.VenueHandlerImpl),
+		EventHandler:       eventHandler.(*handlerEvent.EventHandlerImpl),
+		TicketHandler:      ticketHandler.(*handlerTicket.TicketHandlerImpl),
+		OrderHandler:       orderHandler.(*handlerOrder.OrderHandlerImpl),
+		PaymentHandler:     paymentHandler.(*handlerPayment.PaymentHandlerImpl),
+		WaitlistHandler:    waitlistHandler.(*handlerWaitlist.WaitlistHandlerImpl),
+		AttendeeHandler:    attendeeHandler.(*handlerAttendee.AttendeeHandlerImpl),
+		ExchangeHandler:    exchangeHandler.(*handlerExchange.ExchangeHandlerImpl),
+		AllocationHandler:  allocationHandler.(*handlerAllocation.AllocationHandlerImpl),
+		ProductHandler:     productHandler.(*handlerProduct.ProductHandlerImpl),
+		CartHandler:        cartHandler.(*handlerCart.CartHandlerImpl),
+		GroupHandler:       groupHandler.(*handlerGroup.GroupHandlerImpl),
+		PassHandler:        passHandler.(*handlerPass.PassHandlerImpl),
+		SlotHandler:        slotHandler.(*handlerSlot.SlotHandlerImpl),
+		SeriesHandler:      seriesHandler.(*handlerSeries.SeriesHandlerImpl),
+		WaitingRoomHandler: waitingRoomHandler.(*handlerWaitingRoom.WaitingRoomHandlerImpl),
+		WellKnownHandler:   wellKnownHandler.(*handlerWellKnown.WellKnownHandlerImpl),
 	}
 
 	// Build routes
 	b := builder.Config{
-		App:            config.App,
-		GraphQLHandler: graphqlHandler,
-		UserHandler:    userHandler,
-		VenueHandler:   venueHandler.(*handlerVenue.VenueHandlerImpl),
-		EventHandler:   eventHandler.(*handlerEvent.EventHandlerImpl),
-		TicketHandler:  ticketHandler.(*handlerTicket.TicketHandlerImpl),
-		OrderHandler:   orderHandler.(*handlerOrder.OrderHandlerImpl),
-		PaymentHandler: paymentHandler.(*handlerPayment.PaymentHandlerImpl),
-		AuthMiddleware: authMiddleware,
-		Routes:         &routeConfig,
+		App:                 config.App,
+		GraphQLHandler:      graphqlHandler,
+		UserHandler:         userHandler,
+		VenueHandler:        venueHandler.(*handlerVenue.VenueHandlerImpl),
+		EventHandler:        eventHandler.(*handlerEvent.EventHandlerImpl),
+		TicketHandler:       ticketHandler.(*handlerTicket.TicketHandlerImpl),
+		OrderHandler:        orderHandler.(*handlerOrder.OrderHandlerImpl),
+		PaymentHandler:      paymentHandler.(*handlerPayment.PaymentHandlerImpl),
+		WaitlistHandler:     waitlistHandler.(*handlerWaitlist.WaitlistHandlerImpl),
+		AttendeeHandler:     attendeeHandler.(*handlerAttendee.AttendeeHandlerImpl),
+		ExchangeHandler:     exchangeHandler.(*handlerExchange.ExchangeHandlerImpl),
+		AllocationHandler:   allocationHandler.(*handlerAllocation.AllocationHandlerImpl),
+		ProductHandler:      productHandler.(*handlerProduct.ProductHandlerImpl),
+		CartHandler:         cartHandler.(*handlerCart.CartHandlerImpl),
+		GroupHandler:        groupHandler.(*handlerGroup.GroupHandlerImpl),
+		PassHandler:         passHandler.(*handlerPass.PassHandlerImpl),
+		SlotHandler:         slotHandler.(*handlerSlot.SlotHandlerImpl),
+		SeriesHandler:       seriesHandler.(*handlerSeries.SeriesHandlerImpl),
+		WaitingRoomHandler:  waitingRoomHandler.(*handlerWaitingRoom.WaitingRoomHandlerImpl),
+		WellKnownHandler:    wellKnownHandler.(*handlerWellKnown.WellKnownHandlerImpl),
+		AuthMiddleware:      authMiddleware,
+		ChallengeMiddleware: challengeMiddleware,
+		Routes:              &routeConfig,
 	}
 	b.BuildRoutes()
 
+	// Register background jobs, the scheduler is not available in serverless deployments
+	if config.Scheduler != nil {
+		config.Scheduler.Register(scheduler.Job{
+			Name:     "waitlist-expire-offers",
+			Interval: time.Minute,
+			Run:      waitlistService.ExpireOffers,
+		})
+		config.Scheduler.Register(scheduler.Job{
+			Name:     "group-release-unpaid-shares",
+			Interval: time.Minute,
+			Run:      groupService.ReleaseUnpaidShares,
+		})
+		config.Scheduler.Register(scheduler.Job{
+			Name:     "event-publish-scheduled",
+			Interval: time.Minute,
+			Run:      eventService.PublishScheduled,
+		})
+		config.Scheduler.Register(scheduler.Job{
+			Name:     "ticket-release-expired-holds",
+			Interval: time.Minute,
+			Run:      ticketService.ReleaseExpiredHolds,
+		})
+		config.Scheduler.Register(scheduler.Job{
+			Name:     "reservation-reconcile",
+			Interval: time.Minute,
+			Run:      reservationService.Reconcile,
+		})
+	}
+
 	config.Log.Infof("Application is ready")
 	return nil
 }
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"github.com/TrinityKnights/Backend/pkg/challenge"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// NewChallengeVerifier creates the bot challenge verifier selected by CHALLENGE_PROVIDER
+func NewChallengeVerifier(viper *viper.Viper, log *logrus.Logger) challenge.Verifier {
+	verifyURL := viper.GetString("CHALLENGE_VERIFY_URL")
+
+	switch provider := viper.GetString("CHALLENGE_PROVIDER"); provider {
+	case "hcaptcha", "turnstile":
+		if verifyURL == "" {
+			verifyURL = challenge.HCaptchaVerifyURL
+			if provider == "turnstile" {
+				verifyURL = challenge.TurnstileVerifyURL
+			}
+		}
+		return challenge.NewSiteVerify(verifyURL, viper.GetString("CHALLENGE_SECRET"), nil)
+	case "", "stub":
+		// The stub accepts a fixed token, so production must not start without a real provider
+		if viper.GetString("APP_ENV") == "production" {
+			log.Fatal("bot challenges cannot use the stub verifier in production, set CHALLENGE_PROVIDER")
+		}
+		return challenge.NewStub(viper.GetString("CHALLENGE_STUB_TOKEN"))
+	default:
+		log.Fatalf("unknown challenge provider: %s", provider)
+		return nil
+	}
+}
@@ -2,12 +2,15 @@ package config
 
 import (
 	"context"
+	"net"
 	"os"
 	"os/signal"
+	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 )
 
 func NewEcho() (*echo.Echo, *logrus.Logger) {
@@ -17,6 +20,33 @@ func NewEcho() (*echo.Echo, *logrus.Logger) {
 	return e, log
 }
 
+// NewIPExtractor decides where the client IP comes from. Without TRUSTED_PROXIES the peer address
+// is used as is, so a client cannot pick its own IP with forwarding headers. Behind a load
+// balancer its ranges go into TRUSTED_PROXIES and X-Forwarded-For is only read through them.
+func NewIPExtractor(viper *viper.Viper, log *logrus.Logger) echo.IPExtractor {
+	proxies := strings.FieldsFunc(viper.GetString("TRUSTED_PROXIES"), func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+	if len(proxies) == 0 {
+		return echo.ExtractIPDirect()
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, proxy := range proxies {
+		_, ipRange, err := net.ParseCIDR(proxy)
+		if err != nil {
+			log.Fatalf("invalid trusted proxy range %q: %v", proxy, err)
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...)
+}
+
 // GracefulShutdown handles the graceful shutdown of the Echo server
 func GracefulShutdown(e *echo.Echo, log *logrus.Logger, shutdownTimeout time.Duration) {
 	quit := make(chan os.Signal, 1)
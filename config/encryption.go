@@ -0,0 +1,37 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/TrinityKnights/Backend/pkg/encryption"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// NewEncryption creates the cipher that seals secrets at rest with the base64 key in
+// ENCRYPTION_KEY. Outside production a key is derived from JWT_SECRET when none is set.
+func NewEncryption(viper *viper.Viper, log *logrus.Logger) encryption.Encryption {
+	var key []byte
+	if encoded := viper.GetString("ENCRYPTION_KEY"); encoded != "" {
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			log.Fatalf("failed to decode ENCRYPTION_KEY: %v", err)
+		}
+		key = decoded
+	} else {
+		// Secrets sealed under a derived key are lost when the JWT secret changes
+		if viper.GetString("APP_ENV") == "production" {
+			log.Fatal("ENCRYPTION_KEY must be set in production")
+		}
+		log.Warn("ENCRYPTION_KEY is not set, deriving a development key from JWT_SECRET")
+		sum := sha256.Sum256([]byte("encryption:" + viper.GetString("JWT_SECRET")))
+		key = sum[:]
+	}
+
+	e, err := encryption.NewEncryption(key)
+	if err != nil {
+		log.Fatalf("failed to create encryption: %v", err)
+	}
+	return e
+}
@@ -1,14 +1,97 @@
 package config
 
 import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
 	"github.com/TrinityKnights/Backend/pkg/jwt"
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
 
-func NewJWT(viper *viper.Viper) *jwt.JWTConfig {
-	return &jwt.JWTConfig{
+const (
+	defaultJWTIssuer   = "trinityknights"
+	defaultJWTAudience = "trinityknights-api"
+)
+
+// NewJWT reads the token settings. When JWT_KEYS_DIR is set, every <kid>.pem private key in it is
+// loaded and JWT_KEY_ID picks the one that signs; otherwise tokens are signed with JWT_SECRET.
+func NewJWT(viper *viper.Viper, log *logrus.Logger) *jwt.JWTConfig {
+	config := &jwt.JWTConfig{
 		Secret:        viper.GetString("JWT_SECRET"),
+		KeyID:         viper.GetString("JWT_KEY_ID"),
+		Issuer:        viper.GetString("JWT_ISSUER"),
+		Audience:      viper.GetString("JWT_AUDIENCE"),
 		AccessExpiry:  viper.GetDuration("JWT_ACCESS_EXPIRY"),
 		RefreshExpiry: viper.GetDuration("JWT_REFRESH_EXPIRY"),
 	}
+	if config.Issuer == "" {
+		config.Issuer = defaultJWTIssuer
+	}
+	if config.Audience == "" {
+		config.Audience = defaultJWTAudience
+	}
+
+	if dir := viper.GetString("JWT_KEYS_DIR"); dir != "" {
+		keys, err := loadSigningKeys(dir)
+		if err != nil {
+			log.Fatalf("failed to load jwt keys: %v", err)
+		}
+		config.Keys = keys
+
+		if config.KeyID == "" && len(keys) == 1 {
+			for kid := range keys {
+				config.KeyID = kid
+			}
+		}
+	}
+
+	return config
+}
+
+func loadSigningKeys(dir string) (map[string]crypto.Signer, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no keys found in %s", dir)
+	}
+
+	keys := make(map[string]crypto.Signer, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		block, _ := pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("%s is not a PEM file", path)
+		}
+
+		var parsed interface{}
+		switch block.Type {
+		case "RSA PRIVATE KEY":
+			parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		default:
+			parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+
+		signer, ok := parsed.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("%s does not hold a signing key", path)
+		}
+		keys[strings.TrimSuffix(filepath.Base(path), ".pem")] = signer
+	}
+
+	return keys, nil
 }
@@ -0,0 +1,11 @@
+package config
+
+import (
+	"github.com/TrinityKnights/Backend/pkg/scheduler"
+	"github.com/sirupsen/logrus"
+)
+
+// NewScheduler creates a new background job scheduler
+func NewScheduler(log *logrus.Logger) *scheduler.ImplScheduler {
+	return scheduler.NewScheduler(log)
+}
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_tickets_hold_token;
+
+ALTER TABLE tickets
+    DROP COLUMN IF EXISTS hold_token,
+    DROP COLUMN IF EXISTS held_until;
+
+COMMIT;
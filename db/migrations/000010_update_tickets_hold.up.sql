@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE tickets
+    ADD COLUMN hold_token varchar(64),
+    ADD COLUMN held_until timestamp with time zone;
+
+CREATE INDEX idx_tickets_hold_token
+    ON tickets USING btree
+    (hold_token ASC NULLS LAST);
+
+COMMIT;
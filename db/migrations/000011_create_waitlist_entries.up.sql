@@ -0,0 +1,35 @@
+DROP TABLE IF EXISTS waitlist_entries;
+
+DROP INDEX IF EXISTS idx_waitlist_entries_deleted_at;
+CREATE TABLE IF NOT EXISTS waitlist_entries (
+    id SERIAL NOT NULL,
+    event_id integer NOT NULL,
+    user_id varchar(36) NOT NULL,
+    type varchar(20) NOT NULL,
+    quantity integer NOT NULL DEFAULT 1,
+    status varchar(20) NOT NULL DEFAULT 'WAITING',
+    offer_token varchar(64),
+    offer_expires_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT waitlist_entries_pkey PRIMARY KEY (id),
+    CONSTRAINT waitlist_entries_event_fk FOREIGN KEY (event_id) REFERENCES events (id),
+    CONSTRAINT waitlist_entries_user_fk FOREIGN KEY (user_id) REFERENCES users (id)
+    );
+
+ALTER TABLE waitlist_entries
+    ADD CONSTRAINT waitlist_entries_status_check CHECK (status IN ('WAITING', 'OFFERED', 'PURCHASED', 'EXPIRED', 'CANCELLED'));
+
+CREATE UNIQUE INDEX idx_waitlist_entries_offer_token
+    ON waitlist_entries USING btree
+    (offer_token)
+    WHERE offer_token IS NOT NULL;
+
+CREATE INDEX idx_waitlist_entries_queue
+    ON waitlist_entries USING btree
+    (event_id, type, status, created_at);
+
+CREATE INDEX idx_waitlist_entries_deleted_at
+    ON waitlist_entries USING btree
+    (deleted_at ASC NULLS LAST);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS attendee_answers;
+
+DROP TABLE IF EXISTS attendee_questions;
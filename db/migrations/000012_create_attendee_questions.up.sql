@@ -0,0 +1,52 @@
+DROP TABLE IF EXISTS attendee_questions;
+
+DROP INDEX IF EXISTS idx_attendee_questions_deleted_at;
+CREATE TABLE IF NOT EXISTS attendee_questions (
+    id SERIAL NOT NULL,
+    event_id integer NOT NULL,
+    label varchar(255) NOT NULL,
+    type varchar(20) NOT NULL,
+    options jsonb,
+    required boolean NOT NULL DEFAULT false,
+    position integer NOT NULL DEFAULT 0,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT attendee_questions_pkey PRIMARY KEY (id),
+    CONSTRAINT attendee_questions_event_fk FOREIGN KEY (event_id) REFERENCES events (id)
+    );
+
+ALTER TABLE attendee_questions
+    ADD CONSTRAINT attendee_questions_type_check CHECK (type IN ('TEXT', 'EMAIL', 'NUMBER', 'SELECT', 'CHECKBOX'));
+
+CREATE INDEX idx_attendee_questions_event_id
+    ON attendee_questions USING btree
+    (event_id, position);
+
+CREATE INDEX idx_attendee_questions_deleted_at
+    ON attendee_questions USING btree
+    (deleted_at ASC NULLS LAST);
+
+DROP TABLE IF EXISTS attendee_answers;
+
+DROP INDEX IF EXISTS idx_attendee_answers_deleted_at;
+CREATE TABLE IF NOT EXISTS attendee_answers (
+    id SERIAL NOT NULL,
+    ticket_id varchar(36) NOT NULL,
+    question_id integer NOT NULL,
+    value text NOT NULL,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT attendee_answers_pkey PRIMARY KEY (id),
+    CONSTRAINT attendee_answers_ticket_fk FOREIGN KEY (ticket_id) REFERENCES tickets (id) ON DELETE CASCADE,
+    CONSTRAINT attendee_answers_question_fk FOREIGN KEY (question_id) REFERENCES attendee_questions (id) ON DELETE CASCADE
+    );
+
+CREATE UNIQUE INDEX idx_attendee_answers_ticket_question
+    ON attendee_answers USING btree
+    (ticket_id, question_id);
+
+CREATE INDEX idx_attendee_answers_deleted_at
+    ON attendee_answers USING btree
+    (deleted_at ASC NULLS LAST);
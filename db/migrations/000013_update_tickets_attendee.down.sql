@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS attendee_edit_cutoff;
+
+ALTER TABLE tickets
+    DROP COLUMN IF EXISTS attendee_name,
+    DROP COLUMN IF EXISTS attendee_email;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE tickets
+    ADD COLUMN attendee_name varchar(100),
+    ADD COLUMN attendee_email varchar(100);
+
+ALTER TABLE events
+    ADD COLUMN attendee_edit_cutoff timestamp with time zone;
+
+COMMIT;
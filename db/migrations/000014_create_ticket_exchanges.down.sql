@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS user_credits;
+
+DROP TABLE IF EXISTS ticket_exchanges;
@@ -0,0 +1,65 @@
+DROP TABLE IF EXISTS ticket_exchanges;
+
+DROP INDEX IF EXISTS idx_ticket_exchanges_deleted_at;
+CREATE TABLE IF NOT EXISTS ticket_exchanges (
+    id SERIAL NOT NULL,
+    order_id integer NOT NULL,
+    user_id varchar(36) NOT NULL,
+    old_ticket_id varchar(36) NOT NULL,
+    new_ticket_id varchar(36) NOT NULL,
+    old_price numeric(10,2) NOT NULL,
+    new_price numeric(10,2) NOT NULL,
+    price_difference numeric(10,2) NOT NULL,
+    status varchar(20) NOT NULL DEFAULT 'PENDING',
+    hold_token varchar(64),
+    completed_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT ticket_exchanges_pkey PRIMARY KEY (id),
+    CONSTRAINT ticket_exchanges_order_fk FOREIGN KEY (order_id) REFERENCES orders (id),
+    CONSTRAINT ticket_exchanges_user_fk FOREIGN KEY (user_id) REFERENCES users (id),
+    CONSTRAINT ticket_exchanges_old_ticket_fk FOREIGN KEY (old_ticket_id) REFERENCES tickets (id),
+    CONSTRAINT ticket_exchanges_new_ticket_fk FOREIGN KEY (new_ticket_id) REFERENCES tickets (id)
+    );
+
+ALTER TABLE ticket_exchanges
+    ADD CONSTRAINT ticket_exchanges_status_check CHECK (status IN ('PENDING', 'COMPLETED', 'EXPIRED', 'FAILED'));
+
+CREATE UNIQUE INDEX idx_ticket_exchanges_pending
+    ON ticket_exchanges USING btree
+    (old_ticket_id)
+    WHERE status = 'PENDING' AND deleted_at IS NULL;
+
+CREATE INDEX idx_ticket_exchanges_user_id
+    ON ticket_exchanges USING btree
+    (user_id, created_at);
+
+CREATE INDEX idx_ticket_exchanges_deleted_at
+    ON ticket_exchanges USING btree
+    (deleted_at ASC NULLS LAST);
+
+DROP TABLE IF EXISTS user_credits;
+
+DROP INDEX IF EXISTS idx_user_credits_deleted_at;
+CREATE TABLE IF NOT EXISTS user_credits (
+    id SERIAL NOT NULL,
+    user_id varchar(36) NOT NULL,
+    amount numeric(10,2) NOT NULL,
+    description varchar(255) NOT NULL,
+    ticket_exchange_id integer,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT user_credits_pkey PRIMARY KEY (id),
+    CONSTRAINT user_credits_user_fk FOREIGN KEY (user_id) REFERENCES users (id),
+    CONSTRAINT user_credits_ticket_exchange_fk FOREIGN KEY (ticket_exchange_id) REFERENCES ticket_exchanges (id)
+    );
+
+CREATE INDEX idx_user_credits_user_id
+    ON user_credits USING btree
+    (user_id);
+
+CREATE INDEX idx_user_credits_deleted_at
+    ON user_credits USING btree
+    (deleted_at ASC NULLS LAST);
@@ -0,0 +1,14 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_payments_order_id;
+
+DELETE FROM payments WHERE ticket_exchange_id IS NOT NULL;
+
+ALTER TABLE payments
+    DROP CONSTRAINT IF EXISTS payments_ticket_exchange_fk,
+    DROP COLUMN IF EXISTS ticket_exchange_id;
+
+ALTER TABLE payments
+    ADD CONSTRAINT payments_order_id_key UNIQUE (order_id);
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE payments
+    DROP CONSTRAINT IF EXISTS payments_order_id_key;
+
+ALTER TABLE payments
+    ADD COLUMN ticket_exchange_id integer,
+    ADD CONSTRAINT payments_ticket_exchange_fk FOREIGN KEY (ticket_exchange_id) REFERENCES ticket_exchanges (id);
+
+CREATE UNIQUE INDEX idx_payments_order_id
+    ON payments USING btree
+    (order_id)
+    WHERE ticket_exchange_id IS NULL;
+
+COMMIT;
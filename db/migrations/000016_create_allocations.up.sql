@@ -0,0 +1,28 @@
+DROP TABLE IF EXISTS allocations;
+
+DROP INDEX IF EXISTS idx_allocations_deleted_at;
+CREATE TABLE IF NOT EXISTS allocations (
+    id SERIAL NOT NULL,
+    event_id integer NOT NULL,
+    partner_name varchar(255) NOT NULL,
+    type varchar(20) NOT NULL,
+    quantity integer NOT NULL,
+    status varchar(20) NOT NULL DEFAULT 'ACTIVE',
+    notes text,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT allocations_pkey PRIMARY KEY (id),
+    CONSTRAINT allocations_event_fk FOREIGN KEY (event_id) REFERENCES events (id)
+    );
+
+ALTER TABLE allocations
+    ADD CONSTRAINT allocations_status_check CHECK (status IN ('ACTIVE', 'RELEASED'));
+
+CREATE INDEX idx_allocations_event_id
+    ON allocations USING btree
+    (event_id);
+
+CREATE INDEX idx_allocations_deleted_at
+    ON allocations USING btree
+    (deleted_at ASC NULLS LAST);
@@ -0,0 +1,20 @@
+BEGIN;
+
+ALTER TABLE orders
+    DROP CONSTRAINT IF EXISTS orders_allocation_fk;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS recipient_email,
+    DROP COLUMN IF EXISTS recipient_name,
+    DROP COLUMN IF EXISTS allocation_id,
+    DROP COLUMN IF EXISTS complimentary;
+
+DROP INDEX IF EXISTS idx_tickets_allocation_id;
+
+ALTER TABLE tickets
+    DROP CONSTRAINT IF EXISTS tickets_allocation_fk;
+
+ALTER TABLE tickets
+    DROP COLUMN IF EXISTS allocation_id;
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+ALTER TABLE tickets
+    ADD COLUMN allocation_id integer;
+
+ALTER TABLE tickets
+    ADD CONSTRAINT tickets_allocation_fk FOREIGN KEY (allocation_id) REFERENCES allocations (id);
+
+CREATE INDEX idx_tickets_allocation_id
+    ON tickets USING btree
+    (allocation_id)
+    WHERE allocation_id IS NOT NULL;
+
+ALTER TABLE orders
+    ADD COLUMN complimentary boolean NOT NULL DEFAULT false,
+    ADD COLUMN allocation_id integer,
+    ADD COLUMN recipient_name varchar(255),
+    ADD COLUMN recipient_email varchar(255);
+
+ALTER TABLE orders
+    ADD CONSTRAINT orders_allocation_fk FOREIGN KEY (allocation_id) REFERENCES allocations (id);
+
+COMMIT;
@@ -0,0 +1,28 @@
+DROP TABLE IF EXISTS products;
+
+DROP INDEX IF EXISTS idx_products_deleted_at;
+CREATE TABLE IF NOT EXISTS products (
+    id SERIAL NOT NULL,
+    event_id integer NOT NULL,
+    sku varchar(64) NOT NULL,
+    name varchar(255) NOT NULL,
+    description text,
+    price numeric(10,2) NOT NULL,
+    stock integer NOT NULL DEFAULT 0,
+    redeemable boolean NOT NULL DEFAULT false,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT products_pkey PRIMARY KEY (id),
+    CONSTRAINT products_event_fk FOREIGN KEY (event_id) REFERENCES events (id),
+    CONSTRAINT products_stock_check CHECK (stock >= 0)
+    );
+
+CREATE UNIQUE INDEX idx_products_event_sku
+    ON products USING btree
+    (event_id, sku)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_products_deleted_at
+    ON products USING btree
+    (deleted_at ASC NULLS LAST);
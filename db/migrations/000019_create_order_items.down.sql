@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS vouchers;
+
+DROP TABLE IF EXISTS order_items;
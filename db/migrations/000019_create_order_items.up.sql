@@ -0,0 +1,49 @@
+DROP TABLE IF EXISTS vouchers;
+
+DROP TABLE IF EXISTS order_items;
+
+DROP INDEX IF EXISTS idx_order_items_deleted_at;
+CREATE TABLE IF NOT EXISTS order_items (
+    id SERIAL NOT NULL,
+    order_id integer NOT NULL,
+    product_id integer NOT NULL,
+    sku varchar(64) NOT NULL,
+    name varchar(255) NOT NULL,
+    quantity integer NOT NULL,
+    unit_price numeric(10,2) NOT NULL,
+    total_price numeric(10,2) NOT NULL,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT order_items_pkey PRIMARY KEY (id),
+    CONSTRAINT order_items_order_fk FOREIGN KEY (order_id) REFERENCES orders (id),
+    CONSTRAINT order_items_product_fk FOREIGN KEY (product_id) REFERENCES products (id),
+    CONSTRAINT order_items_quantity_check CHECK (quantity > 0)
+    );
+
+CREATE INDEX idx_order_items_order_id
+    ON order_items USING btree
+    (order_id);
+
+CREATE INDEX idx_order_items_deleted_at
+    ON order_items USING btree
+    (deleted_at ASC NULLS LAST);
+
+DROP INDEX IF EXISTS idx_vouchers_deleted_at;
+CREATE TABLE IF NOT EXISTS vouchers (
+    id SERIAL NOT NULL,
+    code varchar(36) NOT NULL,
+    order_item_id integer NOT NULL,
+    redeemed_at timestamp with time zone,
+    redeemed_by varchar(36),
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT vouchers_pkey PRIMARY KEY (id),
+    CONSTRAINT vouchers_code_key UNIQUE (code),
+    CONSTRAINT vouchers_order_item_fk FOREIGN KEY (order_item_id) REFERENCES order_items (id) ON DELETE CASCADE
+    );
+
+CREATE INDEX idx_vouchers_deleted_at
+    ON vouchers USING btree
+    (deleted_at ASC NULLS LAST);
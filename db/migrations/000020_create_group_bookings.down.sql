@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS group_shares;
+
+DROP TABLE IF EXISTS group_bookings;
+
+COMMIT;
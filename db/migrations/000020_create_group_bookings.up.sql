@@ -0,0 +1,70 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS group_bookings (
+    id SERIAL NOT NULL,
+    event_id integer NOT NULL,
+    organiser_id varchar(36) NOT NULL,
+    type varchar(20) NOT NULL,
+    quantity integer NOT NULL,
+    status varchar(20) NOT NULL DEFAULT 'PENDING',
+    deadline timestamp with time zone NOT NULL,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT group_bookings_pkey PRIMARY KEY (id),
+    CONSTRAINT group_bookings_event_fk FOREIGN KEY (event_id) REFERENCES events (id),
+    CONSTRAINT group_bookings_organiser_fk FOREIGN KEY (organiser_id) REFERENCES users (id)
+    );
+
+ALTER TABLE group_bookings
+    ADD CONSTRAINT group_bookings_status_check CHECK (status IN ('PENDING', 'COMPLETED', 'PARTIAL', 'EXPIRED'));
+
+CREATE INDEX idx_group_bookings_organiser_id
+    ON group_bookings USING btree
+    (organiser_id);
+
+CREATE INDEX idx_group_bookings_pending_deadline
+    ON group_bookings USING btree
+    (deadline)
+    WHERE status = 'PENDING';
+
+CREATE INDEX idx_group_bookings_deleted_at
+    ON group_bookings USING btree
+    (deleted_at ASC NULLS LAST);
+
+CREATE TABLE IF NOT EXISTS group_shares (
+    id SERIAL NOT NULL,
+    group_booking_id integer NOT NULL,
+    order_id integer NOT NULL,
+    name varchar(255) NOT NULL,
+    email varchar(255) NOT NULL,
+    quantity integer NOT NULL,
+    status varchar(20) NOT NULL DEFAULT 'INVITED',
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT group_shares_pkey PRIMARY KEY (id),
+    CONSTRAINT group_shares_group_booking_fk FOREIGN KEY (group_booking_id) REFERENCES group_bookings (id) ON DELETE CASCADE,
+    CONSTRAINT group_shares_order_fk FOREIGN KEY (order_id) REFERENCES orders (id)
+    );
+
+ALTER TABLE group_shares
+    ADD CONSTRAINT group_shares_status_check CHECK (status IN ('INVITED', 'PAID', 'RELEASED'));
+
+CREATE UNIQUE INDEX idx_group_shares_order_id
+    ON group_shares USING btree
+    (order_id);
+
+CREATE INDEX idx_group_shares_group_booking_id
+    ON group_shares USING btree
+    (group_booking_id);
+
+CREATE INDEX idx_group_shares_email
+    ON group_shares USING btree
+    (LOWER(email));
+
+CREATE INDEX idx_group_shares_deleted_at
+    ON group_shares USING btree
+    (deleted_at ASC NULLS LAST);
+
+COMMIT;
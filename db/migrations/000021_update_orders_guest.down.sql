@@ -0,0 +1,18 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_orders_guest_email;
+
+DROP INDEX IF EXISTS idx_orders_code;
+
+ALTER TABLE orders
+    DROP CONSTRAINT IF EXISTS orders_owner_check;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS guest_name,
+    DROP COLUMN IF EXISTS guest_email,
+    DROP COLUMN IF EXISTS code;
+
+ALTER TABLE orders
+    ALTER COLUMN user_id SET NOT NULL;
+
+COMMIT;
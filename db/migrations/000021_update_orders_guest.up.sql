@@ -0,0 +1,24 @@
+BEGIN;
+
+ALTER TABLE orders
+    ALTER COLUMN user_id DROP NOT NULL;
+
+ALTER TABLE orders
+    ADD COLUMN code varchar(16),
+    ADD COLUMN guest_email varchar(255),
+    ADD COLUMN guest_name varchar(255);
+
+ALTER TABLE orders
+    ADD CONSTRAINT orders_owner_check CHECK (user_id IS NOT NULL OR guest_email IS NOT NULL);
+
+CREATE UNIQUE INDEX idx_orders_code
+    ON orders USING btree
+    (code)
+    WHERE code IS NOT NULL;
+
+CREATE INDEX idx_orders_guest_email
+    ON orders USING btree
+    (LOWER(guest_email))
+    WHERE user_id IS NULL;
+
+COMMIT;
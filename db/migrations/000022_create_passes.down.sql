@@ -0,0 +1,21 @@
+BEGIN;
+
+ALTER TABLE tickets
+    DROP COLUMN IF EXISTS checked_in_at;
+
+DROP INDEX IF EXISTS idx_orders_pass_id;
+
+ALTER TABLE orders
+    DROP CONSTRAINT IF EXISTS orders_pass_fk,
+    DROP COLUMN IF EXISTS pass_id;
+
+DROP TABLE IF EXISTS pass_events;
+
+DROP TABLE IF EXISTS passes;
+
+DROP INDEX IF EXISTS idx_events_series;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS series;
+
+COMMIT;
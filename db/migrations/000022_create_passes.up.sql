@@ -0,0 +1,62 @@
+BEGIN;
+
+ALTER TABLE events
+    ADD COLUMN series varchar(100);
+
+CREATE INDEX idx_events_series
+    ON events USING btree
+    (LOWER(series))
+    WHERE series IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS passes (
+    id SERIAL NOT NULL,
+    name varchar(255) NOT NULL,
+    description text,
+    price numeric(10,2) NOT NULL,
+    type varchar(20) NOT NULL,
+    series_id integer,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT passes_pkey PRIMARY KEY (id)
+    );
+
+ALTER TABLE passes
+    ADD CONSTRAINT passes_price_check CHECK (price >= 0);
+
+CREATE INDEX idx_passes_deleted_at
+    ON passes USING btree
+    (deleted_at ASC NULLS LAST);
+
+-- Series passes follow the series itself rather than the free-text label events carry, which
+-- any event could be given. The foreign key is added along with event_series
+CREATE INDEX idx_passes_series_id
+    ON passes USING btree
+    (series_id)
+    WHERE series_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS pass_events (
+    pass_id integer NOT NULL,
+    event_id integer NOT NULL,
+    CONSTRAINT pass_events_pkey PRIMARY KEY (pass_id, event_id),
+    CONSTRAINT pass_events_pass_fk FOREIGN KEY (pass_id) REFERENCES passes (id) ON DELETE CASCADE,
+    CONSTRAINT pass_events_event_fk FOREIGN KEY (event_id) REFERENCES events (id) ON DELETE CASCADE
+    );
+
+CREATE INDEX idx_pass_events_event_id
+    ON pass_events USING btree
+    (event_id);
+
+ALTER TABLE orders
+    ADD COLUMN pass_id integer,
+    ADD CONSTRAINT orders_pass_fk FOREIGN KEY (pass_id) REFERENCES passes (id);
+
+CREATE INDEX idx_orders_pass_id
+    ON orders USING btree
+    (pass_id)
+    WHERE pass_id IS NOT NULL;
+
+ALTER TABLE tickets
+    ADD COLUMN checked_in_at timestamp with time zone;
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP TABLE IF EXISTS slot_bookings;
+
+DROP TABLE IF EXISTS time_slots;
+
+DROP TABLE IF EXISTS slot_templates;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS timed_entry;
+
+COMMIT;
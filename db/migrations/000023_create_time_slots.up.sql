@@ -0,0 +1,99 @@
+BEGIN;
+
+ALTER TABLE events
+    ADD COLUMN timed_entry boolean NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS slot_templates (
+    id SERIAL NOT NULL,
+    event_id integer NOT NULL,
+    name varchar(100) NOT NULL,
+    start_time time NOT NULL,
+    end_time time NOT NULL,
+    interval_minutes integer NOT NULL,
+    duration_minutes integer NOT NULL,
+    capacity integer NOT NULL,
+    price numeric(10,2) NOT NULL,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT slot_templates_pkey PRIMARY KEY (id),
+    CONSTRAINT slot_templates_event_fk FOREIGN KEY (event_id) REFERENCES events (id)
+    );
+
+ALTER TABLE slot_templates
+    ADD CONSTRAINT slot_templates_window_check CHECK (start_time < end_time),
+    ADD CONSTRAINT slot_templates_interval_check CHECK (interval_minutes > 0 AND duration_minutes > 0),
+    ADD CONSTRAINT slot_templates_capacity_check CHECK (capacity > 0),
+    ADD CONSTRAINT slot_templates_price_check CHECK (price >= 0);
+
+CREATE INDEX idx_slot_templates_event_id
+    ON slot_templates USING btree
+    (event_id);
+
+CREATE INDEX idx_slot_templates_deleted_at
+    ON slot_templates USING btree
+    (deleted_at ASC NULLS LAST);
+
+CREATE TABLE IF NOT EXISTS time_slots (
+    id SERIAL NOT NULL,
+    event_id integer NOT NULL,
+    template_id integer NOT NULL,
+    starts_at timestamp with time zone NOT NULL,
+    ends_at timestamp with time zone NOT NULL,
+    capacity integer NOT NULL,
+    reserved integer NOT NULL DEFAULT 0,
+    price numeric(10,2) NOT NULL,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT time_slots_pkey PRIMARY KEY (id),
+    CONSTRAINT time_slots_event_fk FOREIGN KEY (event_id) REFERENCES events (id),
+    CONSTRAINT time_slots_template_fk FOREIGN KEY (template_id) REFERENCES slot_templates (id)
+    );
+
+ALTER TABLE time_slots
+    ADD CONSTRAINT time_slots_reserved_check CHECK (reserved >= 0 AND reserved <= capacity);
+
+CREATE UNIQUE INDEX idx_time_slots_template_starts_at
+    ON time_slots USING btree
+    (template_id, starts_at);
+
+CREATE INDEX idx_time_slots_event_starts_at
+    ON time_slots USING btree
+    (event_id, starts_at);
+
+CREATE INDEX idx_time_slots_deleted_at
+    ON time_slots USING btree
+    (deleted_at ASC NULLS LAST);
+
+CREATE TABLE IF NOT EXISTS slot_bookings (
+    id SERIAL NOT NULL,
+    order_id integer NOT NULL,
+    slot_id integer NOT NULL,
+    quantity integer NOT NULL,
+    unit_price numeric(10,2) NOT NULL,
+    total_price numeric(10,2) NOT NULL,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT slot_bookings_pkey PRIMARY KEY (id),
+    CONSTRAINT slot_bookings_order_fk FOREIGN KEY (order_id) REFERENCES orders (id),
+    CONSTRAINT slot_bookings_slot_fk FOREIGN KEY (slot_id) REFERENCES time_slots (id)
+    );
+
+ALTER TABLE slot_bookings
+    ADD CONSTRAINT slot_bookings_quantity_check CHECK (quantity > 0);
+
+CREATE INDEX idx_slot_bookings_order_id
+    ON slot_bookings USING btree
+    (order_id);
+
+CREATE INDEX idx_slot_bookings_slot_id
+    ON slot_bookings USING btree
+    (slot_id);
+
+CREATE INDEX idx_slot_bookings_deleted_at
+    ON slot_bookings USING btree
+    (deleted_at ASC NULLS LAST);
+
+COMMIT;
@@ -0,0 +1,25 @@
+BEGIN;
+
+ALTER TABLE tickets
+    DROP CONSTRAINT IF EXISTS tickets_event_seat_number_key;
+
+ALTER TABLE tickets
+    ADD CONSTRAINT tickets_seat_number_key UNIQUE (seat_number);
+
+DROP INDEX IF EXISTS idx_events_series_occurrence;
+
+ALTER TABLE passes
+    DROP CONSTRAINT IF EXISTS passes_series_fk;
+
+ALTER TABLE events
+    DROP CONSTRAINT IF EXISTS events_series_fk,
+    DROP COLUMN IF EXISTS occurrence_date,
+    DROP COLUMN IF EXISTS series_id;
+
+DROP TABLE IF EXISTS series_ticket_templates;
+
+DROP TABLE IF EXISTS series_exceptions;
+
+DROP TABLE IF EXISTS event_series;
+
+COMMIT;
@@ -0,0 +1,86 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS event_series (
+    id SERIAL NOT NULL,
+    name varchar(100) NOT NULL,
+    description varchar(255) NOT NULL,
+    venue_id integer NOT NULL,
+    time time NOT NULL,
+    rrule varchar(255) NOT NULL,
+    starts_on date NOT NULL,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT event_series_pkey PRIMARY KEY (id),
+    CONSTRAINT event_series_venue_fk FOREIGN KEY (venue_id) REFERENCES venues (id)
+    );
+
+CREATE INDEX idx_event_series_deleted_at
+    ON event_series USING btree
+    (deleted_at ASC NULLS LAST);
+
+CREATE TABLE IF NOT EXISTS series_exceptions (
+    id SERIAL NOT NULL,
+    series_id integer NOT NULL,
+    original_date date NOT NULL,
+    action varchar(10) NOT NULL,
+    new_date date,
+    new_time time,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT series_exceptions_pkey PRIMARY KEY (id),
+    CONSTRAINT series_exceptions_series_fk FOREIGN KEY (series_id) REFERENCES event_series (id) ON DELETE CASCADE
+    );
+
+ALTER TABLE series_exceptions
+    ADD CONSTRAINT series_exceptions_action_check CHECK (action IN ('SKIP', 'MOVE')),
+    ADD CONSTRAINT series_exceptions_move_check CHECK (action = 'SKIP' OR new_date IS NOT NULL);
+
+CREATE UNIQUE INDEX idx_series_exceptions_series_date
+    ON series_exceptions USING btree
+    (series_id, original_date)
+    WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS series_ticket_templates (
+    id SERIAL NOT NULL,
+    series_id integer NOT NULL,
+    type varchar(20) NOT NULL,
+    price numeric(10,2) NOT NULL,
+    count integer NOT NULL,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    deleted_at timestamp with time zone,
+    CONSTRAINT series_ticket_templates_pkey PRIMARY KEY (id),
+    CONSTRAINT series_ticket_templates_series_fk FOREIGN KEY (series_id) REFERENCES event_series (id) ON DELETE CASCADE
+    );
+
+ALTER TABLE series_ticket_templates
+    ADD CONSTRAINT series_ticket_templates_count_check CHECK (count > 0),
+    ADD CONSTRAINT series_ticket_templates_price_check CHECK (price >= 0);
+
+CREATE INDEX idx_series_ticket_templates_series_id
+    ON series_ticket_templates USING btree
+    (series_id);
+
+ALTER TABLE events
+    ADD COLUMN series_id integer,
+    ADD COLUMN occurrence_date date,
+    ADD CONSTRAINT events_series_fk FOREIGN KEY (series_id) REFERENCES event_series (id);
+
+ALTER TABLE passes
+    ADD CONSTRAINT passes_series_fk FOREIGN KEY (series_id) REFERENCES event_series (id);
+
+CREATE UNIQUE INDEX idx_events_series_occurrence
+    ON events USING btree
+    (series_id, occurrence_date)
+    WHERE series_id IS NOT NULL AND deleted_at IS NULL;
+
+-- Occurrences copy the same ticket layout, so seat numbers only have to be unique per event
+ALTER TABLE tickets
+    DROP CONSTRAINT IF EXISTS tickets_seat_number_key;
+
+ALTER TABLE tickets
+    ADD CONSTRAINT tickets_event_seat_number_key UNIQUE (event_id, seat_number);
+
+COMMIT;
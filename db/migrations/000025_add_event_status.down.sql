@@ -0,0 +1,21 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_orders_refund_status;
+
+ALTER TABLE orders
+    DROP CONSTRAINT IF EXISTS orders_refund_status_check,
+    DROP COLUMN IF EXISTS refund_eligible_at,
+    DROP COLUMN IF EXISTS refund_status;
+
+DROP INDEX IF EXISTS idx_events_publish_at;
+DROP INDEX IF EXISTS idx_events_status;
+
+ALTER TABLE events
+    DROP CONSTRAINT IF EXISTS events_publish_at_check,
+    DROP CONSTRAINT IF EXISTS events_status_check,
+    DROP COLUMN IF EXISTS cancelled_at,
+    DROP COLUMN IF EXISTS status_reason,
+    DROP COLUMN IF EXISTS publish_at,
+    DROP COLUMN IF EXISTS status;
+
+COMMIT;
@@ -0,0 +1,35 @@
+BEGIN;
+
+-- Existing events were public the moment they were created, so they start out published
+ALTER TABLE events
+    ADD COLUMN status varchar(20) NOT NULL DEFAULT 'PUBLISHED',
+    ADD COLUMN publish_at timestamp with time zone,
+    ADD COLUMN status_reason varchar(255),
+    ADD COLUMN cancelled_at timestamp with time zone;
+
+ALTER TABLE events
+    ADD CONSTRAINT events_status_check CHECK (status IN ('DRAFT', 'SCHEDULED', 'PUBLISHED', 'CANCELLED', 'POSTPONED')),
+    ADD CONSTRAINT events_publish_at_check CHECK (status <> 'SCHEDULED' OR publish_at IS NOT NULL);
+
+CREATE INDEX idx_events_status
+    ON events USING btree
+    (status);
+
+CREATE INDEX idx_events_publish_at
+    ON events USING btree
+    (publish_at)
+    WHERE status = 'SCHEDULED';
+
+ALTER TABLE orders
+    ADD COLUMN refund_status varchar(20),
+    ADD COLUMN refund_eligible_at timestamp with time zone;
+
+ALTER TABLE orders
+    ADD CONSTRAINT orders_refund_status_check CHECK (refund_status IS NULL OR refund_status = 'ELIGIBLE');
+
+CREATE INDEX idx_orders_refund_status
+    ON orders USING btree
+    (refund_status)
+    WHERE refund_status IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_events_starts_at;
+
+ALTER TABLE events
+    DROP CONSTRAINT IF EXISTS events_ends_at_check,
+    DROP COLUMN IF EXISTS ends_at,
+    DROP COLUMN IF EXISTS starts_at,
+    DROP COLUMN IF EXISTS timezone;
+
+ALTER TABLE venues
+    DROP COLUMN IF EXISTS timezone;
+
+COMMIT;
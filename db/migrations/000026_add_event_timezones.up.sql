@@ -0,0 +1,29 @@
+BEGIN;
+
+ALTER TABLE venues
+    ADD COLUMN timezone varchar(64) NOT NULL DEFAULT 'Asia/Jakarta';
+
+-- Events take their zone from the venue. Existing dates and times were entered as Jakarta
+-- wall-clock times, so that is how they are converted to instants.
+ALTER TABLE events
+    ADD COLUMN timezone varchar(64) NOT NULL DEFAULT 'Asia/Jakarta',
+    ADD COLUMN starts_at timestamp with time zone,
+    ADD COLUMN ends_at timestamp with time zone;
+
+UPDATE events
+    SET timezone = venues.timezone
+    FROM venues
+    WHERE venues.id = events.venue_id;
+
+UPDATE events
+    SET starts_at = (date + time) AT TIME ZONE timezone;
+
+ALTER TABLE events
+    ALTER COLUMN starts_at SET NOT NULL,
+    ADD CONSTRAINT events_ends_at_check CHECK (ends_at IS NULL OR ends_at > starts_at);
+
+CREATE INDEX idx_events_starts_at
+    ON events USING btree
+    (starts_at);
+
+COMMIT;
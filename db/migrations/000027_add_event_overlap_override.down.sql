@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_events_venue_starts_at;
+
+ALTER TABLE events
+    DROP CONSTRAINT IF EXISTS events_overlap_overridden_by_fk,
+    DROP COLUMN IF EXISTS overlap_overridden_by,
+    DROP COLUMN IF EXISTS overlap_override_reason;
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+-- Admins may book an event over another one at the same venue, the reason is kept with the event
+ALTER TABLE events
+    ADD COLUMN overlap_override_reason varchar(500),
+    ADD COLUMN overlap_overridden_by varchar(36),
+    ADD CONSTRAINT events_overlap_overridden_by_fk FOREIGN KEY (overlap_overridden_by) REFERENCES users (id);
+
+CREATE INDEX idx_events_venue_starts_at
+    ON events USING btree
+    (venue_id, starts_at)
+    WHERE deleted_at IS NULL AND status <> 'CANCELLED';
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE events
+    DROP CONSTRAINT IF EXISTS events_capacity_override_check,
+    DROP COLUMN IF EXISTS capacity_override;
+
+COMMIT;
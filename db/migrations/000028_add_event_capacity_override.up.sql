@@ -0,0 +1,8 @@
+BEGIN;
+
+-- Events sell up to their venue's capacity unless the organiser sets a capacity of their own
+ALTER TABLE events
+    ADD COLUMN capacity_override integer,
+    ADD CONSTRAINT events_capacity_override_check CHECK (capacity_override IS NULL OR capacity_override > 0);
+
+COMMIT;
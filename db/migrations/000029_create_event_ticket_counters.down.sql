@@ -0,0 +1,15 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS tickets_maintain_counters ON tickets;
+
+DROP FUNCTION IF EXISTS tickets_maintain_counters();
+DROP FUNCTION IF EXISTS ticket_counter_move(integer, varchar, text, text);
+DROP FUNCTION IF EXISTS ticket_counter_apply(integer, varchar, numeric, integer);
+DROP FUNCTION IF EXISTS ticket_counter_state(integer, integer, varchar);
+
+DROP INDEX IF EXISTS idx_tickets_event_id_type;
+
+DROP TABLE IF EXISTS event_ticket_state_counters;
+DROP TABLE IF EXISTS event_ticket_counters;
+
+COMMIT;
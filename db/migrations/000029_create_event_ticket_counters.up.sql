@@ -0,0 +1,161 @@
+BEGIN;
+
+-- Per event and ticket category inventory: how many tickets there are and their price range.
+-- It only changes when tickets are added, removed or repriced, never when they are sold.
+CREATE TABLE IF NOT EXISTS event_ticket_counters (
+    event_id integer NOT NULL,
+    type varchar(20) NOT NULL,
+    total integer NOT NULL DEFAULT 0,
+    min_price numeric(10,2),
+    max_price numeric(10,2),
+    updated_at timestamp with time zone DEFAULT now(),
+    CONSTRAINT event_ticket_counters_pkey PRIMARY KEY (event_id, type),
+    CONSTRAINT event_ticket_counters_event_fk FOREIGN KEY (event_id) REFERENCES events (id),
+    CONSTRAINT event_ticket_counters_total_check CHECK (total >= 0)
+);
+
+-- How many tickets of a category are sold, held or allocated, spread over shards so that
+-- concurrent checkouts for the same category do not queue on one row. A ticket is sold once it
+-- belongs to an order, allocated while it is set aside for a partner, held while a hold token is
+-- on it and available otherwise. A ticket sold on one shard may be released on another, so only
+-- the sum over an event's shards is meaningful.
+CREATE TABLE IF NOT EXISTS event_ticket_state_counters (
+    event_id integer NOT NULL,
+    type varchar(20) NOT NULL,
+    shard smallint NOT NULL,
+    sold integer NOT NULL DEFAULT 0,
+    held integer NOT NULL DEFAULT 0,
+    allocated integer NOT NULL DEFAULT 0,
+    updated_at timestamp with time zone DEFAULT now(),
+    CONSTRAINT event_ticket_state_counters_pkey PRIMARY KEY (event_id, type, shard),
+    CONSTRAINT event_ticket_state_counters_event_fk FOREIGN KEY (event_id) REFERENCES events (id)
+);
+
+-- Lets the price range be recomputed without scanning every ticket
+CREATE INDEX IF NOT EXISTS idx_tickets_event_id_type
+    ON tickets USING btree
+    (event_id, UPPER(type))
+    WHERE deleted_at IS NULL;
+
+CREATE OR REPLACE FUNCTION ticket_counter_state(order_id integer, allocation_id integer, hold_token varchar)
+RETURNS text AS $$
+    SELECT CASE
+        WHEN order_id IS NOT NULL THEN 'SOLD'
+        WHEN allocation_id IS NOT NULL THEN 'ALLOCATED'
+        WHEN hold_token IS NOT NULL THEN 'HELD'
+        ELSE 'AVAILABLE'
+    END;
+$$ LANGUAGE sql IMMUTABLE;
+
+-- ticket_counter_apply adds a ticket to the category's inventory, or removes one with a
+-- negative delta.
+CREATE OR REPLACE FUNCTION ticket_counter_apply(p_event_id integer, p_type varchar, p_price numeric, p_delta integer)
+RETURNS void AS $$
+BEGIN
+    INSERT INTO event_ticket_counters AS c (event_id, type, total, min_price, max_price)
+    VALUES (
+        p_event_id, UPPER(p_type), p_delta,
+        CASE WHEN p_delta > 0 THEN p_price END,
+        CASE WHEN p_delta > 0 THEN p_price END
+    )
+    ON CONFLICT (event_id, type) DO UPDATE SET
+        total = c.total + EXCLUDED.total,
+        min_price = LEAST(c.min_price, EXCLUDED.min_price),
+        max_price = GREATEST(c.max_price, EXCLUDED.max_price),
+        updated_at = now();
+
+    -- A ticket leaving the category may have been its cheapest or dearest one
+    IF p_delta < 0 THEN
+        UPDATE event_ticket_counters
+        SET min_price = prices.min_price, max_price = prices.max_price
+        FROM (
+            SELECT MIN(price) AS min_price, MAX(price) AS max_price
+            FROM tickets
+            WHERE event_id = p_event_id AND UPPER(type) = UPPER(p_type) AND deleted_at IS NULL
+        ) AS prices
+        WHERE event_ticket_counters.event_id = p_event_id
+            AND event_ticket_counters.type = UPPER(p_type)
+            AND (event_ticket_counters.min_price = p_price OR event_ticket_counters.max_price = p_price);
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+-- ticket_counter_move records a ticket going from one state to another, a NULL state meaning it
+-- is not part of the category. The shard follows the backend, so one checkout touches one row
+-- per category while other connections write to theirs.
+CREATE OR REPLACE FUNCTION ticket_counter_move(p_event_id integer, p_type varchar, p_from text, p_to text)
+RETURNS void AS $$
+DECLARE
+    d_sold integer := (CASE WHEN p_to = 'SOLD' THEN 1 ELSE 0 END) - (CASE WHEN p_from = 'SOLD' THEN 1 ELSE 0 END);
+    d_held integer := (CASE WHEN p_to = 'HELD' THEN 1 ELSE 0 END) - (CASE WHEN p_from = 'HELD' THEN 1 ELSE 0 END);
+    d_allocated integer := (CASE WHEN p_to = 'ALLOCATED' THEN 1 ELSE 0 END) - (CASE WHEN p_from = 'ALLOCATED' THEN 1 ELSE 0 END);
+BEGIN
+    IF d_sold = 0 AND d_held = 0 AND d_allocated = 0 THEN
+        RETURN;
+    END IF;
+
+    INSERT INTO event_ticket_state_counters AS c (event_id, type, shard, sold, held, allocated)
+    VALUES (p_event_id, UPPER(p_type), pg_backend_pid() % 16, d_sold, d_held, d_allocated)
+    ON CONFLICT (event_id, type, shard) DO UPDATE SET
+        sold = c.sold + EXCLUDED.sold,
+        held = c.held + EXCLUDED.held,
+        allocated = c.allocated + EXCLUDED.allocated,
+        updated_at = now();
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION tickets_maintain_counters()
+RETURNS trigger AS $$
+DECLARE
+    old_state text;
+    new_state text;
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') AND OLD.deleted_at IS NULL THEN
+        old_state := ticket_counter_state(OLD.order_id, OLD.allocation_id, OLD.hold_token);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') AND NEW.deleted_at IS NULL THEN
+        new_state := ticket_counter_state(NEW.order_id, NEW.allocation_id, NEW.hold_token);
+    END IF;
+
+    -- Selling, holding and releasing a ticket leave the inventory alone
+    IF old_state IS NOT NULL AND new_state IS NOT NULL
+        AND OLD.event_id = NEW.event_id
+        AND UPPER(OLD.type) = UPPER(NEW.type)
+        AND OLD.price = NEW.price THEN
+        PERFORM ticket_counter_move(NEW.event_id, NEW.type, old_state, new_state);
+        RETURN NULL;
+    END IF;
+
+    IF old_state IS NOT NULL THEN
+        PERFORM ticket_counter_apply(OLD.event_id, OLD.type, OLD.price, -1);
+        PERFORM ticket_counter_move(OLD.event_id, OLD.type, old_state, NULL);
+    END IF;
+    IF new_state IS NOT NULL THEN
+        PERFORM ticket_counter_apply(NEW.event_id, NEW.type, NEW.price, 1);
+        PERFORM ticket_counter_move(NEW.event_id, NEW.type, NULL, new_state);
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tickets_maintain_counters
+    AFTER INSERT OR UPDATE OR DELETE ON tickets
+    FOR EACH ROW EXECUTE FUNCTION tickets_maintain_counters();
+
+INSERT INTO event_ticket_counters (event_id, type, total, min_price, max_price)
+SELECT event_id, UPPER(type), COUNT(*), MIN(price), MAX(price)
+FROM tickets
+WHERE deleted_at IS NULL
+GROUP BY event_id, UPPER(type);
+
+INSERT INTO event_ticket_state_counters (event_id, type, shard, sold, held, allocated)
+SELECT event_id, UPPER(type), 0,
+    COUNT(*) FILTER (WHERE ticket_counter_state(order_id, allocation_id, hold_token) = 'SOLD'),
+    COUNT(*) FILTER (WHERE ticket_counter_state(order_id, allocation_id, hold_token) = 'HELD'),
+    COUNT(*) FILTER (WHERE ticket_counter_state(order_id, allocation_id, hold_token) = 'ALLOCATED')
+FROM tickets
+WHERE deleted_at IS NULL
+GROUP BY event_id, UPPER(type);
+
+COMMIT;
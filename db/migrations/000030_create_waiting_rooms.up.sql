@@ -0,0 +1,15 @@
+BEGIN;
+
+-- An event with a waiting room only sells to buyers admitted from its queue, at admission_rate
+-- buyers per minute
+CREATE TABLE IF NOT EXISTS waiting_rooms (
+    event_id integer NOT NULL,
+    admission_rate integer NOT NULL,
+    created_at timestamp with time zone DEFAULT now(),
+    updated_at timestamp with time zone DEFAULT now(),
+    CONSTRAINT waiting_rooms_pkey PRIMARY KEY (event_id),
+    CONSTRAINT waiting_rooms_event_fk FOREIGN KEY (event_id) REFERENCES events (id),
+    CONSTRAINT waiting_rooms_admission_rate_check CHECK (admission_rate > 0)
+    );
+
+COMMIT;
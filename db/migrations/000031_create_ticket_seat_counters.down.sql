@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS ticket_seat_counters;
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+-- The last seat number handed out per event and ticket category. Batches reserve a range with a
+-- single row update, so creating tickets for one event never waits on another.
+CREATE TABLE IF NOT EXISTS ticket_seat_counters (
+    event_id integer NOT NULL,
+    type varchar(20) NOT NULL,
+    last_number integer NOT NULL DEFAULT 0,
+    updated_at timestamp with time zone DEFAULT now(),
+    CONSTRAINT ticket_seat_counters_pkey PRIMARY KEY (event_id, type),
+    CONSTRAINT ticket_seat_counters_event_fk FOREIGN KEY (event_id) REFERENCES events (id),
+    CONSTRAINT ticket_seat_counters_last_number_check CHECK (last_number >= 0)
+    );
+
+-- Deleted tickets keep their numbers so that seats are never handed out twice
+INSERT INTO ticket_seat_counters (event_id, type, last_number)
+SELECT event_id, UPPER(type), MAX(CAST(SPLIT_PART(seat_number, '-', 2) AS INTEGER))
+FROM tickets
+WHERE seat_number ~ '^[A-Z]+-[0-9]+$'
+GROUP BY event_id, UPPER(type);
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+-- A session is one login. Its id is the refresh token family the login started, and
+-- refresh_token_id the jti of the family's latest refresh token
+CREATE TABLE IF NOT EXISTS user_sessions (
+    id varchar(36) NOT NULL,
+    user_id varchar(36) NOT NULL,
+    refresh_token_id varchar(36) NOT NULL,
+    user_agent varchar(512) NOT NULL DEFAULT '',
+    ip varchar(45) NOT NULL DEFAULT '',
+    created_at timestamp with time zone DEFAULT now(),
+    last_seen_at timestamp with time zone NOT NULL DEFAULT now(),
+    expires_at timestamp with time zone NOT NULL,
+    revoked_at timestamp with time zone,
+    CONSTRAINT user_sessions_pkey PRIMARY KEY (id),
+    CONSTRAINT user_sessions_user_fk FOREIGN KEY (user_id) REFERENCES users (id)
+    );
+
+CREATE INDEX IF NOT EXISTS idx_user_sessions_user_id
+    ON user_sessions USING btree (user_id)
+    WHERE revoked_at IS NULL;
+
+COMMIT;
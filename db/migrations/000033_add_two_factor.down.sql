@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP TABLE IF EXISTS role_policies;
+
+DROP TABLE IF EXISTS user_recovery_codes;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS two_factor_last_step,
+    DROP COLUMN IF EXISTS two_factor_enabled,
+    DROP COLUMN IF EXISTS two_factor_secret;
+
+COMMIT;
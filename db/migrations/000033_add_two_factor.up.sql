@@ -0,0 +1,35 @@
+BEGIN;
+
+-- two_factor_secret is set at enrolment, sealed with the application key, and only trusted once
+-- two_factor_enabled is. The last accepted time step is kept so that a code cannot be used twice
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS two_factor_secret varchar(128),
+    ADD COLUMN IF NOT EXISTS two_factor_enabled boolean NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS two_factor_last_step bigint NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS user_recovery_codes (
+    id serial NOT NULL,
+    user_id varchar(36) NOT NULL,
+    code_hash varchar(64) NOT NULL,
+    used_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now(),
+    CONSTRAINT user_recovery_codes_pkey PRIMARY KEY (id),
+    CONSTRAINT user_recovery_codes_user_fk FOREIGN KEY (user_id) REFERENCES users (id)
+    );
+
+CREATE INDEX IF NOT EXISTS idx_user_recovery_codes_user_id
+    ON user_recovery_codes USING btree (user_id)
+    WHERE used_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS role_policies (
+    role varchar(5) NOT NULL,
+    two_factor_required boolean NOT NULL DEFAULT false,
+    updated_at timestamp with time zone DEFAULT now(),
+    CONSTRAINT role_policies_pkey PRIMARY KEY (role),
+    CONSTRAINT role_policies_role_check CHECK (role IN ('admin', 'buyer'))
+    );
+
+INSERT INTO role_policies (role) VALUES ('admin'), ('buyer')
+    ON CONFLICT (role) DO NOTHING;
+
+COMMIT;
@@ -2,29 +2,55 @@ package builder
 
 import (
 	graphql "github.com/TrinityKnights/Backend/internal/delivery/graph/handler"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/allocation"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/attendee"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/cart"
 	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/event"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/exchange"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/group"
 	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/order"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/pass"
 	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/payment"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/product"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/series"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/slot"
 	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/ticket"
 	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/user"
 	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/venue"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/waitingroom"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/waitlist"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/wellknown"
 	rbac "github.com/TrinityKnights/Backend/internal/delivery/http/middleware"
 	"github.com/TrinityKnights/Backend/internal/delivery/http/route"
+	pkgRoute "github.com/TrinityKnights/Backend/pkg/route"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
 
 type Config struct {
-	App            *echo.Echo
-	GraphQLHandler *graphql.GraphQLHandler
-	UserHandler    *user.UserHandlerImpl
-	VenueHandler   *venue.VenueHandlerImpl
-	EventHandler   *event.EventHandlerImpl
-	TicketHandler  *ticket.TicketHandlerImpl
-	OrderHandler   *order.OrderHandlerImpl
-	PaymentHandler *payment.PaymentHandlerImpl
-	AuthMiddleware echo.MiddlewareFunc
-	Routes         *route.Config
+	App                 *echo.Echo
+	GraphQLHandler      *graphql.GraphQLHandler
+	UserHandler         *user.UserHandlerImpl
+	VenueHandler        *venue.VenueHandlerImpl
+	EventHandler        *event.EventHandlerImpl
+	TicketHandler       *ticket.TicketHandlerImpl
+	OrderHandler        *order.OrderHandlerImpl
+	PaymentHandler      *payment.PaymentHandlerImpl
+	WaitlistHandler     *waitlist.WaitlistHandlerImpl
+	AttendeeHandler     *attendee.AttendeeHandlerImpl
+	ExchangeHandler     *exchange.ExchangeHandlerImpl
+	AllocationHandler   *allocation.AllocationHandlerImpl
+	ProductHandler      *product.ProductHandlerImpl
+	CartHandler         *cart.CartHandlerImpl
+	GroupHandler        *group.GroupHandlerImpl
+	PassHandler         *pass.PassHandlerImpl
+	SlotHandler         *slot.SlotHandlerImpl
+	SeriesHandler       *series.SeriesHandlerImpl
+	WaitingRoomHandler  *waitingroom.WaitingRoomHandlerImpl
+	WellKnownHandler    *wellknown.WellKnownHandlerImpl
+	AuthMiddleware      echo.MiddlewareFunc
+	ChallengeMiddleware func(pkgRoute.Challenge) echo.MiddlewareFunc
+	Routes              *route.Config
 }
 
 func (c *Config) BuildRoutes() {
@@ -37,14 +63,14 @@ func (c *Config) BuildRoutes() {
 
 	// Public routes
 	for _, r := range c.Routes.PublicRoute() {
-		g.Add(r.Method, r.Path, r.Handler)
+		g.Add(r.Method, r.Path, r.Handler, c.ChallengeMiddleware(r.Challenge))
 	}
 
 	// Private routes with auth and rbac middleware
 	privateGroup := g.Group("", c.AuthMiddleware)
 	for _, r := range c.Routes.PrivateRoute() {
 		rbacMiddleware := rbac.RBACMiddleware(r.Roles)
-		privateGroup.Add(r.Method, r.Path, rbacMiddleware(r.Handler))
+		privateGroup.Add(r.Method, r.Path, rbacMiddleware(r.Handler), c.ChallengeMiddleware(r.Challenge))
 	}
 
 	// GraphQL routes
@@ -56,6 +82,9 @@ func (c *Config) BuildRoutes() {
 	// Swagger routes
 	c.Routes.SwaggerRoutes()
 
+	// Well-known routes
+	c.Routes.WellKnownRoutes()
+
 	// Not found route
 	c.Routes.NotFoundRoute()
 }
@@ -41,6 +41,8 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	AttendeeAnswerResponse() AttendeeAnswerResponseResolver
+	EventAvailabilityResponse() EventAvailabilityResponseResolver
 	EventResponse() EventResponseResolver
 	Mutation() MutationResolver
 	Query() QueryResolver
@@ -55,19 +57,60 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	AttendeeAnswerResponse struct {
+		Label      func(childComplexity int) int
+		QuestionID func(childComplexity int) int
+		Value      func(childComplexity int) int
+	}
+
+	AttendeeResponse struct {
+		Answers func(childComplexity int) int
+		Email   func(childComplexity int) int
+		Name    func(childComplexity int) int
+	}
+
+	CategoryAvailability struct {
+		Allocated func(childComplexity int) int
+		Available func(childComplexity int) int
+		Held      func(childComplexity int) int
+		MaxPrice  func(childComplexity int) int
+		MinPrice  func(childComplexity int) int
+		Sold      func(childComplexity int) int
+		Total     func(childComplexity int) int
+		Type      func(childComplexity int) int
+	}
+
 	Error struct {
 		Code    func(childComplexity int) int
 		Message func(childComplexity int) int
 	}
 
+	EventAvailabilityResponse struct {
+		Allocated  func(childComplexity int) int
+		Available  func(childComplexity int) int
+		Categories func(childComplexity int) int
+		EventID    func(childComplexity int) int
+		Held       func(childComplexity int) int
+		MaxPrice   func(childComplexity int) int
+		MinPrice   func(childComplexity int) int
+		Sold       func(childComplexity int) int
+		Total      func(childComplexity int) int
+	}
+
 	EventResponse struct {
-		Date        func(childComplexity int) int
-		Description func(childComplexity int) int
-		ID          func(childComplexity int) int
-		Name        func(childComplexity int) int
-		Time        func(childComplexity int) int
-		Venue       func(childComplexity int) int
-		VenueID     func(childComplexity int) int
+		Availability    func(childComplexity int) int
+		Date            func(childComplexity int) int
+		Description     func(childComplexity int) int
+		DurationMinutes func(childComplexity int) int
+		EndsAt          func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Name            func(childComplexity int) int
+		StartsAt        func(childComplexity int) int
+		Status          func(childComplexity int) int
+		Time            func(childComplexity int) int
+		Timezone        func(childComplexity int) int
+		Venue           func(childComplexity int) int
+		VenueID         func(childComplexity int) int
 	}
 
 	EventsResponse struct {
@@ -77,9 +120,9 @@ type ComplexityRoot struct {
 	}
 
 	Mutation struct {
-		CreateEvent  func(childComplexity int, name string, description string, date string, time string, venueID int) int
+		CreateEvent  func(childComplexity int, name string, description string, date string, time string, venueID int, durationMinutes *int) int
 		CreateTicket func(childComplexity int, input graphmodel.CreateTicketInput) int
-		CreateVenue  func(childComplexity int, name string, address string, capacity int, city string, state string, zip string) int
+		CreateVenue  func(childComplexity int, name string, address string, capacity int, city string, state string, zip string, timezone *string) int
 		UpdateEvent  func(childComplexity int, id int, input graphmodel.UpdateEventInput) int
 		UpdateTicket func(childComplexity int, id string, input graphmodel.UpdateTicketInput) int
 		UpdateVenue  func(childComplexity int, id int, input graphmodel.UpdateVenueInput) int
@@ -108,12 +151,12 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
-		Event          func(childComplexity int, id int) int
-		Events         func(childComplexity int, page *int, size *int, sort *string, order *string) int
+		Event          func(childComplexity int, id int, tz *string) int
+		Events         func(childComplexity int, page *int, size *int, sort *string, order *string, tz *string) int
 		Payment        func(childComplexity int, id int) int
 		Payments       func(childComplexity int, page *int, size *int, sort *string, order *string) int
 		Profile        func(childComplexity int) int
-		SearchEvents   func(childComplexity int, name *string, description *string, date *string, time *string, venueID *int, page *int, size *int, sort *string, order *string) int
+		SearchEvents   func(childComplexity int, name *string, description *string, date *string, time *string, venueID *int, page *int, size *int, sort *string, order *string, tz *string) int
 		SearchPayments func(childComplexity int, id *int, orderID *int, amount *float64, status *string, page *int, size *int, sort *string, order *string) int
 		SearchTickets  func(childComplexity int, id *string, eventID *int, orderID *int, price *float64, typeArg *string, seatNumber *string, page *int, size *int, sort *string, order *string) int
 		SearchVenues   func(childComplexity int, name *string, address *string, capacity *int, city *string, state *string, zip *string, page *int, size *int, sort *string, order *string) int
@@ -129,6 +172,7 @@ type ComplexityRoot struct {
 	}
 
 	TicketResponse struct {
+		Attendee   func(childComplexity int) int
 		CreatedAt  func(childComplexity int) int
 		EventID    func(childComplexity int) int
 		ID         func(childComplexity int) int
@@ -162,6 +206,7 @@ type ComplexityRoot struct {
 		ID       func(childComplexity int) int
 		Name     func(childComplexity int) int
 		State    func(childComplexity int) int
+		Timezone func(childComplexity int) int
 		Zip      func(childComplexity int) int
 	}
 
@@ -172,25 +217,33 @@ type ComplexityRoot struct {
 	}
 }
 
+type AttendeeAnswerResponseResolver interface {
+	QuestionID(ctx context.Context, obj *model.AttendeeAnswerResponse) (int, error)
+}
+type EventAvailabilityResponseResolver interface {
+	EventID(ctx context.Context, obj *model.EventAvailabilityResponse) (int, error)
+}
 type EventResponseResolver interface {
 	ID(ctx context.Context, obj *model.EventResponse) (int, error)
 
 	Time(ctx context.Context, obj *model.EventResponse) (*time.Time, error)
 	VenueID(ctx context.Context, obj *model.EventResponse) (int, error)
 	Venue(ctx context.Context, obj *model.EventResponse) (*model.VenueResponse, error)
+
+	Availability(ctx context.Context, obj *model.EventResponse) (*model.EventAvailabilityResponse, error)
 }
 type MutationResolver interface {
-	CreateEvent(ctx context.Context, name string, description string, date string, time string, venueID int) (*model.EventResponse, error)
+	CreateEvent(ctx context.Context, name string, description string, date string, time string, venueID int, durationMinutes *int) (*model.EventResponse, error)
 	UpdateEvent(ctx context.Context, id int, input graphmodel.UpdateEventInput) (*model.EventResponse, error)
-	CreateVenue(ctx context.Context, name string, address string, capacity int, city string, state string, zip string) (*model.VenueResponse, error)
+	CreateVenue(ctx context.Context, name string, address string, capacity int, city string, state string, zip string, timezone *string) (*model.VenueResponse, error)
 	UpdateVenue(ctx context.Context, id int, input graphmodel.UpdateVenueInput) (*model.VenueResponse, error)
 	CreateTicket(ctx context.Context, input graphmodel.CreateTicketInput) ([]*graphmodel.TicketResponse, error)
 	UpdateTicket(ctx context.Context, id string, input graphmodel.UpdateTicketInput) (*graphmodel.TicketResponse, error)
 }
 type QueryResolver interface {
-	Event(ctx context.Context, id int) (*model.EventResponse, error)
-	Events(ctx context.Context, page *int, size *int, sort *string, order *string) (*graphmodel.EventsResponse, error)
-	SearchEvents(ctx context.Context, name *string, description *string, date *string, time *string, venueID *int, page *int, size *int, sort *string, order *string) (*graphmodel.EventsResponse, error)
+	Event(ctx context.Context, id int, tz *string) (*model.EventResponse, error)
+	Events(ctx context.Context, page *int, size *int, sort *string, order *string, tz *string) (*graphmodel.EventsResponse, error)
+	SearchEvents(ctx context.Context, name *string, description *string, date *string, time *string, venueID *int, page *int, size *int, sort *string, order *string, tz *string) (*graphmodel.EventsResponse, error)
 	Ticket(ctx context.Context, id string) (*graphmodel.TicketResponse, error)
 	Tickets(ctx context.Context, page *int, size *int, sort *string, order *string) (*graphmodel.TicketsResponse, error)
 	SearchTickets(ctx context.Context, id *string, eventID *int, orderID *int, price *float64, typeArg *string, seatNumber *string, page *int, size *int, sort *string, order *string) (*graphmodel.TicketsResponse, error)
@@ -229,6 +282,104 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "AttendeeAnswerResponse.label":
+		if e.complexity.AttendeeAnswerResponse.Label == nil {
+			break
+		}
+
+		return e.complexity.AttendeeAnswerResponse.Label(childComplexity), true
+
+	case "AttendeeAnswerResponse.questionId":
+		if e.complexity.AttendeeAnswerResponse.QuestionID == nil {
+			break
+		}
+
+		return e.complexity.AttendeeAnswerResponse.QuestionID(childComplexity), true
+
+	case "AttendeeAnswerResponse.value":
+		if e.complexity.AttendeeAnswerResponse.Value == nil {
+			break
+		}
+
+		return e.complexity.AttendeeAnswerResponse.Value(childComplexity), true
+
+	case "AttendeeResponse.answers":
+		if e.complexity.AttendeeResponse.Answers == nil {
+			break
+		}
+
+		return e.complexity.AttendeeResponse.Answers(childComplexity), true
+
+	case "AttendeeResponse.email":
+		if e.complexity.AttendeeResponse.Email == nil {
+			break
+		}
+
+		return e.complexity.AttendeeResponse.Email(childComplexity), true
+
+	case "AttendeeResponse.name":
+		if e.complexity.AttendeeResponse.Name == nil {
+			break
+		}
+
+		return e.complexity.AttendeeResponse.Name(childComplexity), true
+
+	case "CategoryAvailability.allocated":
+		if e.complexity.CategoryAvailability.Allocated == nil {
+			break
+		}
+
+		return e.complexity.CategoryAvailability.Allocated(childComplexity), true
+
+	case "CategoryAvailability.available":
+		if e.complexity.CategoryAvailability.Available == nil {
+			break
+		}
+
+		return e.complexity.CategoryAvailability.Available(childComplexity), true
+
+	case "CategoryAvailability.held":
+		if e.complexity.CategoryAvailability.Held == nil {
+			break
+		}
+
+		return e.complexity.CategoryAvailability.Held(childComplexity), true
+
+	case "CategoryAvailability.maxPrice":
+		if e.complexity.CategoryAvailability.MaxPrice == nil {
+			break
+		}
+
+		return e.complexity.CategoryAvailability.MaxPrice(childComplexity), true
+
+	case "CategoryAvailability.minPrice":
+		if e.complexity.CategoryAvailability.MinPrice == nil {
+			break
+		}
+
+		return e.complexity.CategoryAvailability.MinPrice(childComplexity), true
+
+	case "CategoryAvailability.sold":
+		if e.complexity.CategoryAvailability.Sold == nil {
+			break
+		}
+
+		return e.complexity.CategoryAvailability.Sold(childComplexity), true
+
+	case "CategoryAvailability.total":
+		if e.complexity.CategoryAvailability.Total == nil {
+			break
+		}
+
+		return e.complexity.CategoryAvailability.Total(childComplexity), true
+
+	case "CategoryAvailability.type":
+		if e.complexity.CategoryAvailability.Type == nil {
+			break
+		}
+
+		return e.complexity.CategoryAvailability.Type(childComplexity), true
+
 	case "Error.code":
 		if e.complexity.Error.Code == nil {
 			break
@@ -243,6 +394,76 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Error.Message(childComplexity), true
 
+	case "EventAvailabilityResponse.allocated":
+		if e.complexity.EventAvailabilityResponse.Allocated == nil {
+			break
+		}
+
+		return e.complexity.EventAvailabilityResponse.Allocated(childComplexity), true
+
+	case "EventAvailabilityResponse.available":
+		if e.complexity.EventAvailabilityResponse.Available == nil {
+			break
+		}
+
+		return e.complexity.EventAvailabilityResponse.Available(childComplexity), true
+
+	case "EventAvailabilityResponse.categories":
+		if e.complexity.EventAvailabilityResponse.Categories == nil {
+			break
+		}
+
+		return e.complexity.EventAvailabilityResponse.Categories(childComplexity), true
+
+	case "EventAvailabilityResponse.eventId":
+		if e.complexity.EventAvailabilityResponse.EventID == nil {
+			break
+		}
+
+		return e.complexity.EventAvailabilityResponse.EventID(childComplexity), true
+
+	case "EventAvailabilityResponse.held":
+		if e.complexity.EventAvailabilityResponse.Held == nil {
+			break
+		}
+
+		return e.complexity.EventAvailabilityResponse.Held(childComplexity), true
+
+	case "EventAvailabilityResponse.maxPrice":
+		if e.complexity.EventAvailabilityResponse.MaxPrice == nil {
+			break
+		}
+
+		return e.complexity.EventAvailabilityResponse.MaxPrice(childComplexity), true
+
+	case "EventAvailabilityResponse.minPrice":
+		if e.complexity.EventAvailabilityResponse.MinPrice == nil {
+			break
+		}
+
+		return e.complexity.EventAvailabilityResponse.MinPrice(childComplexity), true
+
+	case "EventAvailabilityResponse.sold":
+		if e.complexity.EventAvailabilityResponse.Sold == nil {
+			break
+		}
+
+		return e.complexity.EventAvailabilityResponse.Sold(childComplexity), true
+
+	case "EventAvailabilityResponse.total":
+		if e.complexity.EventAvailabilityResponse.Total == nil {
+			break
+		}
+
+		return e.complexity.EventAvailabilityResponse.Total(childComplexity), true
+
+	case "EventResponse.availability":
+		if e.complexity.EventResponse.Availability == nil {
+			break
+		}
+
+		return e.complexity.EventResponse.Availability(childComplexity), true
+
 	case "EventResponse.date":
 		if e.complexity.EventResponse.Date == nil {
 			break
@@ -257,6 +478,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.EventResponse.Description(childComplexity), true
 
+	case "EventResponse.durationMinutes":
+		if e.complexity.EventResponse.DurationMinutes == nil {
+			break
+		}
+
+		return e.complexity.EventResponse.DurationMinutes(childComplexity), true
+
+	case "EventResponse.endsAt":
+		if e.complexity.EventResponse.EndsAt == nil {
+			break
+		}
+
+		return e.complexity.EventResponse.EndsAt(childComplexity), true
+
 	case "EventResponse.id":
 		if e.complexity.EventResponse.ID == nil {
 			break
@@ -271,6 +506,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.EventResponse.Name(childComplexity), true
 
+	case "EventResponse.startsAt":
+		if e.complexity.EventResponse.StartsAt == nil {
+			break
+		}
+
+		return e.complexity.EventResponse.StartsAt(childComplexity), true
+
+	case "EventResponse.status":
+		if e.complexity.EventResponse.Status == nil {
+			break
+		}
+
+		return e.complexity.EventResponse.Status(childComplexity), true
+
 	case "EventResponse.time":
 		if e.complexity.EventResponse.Time == nil {
 			break
@@ -278,6 +527,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.EventResponse.Time(childComplexity), true
 
+	case "EventResponse.timezone":
+		if e.complexity.EventResponse.Timezone == nil {
+			break
+		}
+
+		return e.complexity.EventResponse.Timezone(childComplexity), true
+
 	case "EventResponse.venue":
 		if e.complexity.EventResponse.Venue == nil {
 			break
@@ -323,7 +579,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.CreateEvent(childComplexity, args["name"].(string), args["description"].(string), args["date"].(string), args["time"].(string), args["venueId"].(int)), true
+		return e.complexity.Mutation.CreateEvent(childComplexity, args["name"].(string), args["description"].(string), args["date"].(string), args["time"].(string), args["venueId"].(int), args["durationMinutes"].(*int)), true
 
 	case "Mutation.createTicket":
 		if e.complexity.Mutation.CreateTicket == nil {
@@ -347,7 +603,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.CreateVenue(childComplexity, args["name"].(string), args["address"].(string), args["capacity"].(int), args["city"].(string), args["state"].(string), args["zip"].(string)), true
+		return e.complexity.Mutation.CreateVenue(childComplexity, args["name"].(string), args["address"].(string), args["capacity"].(int), args["city"].(string), args["state"].(string), args["zip"].(string), args["timezone"].(*string)), true
 
 	case "Mutation.updateEvent":
 		if e.complexity.Mutation.UpdateEvent == nil {
@@ -486,7 +742,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.Event(childComplexity, args["id"].(int)), true
+		return e.complexity.Query.Event(childComplexity, args["id"].(int), args["tz"].(*string)), true
 
 	case "Query.events":
 		if e.complexity.Query.Events == nil {
@@ -498,7 +754,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.Events(childComplexity, args["page"].(*int), args["size"].(*int), args["sort"].(*string), args["order"].(*string)), true
+		return e.complexity.Query.Events(childComplexity, args["page"].(*int), args["size"].(*int), args["sort"].(*string), args["order"].(*string), args["tz"].(*string)), true
 
 	case "Query.payment":
 		if e.complexity.Query.Payment == nil {
@@ -541,7 +797,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.SearchEvents(childComplexity, args["name"].(*string), args["description"].(*string), args["date"].(*string), args["time"].(*string), args["venueId"].(*int), args["page"].(*int), args["size"].(*int), args["sort"].(*string), args["order"].(*string)), true
+		return e.complexity.Query.SearchEvents(childComplexity, args["name"].(*string), args["description"].(*string), args["date"].(*string), args["time"].(*string), args["venueId"].(*int), args["page"].(*int), args["size"].(*int), args["sort"].(*string), args["order"].(*string), args["tz"].(*string)), true
 
 	case "Query.searchPayments":
 		if e.complexity.Query.SearchPayments == nil {
@@ -641,6 +897,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Response.Paging(childComplexity), true
 
+	case "TicketResponse.attendee":
+		if e.complexity.TicketResponse.Attendee == nil {
+			break
+		}
+
+		return e.complexity.TicketResponse.Attendee(childComplexity), true
+
 	case "TicketResponse.createdAt":
 		if e.complexity.TicketResponse.CreatedAt == nil {
 			break
@@ -809,6 +1072,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VenueResponse.State(childComplexity), true
 
+	case "VenueResponse.timezone":
+		if e.complexity.VenueResponse.Timezone == nil {
+			break
+		}
+
+		return e.complexity.VenueResponse.Timezone(childComplexity), true
+
 	case "VenueResponse.zip":
 		if e.complexity.VenueResponse.Zip == nil {
 			break
@@ -994,6 +1264,11 @@ func (ec *executionContext) field_Mutation_createEvent_args(ctx context.Context,
 		return nil, err
 	}
 	args["venueId"] = arg4
+	arg5, err := ec.field_Mutation_createEvent_argsDurationMinutes(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["durationMinutes"] = arg5
 	return args, nil
 }
 func (ec *executionContext) field_Mutation_createEvent_argsName(
@@ -1061,6 +1336,19 @@ func (ec *executionContext) field_Mutation_createEvent_argsVenueID(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_createEvent_argsDurationMinutes(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*int, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("durationMinutes"))
+	if tmp, ok := rawArgs["durationMinutes"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_createTicket_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1117,6 +1405,11 @@ func (ec *executionContext) field_Mutation_createVenue_args(ctx context.Context,
 		return nil, err
 	}
 	args["zip"] = arg5
+	arg6, err := ec.field_Mutation_createVenue_argsTimezone(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["timezone"] = arg6
 	return args, nil
 }
 func (ec *executionContext) field_Mutation_createVenue_argsName(
@@ -1197,6 +1490,19 @@ func (ec *executionContext) field_Mutation_createVenue_argsZip(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_createVenue_argsTimezone(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("timezone"))
+	if tmp, ok := rawArgs["timezone"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_updateEvent_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1351,6 +1657,11 @@ func (ec *executionContext) field_Query_event_args(ctx context.Context, rawArgs
 		return nil, err
 	}
 	args["id"] = arg0
+	arg1, err := ec.field_Query_event_argsTz(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["tz"] = arg1
 	return args, nil
 }
 func (ec *executionContext) field_Query_event_argsID(
@@ -1366,6 +1677,19 @@ func (ec *executionContext) field_Query_event_argsID(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_event_argsTz(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("tz"))
+	if tmp, ok := rawArgs["tz"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_events_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1389,6 +1713,11 @@ func (ec *executionContext) field_Query_events_args(ctx context.Context, rawArgs
 		return nil, err
 	}
 	args["order"] = arg3
+	arg4, err := ec.field_Query_events_argsTz(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["tz"] = arg4
 	return args, nil
 }
 func (ec *executionContext) field_Query_events_argsPage(
@@ -1443,6 +1772,19 @@ func (ec *executionContext) field_Query_events_argsOrder(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_events_argsTz(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("tz"))
+	if tmp, ok := rawArgs["tz"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_payment_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1591,6 +1933,11 @@ func (ec *executionContext) field_Query_searchEvents_args(ctx context.Context, r
 		return nil, err
 	}
 	args["order"] = arg8
+	arg9, err := ec.field_Query_searchEvents_argsTz(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["tz"] = arg9
 	return args, nil
 }
 func (ec *executionContext) field_Query_searchEvents_argsName(
@@ -1710,6 +2057,19 @@ func (ec *executionContext) field_Query_searchEvents_argsOrder(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_searchEvents_argsTz(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("tz"))
+	if tmp, ok := rawArgs["tz"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_searchPayments_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2483,8 +2843,8 @@ func (ec *executionContext) field___Type_fields_argsIncludeDeprecated(
 
 // region    **************************** field.gotpl *****************************
 
-func (ec *executionContext) _Error_code(ctx context.Context, field graphql.CollectedField, obj *graphmodel.Error) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Error_code(ctx, field)
+func (ec *executionContext) _AttendeeAnswerResponse_questionId(ctx context.Context, field graphql.CollectedField, obj *model.AttendeeAnswerResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AttendeeAnswerResponse_questionId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2497,7 +2857,7 @@ func (ec *executionContext) _Error_code(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		return ec.resolvers.AttendeeAnswerResponse().QuestionID(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2514,12 +2874,12 @@ func (ec *executionContext) _Error_code(ctx context.Context, field graphql.Colle
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Error_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AttendeeAnswerResponse_questionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Error",
+		Object:     "AttendeeAnswerResponse",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type Int does not have child fields")
 		},
@@ -2527,8 +2887,8 @@ func (ec *executionContext) fieldContext_Error_code(_ context.Context, field gra
 	return fc, nil
 }
 
-func (ec *executionContext) _Error_message(ctx context.Context, field graphql.CollectedField, obj *graphmodel.Error) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Error_message(ctx, field)
+func (ec *executionContext) _AttendeeAnswerResponse_label(ctx context.Context, field graphql.CollectedField, obj *model.AttendeeAnswerResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AttendeeAnswerResponse_label(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2541,7 +2901,7 @@ func (ec *executionContext) _Error_message(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.Label, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2558,9 +2918,9 @@ func (ec *executionContext) _Error_message(ctx context.Context, field graphql.Co
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Error_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AttendeeAnswerResponse_label(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Error",
+		Object:     "AttendeeAnswerResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2571,8 +2931,8 @@ func (ec *executionContext) fieldContext_Error_message(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _EventResponse_id(ctx context.Context, field graphql.CollectedField, obj *model.EventResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_EventResponse_id(ctx, field)
+func (ec *executionContext) _AttendeeAnswerResponse_value(ctx context.Context, field graphql.CollectedField, obj *model.AttendeeAnswerResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AttendeeAnswerResponse_value(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2585,7 +2945,7 @@ func (ec *executionContext) _EventResponse_id(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.EventResponse().ID(rctx, obj)
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2597,26 +2957,26 @@ func (ec *executionContext) _EventResponse_id(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_EventResponse_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AttendeeAnswerResponse_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EventResponse",
+		Object:     "AttendeeAnswerResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _EventResponse_name(ctx context.Context, field graphql.CollectedField, obj *model.EventResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_EventResponse_name(ctx, field)
+func (ec *executionContext) _AttendeeResponse_name(ctx context.Context, field graphql.CollectedField, obj *model.AttendeeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AttendeeResponse_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2646,9 +3006,9 @@ func (ec *executionContext) _EventResponse_name(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_EventResponse_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AttendeeResponse_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EventResponse",
+		Object:     "AttendeeResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2659,8 +3019,8 @@ func (ec *executionContext) fieldContext_EventResponse_name(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _EventResponse_description(ctx context.Context, field graphql.CollectedField, obj *model.EventResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_EventResponse_description(ctx, field)
+func (ec *executionContext) _AttendeeResponse_email(ctx context.Context, field graphql.CollectedField, obj *model.AttendeeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AttendeeResponse_email(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2673,26 +3033,23 @@ func (ec *executionContext) _EventResponse_description(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Email, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_EventResponse_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AttendeeResponse_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EventResponse",
+		Object:     "AttendeeResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2703,8 +3060,8 @@ func (ec *executionContext) fieldContext_EventResponse_description(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _EventResponse_date(ctx context.Context, field graphql.CollectedField, obj *model.EventResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_EventResponse_date(ctx, field)
+func (ec *executionContext) _AttendeeResponse_answers(ctx context.Context, field graphql.CollectedField, obj *model.AttendeeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AttendeeResponse_answers(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2717,31 +3074,1054 @@ func (ec *executionContext) _EventResponse_date(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Date, nil
+		return obj.Answers, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.([]model.AttendeeAnswerResponse)
 	fc.Result = res
-	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOAttendeeAnswerResponse2ᚕgithubᚗcomᚋTrinityKnightsᚋBackendᚋinternalᚋdomainᚋmodelᚐAttendeeAnswerResponseᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_EventResponse_date(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AttendeeResponse_answers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EventResponse",
+		Object:     "AttendeeResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			switch field.Name {
+			case "questionId":
+				return ec.fieldContext_AttendeeAnswerResponse_questionId(ctx, field)
+			case "label":
+				return ec.fieldContext_AttendeeAnswerResponse_label(ctx, field)
+			case "value":
+				return ec.fieldContext_AttendeeAnswerResponse_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AttendeeAnswerResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CategoryAvailability_type(ctx context.Context, field graphql.CollectedField, obj *model.CategoryAvailability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CategoryAvailability_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CategoryAvailability_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CategoryAvailability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CategoryAvailability_total(ctx context.Context, field graphql.CollectedField, obj *model.CategoryAvailability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CategoryAvailability_total(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Total, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CategoryAvailability_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CategoryAvailability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CategoryAvailability_held(ctx context.Context, field graphql.CollectedField, obj *model.CategoryAvailability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CategoryAvailability_held(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Held, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CategoryAvailability_held(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CategoryAvailability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CategoryAvailability_sold(ctx context.Context, field graphql.CollectedField, obj *model.CategoryAvailability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CategoryAvailability_sold(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Sold, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CategoryAvailability_sold(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CategoryAvailability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CategoryAvailability_allocated(ctx context.Context, field graphql.CollectedField, obj *model.CategoryAvailability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CategoryAvailability_allocated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Allocated, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CategoryAvailability_allocated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CategoryAvailability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CategoryAvailability_available(ctx context.Context, field graphql.CollectedField, obj *model.CategoryAvailability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CategoryAvailability_available(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Available, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CategoryAvailability_available(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CategoryAvailability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CategoryAvailability_minPrice(ctx context.Context, field graphql.CollectedField, obj *model.CategoryAvailability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CategoryAvailability_minPrice(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MinPrice, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CategoryAvailability_minPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CategoryAvailability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CategoryAvailability_maxPrice(ctx context.Context, field graphql.CollectedField, obj *model.CategoryAvailability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CategoryAvailability_maxPrice(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxPrice, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CategoryAvailability_maxPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CategoryAvailability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Error_code(ctx context.Context, field graphql.CollectedField, obj *graphmodel.Error) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Error_code(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Error_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Error",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Error_message(ctx context.Context, field graphql.CollectedField, obj *graphmodel.Error) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Error_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Error_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Error",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventAvailabilityResponse_eventId(ctx context.Context, field graphql.CollectedField, obj *model.EventAvailabilityResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventAvailabilityResponse_eventId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.EventAvailabilityResponse().EventID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventAvailabilityResponse_eventId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventAvailabilityResponse",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventAvailabilityResponse_total(ctx context.Context, field graphql.CollectedField, obj *model.EventAvailabilityResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventAvailabilityResponse_total(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Total, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventAvailabilityResponse_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventAvailabilityResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventAvailabilityResponse_held(ctx context.Context, field graphql.CollectedField, obj *model.EventAvailabilityResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventAvailabilityResponse_held(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Held, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventAvailabilityResponse_held(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventAvailabilityResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventAvailabilityResponse_sold(ctx context.Context, field graphql.CollectedField, obj *model.EventAvailabilityResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventAvailabilityResponse_sold(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Sold, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventAvailabilityResponse_sold(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventAvailabilityResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventAvailabilityResponse_allocated(ctx context.Context, field graphql.CollectedField, obj *model.EventAvailabilityResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventAvailabilityResponse_allocated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Allocated, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventAvailabilityResponse_allocated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventAvailabilityResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventAvailabilityResponse_available(ctx context.Context, field graphql.CollectedField, obj *model.EventAvailabilityResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventAvailabilityResponse_available(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Available, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventAvailabilityResponse_available(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventAvailabilityResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventAvailabilityResponse_minPrice(ctx context.Context, field graphql.CollectedField, obj *model.EventAvailabilityResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventAvailabilityResponse_minPrice(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MinPrice, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventAvailabilityResponse_minPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventAvailabilityResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventAvailabilityResponse_maxPrice(ctx context.Context, field graphql.CollectedField, obj *model.EventAvailabilityResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventAvailabilityResponse_maxPrice(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxPrice, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventAvailabilityResponse_maxPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventAvailabilityResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventAvailabilityResponse_categories(ctx context.Context, field graphql.CollectedField, obj *model.EventAvailabilityResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventAvailabilityResponse_categories(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Categories, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.CategoryAvailability)
+	fc.Result = res
+	return ec.marshalNCategoryAvailability2ᚕᚖgithubᚗcomᚋTrinityKnightsᚋBackendᚋinternalᚋdomainᚋmodelᚐCategoryAvailabilityᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventAvailabilityResponse_categories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventAvailabilityResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "type":
+				return ec.fieldContext_CategoryAvailability_type(ctx, field)
+			case "total":
+				return ec.fieldContext_CategoryAvailability_total(ctx, field)
+			case "held":
+				return ec.fieldContext_CategoryAvailability_held(ctx, field)
+			case "sold":
+				return ec.fieldContext_CategoryAvailability_sold(ctx, field)
+			case "allocated":
+				return ec.fieldContext_CategoryAvailability_allocated(ctx, field)
+			case "available":
+				return ec.fieldContext_CategoryAvailability_available(ctx, field)
+			case "minPrice":
+				return ec.fieldContext_CategoryAvailability_minPrice(ctx, field)
+			case "maxPrice":
+				return ec.fieldContext_CategoryAvailability_maxPrice(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CategoryAvailability", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventResponse_id(ctx context.Context, field graphql.CollectedField, obj *model.EventResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventResponse_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.EventResponse().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventResponse_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventResponse",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventResponse_name(ctx context.Context, field graphql.CollectedField, obj *model.EventResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventResponse_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventResponse_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventResponse_description(ctx context.Context, field graphql.CollectedField, obj *model.EventResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventResponse_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventResponse_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventResponse_date(ctx context.Context, field graphql.CollectedField, obj *model.EventResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventResponse_date(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Date, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventResponse_date(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
@@ -2858,12 +4238,285 @@ func (ec *executionContext) _EventResponse_venue(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.VenueResponse)
+	res := resTmp.(*model.VenueResponse)
+	fc.Result = res
+	return ec.marshalOVenueResponse2ᚖgithubᚗcomᚋTrinityKnightsᚋBackendᚋinternalᚋdomainᚋmodelᚐVenueResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventResponse_venue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventResponse",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_VenueResponse_id(ctx, field)
+			case "name":
+				return ec.fieldContext_VenueResponse_name(ctx, field)
+			case "address":
+				return ec.fieldContext_VenueResponse_address(ctx, field)
+			case "capacity":
+				return ec.fieldContext_VenueResponse_capacity(ctx, field)
+			case "city":
+				return ec.fieldContext_VenueResponse_city(ctx, field)
+			case "state":
+				return ec.fieldContext_VenueResponse_state(ctx, field)
+			case "zip":
+				return ec.fieldContext_VenueResponse_zip(ctx, field)
+			case "timezone":
+				return ec.fieldContext_VenueResponse_timezone(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VenueResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.EventResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventResponse_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventResponse_startsAt(ctx context.Context, field graphql.CollectedField, obj *model.EventResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventResponse_startsAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartsAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventResponse_startsAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventResponse_endsAt(ctx context.Context, field graphql.CollectedField, obj *model.EventResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventResponse_endsAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndsAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventResponse_endsAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventResponse_durationMinutes(ctx context.Context, field graphql.CollectedField, obj *model.EventResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventResponse_durationMinutes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DurationMinutes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventResponse_durationMinutes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventResponse_timezone(ctx context.Context, field graphql.CollectedField, obj *model.EventResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventResponse_timezone(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timezone, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EventResponse_timezone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventResponse_availability(ctx context.Context, field graphql.CollectedField, obj *model.EventResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EventResponse_availability(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.EventResponse().Availability(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.EventAvailabilityResponse)
 	fc.Result = res
-	return ec.marshalOVenueResponse2ᚖgithubᚗcomᚋTrinityKnightsᚋBackendᚋinternalᚋdomainᚋmodelᚐVenueResponse(ctx, field.Selections, res)
+	return ec.marshalOEventAvailabilityResponse2ᚖgithubᚗcomᚋTrinityKnightsᚋBackendᚋinternalᚋdomainᚋmodelᚐEventAvailabilityResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_EventResponse_venue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EventResponse_availability(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "EventResponse",
 		Field:      field,
@@ -2871,22 +4524,26 @@ func (ec *executionContext) fieldContext_EventResponse_venue(_ context.Context,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_VenueResponse_id(ctx, field)
-			case "name":
-				return ec.fieldContext_VenueResponse_name(ctx, field)
-			case "address":
-				return ec.fieldContext_VenueResponse_address(ctx, field)
-			case "capacity":
-				return ec.fieldContext_VenueResponse_capacity(ctx, field)
-			case "city":
-				return ec.fieldContext_VenueResponse_city(ctx, field)
-			case "state":
-				return ec.fieldContext_VenueResponse_state(ctx, field)
-			case "zip":
-				return ec.fieldContext_VenueResponse_zip(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type VenueResponse", field.Name)
+			case "eventId":
+				return ec.fieldContext_EventAvailabilityResponse_eventId(ctx, field)
+			case "total":
+				return ec.fieldContext_EventAvailabilityResponse_total(ctx, field)
+			case "held":
+				return ec.fieldContext_EventAvailabilityResponse_held(ctx, field)
+			case "sold":
+				return ec.fieldContext_EventAvailabilityResponse_sold(ctx, field)
+			case "allocated":
+				return ec.fieldContext_EventAvailabilityResponse_allocated(ctx, field)
+			case "available":
+				return ec.fieldContext_EventAvailabilityResponse_available(ctx, field)
+			case "minPrice":
+				return ec.fieldContext_EventAvailabilityResponse_minPrice(ctx, field)
+			case "maxPrice":
+				return ec.fieldContext_EventAvailabilityResponse_maxPrice(ctx, field)
+			case "categories":
+				return ec.fieldContext_EventAvailabilityResponse_categories(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EventAvailabilityResponse", field.Name)
 		},
 	}
 	return fc, nil
@@ -2942,6 +4599,18 @@ func (ec *executionContext) fieldContext_EventsResponse_data(_ context.Context,
 				return ec.fieldContext_EventResponse_venueId(ctx, field)
 			case "venue":
 				return ec.fieldContext_EventResponse_venue(ctx, field)
+			case "status":
+				return ec.fieldContext_EventResponse_status(ctx, field)
+			case "startsAt":
+				return ec.fieldContext_EventResponse_startsAt(ctx, field)
+			case "endsAt":
+				return ec.fieldContext_EventResponse_endsAt(ctx, field)
+			case "durationMinutes":
+				return ec.fieldContext_EventResponse_durationMinutes(ctx, field)
+			case "timezone":
+				return ec.fieldContext_EventResponse_timezone(ctx, field)
+			case "availability":
+				return ec.fieldContext_EventResponse_availability(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type EventResponse", field.Name)
 		},
@@ -3062,7 +4731,7 @@ func (ec *executionContext) _Mutation_createEvent(ctx context.Context, field gra
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().CreateEvent(rctx, fc.Args["name"].(string), fc.Args["description"].(string), fc.Args["date"].(string), fc.Args["time"].(string), fc.Args["venueId"].(int))
+			return ec.resolvers.Mutation().CreateEvent(rctx, fc.Args["name"].(string), fc.Args["description"].(string), fc.Args["date"].(string), fc.Args["time"].(string), fc.Args["venueId"].(int), fc.Args["durationMinutes"].(*int))
 		}
 
 		directive1 := func(ctx context.Context) (interface{}, error) {
@@ -3122,6 +4791,18 @@ func (ec *executionContext) fieldContext_Mutation_createEvent(ctx context.Contex
 				return ec.fieldContext_EventResponse_venueId(ctx, field)
 			case "venue":
 				return ec.fieldContext_EventResponse_venue(ctx, field)
+			case "status":
+				return ec.fieldContext_EventResponse_status(ctx, field)
+			case "startsAt":
+				return ec.fieldContext_EventResponse_startsAt(ctx, field)
+			case "endsAt":
+				return ec.fieldContext_EventResponse_endsAt(ctx, field)
+			case "durationMinutes":
+				return ec.fieldContext_EventResponse_durationMinutes(ctx, field)
+			case "timezone":
+				return ec.fieldContext_EventResponse_timezone(ctx, field)
+			case "availability":
+				return ec.fieldContext_EventResponse_availability(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type EventResponse", field.Name)
 		},
@@ -3215,6 +4896,18 @@ func (ec *executionContext) fieldContext_Mutation_updateEvent(ctx context.Contex
 				return ec.fieldContext_EventResponse_venueId(ctx, field)
 			case "venue":
 				return ec.fieldContext_EventResponse_venue(ctx, field)
+			case "status":
+				return ec.fieldContext_EventResponse_status(ctx, field)
+			case "startsAt":
+				return ec.fieldContext_EventResponse_startsAt(ctx, field)
+			case "endsAt":
+				return ec.fieldContext_EventResponse_endsAt(ctx, field)
+			case "durationMinutes":
+				return ec.fieldContext_EventResponse_durationMinutes(ctx, field)
+			case "timezone":
+				return ec.fieldContext_EventResponse_timezone(ctx, field)
+			case "availability":
+				return ec.fieldContext_EventResponse_availability(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type EventResponse", field.Name)
 		},
@@ -3248,7 +4941,7 @@ func (ec *executionContext) _Mutation_createVenue(ctx context.Context, field gra
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().CreateVenue(rctx, fc.Args["name"].(string), fc.Args["address"].(string), fc.Args["capacity"].(int), fc.Args["city"].(string), fc.Args["state"].(string), fc.Args["zip"].(string))
+			return ec.resolvers.Mutation().CreateVenue(rctx, fc.Args["name"].(string), fc.Args["address"].(string), fc.Args["capacity"].(int), fc.Args["city"].(string), fc.Args["state"].(string), fc.Args["zip"].(string), fc.Args["timezone"].(*string))
 		}
 
 		directive1 := func(ctx context.Context) (interface{}, error) {
@@ -3308,6 +5001,8 @@ func (ec *executionContext) fieldContext_Mutation_createVenue(ctx context.Contex
 				return ec.fieldContext_VenueResponse_state(ctx, field)
 			case "zip":
 				return ec.fieldContext_VenueResponse_zip(ctx, field)
+			case "timezone":
+				return ec.fieldContext_VenueResponse_timezone(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type VenueResponse", field.Name)
 		},
@@ -3401,6 +5096,8 @@ func (ec *executionContext) fieldContext_Mutation_updateVenue(ctx context.Contex
 				return ec.fieldContext_VenueResponse_state(ctx, field)
 			case "zip":
 				return ec.fieldContext_VenueResponse_zip(ctx, field)
+			case "timezone":
+				return ec.fieldContext_VenueResponse_timezone(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type VenueResponse", field.Name)
 		},
@@ -3492,6 +5189,8 @@ func (ec *executionContext) fieldContext_Mutation_createTicket(ctx context.Conte
 				return ec.fieldContext_TicketResponse_type(ctx, field)
 			case "seatNumber":
 				return ec.fieldContext_TicketResponse_seatNumber(ctx, field)
+			case "attendee":
+				return ec.fieldContext_TicketResponse_attendee(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_TicketResponse_createdAt(ctx, field)
 			case "updatedAt":
@@ -3587,6 +5286,8 @@ func (ec *executionContext) fieldContext_Mutation_updateTicket(ctx context.Conte
 				return ec.fieldContext_TicketResponse_type(ctx, field)
 			case "seatNumber":
 				return ec.fieldContext_TicketResponse_seatNumber(ctx, field)
+			case "attendee":
+				return ec.fieldContext_TicketResponse_attendee(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_TicketResponse_createdAt(ctx, field)
 			case "updatedAt":
@@ -4214,7 +5915,7 @@ func (ec *executionContext) _Query_event(ctx context.Context, field graphql.Coll
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Query().Event(rctx, fc.Args["id"].(int))
+			return ec.resolvers.Query().Event(rctx, fc.Args["id"].(int), fc.Args["tz"].(*string))
 		}
 
 		directive1 := func(ctx context.Context) (interface{}, error) {
@@ -4274,6 +5975,18 @@ func (ec *executionContext) fieldContext_Query_event(ctx context.Context, field
 				return ec.fieldContext_EventResponse_venueId(ctx, field)
 			case "venue":
 				return ec.fieldContext_EventResponse_venue(ctx, field)
+			case "status":
+				return ec.fieldContext_EventResponse_status(ctx, field)
+			case "startsAt":
+				return ec.fieldContext_EventResponse_startsAt(ctx, field)
+			case "endsAt":
+				return ec.fieldContext_EventResponse_endsAt(ctx, field)
+			case "durationMinutes":
+				return ec.fieldContext_EventResponse_durationMinutes(ctx, field)
+			case "timezone":
+				return ec.fieldContext_EventResponse_timezone(ctx, field)
+			case "availability":
+				return ec.fieldContext_EventResponse_availability(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type EventResponse", field.Name)
 		},
@@ -4307,7 +6020,7 @@ func (ec *executionContext) _Query_events(ctx context.Context, field graphql.Col
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Query().Events(rctx, fc.Args["page"].(*int), fc.Args["size"].(*int), fc.Args["sort"].(*string), fc.Args["order"].(*string))
+			return ec.resolvers.Query().Events(rctx, fc.Args["page"].(*int), fc.Args["size"].(*int), fc.Args["sort"].(*string), fc.Args["order"].(*string), fc.Args["tz"].(*string))
 		}
 
 		directive1 := func(ctx context.Context) (interface{}, error) {
@@ -4392,7 +6105,7 @@ func (ec *executionContext) _Query_searchEvents(ctx context.Context, field graph
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Query().SearchEvents(rctx, fc.Args["name"].(*string), fc.Args["description"].(*string), fc.Args["date"].(*string), fc.Args["time"].(*string), fc.Args["venueId"].(*int), fc.Args["page"].(*int), fc.Args["size"].(*int), fc.Args["sort"].(*string), fc.Args["order"].(*string))
+			return ec.resolvers.Query().SearchEvents(rctx, fc.Args["name"].(*string), fc.Args["description"].(*string), fc.Args["date"].(*string), fc.Args["time"].(*string), fc.Args["venueId"].(*int), fc.Args["page"].(*int), fc.Args["size"].(*int), fc.Args["sort"].(*string), fc.Args["order"].(*string), fc.Args["tz"].(*string))
 		}
 
 		directive1 := func(ctx context.Context) (interface{}, error) {
@@ -4535,6 +6248,8 @@ func (ec *executionContext) fieldContext_Query_ticket(ctx context.Context, field
 				return ec.fieldContext_TicketResponse_type(ctx, field)
 			case "seatNumber":
 				return ec.fieldContext_TicketResponse_seatNumber(ctx, field)
+			case "attendee":
+				return ec.fieldContext_TicketResponse_attendee(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_TicketResponse_createdAt(ctx, field)
 			case "updatedAt":
@@ -4884,6 +6599,8 @@ func (ec *executionContext) fieldContext_Query_venue(ctx context.Context, field
 				return ec.fieldContext_VenueResponse_state(ctx, field)
 			case "zip":
 				return ec.fieldContext_VenueResponse_zip(ctx, field)
+			case "timezone":
+				return ec.fieldContext_VenueResponse_timezone(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type VenueResponse", field.Name)
 		},
@@ -5821,6 +7538,55 @@ func (ec *executionContext) fieldContext_TicketResponse_seatNumber(_ context.Con
 	return fc, nil
 }
 
+func (ec *executionContext) _TicketResponse_attendee(ctx context.Context, field graphql.CollectedField, obj *graphmodel.TicketResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TicketResponse_attendee(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Attendee, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.AttendeeResponse)
+	fc.Result = res
+	return ec.marshalOAttendeeResponse2ᚖgithubᚗcomᚋTrinityKnightsᚋBackendᚋinternalᚋdomainᚋmodelᚐAttendeeResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TicketResponse_attendee(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TicketResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_AttendeeResponse_name(ctx, field)
+			case "email":
+				return ec.fieldContext_AttendeeResponse_email(ctx, field)
+			case "answers":
+				return ec.fieldContext_AttendeeResponse_answers(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AttendeeResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _TicketResponse_createdAt(ctx context.Context, field graphql.CollectedField, obj *graphmodel.TicketResponse) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TicketResponse_createdAt(ctx, field)
 	if err != nil {
@@ -5951,6 +7717,8 @@ func (ec *executionContext) fieldContext_TicketsResponse_data(_ context.Context,
 				return ec.fieldContext_TicketResponse_type(ctx, field)
 			case "seatNumber":
 				return ec.fieldContext_TicketResponse_seatNumber(ctx, field)
+			case "attendee":
+				return ec.fieldContext_TicketResponse_attendee(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_TicketResponse_createdAt(ctx, field)
 			case "updatedAt":
@@ -6670,6 +8438,50 @@ func (ec *executionContext) fieldContext_VenueResponse_zip(_ context.Context, fi
 	return fc, nil
 }
 
+func (ec *executionContext) _VenueResponse_timezone(ctx context.Context, field graphql.CollectedField, obj *model.VenueResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VenueResponse_timezone(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timezone, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VenueResponse_timezone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VenueResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _VenuesResponse_data(ctx context.Context, field graphql.CollectedField, obj *graphmodel.VenuesResponse) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_VenuesResponse_data(ctx, field)
 	if err != nil {
@@ -6720,6 +8532,8 @@ func (ec *executionContext) fieldContext_VenuesResponse_data(_ context.Context,
 				return ec.fieldContext_VenueResponse_state(ctx, field)
 			case "zip":
 				return ec.fieldContext_VenueResponse_zip(ctx, field)
+			case "timezone":
+				return ec.fieldContext_VenueResponse_timezone(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type VenueResponse", field.Name)
 		},
@@ -8653,7 +10467,7 @@ func (ec *executionContext) unmarshalInputUpdateEventInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name", "description", "date", "time", "venueId"}
+	fieldsInOrder := [...]string{"name", "description", "date", "time", "venueId", "durationMinutes"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -8695,6 +10509,13 @@ func (ec *executionContext) unmarshalInputUpdateEventInput(ctx context.Context,
 				return it, err
 			}
 			it.VenueID = data
+		case "durationMinutes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("durationMinutes"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DurationMinutes = data
 		}
 	}
 
@@ -8797,7 +10618,7 @@ func (ec *executionContext) unmarshalInputUpdateVenueInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name", "address", "capacity", "city", "state", "zip"}
+	fieldsInOrder := [...]string{"name", "address", "capacity", "city", "state", "zip", "timezone"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -8846,6 +10667,13 @@ func (ec *executionContext) unmarshalInputUpdateVenueInput(ctx context.Context,
 				return it, err
 			}
 			it.Zip = data
+		case "timezone":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timezone"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Timezone = data
 		}
 	}
 
@@ -8854,11 +10682,202 @@ func (ec *executionContext) unmarshalInputUpdateVenueInput(ctx context.Context,
 
 // endregion **************************** input.gotpl *****************************
 
-// region    ************************** interface.gotpl ***************************
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var attendeeAnswerResponseImplementors = []string{"AttendeeAnswerResponse"}
+
+func (ec *executionContext) _AttendeeAnswerResponse(ctx context.Context, sel ast.SelectionSet, obj *model.AttendeeAnswerResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, attendeeAnswerResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AttendeeAnswerResponse")
+		case "questionId":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AttendeeAnswerResponse_questionId(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "label":
+			out.Values[i] = ec._AttendeeAnswerResponse_label(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "value":
+			out.Values[i] = ec._AttendeeAnswerResponse_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var attendeeResponseImplementors = []string{"AttendeeResponse"}
+
+func (ec *executionContext) _AttendeeResponse(ctx context.Context, sel ast.SelectionSet, obj *model.AttendeeResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, attendeeResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AttendeeResponse")
+		case "name":
+			out.Values[i] = ec._AttendeeResponse_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "email":
+			out.Values[i] = ec._AttendeeResponse_email(ctx, field, obj)
+		case "answers":
+			out.Values[i] = ec._AttendeeResponse_answers(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var categoryAvailabilityImplementors = []string{"CategoryAvailability"}
+
+func (ec *executionContext) _CategoryAvailability(ctx context.Context, sel ast.SelectionSet, obj *model.CategoryAvailability) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, categoryAvailabilityImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CategoryAvailability")
+		case "type":
+			out.Values[i] = ec._CategoryAvailability_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "total":
+			out.Values[i] = ec._CategoryAvailability_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "held":
+			out.Values[i] = ec._CategoryAvailability_held(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sold":
+			out.Values[i] = ec._CategoryAvailability_sold(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "allocated":
+			out.Values[i] = ec._CategoryAvailability_allocated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "available":
+			out.Values[i] = ec._CategoryAvailability_available(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "minPrice":
+			out.Values[i] = ec._CategoryAvailability_minPrice(ctx, field, obj)
+		case "maxPrice":
+			out.Values[i] = ec._CategoryAvailability_maxPrice(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-// endregion ************************** interface.gotpl ***************************
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-// region    **************************** object.gotpl ****************************
+	return out
+}
 
 var errorImplementors = []string{"Error"}
 
@@ -8904,6 +10923,110 @@ func (ec *executionContext) _Error(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
+var eventAvailabilityResponseImplementors = []string{"EventAvailabilityResponse"}
+
+func (ec *executionContext) _EventAvailabilityResponse(ctx context.Context, sel ast.SelectionSet, obj *model.EventAvailabilityResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, eventAvailabilityResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("EventAvailabilityResponse")
+		case "eventId":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._EventAvailabilityResponse_eventId(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "total":
+			out.Values[i] = ec._EventAvailabilityResponse_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "held":
+			out.Values[i] = ec._EventAvailabilityResponse_held(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "sold":
+			out.Values[i] = ec._EventAvailabilityResponse_sold(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "allocated":
+			out.Values[i] = ec._EventAvailabilityResponse_allocated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "available":
+			out.Values[i] = ec._EventAvailabilityResponse_available(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "minPrice":
+			out.Values[i] = ec._EventAvailabilityResponse_minPrice(ctx, field, obj)
+		case "maxPrice":
+			out.Values[i] = ec._EventAvailabilityResponse_maxPrice(ctx, field, obj)
+		case "categories":
+			out.Values[i] = ec._EventAvailabilityResponse_categories(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var eventResponseImplementors = []string{"EventResponse"}
 
 func (ec *executionContext) _EventResponse(ctx context.Context, sel ast.SelectionSet, obj *model.EventResponse) graphql.Marshaler {
@@ -9070,6 +11193,58 @@ func (ec *executionContext) _EventResponse(ctx context.Context, sel ast.Selectio
 				continue
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "status":
+			out.Values[i] = ec._EventResponse_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "startsAt":
+			out.Values[i] = ec._EventResponse_startsAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "endsAt":
+			out.Values[i] = ec._EventResponse_endsAt(ctx, field, obj)
+		case "durationMinutes":
+			out.Values[i] = ec._EventResponse_durationMinutes(ctx, field, obj)
+		case "timezone":
+			out.Values[i] = ec._EventResponse_timezone(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "availability":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._EventResponse_availability(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -9785,6 +11960,8 @@ func (ec *executionContext) _TicketResponse(ctx context.Context, sel ast.Selecti
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "attendee":
+			out.Values[i] = ec._TicketResponse_attendee(ctx, field, obj)
 		case "createdAt":
 			out.Values[i] = ec._TicketResponse_createdAt(ctx, field, obj)
 		case "updatedAt":
@@ -10054,6 +12231,11 @@ func (ec *executionContext) _VenueResponse(ctx context.Context, sel ast.Selectio
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "timezone":
+			out.Values[i] = ec._VenueResponse_timezone(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10443,6 +12625,10 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) marshalNAttendeeAnswerResponse2githubᚗcomᚋTrinityKnightsᚋBackendᚋinternalᚋdomainᚋmodelᚐAttendeeAnswerResponse(ctx context.Context, sel ast.SelectionSet, v model.AttendeeAnswerResponse) graphql.Marshaler {
+	return ec._AttendeeAnswerResponse(ctx, sel, &v)
+}
+
 func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
 	res, err := graphql.UnmarshalBoolean(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -10458,6 +12644,60 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
+func (ec *executionContext) marshalNCategoryAvailability2ᚕᚖgithubᚗcomᚋTrinityKnightsᚋBackendᚋinternalᚋdomainᚋmodelᚐCategoryAvailabilityᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CategoryAvailability) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCategoryAvailability2ᚖgithubᚗcomᚋTrinityKnightsᚋBackendᚋinternalᚋdomainᚋmodelᚐCategoryAvailability(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCategoryAvailability2ᚖgithubᚗcomᚋTrinityKnightsᚋBackendᚋinternalᚋdomainᚋmodelᚐCategoryAvailability(ctx context.Context, sel ast.SelectionSet, v *model.CategoryAvailability) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CategoryAvailability(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNCreateTicketInput2githubᚗcomᚋTrinityKnightsᚋBackendᚋinternalᚋdeliveryᚋgraphᚋmodelᚐCreateTicketInput(ctx context.Context, v interface{}) (graphmodel.CreateTicketInput, error) {
 	res, err := ec.unmarshalInputCreateTicketInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -11012,6 +13252,60 @@ func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel a
 	return res
 }
 
+func (ec *executionContext) marshalOAttendeeAnswerResponse2ᚕgithubᚗcomᚋTrinityKnightsᚋBackendᚋinternalᚋdomainᚋmodelᚐAttendeeAnswerResponseᚄ(ctx context.Context, sel ast.SelectionSet, v []model.AttendeeAnswerResponse) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAttendeeAnswerResponse2githubᚗcomᚋTrinityKnightsᚋBackendᚋinternalᚋdomainᚋmodelᚐAttendeeAnswerResponse(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalOAttendeeResponse2ᚖgithubᚗcomᚋTrinityKnightsᚋBackendᚋinternalᚋdomainᚋmodelᚐAttendeeResponse(ctx context.Context, sel ast.SelectionSet, v *model.AttendeeResponse) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._AttendeeResponse(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
 	res, err := graphql.UnmarshalBoolean(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -11061,6 +13355,13 @@ func (ec *executionContext) marshalOError2ᚖgithubᚗcomᚋTrinityKnightsᚋBac
 	return ec._Error(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOEventAvailabilityResponse2ᚖgithubᚗcomᚋTrinityKnightsᚋBackendᚋinternalᚋdomainᚋmodelᚐEventAvailabilityResponse(ctx context.Context, sel ast.SelectionSet, v *model.EventAvailabilityResponse) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._EventAvailabilityResponse(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOEventResponse2ᚕᚖgithubᚗcomᚋTrinityKnightsᚋBackendᚋinternalᚋdomainᚋmodelᚐEventResponseᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.EventResponse) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -11194,6 +13495,16 @@ func (ec *executionContext) marshalOPaymentResponse2ᚕᚖgithubᚗcomᚋTrinity
 	return ret
 }
 
+func (ec *executionContext) unmarshalOString2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	return res
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
 	if v == nil {
 		return nil, nil
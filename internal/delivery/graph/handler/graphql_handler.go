@@ -11,7 +11,9 @@ import (
 	"github.com/TrinityKnights/Backend/internal/delivery/graph"
 	"github.com/TrinityKnights/Backend/internal/delivery/graph/resolvers"
 	"github.com/TrinityKnights/Backend/pkg/jwt"
+	"github.com/TrinityKnights/Backend/pkg/tokenstore"
 	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
 )
 
 const contextKey = "claims"
@@ -19,12 +21,16 @@ const contextKey = "claims"
 type GraphQLHandler struct {
 	resolver   *resolvers.Resolver
 	jwtService jwt.JWTService
+	tokenStore tokenstore.TokenStore
+	log        *logrus.Logger
 }
 
-func NewGraphQLHandler(resolver *resolvers.Resolver, jwtService jwt.JWTService) *GraphQLHandler {
+func NewGraphQLHandler(resolver *resolvers.Resolver, jwtService jwt.JWTService, tokenStore tokenstore.TokenStore, log *logrus.Logger) *GraphQLHandler {
 	return &GraphQLHandler{
 		resolver:   resolver,
 		jwtService: jwtService,
+		tokenStore: tokenStore,
+		log:        log,
 	}
 }
 
@@ -68,8 +74,8 @@ func (h *GraphQLHandler) GraphQLHandler(c echo.Context) error {
 	if authHeader != "" {
 		bearerToken := strings.Split(authHeader, " ")
 		if len(bearerToken) == 2 && bearerToken[0] == "Bearer" {
-			claims, err := h.jwtService.ValidateToken(bearerToken[1])
-			if err == nil {
+			claims, err := h.jwtService.ValidateAccessToken(bearerToken[1])
+			if err == nil && !h.isRevoked(c.Request().Context(), claims) {
 				// If token is valid, add claims to context
 				ctx := context.WithValue(c.Request().Context(), contextKey, claims)
 				c.SetRequest(c.Request().WithContext(ctx))
@@ -88,3 +94,14 @@ func (h *GraphQLHandler) PlaygroundHandler(c echo.Context) error {
 	playgroundHandler.ServeHTTP(c.Response(), c.Request())
 	return nil
 }
+
+// isRevoked reports whether the token was logged out. Like the REST middleware, it lets the
+// token through when the denylist cannot be read.
+func (h *GraphQLHandler) isRevoked(ctx context.Context, claims *jwt.JWTClaims) bool {
+	denied, err := h.tokenStore.IsDenied(ctx, claims.UserID, claims.FamilyID, claims.ID)
+	if err != nil {
+		h.log.Warnf("failed to check token revocation, letting it through: %v", err)
+		return false
+	}
+	return denied
+}
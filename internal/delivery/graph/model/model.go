@@ -60,14 +60,15 @@ type Response struct {
 }
 
 type TicketResponse struct {
-	ID         string     `json:"id"`
-	EventID    int        `json:"eventId"`
-	OrderID    *int       `json:"orderId,omitempty"`
-	Price      float64    `json:"price"`
-	Type       string     `json:"type"`
-	SeatNumber string     `json:"seatNumber"`
-	CreatedAt  *time.Time `json:"createdAt,omitempty"`
-	UpdatedAt  *time.Time `json:"updatedAt,omitempty"`
+	ID         string                  `json:"id"`
+	EventID    int                     `json:"eventId"`
+	OrderID    *int                    `json:"orderId,omitempty"`
+	Price      float64                 `json:"price"`
+	Type       string                  `json:"type"`
+	SeatNumber string                  `json:"seatNumber"`
+	Attendee   *model.AttendeeResponse `json:"attendee,omitempty"`
+	CreatedAt  *time.Time              `json:"createdAt,omitempty"`
+	UpdatedAt  *time.Time              `json:"updatedAt,omitempty"`
 }
 
 type TicketsResponse struct {
@@ -77,11 +78,12 @@ type TicketsResponse struct {
 }
 
 type UpdateEventInput struct {
-	Name        *string `json:"name,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Date        *string `json:"date,omitempty"`
-	Time        *string `json:"time,omitempty"`
-	VenueID     *int    `json:"venueId,omitempty"`
+	Name            *string `json:"name,omitempty"`
+	Description     *string `json:"description,omitempty"`
+	Date            *string `json:"date,omitempty"`
+	Time            *string `json:"time,omitempty"`
+	VenueID         *int    `json:"venueId,omitempty"`
+	DurationMinutes *int    `json:"durationMinutes,omitempty"`
 }
 
 type UpdateTicketInput struct {
@@ -104,6 +106,7 @@ type UpdateVenueInput struct {
 	City     *string `json:"city,omitempty"`
 	State    *string `json:"state,omitempty"`
 	Zip      *string `json:"zip,omitempty"`
+	Timezone *string `json:"timezone,omitempty"`
 }
 
 type VenuesResponse struct {
@@ -6,13 +6,26 @@ package resolvers
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/TrinityKnights/Backend/internal/delivery/graph"
 	graphmodel "github.com/TrinityKnights/Backend/internal/delivery/graph/model"
 	"github.com/TrinityKnights/Backend/internal/domain/model"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/helper"
 )
 
+// QuestionID is the resolver for the questionId field.
+func (r *attendeeAnswerResponseResolver) QuestionID(ctx context.Context, obj *model.AttendeeAnswerResponse) (int, error) {
+	return int(obj.QuestionID), nil
+}
+
+// EventID is the resolver for the eventId field.
+func (r *eventAvailabilityResponseResolver) EventID(ctx context.Context, obj *model.EventAvailabilityResponse) (int, error) {
+	return int(obj.EventID), nil
+}
+
 // ID is the resolver for the id field.
 func (r *eventResponseResolver) ID(ctx context.Context, obj *model.EventResponse) (int, error) {
 	return int(obj.ID), nil
@@ -40,14 +53,30 @@ func (r *eventResponseResolver) Venue(ctx context.Context, obj *model.EventRespo
 	return venue, nil
 }
 
+// Availability is the resolver for the availability field.
+func (r *eventResponseResolver) Availability(ctx context.Context, obj *model.EventResponse) (*model.EventAvailabilityResponse, error) {
+	availability, err := r.TicketService.GetEventAvailability(ctx, &model.GetEventAvailabilityRequest{
+		EventID: obj.ID,
+	})
+	if err != nil {
+		// Events that are not listed to buyers have no availability to show
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return availability, nil
+}
+
 // CreateEvent is the resolver for the createEvent field.
-func (r *mutationResolver) CreateEvent(ctx context.Context, name string, description string, date string, time string, venueID int) (*model.EventResponse, error) {
+func (r *mutationResolver) CreateEvent(ctx context.Context, name string, description string, date string, time string, venueID int, durationMinutes *int) (*model.EventResponse, error) {
 	event, err := r.EventService.CreateEvent(ctx, &model.CreateEventRequest{
-		Name:        name,
-		Description: description,
-		Date:        date,
-		Time:        time,
-		VenueID:     uint(venueID),
+		Name:            name,
+		Description:     description,
+		Date:            date,
+		Time:            time,
+		VenueID:         uint(venueID),
+		DurationMinutes: helper.IntOrZero(durationMinutes),
 	})
 	if err != nil {
 		return nil, err
@@ -58,12 +87,13 @@ func (r *mutationResolver) CreateEvent(ctx context.Context, name string, descrip
 // UpdateEvent is the resolver for the updateEvent field.
 func (r *mutationResolver) UpdateEvent(ctx context.Context, id int, input graphmodel.UpdateEventInput) (*model.EventResponse, error) {
 	event, err := r.EventService.UpdateEvent(ctx, &model.UpdateEventRequest{
-		ID:          uint(id),
-		Name:        *input.Name,
-		Description: *input.Description,
-		Date:        *input.Date,
-		Time:        *input.Time,
-		VenueID:     uint(*input.VenueID),
+		ID:              uint(id),
+		Name:            helper.StringOrEmpty(input.Name),
+		Description:     helper.StringOrEmpty(input.Description),
+		Date:            helper.StringOrEmpty(input.Date),
+		Time:            helper.StringOrEmpty(input.Time),
+		VenueID:         uint(helper.IntOrZero(input.VenueID)),
+		DurationMinutes: helper.IntOrZero(input.DurationMinutes),
 	})
 	if err != nil {
 		return nil, err
@@ -72,7 +102,7 @@ func (r *mutationResolver) UpdateEvent(ctx context.Context, id int, input graphm
 }
 
 // CreateVenue is the resolver for the createVenue field.
-func (r *mutationResolver) CreateVenue(ctx context.Context, name string, address string, capacity int, city string, state string, zip string) (*model.VenueResponse, error) {
+func (r *mutationResolver) CreateVenue(ctx context.Context, name string, address string, capacity int, city string, state string, zip string, timezone *string) (*model.VenueResponse, error) {
 	venue, err := r.VenueService.CreateVenue(ctx, &model.CreateVenueRequest{
 		Name:     name,
 		Address:  address,
@@ -80,6 +110,7 @@ func (r *mutationResolver) CreateVenue(ctx context.Context, name string, address
 		City:     city,
 		State:    state,
 		Zip:      zip,
+		Timezone: helper.StringOrEmpty(timezone),
 	})
 	if err != nil {
 		return nil, err
@@ -97,6 +128,7 @@ func (r *mutationResolver) UpdateVenue(ctx context.Context, id int, input graphm
 		City:     *input.City,
 		State:    *input.State,
 		Zip:      *input.Zip,
+		Timezone: helper.StringOrEmpty(input.Timezone),
 	})
 	if err != nil {
 		return nil, err
@@ -158,9 +190,10 @@ func (r *mutationResolver) UpdateTicket(ctx context.Context, id string, input gr
 }
 
 // Event is the resolver for the event field.
-func (r *queryResolver) Event(ctx context.Context, id int) (*model.EventResponse, error) {
+func (r *queryResolver) Event(ctx context.Context, id int, tz *string) (*model.EventResponse, error) {
 	event, err := r.EventService.GetEventByID(ctx, &model.GetEventRequest{
 		ID: uint(id),
+		TZ: helper.StringOrEmpty(tz),
 	})
 	if err != nil {
 		return nil, err
@@ -169,7 +202,7 @@ func (r *queryResolver) Event(ctx context.Context, id int) (*model.EventResponse
 }
 
 // Events is the resolver for the events field.
-func (r *queryResolver) Events(ctx context.Context, page *int, size *int, sort *string, order *string) (*graphmodel.EventsResponse, error) {
+func (r *queryResolver) Events(ctx context.Context, page *int, size *int, sort *string, order *string, tz *string) (*graphmodel.EventsResponse, error) {
 	// Set default values
 	defaultPage := 1
 	defaultSize := 10
@@ -202,6 +235,7 @@ func (r *queryResolver) Events(ctx context.Context, page *int, size *int, sort *
 		Size:  requestSize,
 		Sort:  requestSort,
 		Order: requestOrder,
+		TZ:    helper.StringOrEmpty(tz),
 	})
 	if err != nil {
 		return nil, err
@@ -214,7 +248,7 @@ func (r *queryResolver) Events(ctx context.Context, page *int, size *int, sort *
 }
 
 // SearchEvents is the resolver for the searchEvents field.
-func (r *queryResolver) SearchEvents(ctx context.Context, name *string, description *string, date *string, time *string, venueID *int, page *int, size *int, sort *string, order *string) (*graphmodel.EventsResponse, error) {
+func (r *queryResolver) SearchEvents(ctx context.Context, name *string, description *string, date *string, time *string, venueID *int, page *int, size *int, sort *string, order *string, tz *string) (*graphmodel.EventsResponse, error) {
 	defaultPage := 1
 	defaultSize := 10
 	defaultSort := "created_at"
@@ -275,6 +309,7 @@ func (r *queryResolver) SearchEvents(ctx context.Context, name *string, descript
 		Size:        requestSize,
 		Sort:        requestSort,
 		Order:       requestOrder,
+		TZ:          helper.StringOrEmpty(tz),
 	}
 
 	events, err := r.EventService.SearchEvents(ctx, request)
@@ -305,6 +340,7 @@ func (r *queryResolver) Ticket(ctx context.Context, id string) (*graphmodel.Tick
 		Price:      ticket.Price,
 		Type:       ticket.Type,
 		SeatNumber: ticket.SeatNumber,
+		Attendee:   ticket.Attendee,
 	}, nil
 }
 
@@ -356,6 +392,7 @@ func (r *queryResolver) Tickets(ctx context.Context, page *int, size *int, sort
 				Price:      ticket.Price,
 				Type:       ticket.Type,
 				SeatNumber: ticket.SeatNumber,
+				Attendee:   ticket.Attendee,
 			}
 		}
 	}
@@ -447,6 +484,7 @@ func (r *queryResolver) SearchTickets(ctx context.Context, id *string, eventID *
 				Price:      ticket.Price,
 				Type:       ticket.Type,
 				SeatNumber: ticket.SeatNumber,
+				Attendee:   ticket.Attendee,
 			}
 		}
 	}
@@ -779,6 +817,16 @@ func (r *venueResponseResolver) ID(ctx context.Context, obj *model.VenueResponse
 	return int(obj.ID), nil
 }
 
+// AttendeeAnswerResponse returns graph.AttendeeAnswerResponseResolver implementation.
+func (r *Resolver) AttendeeAnswerResponse() graph.AttendeeAnswerResponseResolver {
+	return &attendeeAnswerResponseResolver{r}
+}
+
+// EventAvailabilityResponse returns graph.EventAvailabilityResponseResolver implementation.
+func (r *Resolver) EventAvailabilityResponse() graph.EventAvailabilityResponseResolver {
+	return &eventAvailabilityResponseResolver{r}
+}
+
 // EventResponse returns graph.EventResponseResolver implementation.
 func (r *Resolver) EventResponse() graph.EventResponseResolver { return &eventResponseResolver{r} }
 
@@ -794,6 +842,8 @@ func (r *Resolver) UserResponse() graph.UserResponseResolver { return &userRespo
 // VenueResponse returns graph.VenueResponseResolver implementation.
 func (r *Resolver) VenueResponse() graph.VenueResponseResolver { return &venueResponseResolver{r} }
 
+type attendeeAnswerResponseResolver struct{ *Resolver }
+type eventAvailabilityResponseResolver struct{ *Resolver }
 type eventResponseResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
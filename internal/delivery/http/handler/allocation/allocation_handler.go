@@ -0,0 +1,13 @@
+package allocation
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+type AllocationHandler interface {
+	CreateAllocation(ctx echo.Context) error
+	GetAllocations(ctx echo.Context) error
+	GetAllocationByID(ctx echo.Context) error
+	IssueComps(ctx echo.Context) error
+	ReleaseAllocation(ctx echo.Context) error
+}
@@ -0,0 +1,210 @@
+package allocation
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/service/allocation"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type AllocationHandlerImpl struct {
+	Log               *logrus.Logger
+	AllocationService allocation.AllocationService
+}
+
+func NewAllocationHandler(log *logrus.Logger, allocationService allocation.AllocationService) AllocationHandler {
+	return &AllocationHandlerImpl{
+		Log:               log,
+		AllocationService: allocationService,
+	}
+}
+
+// @Summary Create an allocation
+// @Description Hold back a block of tickets from public sale for a named partner
+// @Tags allocations
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body model.CreateAllocationRequest true "Allocation details"
+// @Success 201 {object} model.Response[model.AllocationResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/allocations [post]
+func (h *AllocationHandlerImpl) CreateAllocation(ctx echo.Context) error {
+	request := new(model.CreateAllocationRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.AllocationService.CreateAllocation(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to create allocation: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrNotEnoughTickets):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Get event allocations
+// @Description Get the allocations of an event with issued and remaining ticket counts
+// @Tags allocations
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} model.Response[[]model.AllocationResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/allocations [get]
+func (h *AllocationHandlerImpl) GetAllocations(ctx echo.Context) error {
+	request := new(model.AllocationsRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.AllocationService.GetAllocations(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Get an allocation
+// @Description Get an allocation by ID
+// @Tags allocations
+// @Produce json
+// @Param id path int true "Allocation ID"
+// @Success 200 {object} model.Response[model.AllocationResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /allocations/{id} [get]
+func (h *AllocationHandlerImpl) GetAllocationByID(ctx echo.Context) error {
+	request := new(model.GetAllocationRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.AllocationService.GetAllocationByID(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Issue complimentary tickets
+// @Description Issue zero-priced complimentary orders from an allocation, one per recipient. Recipients receive their tickets by email.
+// @Tags allocations
+// @Accept json
+// @Produce json
+// @Param id path int true "Allocation ID"
+// @Param request body model.IssueCompsRequest true "Recipients"
+// @Success 201 {object} model.Response[[]model.OrderResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /allocations/{id}/comps [post]
+func (h *AllocationHandlerImpl) IssueComps(ctx echo.Context) error {
+	request := new(model.IssueCompsRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.AllocationService.IssueComps(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to issue complimentary tickets: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrNotEnoughTickets):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Release an allocation
+// @Description Return unissued tickets of an allocation to general sale. Releases all of them unless a quantity is given.
+// @Tags allocations
+// @Accept json
+// @Produce json
+// @Param id path int true "Allocation ID"
+// @Param request body model.ReleaseAllocationRequest false "Quantity to release"
+// @Success 200 {object} model.Response[model.AllocationResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /allocations/{id}/release [post]
+func (h *AllocationHandlerImpl) ReleaseAllocation(ctx echo.Context) error {
+	request := new(model.ReleaseAllocationRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.AllocationService.ReleaseAllocation(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to release allocation: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrNotEnoughTickets):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
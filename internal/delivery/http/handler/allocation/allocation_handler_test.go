@@ -0,0 +1,120 @@
+package allocation_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/allocation"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	mockAllocation "github.com/TrinityKnights/Backend/test/mock/service/allocation"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTest(t *testing.T) (*allocation.AllocationHandlerImpl, *mockAllocation.MockAllocationService, *echo.Echo) {
+	ctrl := gomock.NewController(t)
+	mockAllocationService := mockAllocation.NewMockAllocationService(ctrl)
+	logger := logrus.New()
+	handler := allocation.NewAllocationHandler(logger, mockAllocationService).(*allocation.AllocationHandlerImpl)
+	e := echo.New()
+	return handler, mockAllocationService, e
+}
+
+func TestAllocationHandler_IssueComps(t *testing.T) {
+	handler, mockAllocationService, e := setupTest(t)
+
+	eventID := uint(1)
+	allocationID := uint(3)
+	quantity := 2
+	totalPrice := 0.0
+	name := "Jane Doe"
+	email := "jane@press.example.com"
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"recipients": [{"name": "Jane Doe", "email": "jane@press.example.com", "quantity": 2}]}`,
+			setupMock: func() {
+				mockAllocationService.EXPECT().
+					IssueComps(gomock.Any(), &model.IssueCompsRequest{
+						ID: allocationID,
+						Recipients: []model.CompRecipientRequest{
+							{Name: name, Email: email, Quantity: 2},
+						},
+					}).
+					Return([]*model.OrderResponse{
+						{
+							ID:             10,
+							EventID:        &eventID,
+							UserID:         "admin-1",
+							Quantity:       &quantity,
+							TotalPrice:     &totalPrice,
+							Date:           "2024-03-20T14:30:00Z",
+							Complimentary:  true,
+							AllocationID:   &allocationID,
+							RecipientName:  &name,
+							RecipientEmail: &email,
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"data":[{"id":10,"event_id":1,"user_id":"admin-1","quantity":2,"total_price":0,"date":"2024-03-20T14:30:00Z","complimentary":true,"allocation_id":3,"recipient_name":"Jane Doe","recipient_email":"jane@press.example.com"}]}`,
+		},
+		{
+			name:        "Allocation Exhausted",
+			requestBody: `{"recipients": [{"name": "Jane Doe", "email": "jane@press.example.com", "quantity": 5}]}`,
+			setupMock: func() {
+				mockAllocationService.EXPECT().
+					IssueComps(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotEnoughTickets)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"not enough tickets available"}}`,
+		},
+		{
+			name:        "Invalid Recipient",
+			requestBody: `{"recipients": [{"name": "Jane Doe", "email": "not-an-email"}]}`,
+			setupMock: func() {
+				mockAllocationService.EXPECT().
+					IssueComps(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrValidation)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"validation error"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/allocations/3/comps", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("3")
+
+			tc.setupMock()
+
+			err := handler.IssueComps(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
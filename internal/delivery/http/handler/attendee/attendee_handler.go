@@ -0,0 +1,15 @@
+package attendee
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+type AttendeeHandler interface {
+	CreateQuestion(ctx echo.Context) error
+	UpdateQuestion(ctx echo.Context) error
+	DeleteQuestion(ctx echo.Context) error
+	GetQuestions(ctx echo.Context) error
+	UpdateAttendee(ctx echo.Context) error
+	GetAttendees(ctx echo.Context) error
+	ExportAttendees(ctx echo.Context) error
+}
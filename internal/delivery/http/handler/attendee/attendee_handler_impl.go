@@ -0,0 +1,273 @@
+package attendee
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/service/attendee"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type AttendeeHandlerImpl struct {
+	Log             *logrus.Logger
+	AttendeeService attendee.AttendeeService
+}
+
+func NewAttendeeHandler(log *logrus.Logger, attendeeService attendee.AttendeeService) AttendeeHandler {
+	return &AttendeeHandlerImpl{
+		Log:             log,
+		AttendeeService: attendeeService,
+	}
+}
+
+// @Summary Create an attendee question
+// @Description Add a question ticket holders must or may answer for an event
+// @Tags attendees
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body model.CreateAttendeeQuestionRequest true "Question details"
+// @Success 201 {object} model.Response[model.AttendeeQuestionResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/questions [post]
+func (h *AttendeeHandlerImpl) CreateQuestion(ctx echo.Context) error {
+	request := new(model.CreateAttendeeQuestionRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.AttendeeService.CreateQuestion(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to create attendee question: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Update an attendee question
+// @Description Update an attendee question of an event
+// @Tags attendees
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param question_id path int true "Question ID"
+// @Param request body model.UpdateAttendeeQuestionRequest true "Question details"
+// @Success 200 {object} model.Response[model.AttendeeQuestionResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/questions/{question_id} [put]
+func (h *AttendeeHandlerImpl) UpdateQuestion(ctx echo.Context) error {
+	request := new(model.UpdateAttendeeQuestionRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.AttendeeService.UpdateQuestion(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to update attendee question: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Delete an attendee question
+// @Description Delete an attendee question. Answers already given are no longer shown.
+// @Tags attendees
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param question_id path int true "Question ID"
+// @Success 204
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/questions/{question_id} [delete]
+func (h *AttendeeHandlerImpl) DeleteQuestion(ctx echo.Context) error {
+	request := new(model.DeleteAttendeeQuestionRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	if err := h.AttendeeService.DeleteQuestion(ctx.Request().Context(), request); err != nil {
+		h.Log.Errorf("failed to delete attendee question: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// @Summary Get attendee questions
+// @Description Get the questions ticket holders are asked for an event
+// @Tags attendees
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} model.Response[[]model.AttendeeQuestionResponse]
+// @Failure 400 {object} model.Error
+// @Failure 500 {object} model.Error
+// @Router /events/{id}/questions [get]
+func (h *AttendeeHandlerImpl) GetQuestions(ctx echo.Context) error {
+	request := new(model.GetAttendeeQuestionsRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.AttendeeService.GetQuestions(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to get attendee questions: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Update ticket holder details
+// @Description Set the holder name, email and question answers of a ticket. Holders can edit until the event's attendee cutoff, admins at any time.
+// @Tags attendees
+// @Accept json
+// @Produce json
+// @Param id path string true "Ticket ID"
+// @Param request body model.UpdateAttendeeRequest true "Holder details"
+// @Success 200 {object} model.Response[model.TicketResponse]
+// @Failure 400 {object} model.Error
+// @Failure 403 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /tickets/{id}/attendee [put]
+func (h *AttendeeHandlerImpl) UpdateAttendee(ctx echo.Context) error {
+	request := new(model.UpdateAttendeeRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.AttendeeService.UpdateAttendee(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to update attendee: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrForbidden):
+			return handler.HandleError(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrEditWindowClosed):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Get event attendees
+// @Description Get sold tickets of an event with their holder details
+// @Tags attendees
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param page query int false "Page number"
+// @Param size query int false "Page size"
+// @Success 200 {object} model.Response[[]model.TicketResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/attendees [get]
+func (h *AttendeeHandlerImpl) GetAttendees(ctx echo.Context) error {
+	request := new(model.AttendeesRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.AttendeeService.GetAttendees(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to get attendees: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Export event attendees
+// @Description Download the holder details of every sold ticket of an event as CSV
+// @Tags attendees
+// @Produce text/csv
+// @Param id path int true "Event ID"
+// @Success 200 {file} file
+// @Failure 400 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/attendees/export [get]
+func (h *AttendeeHandlerImpl) ExportAttendees(ctx echo.Context) error {
+	request := new(model.ExportAttendeesRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	data, err := h.AttendeeService.ExportAttendees(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to export attendees: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=event-%d-attendees.csv", request.EventID))
+	return ctx.Blob(http.StatusOK, "text/csv", data)
+}
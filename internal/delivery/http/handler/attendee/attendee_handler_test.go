@@ -0,0 +1,143 @@
+package attendee_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/attendee"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	mockAttendee "github.com/TrinityKnights/Backend/test/mock/service/attendee"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTest(t *testing.T) (*attendee.AttendeeHandlerImpl, *mockAttendee.MockAttendeeService, *echo.Echo) {
+	ctrl := gomock.NewController(t)
+	mockAttendeeService := mockAttendee.NewMockAttendeeService(ctrl)
+	logger := logrus.New()
+	handler := attendee.NewAttendeeHandler(logger, mockAttendeeService).(*attendee.AttendeeHandlerImpl)
+	e := echo.New()
+	return handler, mockAttendeeService, e
+}
+
+func TestAttendeeHandler_UpdateAttendee(t *testing.T) {
+	handler, mockAttendeeService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		ticketID       string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success",
+			ticketID:    "T-abc123",
+			requestBody: `{"name": "Jane Doe", "email": "jane@example.com", "answers": [{"question_id": 1, "value": "M"}]}`,
+			setupMock: func() {
+				mockAttendeeService.EXPECT().
+					UpdateAttendee(gomock.Any(), &model.UpdateAttendeeRequest{
+						TicketID: "T-abc123",
+						Name:     "Jane Doe",
+						Email:    "jane@example.com",
+						Answers: []model.AttendeeAnswerRequest{
+							{QuestionID: 1, Value: "M"},
+						},
+					}).
+					Return(&model.TicketResponse{
+						ID:         "T-abc123",
+						EventID:    1,
+						OrderID:    1,
+						Price:      150000,
+						Type:       "VIP",
+						SeatNumber: "VIP-1",
+						Attendee: &model.AttendeeResponse{
+							Name:  "Jane Doe",
+							Email: "jane@example.com",
+							Answers: []model.AttendeeAnswerResponse{
+								{QuestionID: 1, Label: "T-shirt size", Value: "M"},
+							},
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"id":"T-abc123","event_id":1,"order_id":1,"price":150000,"type":"VIP","seat_number":"VIP-1","attendee":{"name":"Jane Doe","email":"jane@example.com","answers":[{"question_id":1,"label":"T-shirt size","value":"M"}]}}}`,
+		},
+		{
+			name:        "Not Ticket Owner",
+			ticketID:    "T-abc123",
+			requestBody: `{"name": "Jane Doe"}`,
+			setupMock: func() {
+				mockAttendeeService.EXPECT().
+					UpdateAttendee(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrForbidden)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":{"code":403,"message":"forbidden"}}`,
+		},
+		{
+			name:        "Edit Window Closed",
+			ticketID:    "T-abc123",
+			requestBody: `{"name": "Jane Doe"}`,
+			setupMock: func() {
+				mockAttendeeService.EXPECT().
+					UpdateAttendee(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrEditWindowClosed)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"attendee details can no longer be changed"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/tickets/"+tc.ticketID+"/attendee", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tc.ticketID)
+
+			tc.setupMock()
+
+			err := handler.UpdateAttendee(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestAttendeeHandler_ExportAttendees(t *testing.T) {
+	handler, mockAttendeeService, e := setupTest(t)
+
+	csvData := "ticket_id,seat_number,type,order_id,buyer_id,complimentary,name,email,T-shirt size\nT-abc123,VIP-1,VIP,1,user-1,false,Jane Doe,jane@example.com,M\n"
+
+	mockAttendeeService.EXPECT().
+		ExportAttendees(gomock.Any(), &model.ExportAttendeesRequest{EventID: 1}).
+		Return([]byte(csvData), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/events/1/attendees/export", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := handler.ExportAttendees(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/csv", rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, "attachment; filename=event-1-attendees.csv", rec.Header().Get(echo.HeaderContentDisposition))
+	assert.Equal(t, csvData, rec.Body.String())
+}
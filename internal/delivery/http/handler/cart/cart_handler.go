@@ -0,0 +1,13 @@
+package cart
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+type CartHandler interface {
+	GetCart(ctx echo.Context) error
+	AddItem(ctx echo.Context) error
+	RemoveItem(ctx echo.Context) error
+	ClearCart(ctx echo.Context) error
+	Checkout(ctx echo.Context) error
+}
@@ -0,0 +1,195 @@
+package cart
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/service/cart"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type CartHandlerImpl struct {
+	Log         *logrus.Logger
+	CartService cart.CartService
+}
+
+func NewCartHandler(log *logrus.Logger, cartService cart.CartService) CartHandler {
+	return &CartHandlerImpl{
+		Log:         log,
+		CartService: cartService,
+	}
+}
+
+// @Summary Get cart
+// @Description Get the caller's cart. Carts expire a short while after the last change.
+// @Tags cart
+// @Produce json
+// @Success 200 {object} model.Response[model.CartResponse]
+// @Failure 401 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /cart [get]
+func (h *CartHandlerImpl) GetCart(ctx echo.Context) error {
+	response, err := h.CartService.GetCart(ctx.Request().Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Add a ticket to the cart
+// @Description Add an available ticket of any event to the caller's cart. Tickets are not held until checkout.
+// @Tags cart
+// @Accept json
+// @Produce json
+// @Param request body model.AddCartItemRequest true "Ticket to add"
+// @Success 201 {object} model.Response[model.CartResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /cart/items [post]
+func (h *CartHandlerImpl) AddItem(ctx echo.Context) error {
+	request := new(model.AddCartItemRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.CartService.AddItem(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to add cart item: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrDuplicateEntry),
+			errors.Is(err, domainErrors.ErrSeatAlreadyTaken),
+			errors.Is(err, domainErrors.ErrEventNotOnSale):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Remove a ticket from the cart
+// @Description Remove a ticket from the caller's cart
+// @Tags cart
+// @Produce json
+// @Param ticket_id path string true "Ticket ID"
+// @Success 200 {object} model.Response[model.CartResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /cart/items/{ticket_id} [delete]
+func (h *CartHandlerImpl) RemoveItem(ctx echo.Context) error {
+	request := new(model.RemoveCartItemRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.CartService.RemoveItem(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Clear the cart
+// @Description Remove every ticket from the caller's cart
+// @Tags cart
+// @Produce json
+// @Success 204
+// @Failure 401 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /cart [delete]
+func (h *CartHandlerImpl) ClearCart(ctx echo.Context) error {
+	if err := h.CartService.ClearCart(ctx.Request().Context()); err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// @Summary Check out the cart
+// @Description Turn the cart into a single order with one invoice, even when it holds tickets for several events. Events with an open waiting room need their admission token. When a price changed since a ticket was added the cart is updated and the checkout is refused with 409.
+// @Tags cart
+// @Accept json
+// @Produce json
+// @Param request body model.CheckoutCartRequest false "Admission tokens"
+// @Param X-Challenge-Token header string false "Bot challenge token, required when the client is challenged"
+// @Success 201 {object} model.Response[model.OrderResponse]
+// @Failure 400 {object} model.Error
+// @Failure 401 {object} model.Error
+// @Failure 403 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 428 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /cart/checkout [post]
+func (h *CartHandlerImpl) Checkout(ctx echo.Context) error {
+	request := new(model.CheckoutCartRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.CartService.Checkout(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to check out cart: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrAdmissionRequired):
+			return handler.HandleError(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrSeatAlreadyTaken),
+			errors.Is(err, domainErrors.ErrEventNotOnSale),
+			errors.Is(err, domainErrors.ErrPriceChanged):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
@@ -0,0 +1,196 @@
+package cart_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/cart"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	mockCart "github.com/TrinityKnights/Backend/test/mock/service/cart"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTest(t *testing.T) (*cart.CartHandlerImpl, *mockCart.MockCartService, *echo.Echo) {
+	ctrl := gomock.NewController(t)
+	mockCartService := mockCart.NewMockCartService(ctrl)
+	logger := logrus.New()
+	handler := cart.NewCartHandler(logger, mockCartService).(*cart.CartHandlerImpl)
+	e := echo.New()
+	return handler, mockCartService, e
+}
+
+func TestCartHandler_AddItem(t *testing.T) {
+	handler, mockCartService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"ticket_id":"t-1"}`,
+			setupMock: func() {
+				mockCartService.EXPECT().
+					AddItem(gomock.Any(), &model.AddCartItemRequest{TicketID: "t-1"}).
+					Return(&model.CartResponse{
+						Items: []model.CartItemResponse{
+							{TicketID: "t-1", EventID: 1, EventName: "Concert", Type: "VIP", SeatNumber: "VIP-1", Price: 100, AddedAt: "2024-03-20 10:00:00"},
+						},
+						TotalPrice: 100,
+						ExpiresAt:  "2024-03-20 10:15:00",
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"data":{"items":[{"ticket_id":"t-1","event_id":1,"event_name":"Concert","type":"VIP","seat_number":"VIP-1","price":100,"added_at":"2024-03-20 10:00:00"}],"total_price":100,"expires_at":"2024-03-20 10:15:00"}}`,
+		},
+		{
+			name:        "Already In Cart",
+			requestBody: `{"ticket_id":"t-1"}`,
+			setupMock: func() {
+				mockCartService.EXPECT().
+					AddItem(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrDuplicateEntry)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"duplicate entry"}}`,
+		},
+		{
+			name:        "Ticket Taken",
+			requestBody: `{"ticket_id":"t-2"}`,
+			setupMock: func() {
+				mockCartService.EXPECT().
+					AddItem(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrSeatAlreadyTaken)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"seat is already taken"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/cart/items", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.AddItem(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestCartHandler_Checkout(t *testing.T) {
+	handler, mockCartService, e := setupTest(t)
+
+	totalPrice := 150.0
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success Across Events",
+			setupMock: func() {
+				mockCartService.EXPECT().
+					Checkout(gomock.Any(), gomock.Any()).
+					Return(&model.OrderResponse{
+						ID:         7,
+						EventIDs:   []uint{1, 2},
+						UserID:     "user-1",
+						TotalPrice: &totalPrice,
+						Date:       "2024-03-20 10:05:00",
+						Payment: &model.CreatePaymentResponse{
+							ID:         3,
+							OrderID:    7,
+							Amount:     150,
+							Status:     "PENDING",
+							ExpiryDate: "2024-03-21 10:05:00",
+							PaymentURL: "https://checkout.xendit.co/web/abc",
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"data":{"id":7,"event_ids":[1,2],"user_id":"user-1","total_price":150,"date":"2024-03-20 10:05:00","payment":{"id":3,"order_id":7,"amount":150,"status":"PENDING","expiry_date":"2024-03-21 10:05:00","payment_url":"https://checkout.xendit.co/web/abc"}}}`,
+		},
+		{
+			name: "Ticket No Longer Available",
+			setupMock: func() {
+				mockCartService.EXPECT().
+					Checkout(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrSeatAlreadyTaken)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"seat is already taken"}}`,
+		},
+		{
+			name: "Price Changed",
+			setupMock: func() {
+				mockCartService.EXPECT().
+					Checkout(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrPriceChanged)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"ticket price has changed, review the cart and try again"}}`,
+		},
+		{
+			name: "Admission Required",
+			setupMock: func() {
+				mockCartService.EXPECT().
+					Checkout(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrAdmissionRequired)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":{"code":403,"message":"admission from the waiting room is required"}}`,
+		},
+		{
+			name: "Empty Cart",
+			setupMock: func() {
+				mockCartService.EXPECT().
+					Checkout(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/cart/checkout", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.Checkout(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
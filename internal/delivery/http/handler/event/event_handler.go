@@ -10,4 +10,6 @@ type EventHandler interface {
 	GetEventByID(ctx echo.Context) error
 	GetAllEvents(ctx echo.Context) error
 	SearchEvents(ctx echo.Context) error
+	GetUnpublishedEvents(ctx echo.Context) error
+	UpdateEventStatus(ctx echo.Context) error
 }
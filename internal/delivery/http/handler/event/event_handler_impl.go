@@ -33,6 +33,8 @@ func NewEventHandler(log *logrus.Logger, eventService event.EventService) EventH
 // @Param request body model.CreateEventRequest true "Event details"
 // @Success 201 {object} model.Response[model.EventResponse]
 // @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
 // @Failure 500 {object} model.Error
 // @security ApiKeyAuth
 // @Router /events [post]
@@ -46,10 +48,18 @@ func (h *EventHandlerImpl) CreateEvent(ctx echo.Context) error {
 	response, err := h.EventService.CreateEvent(ctx.Request().Context(), request)
 	if err != nil {
 		h.Log.Errorf("failed to create event: %v", err)
-		if strings.Contains(err.Error(), "invalid request") {
+		switch {
+		case strings.Contains(err.Error(), "invalid request"), errors.Is(err, domainErrors.ErrValidation):
 			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrVenueConflict):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
 		}
-		return handler.HandleError(ctx, http.StatusInternalServerError, err)
 	}
 
 	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
@@ -64,6 +74,8 @@ func (h *EventHandlerImpl) CreateEvent(ctx echo.Context) error {
 // @Param request body model.UpdateEventRequest true "Updated event details"
 // @Success 200 {object} model.Response[model.EventResponse]
 // @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
 // @Failure 500 {object} model.Error
 // @security ApiKeyAuth
 // @Router /events/{id} [put]
@@ -78,8 +90,14 @@ func (h *EventHandlerImpl) UpdateEvent(ctx echo.Context) error {
 	if err != nil {
 		h.Log.Errorf("failed to update event: %v", err)
 		switch {
-		case errors.Is(err, errors.New(http.StatusText(http.StatusBadRequest))):
+		case errors.Is(err, errors.New(http.StatusText(http.StatusBadRequest))), errors.Is(err, domainErrors.ErrValidation):
 			return handler.HandleError(ctx, 400, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, 404, err)
+		case errors.Is(err, domainErrors.ErrVenueConflict):
+			return handler.HandleError(ctx, 409, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, 401, err)
 		default:
 			return handler.HandleError(ctx, 500, err)
 		}
@@ -93,6 +111,7 @@ func (h *EventHandlerImpl) UpdateEvent(ctx echo.Context) error {
 // @Tags events
 // @Produce json
 // @Param id path int true "Event ID"
+// @Param tz query string false "IANA time zone to show the times in, the venue's zone by default"
 // @Success 200 {object} model.Response[model.EventResponse]
 // @Failure 400 {object} model.Error
 // @Failure 500 {object} model.Error
@@ -108,6 +127,8 @@ func (h *EventHandlerImpl) GetEventByID(ctx echo.Context) error {
 	if err != nil {
 		h.Log.Errorf("failed to get event by id: %v", err)
 		switch err {
+		case domainErrors.ErrValidation:
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
 		case domainErrors.ErrNotFound:
 			return handler.HandleError(ctx, http.StatusNotFound, err)
 		default:
@@ -126,6 +147,7 @@ func (h *EventHandlerImpl) GetEventByID(ctx echo.Context) error {
 // @Param size query int false "Page size"
 // @Param sort query string false "Sort field" Enums(id, name, description, date, time, venue_id)
 // @Param order query string false "Sort order"
+// @Param tz query string false "IANA time zone to show the times in, the venue's zone by default"
 // @Success 200 {object} model.Response[[]model.EventResponse]
 // @Failure 400 {object} model.Error
 // @Failure 500 {object} model.Error
@@ -166,6 +188,7 @@ func (h *EventHandlerImpl) GetAllEvents(ctx echo.Context) error {
 // @Param size query int false "Page size"
 // @Param sort query string false "Sort field" Enums(id, name, description, date, time, venue_id)
 // @Param order query string false "Sort order"
+// @Param tz query string false "IANA time zone to show the times in, the venue's zone by default"
 // @Success 200 {object} model.Response[[]model.EventResponse]
 // @Failure 400 {object} model.Error
 // @Failure 500 {object} model.Error
@@ -181,7 +204,7 @@ func (h *EventHandlerImpl) SearchEvents(ctx echo.Context) error {
 	if err != nil {
 		h.Log.Errorf("failed to search events: %v", err)
 		switch {
-		case errors.Is(err, errors.New(http.StatusText(http.StatusBadRequest))):
+		case errors.Is(err, errors.New(http.StatusText(http.StatusBadRequest))), errors.Is(err, domainErrors.ErrValidation):
 			return handler.HandleError(ctx, 400, err)
 		default:
 			return handler.HandleError(ctx, 500, err)
@@ -190,3 +213,78 @@ func (h *EventHandlerImpl) SearchEvents(ctx echo.Context) error {
 
 	return ctx.JSON(http.StatusOK, response)
 }
+
+// @Summary Get unpublished events @admin
+// @Description Get a paginated list of the draft and scheduled events that are not public yet
+// @Tags events
+// @Produce json
+// @Param page query int false "Page number"
+// @Param size query int false "Page size"
+// @Param sort query string false "Sort field" Enums(id, name, description, date, time, venue_id)
+// @Param order query string false "Sort order"
+// @Success 200 {object} model.Response[[]model.EventResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/unpublished [get]
+func (h *EventHandlerImpl) GetUnpublishedEvents(ctx echo.Context) error {
+	request := new(model.EventsRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.EventService.GetUnpublishedEvents(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Change an event's status @admin
+// @Description Publish, schedule, postpone or cancel an event. Scheduled events need a future publish_at and are published by the scheduler. Cancelling makes paid orders eligible for a refund; ticket holders are emailed when an event is cancelled or postponed.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body model.UpdateEventStatusRequest true "New status"
+// @Success 200 {object} model.Response[model.EventResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/status [put]
+func (h *EventHandlerImpl) UpdateEventStatus(ctx echo.Context) error {
+	request := new(model.UpdateEventStatusRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.EventService.UpdateEventStatus(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to update event status: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrInvalidStatusChange):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
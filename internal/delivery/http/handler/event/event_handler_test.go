@@ -0,0 +1,277 @@
+package event_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/event"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+	mockEvent "github.com/TrinityKnights/Backend/test/mock/service/event"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTest(t *testing.T) (*event.EventHandlerImpl, *mockEvent.MockEventService, *echo.Echo) {
+	ctrl := gomock.NewController(t)
+	mockEventService := mockEvent.NewMockEventService(ctrl)
+	logger := logrus.New()
+	handler := event.NewEventHandler(logger, mockEventService).(*event.EventHandlerImpl)
+	e := echo.New()
+	return handler, mockEventService, e
+}
+
+func TestEventHandler_UpdateEventStatus(t *testing.T) {
+	handler, mockEventService, e := setupTest(t)
+
+	requestBody := `{"status":"cancelled","reason":"The headliner is unwell"}`
+	reason := "The headliner is unwell"
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			setupMock: func() {
+				mockEventService.EXPECT().
+					UpdateEventStatus(gomock.Any(), &model.UpdateEventStatusRequest{ID: 1, Status: "cancelled", Reason: reason}).
+					Return(&model.EventResponse{
+						ID:           1,
+						Name:         "Jazz Night",
+						Description:  "Live jazz",
+						Date:         time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC),
+						Time:         helper.SQLTime(time.Date(0, 1, 1, 19, 30, 0, 0, time.UTC)),
+						VenueID:      2,
+						Status:       "CANCELLED",
+						StatusReason: &reason,
+						StartsAt:     time.Date(2024, 3, 20, 19, 30, 0, 0, jakarta),
+						Timezone:     "Asia/Jakarta",
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"data":{"id":1,"name":"Jazz Night","description":"Live jazz","date":"2024-03-20T00:00:00Z","time":"19:30:00",` +
+				`"venue_id":2,"timed_entry":false,"status":"CANCELLED","status_reason":"The headliner is unwell",` +
+				`"starts_at":"2024-03-20T19:30:00+07:00","timezone":"Asia/Jakarta"}}`,
+		},
+		{
+			name: "Already Cancelled",
+			setupMock: func() {
+				mockEventService.EXPECT().
+					UpdateEventStatus(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrInvalidStatusChange)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"event status cannot be changed this way"}}`,
+		},
+		{
+			name: "Event Not Found",
+			setupMock: func() {
+				mockEventService.EXPECT().
+					UpdateEventStatus(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+		{
+			name: "Publish Time In The Past",
+			setupMock: func() {
+				mockEventService.EXPECT().
+					UpdateEventStatus(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrValidation)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"validation error"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/events/1/status", strings.NewReader(requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+
+			tc.setupMock()
+
+			err := handler.UpdateEventStatus(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestEventHandler_GetEventByID(t *testing.T) {
+	handler, mockEventService, e := setupTest(t)
+
+	amsterdam, _ := time.LoadLocation("Europe/Amsterdam")
+	endsAt := time.Date(2024, 3, 20, 16, 30, 0, 0, amsterdam)
+	duration := 180
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:  "Success In Client Time Zone",
+			query: "?tz=Europe/Amsterdam",
+			setupMock: func() {
+				mockEventService.EXPECT().
+					GetEventByID(gomock.Any(), &model.GetEventRequest{ID: 1, TZ: "Europe/Amsterdam"}).
+					Return(&model.EventResponse{
+						ID:              1,
+						Name:            "Jazz Night",
+						Description:     "Live jazz",
+						Date:            time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC),
+						Time:            helper.SQLTime(time.Date(0, 1, 1, 19, 30, 0, 0, time.UTC)),
+						VenueID:         2,
+						Status:          "PUBLISHED",
+						StartsAt:        time.Date(2024, 3, 20, 13, 30, 0, 0, amsterdam),
+						EndsAt:          &endsAt,
+						DurationMinutes: &duration,
+						Timezone:        "Asia/Jakarta",
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"data":{"id":1,"name":"Jazz Night","description":"Live jazz","date":"2024-03-20T00:00:00Z","time":"19:30:00",` +
+				`"venue_id":2,"timed_entry":false,"status":"PUBLISHED","starts_at":"2024-03-20T13:30:00+01:00",` +
+				`"ends_at":"2024-03-20T16:30:00+01:00","duration_minutes":180,"timezone":"Asia/Jakarta"}}`,
+		},
+		{
+			name:  "Unknown Time Zone",
+			query: "?tz=Mars/Olympus",
+			setupMock: func() {
+				mockEventService.EXPECT().
+					GetEventByID(gomock.Any(), &model.GetEventRequest{ID: 1, TZ: "Mars/Olympus"}).
+					Return(nil, domainErrors.ErrValidation)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"validation error"}}`,
+		},
+		{
+			name:  "Event Not Found",
+			query: "",
+			setupMock: func() {
+				mockEventService.EXPECT().
+					GetEventByID(gomock.Any(), &model.GetEventRequest{ID: 1}).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/events/1"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+
+			tc.setupMock()
+
+			err := handler.GetEventByID(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestEventHandler_CreateEvent(t *testing.T) {
+	handler, mockEventService, e := setupTest(t)
+
+	requestBody := `{"name":"Jazz Night","description":"Live jazz","date":"2024-03-20","time":"19:00:00","venue_id":2,"duration_minutes":180}`
+	request := &model.CreateEventRequest{
+		Name:            "Jazz Night",
+		Description:     "Live jazz",
+		Date:            "2024-03-20",
+		Time:            "19:00:00",
+		VenueID:         2,
+		DurationMinutes: 180,
+	}
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Venue Already Booked",
+			setupMock: func() {
+				mockEventService.EXPECT().
+					CreateEvent(gomock.Any(), request).
+					Return(nil, domainErrors.ErrVenueConflict)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"venue is already booked at that time"}}`,
+		},
+		{
+			name: "Venue Not Found",
+			setupMock: func() {
+				mockEventService.EXPECT().
+					CreateEvent(gomock.Any(), request).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+		{
+			name: "Override Without Reason",
+			setupMock: func() {
+				mockEventService.EXPECT().
+					CreateEvent(gomock.Any(), request).
+					Return(nil, domainErrors.ErrValidation)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"validation error"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.CreateEvent(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
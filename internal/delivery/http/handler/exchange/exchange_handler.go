@@ -0,0 +1,11 @@
+package exchange
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+type ExchangeHandler interface {
+	ExchangeTicket(ctx echo.Context) error
+	GetExchangeByID(ctx echo.Context) error
+	GetExchanges(ctx echo.Context) error
+}
@@ -0,0 +1,141 @@
+package exchange
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/service/exchange"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type ExchangeHandlerImpl struct {
+	Log             *logrus.Logger
+	ExchangeService exchange.ExchangeService
+}
+
+func NewExchangeHandler(log *logrus.Logger, exchangeService exchange.ExchangeService) ExchangeHandler {
+	return &ExchangeHandlerImpl{
+		Log:             log,
+		ExchangeService: exchangeService,
+	}
+}
+
+// @Summary Exchange a ticket
+// @Description Swap a paid ticket for an available ticket of the same event. A more expensive ticket is held until the returned differential invoice is paid; a cheaper one completes immediately and records a credit.
+// @Tags exchanges
+// @Accept json
+// @Produce json
+// @Param id path string true "Ticket ID"
+// @Param request body model.ExchangeTicketRequest true "Exchange details"
+// @Success 201 {object} model.Response[model.TicketExchangeResponse]
+// @Failure 400 {object} model.Error
+// @Failure 403 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /tickets/{id}/exchange [post]
+func (h *ExchangeHandlerImpl) ExchangeTicket(ctx echo.Context) error {
+	request := new(model.ExchangeTicketRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.ExchangeService.ExchangeTicket(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to exchange ticket: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrForbidden):
+			return handler.HandleError(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrNotExchangeable),
+			errors.Is(err, domainErrors.ErrDuplicateEntry),
+			errors.Is(err, domainErrors.ErrSeatAlreadyTaken):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Get a ticket exchange
+// @Description Get a ticket exchange by ID
+// @Tags exchanges
+// @Produce json
+// @Param id path int true "Exchange ID"
+// @Success 200 {object} model.Response[model.TicketExchangeResponse]
+// @Failure 400 {object} model.Error
+// @Failure 403 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /exchanges/{id} [get]
+func (h *ExchangeHandlerImpl) GetExchangeByID(ctx echo.Context) error {
+	request := new(model.GetTicketExchangeRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.ExchangeService.GetExchangeByID(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrForbidden):
+			return handler.HandleError(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Get ticket exchanges
+// @Description Get the caller's ticket exchanges. Admins see every exchange.
+// @Tags exchanges
+// @Produce json
+// @Param page query int false "Page number"
+// @Param size query int false "Page size"
+// @Success 200 {object} model.Response[[]model.TicketExchangeResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /exchanges [get]
+func (h *ExchangeHandlerImpl) GetExchanges(ctx echo.Context) error {
+	request := new(model.TicketExchangesRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.ExchangeService.GetExchanges(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
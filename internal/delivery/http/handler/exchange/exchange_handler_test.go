@@ -0,0 +1,146 @@
+package exchange_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/exchange"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	mockExchange "github.com/TrinityKnights/Backend/test/mock/service/exchange"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTest(t *testing.T) (*exchange.ExchangeHandlerImpl, *mockExchange.MockExchangeService, *echo.Echo) {
+	ctrl := gomock.NewController(t)
+	mockExchangeService := mockExchange.NewMockExchangeService(ctrl)
+	logger := logrus.New()
+	handler := exchange.NewExchangeHandler(logger, mockExchangeService).(*exchange.ExchangeHandlerImpl)
+	e := echo.New()
+	return handler, mockExchangeService, e
+}
+
+func TestExchangeHandler_ExchangeTicket(t *testing.T) {
+	handler, mockExchangeService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		ticketID       string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Upgrade Requires Payment",
+			ticketID:    "T-old",
+			requestBody: `{"new_ticket_id": "T-new"}`,
+			setupMock: func() {
+				mockExchangeService.EXPECT().
+					ExchangeTicket(gomock.Any(), &model.ExchangeTicketRequest{
+						TicketID:    "T-old",
+						NewTicketID: "T-new",
+					}).
+					Return(&model.TicketExchangeResponse{
+						ID:              1,
+						OrderID:         10,
+						UserID:          "user-1",
+						OldTicketID:     "T-old",
+						NewTicketID:     "T-new",
+						OldPrice:        100000,
+						NewPrice:        150000,
+						PriceDifference: 50000,
+						Status:          string(model.TicketExchangeStatusPending),
+						CreatedAt:       "2024-03-20T14:30:00Z",
+						Payment: &model.CreatePaymentResponse{
+							ID:         5,
+							OrderID:    10,
+							Amount:     50000,
+							Status:     "PENDING",
+							PaymentURL: "https://checkout.xendit.co/inv",
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"data":{"id":1,"order_id":10,"user_id":"user-1","old_ticket_id":"T-old","new_ticket_id":"T-new","old_price":100000,"new_price":150000,"price_difference":50000,"status":"PENDING","created_at":"2024-03-20T14:30:00Z","payment":{"id":5,"order_id":10,"amount":50000,"status":"PENDING","expiry_date":"","payment_url":"https://checkout.xendit.co/inv"}}}`,
+		},
+		{
+			name:        "Downgrade Records Credit",
+			ticketID:    "T-old",
+			requestBody: `{"new_ticket_id": "T-cheap"}`,
+			setupMock: func() {
+				completedAt := "2024-03-20T14:30:00Z"
+				mockExchangeService.EXPECT().
+					ExchangeTicket(gomock.Any(), gomock.Any()).
+					Return(&model.TicketExchangeResponse{
+						ID:              2,
+						OrderID:         10,
+						UserID:          "user-1",
+						OldTicketID:     "T-old",
+						NewTicketID:     "T-cheap",
+						OldPrice:        150000,
+						NewPrice:        100000,
+						PriceDifference: -50000,
+						CreditAmount:    50000,
+						Status:          string(model.TicketExchangeStatusCompleted),
+						CompletedAt:     &completedAt,
+						CreatedAt:       "2024-03-20T14:30:00Z",
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"data":{"id":2,"order_id":10,"user_id":"user-1","old_ticket_id":"T-old","new_ticket_id":"T-cheap","old_price":150000,"new_price":100000,"price_difference":-50000,"credit_amount":50000,"status":"COMPLETED","completed_at":"2024-03-20T14:30:00Z","created_at":"2024-03-20T14:30:00Z"}}`,
+		},
+		{
+			name:        "Ticket Not Exchangeable",
+			ticketID:    "T-old",
+			requestBody: `{"new_ticket_id": "T-new"}`,
+			setupMock: func() {
+				mockExchangeService.EXPECT().
+					ExchangeTicket(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotExchangeable)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"ticket cannot be exchanged"}}`,
+		},
+		{
+			name:        "Not Ticket Owner",
+			ticketID:    "T-old",
+			requestBody: `{"new_ticket_id": "T-new"}`,
+			setupMock: func() {
+				mockExchangeService.EXPECT().
+					ExchangeTicket(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrForbidden)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":{"code":403,"message":"forbidden"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/tickets/"+tc.ticketID+"/exchange", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tc.ticketID)
+
+			tc.setupMock()
+
+			err := handler.ExchangeTicket(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package group
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+type GroupHandler interface {
+	CreateGroupBooking(ctx echo.Context) error
+	GetGroupBookings(ctx echo.Context) error
+	GetGroupBookingByID(ctx echo.Context) error
+}
@@ -0,0 +1,148 @@
+package group
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/service/group"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type GroupHandlerImpl struct {
+	Log          *logrus.Logger
+	GroupService group.GroupService
+}
+
+func NewGroupHandler(log *logrus.Logger, groupService group.GroupService) GroupHandler {
+	return &GroupHandlerImpl{
+		Log:          log,
+		GroupService: groupService,
+	}
+}
+
+// @Summary Create a group booking
+// @Description Reserve a block of seats and invite participants by email. Each participant gets their own invoice for their seats, unpaid seats are released at the deadline.
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body model.CreateGroupBookingRequest true "Group booking details"
+// @Param X-Challenge-Token header string false "Bot challenge token, required when the client is challenged"
+// @Success 201 {object} model.Response[model.GroupBookingResponse]
+// @Failure 400 {object} model.Error
+// @Failure 401 {object} model.Error
+// @Failure 403 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 428 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/groups [post]
+func (h *GroupHandlerImpl) CreateGroupBooking(ctx echo.Context) error {
+	request := new(model.CreateGroupBookingRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.GroupService.CreateGroupBooking(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to create group booking: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrAdmissionRequired):
+			return handler.HandleError(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrNotEnoughTickets),
+			errors.Is(err, domainErrors.ErrSeatAlreadyTaken),
+			errors.Is(err, domainErrors.ErrEventNotOnSale):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Get group bookings
+// @Description Get the group bookings the caller organised or was invited to. Admins see every group booking.
+// @Tags groups
+// @Produce json
+// @Param page query int false "Page number"
+// @Param size query int false "Page size"
+// @Success 200 {object} model.Response[[]model.GroupBookingResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /groups [get]
+func (h *GroupHandlerImpl) GetGroupBookings(ctx echo.Context) error {
+	request := new(model.GroupBookingsRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.GroupService.GetGroupBookings(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get a group booking
+// @Description Get a group booking with the payment status of every share
+// @Tags groups
+// @Produce json
+// @Param id path int true "Group booking ID"
+// @Success 200 {object} model.Response[model.GroupBookingResponse]
+// @Failure 400 {object} model.Error
+// @Failure 403 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /groups/{id} [get]
+func (h *GroupHandlerImpl) GetGroupBookingByID(ctx echo.Context) error {
+	request := new(model.GetGroupBookingRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.GroupService.GetGroupBookingByID(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrForbidden):
+			return handler.HandleError(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
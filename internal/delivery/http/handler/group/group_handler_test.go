@@ -0,0 +1,134 @@
+package group_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/group"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	mockGroup "github.com/TrinityKnights/Backend/test/mock/service/group"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTest(t *testing.T) (*group.GroupHandlerImpl, *mockGroup.MockGroupService, *echo.Echo) {
+	ctrl := gomock.NewController(t)
+	mockGroupService := mockGroup.NewMockGroupService(ctrl)
+	logger := logrus.New()
+	handler := group.NewGroupHandler(logger, mockGroupService).(*group.GroupHandlerImpl)
+	e := echo.New()
+	return handler, mockGroupService, e
+}
+
+func TestGroupHandler_CreateGroupBooking(t *testing.T) {
+	handler, mockGroupService, e := setupTest(t)
+
+	requestBody := `{"type":"regular","deadline":"2024-03-18T18:00:00+07:00","participants":[{"name":"Ana","email":"ana@example.com","quantity":2},{"name":"Budi","email":"budi@example.com"}]}`
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			setupMock: func() {
+				mockGroupService.EXPECT().
+					CreateGroupBooking(gomock.Any(), &model.CreateGroupBookingRequest{
+						EventID:  1,
+						Type:     "regular",
+						Deadline: "2024-03-18T18:00:00+07:00",
+						Participants: []model.GroupParticipantRequest{
+							{Name: "Ana", Email: "ana@example.com", Quantity: 2},
+							{Name: "Budi", Email: "budi@example.com"},
+						},
+					}).
+					Return(&model.GroupBookingResponse{
+						ID:          5,
+						EventID:     1,
+						OrganiserID: "user-1",
+						Type:        "REGULAR",
+						Quantity:    3,
+						Status:      "PENDING",
+						Deadline:    "2024-03-18 18:00:00",
+						CreatedAt:   "2024-03-15 09:00:00",
+						Shares: []*model.GroupShareResponse{
+							{
+								ID: 1, OrderID: 20, Name: "Ana", Email: "ana@example.com", Quantity: 2, Amount: 200, Status: "INVITED",
+								SeatNumbers: []string{"REG-1", "REG-2"},
+								Payment:     &model.CreatePaymentResponse{ID: 30, OrderID: 20, Amount: 200, Status: "PENDING", ExpiryDate: "2024-03-18T11:00:00Z", PaymentURL: "https://checkout.xendit.co/web/a"},
+							},
+							{
+								ID: 2, OrderID: 21, Name: "Budi", Email: "budi@example.com", Quantity: 1, Amount: 100, Status: "INVITED",
+								SeatNumbers: []string{"REG-3"},
+								Payment:     &model.CreatePaymentResponse{ID: 31, OrderID: 21, Amount: 100, Status: "PENDING", ExpiryDate: "2024-03-18T11:00:00Z", PaymentURL: "https://checkout.xendit.co/web/b"},
+							},
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: `{"data":{"id":5,"event_id":1,"organiser_id":"user-1","type":"REGULAR","quantity":3,"paid":0,"status":"PENDING","deadline":"2024-03-18 18:00:00","created_at":"2024-03-15 09:00:00","shares":[` +
+				`{"id":1,"order_id":20,"name":"Ana","email":"ana@example.com","quantity":2,"amount":200,"status":"INVITED","seat_numbers":["REG-1","REG-2"],"payment":{"id":30,"order_id":20,"amount":200,"status":"PENDING","expiry_date":"2024-03-18T11:00:00Z","payment_url":"https://checkout.xendit.co/web/a"}},` +
+				`{"id":2,"order_id":21,"name":"Budi","email":"budi@example.com","quantity":1,"amount":100,"status":"INVITED","seat_numbers":["REG-3"],"payment":{"id":31,"order_id":21,"amount":100,"status":"PENDING","expiry_date":"2024-03-18T11:00:00Z","payment_url":"https://checkout.xendit.co/web/b"}}]}}`,
+		},
+		{
+			name: "Not Enough Seats",
+			setupMock: func() {
+				mockGroupService.EXPECT().
+					CreateGroupBooking(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotEnoughTickets)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"not enough tickets available"}}`,
+		},
+		{
+			name: "Waiting Room Admission Required",
+			setupMock: func() {
+				mockGroupService.EXPECT().
+					CreateGroupBooking(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrAdmissionRequired)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":{"code":403,"message":"admission from the waiting room is required"}}`,
+		},
+		{
+			name: "Invalid Deadline",
+			setupMock: func() {
+				mockGroupService.EXPECT().
+					CreateGroupBooking(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrValidation)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"validation error"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/events/1/groups", strings.NewReader(requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+
+			tc.setupMock()
+
+			err := handler.CreateGroupBooking(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
@@ -6,6 +6,9 @@ import (
 
 type OrderHandler interface {
 	CreateOrder(ctx echo.Context) error
+	CreateGuestOrder(ctx echo.Context) error
+	RequestGuestOrderLink(ctx echo.Context) error
+	GetGuestOrder(ctx echo.Context) error
 	GetOrderByID(ctx echo.Context) error
 	GetAllOrders(ctx echo.Context) error
 }
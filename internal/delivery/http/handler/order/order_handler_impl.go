@@ -25,13 +25,18 @@ func NewOrderHandler(log *logrus.Logger, orderService order.OrderService) OrderH
 }
 
 // @Summary Create a new order
-// @Description Create a new order for event tickets
+// @Description Create a new order for event tickets, optionally with add-on products. While the event's waiting room is open the order needs the admission token issued when the buyer reaches the front of the queue.
 // @Tags orders
 // @Accept json
 // @Produce json
 // @Param request body model.OrderTicketRequest true "Order details"
+// @Param X-Challenge-Token header string false "Bot challenge token, required when the client is challenged"
 // @Success 201 {object} model.Response[model.OrderResponse]
 // @Failure 400 {object} model.Error
+// @Failure 403 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 410 {object} model.Error
+// @Failure 428 {object} model.Error
 // @Failure 500 {object} model.Error
 // @security ApiKeyAuth
 // @Router /orders [post]
@@ -50,6 +55,15 @@ func (h *OrderHandlerImpl) CreateOrder(ctx echo.Context) error {
 			return handler.HandleError(ctx, http.StatusNotFound, err)
 		case errors.Is(err, domainErrors.ErrValidation):
 			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrForbidden),
+			errors.Is(err, domainErrors.ErrAdmissionRequired):
+			return handler.HandleError(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domainErrors.ErrSeatAlreadyTaken),
+			errors.Is(err, domainErrors.ErrOutOfStock),
+			errors.Is(err, domainErrors.ErrEventNotOnSale):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		case errors.Is(err, domainErrors.ErrOfferExpired):
+			return handler.HandleError(ctx, http.StatusGone, err)
 		default:
 			return handler.HandleError(ctx, http.StatusInternalServerError, err)
 		}
@@ -58,6 +72,115 @@ func (h *OrderHandlerImpl) CreateOrder(ctx echo.Context) error {
 	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
 }
 
+// @Summary Create a guest order
+// @Description Order event tickets without an account. The buyer is emailed a link to view the order, and the order is added to their account if they later register and verify the same email. While the event's waiting room is open the order needs an admission token from the queue.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param request body model.GuestOrderRequest true "Order and buyer details"
+// @Param X-Challenge-Token header string false "Bot challenge token, required when the client is challenged"
+// @Success 201 {object} model.Response[model.OrderResponse]
+// @Failure 400 {object} model.Error
+// @Failure 403 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 428 {object} model.Error
+// @Failure 500 {object} model.Error
+// @Router /guest/orders [post]
+func (h *OrderHandlerImpl) CreateGuestOrder(ctx echo.Context) error {
+	request := new(model.GuestOrderRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.OrderService.CreateGuestOrder(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to create guest order: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrForbidden),
+			errors.Is(err, domainErrors.ErrAdmissionRequired):
+			return handler.HandleError(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domainErrors.ErrSeatAlreadyTaken),
+			errors.Is(err, domainErrors.ErrOutOfStock),
+			errors.Is(err, domainErrors.ErrEventNotOnSale):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Request a guest order link
+// @Description Email a link to view a guest order, given its order code and the buyer's email. The response is the same whether or not an order matches.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param request body model.GuestOrderLookupRequest true "Order code and email"
+// @Param X-Challenge-Token header string false "Bot challenge token, required when the client is challenged"
+// @Success 202 {object} model.Response[model.VerifyResponse]
+// @Failure 400 {object} model.Error
+// @Failure 428 {object} model.Error
+// @Failure 500 {object} model.Error
+// @Router /guest/orders/lookup [post]
+func (h *OrderHandlerImpl) RequestGuestOrderLink(ctx echo.Context) error {
+	request := new(model.GuestOrderLookupRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.OrderService.RequestGuestOrderLink(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusAccepted, model.NewResponse(response, nil))
+}
+
+// @Summary Get a guest order
+// @Description View the tickets and payment status of a guest order through an emailed link
+// @Tags orders
+// @Produce json
+// @Param token path string true "Order link token"
+// @Success 200 {object} model.Response[model.OrderResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @Router /guest/orders/{token} [get]
+func (h *OrderHandlerImpl) GetGuestOrder(ctx echo.Context) error {
+	request := new(model.GetGuestOrderRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.OrderService.GetGuestOrder(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
 // @Summary Get order by ID
 // @Description Get details of a specific order
 // @Tags orders
@@ -98,6 +221,7 @@ func (h *OrderHandlerImpl) GetOrderByID(ctx echo.Context) error {
 // @Param size query int false "Page size"
 // @Param sort query string false "Sort field" Enums(id, date, total_price)
 // @Param order query string false "Sort order"
+// @Param kind query string false "Order kind" Enums(paid, complimentary)
 // @Success 200 {object} model.Response[[]model.OrderResponse]
 // @Failure 400 {object} model.Error
 // @Failure 500 {object} model.Error
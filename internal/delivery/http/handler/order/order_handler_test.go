@@ -0,0 +1,192 @@
+package order_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/order"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	mockOrder "github.com/TrinityKnights/Backend/test/mock/service/order"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTest(t *testing.T) (*order.OrderHandlerImpl, *mockOrder.MockOrderService, *echo.Echo) {
+	ctrl := gomock.NewController(t)
+	mockOrderService := mockOrder.NewMockOrderService(ctrl)
+	logger := logrus.New()
+	handler := order.NewOrderHandler(logger, mockOrderService).(*order.OrderHandlerImpl)
+	e := echo.New()
+	return handler, mockOrderService, e
+}
+
+func TestOrderHandler_CreateGuestOrder(t *testing.T) {
+	handler, mockOrderService, e := setupTest(t)
+
+	eventID := uint(1)
+	quantity := 1
+	totalPrice := 100.0
+	code := "TK-1A2B3C4D"
+	guestEmail := "guest@example.com"
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"event_id":1,"ticket_ids":["t-1"],"seat_numbers":["REG-1"],"email":"guest@example.com","name":"Guest"}`,
+			setupMock: func() {
+				mockOrderService.EXPECT().
+					CreateGuestOrder(gomock.Any(), &model.GuestOrderRequest{
+						OrderTicketRequest: model.OrderTicketRequest{
+							EventID:     1,
+							TicketIDs:   []string{"t-1"},
+							SeatNumbers: []string{"REG-1"},
+						},
+						Email: "guest@example.com",
+						Name:  "Guest",
+					}).
+					Return(&model.OrderResponse{
+						ID:         12,
+						EventID:    &eventID,
+						Code:       &code,
+						GuestEmail: &guestEmail,
+						Quantity:   &quantity,
+						TotalPrice: &totalPrice,
+						Date:       "2024-03-20 10:00:00",
+						Payment: &model.CreatePaymentResponse{
+							ID:         4,
+							OrderID:    12,
+							Amount:     100,
+							Status:     "PENDING",
+							ExpiryDate: "2024-03-21T03:00:00Z",
+							PaymentURL: "https://checkout.xendit.co/web/c",
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"data":{"id":12,"event_id":1,"code":"TK-1A2B3C4D","guest_email":"guest@example.com","quantity":1,"total_price":100,"date":"2024-03-20 10:00:00","payment":{"id":4,"order_id":12,"amount":100,"status":"PENDING","expiry_date":"2024-03-21T03:00:00Z","payment_url":"https://checkout.xendit.co/web/c"}}}`,
+		},
+		{
+			name:        "Seat Taken",
+			requestBody: `{"event_id":1,"ticket_ids":["t-1"],"seat_numbers":["REG-1"],"email":"guest@example.com","name":"Guest"}`,
+			setupMock: func() {
+				mockOrderService.EXPECT().
+					CreateGuestOrder(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrSeatAlreadyTaken)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"seat is already taken"}}`,
+		},
+		{
+			name:        "Waitlist Offer Needs Account",
+			requestBody: `{"event_id":1,"ticket_ids":["t-1"],"seat_numbers":["REG-1"],"hold_token":"abc","email":"guest@example.com","name":"Guest"}`,
+			setupMock: func() {
+				mockOrderService.EXPECT().
+					CreateGuestOrder(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrForbidden)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":{"code":403,"message":"forbidden"}}`,
+		},
+		{
+			name:        "Waiting Room Admission Missing",
+			requestBody: `{"event_id":1,"ticket_ids":["t-1"],"seat_numbers":["REG-1"],"email":"guest@example.com","name":"Guest"}`,
+			setupMock: func() {
+				mockOrderService.EXPECT().
+					CreateGuestOrder(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrAdmissionRequired)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":{"code":403,"message":"admission from the waiting room is required"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/guest/orders", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.CreateGuestOrder(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestOrderHandler_RequestGuestOrderLink(t *testing.T) {
+	handler, mockOrderService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Accepted",
+			requestBody: `{"code":"TK-1A2B3C4D","email":"guest@example.com"}`,
+			setupMock: func() {
+				mockOrderService.EXPECT().
+					RequestGuestOrderLink(gomock.Any(), &model.GuestOrderLookupRequest{
+						Code:  "TK-1A2B3C4D",
+						Email: "guest@example.com",
+					}).
+					Return(&model.VerifyResponse{Status: "success"}, nil)
+			},
+			expectedStatus: http.StatusAccepted,
+			expectedBody:   `{"data":{"status":"success"}}`,
+		},
+		{
+			name:        "Invalid Email",
+			requestBody: `{"code":"TK-1A2B3C4D","email":"not-an-email"}`,
+			setupMock: func() {
+				mockOrderService.EXPECT().
+					RequestGuestOrderLink(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrValidation)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"validation error"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/guest/orders/lookup", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.RequestGuestOrderLink(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package pass
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+type PassHandler interface {
+	CreatePass(ctx echo.Context) error
+	DeletePass(ctx echo.Context) error
+	GetPasses(ctx echo.Context) error
+	GetPassByID(ctx echo.Context) error
+	OrderPass(ctx echo.Context) error
+	ScanPass(ctx echo.Context) error
+}
@@ -0,0 +1,236 @@
+package pass
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/service/pass"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type PassHandlerImpl struct {
+	Log         *logrus.Logger
+	PassService pass.PassService
+}
+
+func NewPassHandler(log *logrus.Logger, passService pass.PassService) PassHandler {
+	return &PassHandlerImpl{
+		Log:         log,
+		PassService: passService,
+	}
+}
+
+// @Summary Create a pass
+// @Description Create a day pass or season membership covering a set of events, every event of a series, or both
+// @Tags passes
+// @Accept json
+// @Produce json
+// @Param request body model.CreatePassRequest true "Pass details"
+// @Success 201 {object} model.Response[model.PassResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /passes [post]
+func (h *PassHandlerImpl) CreatePass(ctx echo.Context) error {
+	request := new(model.CreatePassRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.PassService.CreatePass(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to create pass: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Delete a pass
+// @Description Withdraw a pass from sale. Passes already sold stay valid.
+// @Tags passes
+// @Param id path int true "Pass ID"
+// @Success 204
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /passes/{id} [delete]
+func (h *PassHandlerImpl) DeletePass(ctx echo.Context) error {
+	request := new(model.DeletePassRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	if err := h.PassService.DeletePass(ctx.Request().Context(), request); err != nil {
+		h.Log.Errorf("failed to delete pass: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// @Summary Get passes
+// @Description Get the passes on sale with the upcoming events each one covers
+// @Tags passes
+// @Produce json
+// @Success 200 {object} model.Response[[]model.PassResponse]
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @Router /passes [get]
+func (h *PassHandlerImpl) GetPasses(ctx echo.Context) error {
+	response, err := h.PassService.GetPasses(ctx.Request().Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Get a pass
+// @Description Get a pass with the upcoming events it covers
+// @Tags passes
+// @Produce json
+// @Param id path int true "Pass ID"
+// @Success 200 {object} model.Response[model.PassResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @Router /passes/{id} [get]
+func (h *PassHandlerImpl) GetPassByID(ctx echo.Context) error {
+	request := new(model.GetPassRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.PassService.GetPassByID(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Buy a pass
+// @Description Buy a pass in a single order. A seat is taken at every upcoming covered event, the order code is scanned at the door.
+// @Tags passes
+// @Produce json
+// @Param id path int true "Pass ID"
+// @Param X-Challenge-Token header string false "Bot challenge token, required when the client is challenged"
+// @Success 201 {object} model.Response[model.OrderResponse]
+// @Failure 400 {object} model.Error
+// @Failure 401 {object} model.Error
+// @Failure 403 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 428 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /passes/{id}/orders [post]
+func (h *PassHandlerImpl) OrderPass(ctx echo.Context) error {
+	request := new(model.OrderPassRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.PassService.OrderPass(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to order pass: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrAdmissionRequired):
+			return handler.HandleError(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrNotEnoughTickets),
+			errors.Is(err, domainErrors.ErrSeatAlreadyTaken),
+			errors.Is(err, domainErrors.ErrEventNotOnSale):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Scan a pass
+// @Description Admit a pass holder at an event. A paid pass is valid once at each event it covers.
+// @Tags passes
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body model.ScanPassRequest true "Scanned pass code"
+// @Success 200 {object} model.Response[model.PassScanResponse]
+// @Failure 400 {object} model.Error
+// @Failure 403 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/passes/scan [post]
+func (h *PassHandlerImpl) ScanPass(ctx echo.Context) error {
+	request := new(model.ScanPassRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.PassService.ScanPass(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to scan pass: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrForbidden):
+			return handler.HandleError(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrAlreadyCheckedIn),
+			errors.Is(err, domainErrors.ErrOrderNotPaid):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
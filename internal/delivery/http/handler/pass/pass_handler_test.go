@@ -0,0 +1,211 @@
+package pass_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/pass"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	mockPass "github.com/TrinityKnights/Backend/test/mock/service/pass"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTest(t *testing.T) (*pass.PassHandlerImpl, *mockPass.MockPassService, *echo.Echo) {
+	ctrl := gomock.NewController(t)
+	mockPassService := mockPass.NewMockPassService(ctrl)
+	logger := logrus.New()
+	handler := pass.NewPassHandler(logger, mockPassService).(*pass.PassHandlerImpl)
+	e := echo.New()
+	return handler, mockPassService, e
+}
+
+func TestPassHandler_OrderPass(t *testing.T) {
+	handler, mockPassService, e := setupTest(t)
+
+	code := "PS-1A2B3C4D"
+	passID := uint(3)
+	total := 450.0
+	quantity := 3
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			setupMock: func() {
+				mockPassService.EXPECT().
+					OrderPass(gomock.Any(), &model.OrderPassRequest{ID: 3}).
+					Return(&model.OrderResponse{
+						ID:         40,
+						EventIDs:   []uint{1, 2, 3},
+						UserID:     "user-1",
+						Code:       &code,
+						PassID:     &passID,
+						Quantity:   &quantity,
+						TotalPrice: &total,
+						Date:       "2024-03-15 09:00:00",
+						Payment:    &model.CreatePaymentResponse{ID: 50, OrderID: 40, Amount: 450, Status: "PENDING", ExpiryDate: "2024-03-16T02:00:00Z", PaymentURL: "https://checkout.xendit.co/web/a"},
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: `{"data":{"id":40,"event_ids":[1,2,3],"user_id":"user-1","code":"PS-1A2B3C4D","pass_id":3,"quantity":3,"total_price":450,"date":"2024-03-15 09:00:00",` +
+				`"payment":{"id":50,"order_id":40,"amount":450,"status":"PENDING","expiry_date":"2024-03-16T02:00:00Z","payment_url":"https://checkout.xendit.co/web/a"}}}`,
+		},
+		{
+			name: "Sold Out At A Covered Event",
+			setupMock: func() {
+				mockPassService.EXPECT().
+					OrderPass(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotEnoughTickets)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"not enough tickets available"}}`,
+		},
+		{
+			name: "Waiting Room Admission Required",
+			setupMock: func() {
+				mockPassService.EXPECT().
+					OrderPass(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrAdmissionRequired)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":{"code":403,"message":"admission from the waiting room is required"}}`,
+		},
+		{
+			name: "Covered Event Off Sale",
+			setupMock: func() {
+				mockPassService.EXPECT().
+					OrderPass(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrEventNotOnSale)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"event is not on sale"}}`,
+		},
+		{
+			name: "Pass Not Found",
+			setupMock: func() {
+				mockPassService.EXPECT().
+					OrderPass(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/passes/3/orders", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("3")
+
+			tc.setupMock()
+
+			err := handler.OrderPass(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestPassHandler_ScanPass(t *testing.T) {
+	handler, mockPassService, e := setupTest(t)
+
+	requestBody := `{"code":"PS-1A2B3C4D"}`
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			setupMock: func() {
+				mockPassService.EXPECT().
+					ScanPass(gomock.Any(), &model.ScanPassRequest{EventID: 2, Code: "PS-1A2B3C4D"}).
+					Return(&model.PassScanResponse{
+						Code:        "PS-1A2B3C4D",
+						PassID:      3,
+						OrderID:     40,
+						EventID:     2,
+						TicketID:    "ticket-2",
+						Type:        "REGULAR",
+						SeatNumber:  "REG-7",
+						CheckedInAt: "2024-03-21 18:30:00",
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"code":"PS-1A2B3C4D","pass_id":3,"order_id":40,"event_id":2,"ticket_id":"ticket-2","type":"REGULAR","seat_number":"REG-7","checked_in_at":"2024-03-21 18:30:00"}}`,
+		},
+		{
+			name: "Already Used At This Event",
+			setupMock: func() {
+				mockPassService.EXPECT().
+					ScanPass(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrAlreadyCheckedIn)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"ticket has already been checked in"}}`,
+		},
+		{
+			name: "Event Not Covered",
+			setupMock: func() {
+				mockPassService.EXPECT().
+					ScanPass(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrForbidden)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":{"code":403,"message":"forbidden"}}`,
+		},
+		{
+			name: "Not Paid",
+			setupMock: func() {
+				mockPassService.EXPECT().
+					ScanPass(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrOrderNotPaid)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"order has not been paid"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/events/2/passes/scan", strings.NewReader(requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("2")
+
+			tc.setupMock()
+
+			err := handler.ScanPass(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
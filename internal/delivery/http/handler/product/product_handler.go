@@ -0,0 +1,14 @@
+package product
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+type ProductHandler interface {
+	CreateProduct(ctx echo.Context) error
+	UpdateProduct(ctx echo.Context) error
+	DeleteProduct(ctx echo.Context) error
+	GetProducts(ctx echo.Context) error
+	GetVoucher(ctx echo.Context) error
+	RedeemVoucher(ctx echo.Context) error
+}
@@ -0,0 +1,238 @@
+package product
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/service/product"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type ProductHandlerImpl struct {
+	Log            *logrus.Logger
+	ProductService product.ProductService
+}
+
+func NewProductHandler(log *logrus.Logger, productService product.ProductService) ProductHandler {
+	return &ProductHandlerImpl{
+		Log:            log,
+		ProductService: productService,
+	}
+}
+
+// @Summary Create a product
+// @Description Add an add-on product such as parking, merchandise or a food voucher to an event's catalogue
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body model.CreateProductRequest true "Product details"
+// @Success 201 {object} model.Response[model.ProductResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/products [post]
+func (h *ProductHandlerImpl) CreateProduct(ctx echo.Context) error {
+	request := new(model.CreateProductRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.ProductService.CreateProduct(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to create product: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrDuplicateEntry):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Update a product
+// @Description Update a product's details, price or stock
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param request body model.UpdateProductRequest true "Product details"
+// @Success 200 {object} model.Response[model.ProductResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /products/{id} [put]
+func (h *ProductHandlerImpl) UpdateProduct(ctx echo.Context) error {
+	request := new(model.UpdateProductRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.ProductService.UpdateProduct(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to update product: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Delete a product
+// @Description Remove a product from the catalogue. Items already sold are kept on their orders.
+// @Tags products
+// @Produce json
+// @Param id path int true "Product ID"
+// @Success 204
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /products/{id} [delete]
+func (h *ProductHandlerImpl) DeleteProduct(ctx echo.Context) error {
+	request := new(model.DeleteProductRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	if err := h.ProductService.DeleteProduct(ctx.Request().Context(), request); err != nil {
+		h.Log.Errorf("failed to delete product: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// @Summary Get event products
+// @Description Get the add-on products that can be ordered with an event's tickets
+// @Tags products
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} model.Response[[]model.ProductResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @Router /events/{id}/products [get]
+func (h *ProductHandlerImpl) GetProducts(ctx echo.Context) error {
+	request := new(model.ProductsRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.ProductService.GetProducts(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Get a voucher
+// @Description Look up a scanned add-on voucher
+// @Tags products
+// @Produce json
+// @Param code path string true "Voucher code"
+// @Success 200 {object} model.Response[model.VoucherResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /vouchers/{code} [get]
+func (h *ProductHandlerImpl) GetVoucher(ctx echo.Context) error {
+	request := new(model.GetVoucherRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.ProductService.GetVoucher(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Redeem a voucher
+// @Description Mark a scanned add-on voucher as used. A voucher can only be redeemed once, and only for a paid or complimentary order.
+// @Tags products
+// @Produce json
+// @Param code path string true "Voucher code"
+// @Success 200 {object} model.Response[model.VoucherResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /vouchers/{code}/redeem [post]
+func (h *ProductHandlerImpl) RedeemVoucher(ctx echo.Context) error {
+	request := new(model.GetVoucherRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.ProductService.RedeemVoucher(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to redeem voucher: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrVoucherRedeemed),
+			errors.Is(err, domainErrors.ErrOrderNotPaid):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
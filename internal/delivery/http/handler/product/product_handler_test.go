@@ -0,0 +1,117 @@
+package product_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/product"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	mockProduct "github.com/TrinityKnights/Backend/test/mock/service/product"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTest(t *testing.T) (*product.ProductHandlerImpl, *mockProduct.MockProductService, *echo.Echo) {
+	ctrl := gomock.NewController(t)
+	mockProductService := mockProduct.NewMockProductService(ctrl)
+	logger := logrus.New()
+	handler := product.NewProductHandler(logger, mockProductService).(*product.ProductHandlerImpl)
+	e := echo.New()
+	return handler, mockProductService, e
+}
+
+func TestProductHandler_RedeemVoucher(t *testing.T) {
+	handler, mockProductService, e := setupTest(t)
+
+	redeemedAt := "2024-03-20T18:00:00Z"
+	redeemedBy := "admin-1"
+
+	tests := []struct {
+		name           string
+		code           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			code: "V-1a2b3c4d",
+			setupMock: func() {
+				mockProductService.EXPECT().
+					RedeemVoucher(gomock.Any(), &model.GetVoucherRequest{
+						Code: "V-1a2b3c4d",
+					}).
+					Return(&model.VoucherResponse{
+						Code:       "V-1a2b3c4d",
+						OrderID:    10,
+						ProductID:  2,
+						SKU:        "PARK-01",
+						Name:       "Parking Pass",
+						RedeemedAt: &redeemedAt,
+						RedeemedBy: &redeemedBy,
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"code":"V-1a2b3c4d","order_id":10,"product_id":2,"sku":"PARK-01","name":"Parking Pass","redeemed_at":"2024-03-20T18:00:00Z","redeemed_by":"admin-1"}}`,
+		},
+		{
+			name: "Already Redeemed",
+			code: "V-1a2b3c4d",
+			setupMock: func() {
+				mockProductService.EXPECT().
+					RedeemVoucher(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrVoucherRedeemed)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"voucher has already been redeemed"}}`,
+		},
+		{
+			name: "Order Not Paid",
+			code: "V-9f8e7d6c",
+			setupMock: func() {
+				mockProductService.EXPECT().
+					RedeemVoucher(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrOrderNotPaid)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"order has not been paid"}}`,
+		},
+		{
+			name: "Unknown Voucher",
+			code: "V-00000000",
+			setupMock: func() {
+				mockProductService.EXPECT().
+					RedeemVoucher(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/vouchers/"+tc.code+"/redeem", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("code")
+			c.SetParamValues(tc.code)
+
+			tc.setupMock()
+
+			err := handler.RedeemVoucher(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package series
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+type SeriesHandler interface {
+	CreateSeries(ctx echo.Context) error
+	GetSeriesByID(ctx echo.Context) error
+	AddException(ctx echo.Context) error
+	AddTicketTemplate(ctx echo.Context) error
+	UpdateOccurrence(ctx echo.Context) error
+}
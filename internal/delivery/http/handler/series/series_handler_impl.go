@@ -0,0 +1,223 @@
+package series
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/service/series"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type SeriesHandlerImpl struct {
+	Log           *logrus.Logger
+	SeriesService series.SeriesService
+}
+
+func NewSeriesHandler(log *logrus.Logger, seriesService series.SeriesService) SeriesHandler {
+	return &SeriesHandlerImpl{
+		Log:           log,
+		SeriesService: seriesService,
+	}
+}
+
+// @Summary Create an event series
+// @Description Create a recurring event from an RRULE (FREQ DAILY, WEEKLY or MONTHLY with UNTIL or COUNT). One event is generated per date, with the exceptions applied and the ticket templates copied onto each.
+// @Tags series
+// @Accept json
+// @Produce json
+// @Param request body model.CreateSeriesRequest true "Series details"
+// @Success 201 {object} model.Response[model.SeriesResponse]
+// @Failure 400 {object} model.Error
+// @Failure 401 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /series [post]
+func (h *SeriesHandlerImpl) CreateSeries(ctx echo.Context) error {
+	request := new(model.CreateSeriesRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.SeriesService.CreateSeries(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to create series: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrCapacityExceeded), errors.Is(err, domainErrors.ErrVenueConflict):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Get an event series
+// @Description Get a series with its exceptions, ticket templates and occurrences
+// @Tags series
+// @Produce json
+// @Param id path int true "Series ID"
+// @Success 200 {object} model.Response[model.SeriesResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @Router /series/{id} [get]
+func (h *SeriesHandlerImpl) GetSeriesByID(ctx echo.Context) error {
+	request := new(model.GetSeriesRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.SeriesService.GetSeriesByID(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Add a series exception
+// @Description Skip or move one date of a series. Dates with tickets sold cannot be skipped.
+// @Tags series
+// @Accept json
+// @Produce json
+// @Param id path int true "Series ID"
+// @Param request body model.SeriesExceptionRequest true "Exception details"
+// @Success 201 {object} model.Response[model.SeriesExceptionResponse]
+// @Failure 400 {object} model.Error
+// @Failure 401 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /series/{id}/exceptions [post]
+func (h *SeriesHandlerImpl) AddException(ctx echo.Context) error {
+	request := new(model.AddSeriesExceptionRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.SeriesService.AddException(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to add series exception: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrDuplicateEntry), errors.Is(err, domainErrors.ErrEventHasSales),
+			errors.Is(err, domainErrors.ErrVenueConflict):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Add a series ticket template
+// @Description Add a ticket layout to a series and copy it onto every upcoming occurrence
+// @Tags series
+// @Accept json
+// @Produce json
+// @Param id path int true "Series ID"
+// @Param request body model.SeriesTicketTemplateRequest true "Ticket template"
+// @Success 201 {object} model.Response[model.SeriesTicketTemplateCopyResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /series/{id}/ticket-templates [post]
+func (h *SeriesHandlerImpl) AddTicketTemplate(ctx echo.Context) error {
+	request := new(model.AddSeriesTicketTemplateRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.SeriesService.AddTicketTemplate(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to add series ticket template: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrCapacityExceeded):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Update series occurrences
+// @Description Edit one occurrence (scope single), it and every following one (following) or the whole series (all). Only a single occurrence can be moved to another date.
+// @Tags series
+// @Accept json
+// @Produce json
+// @Param id path int true "Series ID"
+// @Param event_id path int true "Occurrence event ID"
+// @Param request body model.UpdateOccurrenceRequest true "Changes and scope"
+// @Success 200 {object} model.Response[model.SeriesResponse]
+// @Failure 400 {object} model.Error
+// @Failure 401 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /series/{id}/occurrences/{event_id} [put]
+func (h *SeriesHandlerImpl) UpdateOccurrence(ctx echo.Context) error {
+	request := new(model.UpdateOccurrenceRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.SeriesService.UpdateOccurrence(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to update occurrence: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrVenueConflict):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
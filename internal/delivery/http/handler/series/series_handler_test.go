@@ -0,0 +1,201 @@
+package series_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/series"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	mockSeries "github.com/TrinityKnights/Backend/test/mock/service/series"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTest(t *testing.T) (*series.SeriesHandlerImpl, *mockSeries.MockSeriesService, *echo.Echo) {
+	ctrl := gomock.NewController(t)
+	mockSeriesService := mockSeries.NewMockSeriesService(ctrl)
+	logger := logrus.New()
+	handler := series.NewSeriesHandler(logger, mockSeriesService).(*series.SeriesHandlerImpl)
+	e := echo.New()
+	return handler, mockSeriesService, e
+}
+
+func TestSeriesHandler_AddException(t *testing.T) {
+	handler, mockSeriesService, e := setupTest(t)
+
+	requestBody := `{"date":"2024-03-22","action":"move","new_date":"2024-03-23","new_time":"20:00:00"}`
+	newDate := "2024-03-23"
+	newTime := "20:00:00"
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			setupMock: func() {
+				mockSeriesService.EXPECT().
+					AddException(gomock.Any(), &model.AddSeriesExceptionRequest{ID: 3, Date: "2024-03-22", Action: "move", NewDate: "2024-03-23", NewTime: "20:00:00"}).
+					Return(&model.SeriesExceptionResponse{ID: 8, Date: "2024-03-22", Action: "MOVE", NewDate: &newDate, NewTime: &newTime}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"data":{"id":8,"date":"2024-03-22","action":"MOVE","new_date":"2024-03-23","new_time":"20:00:00"}}`,
+		},
+		{
+			name: "Date Already Has An Exception",
+			setupMock: func() {
+				mockSeriesService.EXPECT().
+					AddException(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrDuplicateEntry)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"duplicate entry"}}`,
+		},
+		{
+			name: "Tickets Sold",
+			setupMock: func() {
+				mockSeriesService.EXPECT().
+					AddException(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrEventHasSales)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"event already has tickets sold"}}`,
+		},
+		{
+			name: "Moved Onto A Booked Venue",
+			setupMock: func() {
+				mockSeriesService.EXPECT().
+					AddException(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrVenueConflict)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"venue is already booked at that time"}}`,
+		},
+		{
+			name: "Date Not In Series",
+			setupMock: func() {
+				mockSeriesService.EXPECT().
+					AddException(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrValidation)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"validation error"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/series/3/exceptions", strings.NewReader(requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("3")
+
+			tc.setupMock()
+
+			err := handler.AddException(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestSeriesHandler_UpdateOccurrence(t *testing.T) {
+	handler, mockSeriesService, e := setupTest(t)
+
+	requestBody := `{"scope":"following","time":"20:00:00"}`
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			setupMock: func() {
+				mockSeriesService.EXPECT().
+					UpdateOccurrence(gomock.Any(), &model.UpdateOccurrenceRequest{ID: 3, EventID: 41, Scope: "following", Time: "20:00:00"}).
+					Return(&model.SeriesResponse{
+						ID:          3,
+						Name:        "Friday Jazz",
+						Description: "Live jazz every weekend",
+						VenueID:     2,
+						Time:        "19:30:00",
+						RRule:       "FREQ=WEEKLY;BYDAY=FR,SA;UNTIL=20240630",
+						StartsOn:    "2024-03-01",
+						Occurrences: []*model.EventResponse{},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"data":{"id":3,"name":"Friday Jazz","description":"Live jazz every weekend","venue_id":2,"time":"19:30:00",` +
+				`"rrule":"FREQ=WEEKLY;BYDAY=FR,SA;UNTIL=20240630","starts_on":"2024-03-01","occurrences":[]}}`,
+		},
+		{
+			name: "Occurrence Not In Series",
+			setupMock: func() {
+				mockSeriesService.EXPECT().
+					UpdateOccurrence(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+		{
+			name: "Moved Onto A Booked Venue",
+			setupMock: func() {
+				mockSeriesService.EXPECT().
+					UpdateOccurrence(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrVenueConflict)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"venue is already booked at that time"}}`,
+		},
+		{
+			name: "Date Change Outside Single Scope",
+			setupMock: func() {
+				mockSeriesService.EXPECT().
+					UpdateOccurrence(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrValidation)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"validation error"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/series/3/occurrences/41", strings.NewReader(requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id", "event_id")
+			c.SetParamValues("3", "41")
+
+			tc.setupMock()
+
+			err := handler.UpdateOccurrence(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package slot
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+type SlotHandler interface {
+	CreateSlotTemplate(ctx echo.Context) error
+	GetSlotTemplates(ctx echo.Context) error
+	GenerateSlots(ctx echo.Context) error
+	GetSlots(ctx echo.Context) error
+	OrderSlot(ctx echo.Context) error
+}
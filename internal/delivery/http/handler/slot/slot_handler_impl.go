@@ -0,0 +1,215 @@
+package slot
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/service/slot"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type SlotHandlerImpl struct {
+	Log         *logrus.Logger
+	SlotService slot.SlotService
+}
+
+func NewSlotHandler(log *logrus.Logger, slotService slot.SlotService) SlotHandler {
+	return &SlotHandlerImpl{
+		Log:         log,
+		SlotService: slotService,
+	}
+}
+
+// @Summary Create a slot template
+// @Description Define a daily schedule of entry slots for a timed-entry event, for example every 30 minutes from 10:00 to 17:00 for 50 people each
+// @Tags slots
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body model.CreateSlotTemplateRequest true "Slot template details"
+// @Success 201 {object} model.Response[model.SlotTemplateResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/slot-templates [post]
+func (h *SlotHandlerImpl) CreateSlotTemplate(ctx echo.Context) error {
+	request := new(model.CreateSlotTemplateRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.SlotService.CreateSlotTemplate(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to create slot template: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Get slot templates
+// @Description Get the slot templates of a timed-entry event
+// @Tags slots
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} model.Response[[]model.SlotTemplateResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/slot-templates [get]
+func (h *SlotHandlerImpl) GetSlotTemplates(ctx echo.Context) error {
+	request := new(model.SlotTemplatesRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.SlotService.GetSlotTemplates(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Generate slots
+// @Description Generate a template's slots for every day of a date range of up to 92 days. Existing and past slots are skipped, so a range can be generated again.
+// @Tags slots
+// @Accept json
+// @Produce json
+// @Param id path int true "Slot template ID"
+// @Param request body model.GenerateSlotsRequest true "Date range"
+// @Success 201 {object} model.Response[model.GenerateSlotsResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /slot-templates/{id}/slots [post]
+func (h *SlotHandlerImpl) GenerateSlots(ctx echo.Context) error {
+	request := new(model.GenerateSlotsRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.SlotService.GenerateSlots(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to generate slots: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrCapacityExceeded):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Get slot availability
+// @Description Get an event's slots with their remaining places for a calendar view. Defaults to the coming 7 days, a range can span up to 31 days.
+// @Tags slots
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Success 200 {object} model.Response[[]model.SlotResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @Router /events/{id}/slots [get]
+func (h *SlotHandlerImpl) GetSlots(ctx echo.Context) error {
+	request := new(model.SlotsRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.SlotService.GetSlots(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Book a slot
+// @Description Order a number of places in an entry slot. Places go back to the slot if the invoice expires.
+// @Tags slots
+// @Accept json
+// @Produce json
+// @Param id path int true "Slot ID"
+// @Param request body model.OrderSlotRequest true "Number of places"
+// @Param X-Challenge-Token header string false "Bot challenge token, required when the client is challenged"
+// @Success 201 {object} model.Response[model.OrderResponse]
+// @Failure 400 {object} model.Error
+// @Failure 401 {object} model.Error
+// @Failure 403 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 428 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /slots/{id}/orders [post]
+func (h *SlotHandlerImpl) OrderSlot(ctx echo.Context) error {
+	request := new(model.OrderSlotRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.SlotService.OrderSlot(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to order slot: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrAdmissionRequired):
+			return handler.HandleError(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrNotEnoughTickets),
+			errors.Is(err, domainErrors.ErrEventNotOnSale):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
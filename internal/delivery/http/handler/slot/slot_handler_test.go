@@ -0,0 +1,253 @@
+package slot_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/slot"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	mockSlot "github.com/TrinityKnights/Backend/test/mock/service/slot"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTest(t *testing.T) (*slot.SlotHandlerImpl, *mockSlot.MockSlotService, *echo.Echo) {
+	ctrl := gomock.NewController(t)
+	mockSlotService := mockSlot.NewMockSlotService(ctrl)
+	logger := logrus.New()
+	handler := slot.NewSlotHandler(logger, mockSlotService).(*slot.SlotHandlerImpl)
+	e := echo.New()
+	return handler, mockSlotService, e
+}
+
+func TestSlotHandler_GetSlots(t *testing.T) {
+	handler, mockSlotService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			setupMock: func() {
+				mockSlotService.EXPECT().
+					GetSlots(gomock.Any(), &model.SlotsRequest{EventID: 1, From: "2024-03-01", To: "2024-03-01"}).
+					Return([]*model.SlotResponse{
+						{ID: 10, EventID: 1, TemplateID: 2, StartsAt: "2024-03-01T10:00:00Z", EndsAt: "2024-03-01T10:30:00Z", Capacity: 50, Available: 12, Price: 75},
+						{ID: 11, EventID: 1, TemplateID: 2, StartsAt: "2024-03-01T10:30:00Z", EndsAt: "2024-03-01T11:00:00Z", Capacity: 50, Available: 50, Price: 75},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"data":[` +
+				`{"id":10,"event_id":1,"template_id":2,"starts_at":"2024-03-01T10:00:00Z","ends_at":"2024-03-01T10:30:00Z","capacity":50,"available":12,"price":75},` +
+				`{"id":11,"event_id":1,"template_id":2,"starts_at":"2024-03-01T10:30:00Z","ends_at":"2024-03-01T11:00:00Z","capacity":50,"available":50,"price":75}]}`,
+		},
+		{
+			name: "No Slots",
+			setupMock: func() {
+				mockSlotService.EXPECT().
+					GetSlots(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+		{
+			name: "Range Too Long",
+			setupMock: func() {
+				mockSlotService.EXPECT().
+					GetSlots(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrValidation)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"validation error"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/events/1/slots?from=2024-03-01&to=2024-03-01", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+
+			tc.setupMock()
+
+			err := handler.GetSlots(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestSlotHandler_OrderSlot(t *testing.T) {
+	handler, mockSlotService, e := setupTest(t)
+
+	requestBody := `{"quantity":3}`
+	eventID := uint(1)
+	quantity := 3
+	total := 225.0
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			setupMock: func() {
+				mockSlotService.EXPECT().
+					OrderSlot(gomock.Any(), &model.OrderSlotRequest{SlotID: 10, Quantity: 3}).
+					Return(&model.OrderResponse{
+						ID:         60,
+						EventID:    &eventID,
+						UserID:     "user-1",
+						Quantity:   &quantity,
+						TotalPrice: &total,
+						Date:       "2024-02-20T09:00:00Z",
+						Slots: []*model.SlotBookingResponse{
+							{ID: 4, SlotID: 10, StartsAt: "2024-03-01T10:00:00Z", EndsAt: "2024-03-01T10:30:00Z", Quantity: 3, UnitPrice: 75, TotalPrice: 225},
+						},
+						Payment: &model.CreatePaymentResponse{ID: 70, OrderID: 60, Amount: 225, Status: "PENDING", ExpiryDate: "2024-02-21T02:00:00Z", PaymentURL: "https://checkout.xendit.co/web/a"},
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: `{"data":{"id":60,"event_id":1,"user_id":"user-1","quantity":3,"total_price":225,"date":"2024-02-20T09:00:00Z",` +
+				`"slots":[{"id":4,"slot_id":10,"starts_at":"2024-03-01T10:00:00Z","ends_at":"2024-03-01T10:30:00Z","quantity":3,"unit_price":75,"total_price":225}],` +
+				`"payment":{"id":70,"order_id":60,"amount":225,"status":"PENDING","expiry_date":"2024-02-21T02:00:00Z","payment_url":"https://checkout.xendit.co/web/a"}}}`,
+		},
+		{
+			name: "Slot Full",
+			setupMock: func() {
+				mockSlotService.EXPECT().
+					OrderSlot(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotEnoughTickets)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"not enough tickets available"}}`,
+		},
+		{
+			name: "Waiting Room Admission Required",
+			setupMock: func() {
+				mockSlotService.EXPECT().
+					OrderSlot(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrAdmissionRequired)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":{"code":403,"message":"admission from the waiting room is required"}}`,
+		},
+		{
+			name: "Slot Not Found",
+			setupMock: func() {
+				mockSlotService.EXPECT().
+					OrderSlot(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/slots/10/orders", strings.NewReader(requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("10")
+
+			tc.setupMock()
+
+			err := handler.OrderSlot(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestSlotHandler_GenerateSlots(t *testing.T) {
+	handler, mockSlotService, e := setupTest(t)
+
+	requestBody := `{"from":"2024-03-01","to":"2024-03-07"}`
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			setupMock: func() {
+				mockSlotService.EXPECT().
+					GenerateSlots(gomock.Any(), &model.GenerateSlotsRequest{TemplateID: 2, From: "2024-03-01", To: "2024-03-07"}).
+					Return(&model.GenerateSlotsResponse{TemplateID: 2, Created: 110, Skipped: 2}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"data":{"template_id":2,"created":110,"skipped":2}}`,
+		},
+		{
+			name: "Capacity Exceeded",
+			setupMock: func() {
+				mockSlotService.EXPECT().
+					GenerateSlots(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrCapacityExceeded)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"event capacity exceeded"}}`,
+		},
+		{
+			name: "Template Not Found",
+			setupMock: func() {
+				mockSlotService.EXPECT().
+					GenerateSlots(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/slot-templates/2/slots", strings.NewReader(requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("2")
+
+			tc.setupMock()
+
+			err := handler.GenerateSlots(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 type TicketHandler interface {
 	CreateTicket(ctx echo.Context) error
+	GetEventCapacity(ctx echo.Context) error
+	GetEventAvailability(ctx echo.Context) error
 	UpdateTicket(ctx echo.Context) error
 	GetTicketByID(ctx echo.Context) error
 	GetAllTickets(ctx echo.Context) error
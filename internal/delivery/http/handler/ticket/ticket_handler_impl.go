@@ -32,6 +32,8 @@ func NewTicketHandler(log *logrus.Logger, ticketService ticket.TicketService) Ti
 // @Param request body model.CreateTicketRequest true "Ticket details"
 // @Success 201 {object} model.Response[[]model.TicketResponse]
 // @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error "Event capacity exceeded, the message tells the remaining capacity"
 // @Failure 500 {object} model.Error
 // @security ApiKeyAuth
 // @Router /tickets [post]
@@ -45,15 +47,88 @@ func (h *TicketHandlerImpl) CreateTicket(ctx echo.Context) error {
 	response, err := h.TicketService.CreateTicket(ctx.Request().Context(), request)
 	if err != nil {
 		h.Log.Errorf("failed to create tickets: %v", err)
-		if errors.Is(err, domainErrors.ErrBadRequest) {
+		switch {
+		case errors.Is(err, domainErrors.ErrBadRequest):
 			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrCapacityExceeded):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
 		}
-		return handler.HandleError(ctx, http.StatusInternalServerError, err)
 	}
 
 	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
 }
 
+// @Summary Get an event's capacity @admin
+// @Description Show how much of an event's capacity its ticket inventory uses, per ticket category. The capacity is the event's own override or else its venue's; a null capacity means the event is not limited.
+// @Tags tickets
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} model.Response[model.EventCapacityResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/capacity [get]
+func (h *TicketHandlerImpl) GetEventCapacity(ctx echo.Context) error {
+	request := new(model.GetEventCapacityRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.TicketService.GetEventCapacity(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to get event capacity: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Get an event's ticket availability
+// @Description Count each ticket category's seats as sold, held, allocated to partners or available, with the category's price range and totals across categories
+// @Tags tickets
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} model.Response[model.EventAvailabilityResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @Router /events/{id}/availability [get]
+func (h *TicketHandlerImpl) GetEventAvailability(ctx echo.Context) error {
+	request := new(model.GetEventAvailabilityRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.TicketService.GetEventAvailability(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to get event availability: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
 // @Summary Update an existing ticket @admin
 // @Description Update an existing ticket with the provided details
 // @Tags tickets
@@ -0,0 +1,223 @@
+package ticket_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/ticket"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	mockTicket "github.com/TrinityKnights/Backend/test/mock/service/ticket"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTest(t *testing.T) (*ticket.TicketHandlerImpl, *mockTicket.MockTicketService, *echo.Echo) {
+	ctrl := gomock.NewController(t)
+	mockTicketService := mockTicket.NewMockTicketService(ctrl)
+	logger := logrus.New()
+	handler := ticket.NewTicketHandler(logger, mockTicketService).(*ticket.TicketHandlerImpl)
+	e := echo.New()
+	return handler, mockTicketService, e
+}
+
+func TestTicketHandler_CreateTicket(t *testing.T) {
+	handler, mockTicketService, e := setupTest(t)
+
+	requestBody := `{"event_id":1,"price":150000,"type":"regular","count":200}`
+	request := &model.CreateTicketRequest{EventID: 1, Price: 150000, Type: "regular", Count: 200}
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Capacity Exceeded",
+			setupMock: func() {
+				mockTicketService.EXPECT().
+					CreateTicket(gomock.Any(), request).
+					Return(nil, fmt.Errorf("%w: %d seat(s) remaining of %d", domainErrors.ErrCapacityExceeded, 120, 1000))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"event capacity exceeded: 120 seat(s) remaining of 1000"}}`,
+		},
+		{
+			name: "Event Not Found",
+			setupMock: func() {
+				mockTicketService.EXPECT().
+					CreateTicket(gomock.Any(), request).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/tickets", strings.NewReader(requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.CreateTicket(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestTicketHandler_GetEventCapacity(t *testing.T) {
+	handler, mockTicketService, e := setupTest(t)
+
+	capacity := 800
+	available := 300
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			setupMock: func() {
+				mockTicketService.EXPECT().
+					GetEventCapacity(gomock.Any(), &model.GetEventCapacityRequest{EventID: 1}).
+					Return(&model.EventCapacityResponse{
+						EventID:          1,
+						VenueID:          2,
+						VenueCapacity:    1000,
+						CapacityOverride: &capacity,
+						Capacity:         &capacity,
+						Used:             500,
+						Available:        &available,
+						Categories: []*model.TicketCategoryCount{
+							{Type: "REGULAR", Count: 400},
+							{Type: "VIP", Count: 100},
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"data":{"event_id":1,"venue_id":2,"venue_capacity":1000,"capacity_override":800,"capacity":800,` +
+				`"used":500,"available":300,"categories":[{"type":"REGULAR","count":400},{"type":"VIP","count":100}]}}`,
+		},
+		{
+			name: "Event Not Found",
+			setupMock: func() {
+				mockTicketService.EXPECT().
+					GetEventCapacity(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/events/1/capacity", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+
+			tc.setupMock()
+
+			err := handler.GetEventCapacity(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestTicketHandler_GetEventAvailability(t *testing.T) {
+	handler, mockTicketService, e := setupTest(t)
+
+	regularPrice := 150000.0
+	vipPrice := 500000.0
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			setupMock: func() {
+				mockTicketService.EXPECT().
+					GetEventAvailability(gomock.Any(), &model.GetEventAvailabilityRequest{EventID: 1}).
+					Return(&model.EventAvailabilityResponse{
+						EventID:   1,
+						Total:     150,
+						Held:      5,
+						Sold:      92,
+						Allocated: 20,
+						Available: 33,
+						MinPrice:  &regularPrice,
+						MaxPrice:  &vipPrice,
+						Categories: []*model.CategoryAvailability{
+							{Type: "REGULAR", Total: 100, Held: 3, Sold: 67, Allocated: 20, Available: 10, MinPrice: &regularPrice, MaxPrice: &regularPrice},
+							{Type: "VIP", Total: 50, Held: 2, Sold: 25, Available: 23, MinPrice: &vipPrice, MaxPrice: &vipPrice},
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"data":{"event_id":1,"total":150,"held":5,"sold":92,"allocated":20,"available":33,"min_price":150000,"max_price":500000,"categories":[` +
+				`{"type":"REGULAR","total":100,"held":3,"sold":67,"allocated":20,"available":10,"min_price":150000,"max_price":150000},` +
+				`{"type":"VIP","total":50,"held":2,"sold":25,"allocated":0,"available":23,"min_price":500000,"max_price":500000}]}}`,
+		},
+		{
+			name: "Event Not Found",
+			setupMock: func() {
+				mockTicketService.EXPECT().
+					GetEventAvailability(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/events/1/availability", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+
+			tc.setupMock()
+
+			err := handler.GetEventAvailability(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
@@ -11,4 +11,19 @@ type UserHandler interface {
 	RequestReset(ctx echo.Context) error
 	ResetPassword(ctx echo.Context) error
 	VerifyEmail(ctx echo.Context) error
+	Unlock(ctx echo.Context) error
+	Logout(ctx echo.Context) error
+	LogoutAll(ctx echo.Context) error
+	GetSessions(ctx echo.Context) error
+	RevokeSession(ctx echo.Context) error
+	GetUserSessions(ctx echo.Context) error
+	LoginTwoFactor(ctx echo.Context) error
+	LoginEnrolTwoFactor(ctx echo.Context) error
+	LoginConfirmTwoFactor(ctx echo.Context) error
+	EnrolTwoFactor(ctx echo.Context) error
+	ConfirmTwoFactor(ctx echo.Context) error
+	DisableTwoFactor(ctx echo.Context) error
+	RegenerateRecoveryCodes(ctx echo.Context) error
+	GetRolePolicy(ctx echo.Context) error
+	UpdateRolePolicy(ctx echo.Context) error
 }
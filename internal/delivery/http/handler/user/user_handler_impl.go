@@ -31,8 +31,10 @@ func NewUserHandler(log *logrus.Logger, userService user.UserService) *UserHandl
 // @Accept json
 // @Produce json
 // @Param user body model.RegisterRequest true "User data"
+// @Param X-Challenge-Token header string false "Bot challenge token, required when the client is challenged"
 // @Success 201 {object} model.Response[model.UserResponse]
 // @Failure 400 {object} model.Error
+// @Failure 428 {object} model.Error
 // @Failure 500 {object} model.Error
 // @Router /users [post]
 func (h *UserHandlerImpl) Register(ctx echo.Context) error {
@@ -60,14 +62,17 @@ func (h *UserHandlerImpl) Register(ctx echo.Context) error {
 
 // Login function is a handler to login user
 // @Summary Login user
-// @Description Login user
+// @Description Login user. When the user has two-factor authentication, or their role requires it, no tokens are returned yet: two_factor is set and challenge_token must be exchanged at /users/login/2fa, or at /users/login/2fa/enrol and /users/login/2fa/confirm for users who still have to enrol.
 // @Tags user
 // @Accept json
 // @Produce json
 // @Param user body model.LoginRequest true "User data"
+// @Param X-Challenge-Token header string false "Bot challenge token, required when the client is challenged"
 // @Success 200 {object} model.Response[model.TokenResponse]
 // @Failure 400 {object} model.Error
 // @Failure 401 {object} model.Error
+// @Failure 428 {object} model.Error
+// @Failure 429 {object} model.Error
 // @Failure 500 {object} model.Error
 // @Router /users/login [post]
 func (h *UserHandlerImpl) Login(ctx echo.Context) error {
@@ -77,6 +82,9 @@ func (h *UserHandlerImpl) Login(ctx echo.Context) error {
 		return handler.HandleError(ctx, http.StatusBadRequest, domainErrors.ErrBadRequest)
 	}
 
+	request.IP = ctx.RealIP()
+	request.UserAgent = ctx.Request().UserAgent()
+
 	response, err := h.User.Login(ctx.Request().Context(), request)
 	if err != nil {
 		h.Log.Errorf("failed to login: %v", err)
@@ -85,6 +93,8 @@ func (h *UserHandlerImpl) Login(ctx echo.Context) error {
 			return handler.HandleError(ctx, http.StatusBadRequest, err)
 		case errors.Is(err, domainErrors.ErrUnauthorized):
 			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrTooManyAttempts):
+			return handler.HandleError(ctx, http.StatusTooManyRequests, err)
 		default:
 			return handler.HandleError(ctx, http.StatusInternalServerError, err)
 		}
@@ -177,6 +187,8 @@ func (h *UserHandlerImpl) RefreshToken(ctx echo.Context) error {
 		return handler.HandleError(ctx, http.StatusBadRequest, domainErrors.ErrBadRequest)
 	}
 
+	request.IP = ctx.RealIP()
+
 	response, err := h.User.RefreshToken(ctx.Request().Context(), request)
 	if err != nil {
 		h.Log.Errorf("failed to refresh token: %v", err)
@@ -200,9 +212,10 @@ func (h *UserHandlerImpl) RefreshToken(ctx echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param user body model.ReqResetPasswordRequest true "User data"
+// @Param X-Challenge-Token header string false "Bot challenge token, required when the client is challenged"
 // @Success 200 {object} model.Response[model.VerifyResponse]
 // @Failure 400 {object} model.Error
-// @Failure 404 {object} model.Error
+// @Failure 428 {object} model.Error
 // @Failure 500 {object} model.Error
 // @Router /users/request-reset [post]
 func (h *UserHandlerImpl) RequestReset(ctx echo.Context) error {
@@ -235,9 +248,11 @@ func (h *UserHandlerImpl) RequestReset(ctx echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param user body model.ResetPasswordRequest true "User data"
+// @Param X-Challenge-Token header string false "Bot challenge token, required when the client is challenged"
 // @Success 200 {object} model.Response[model.VerifyResponse]
 // @Failure 400 {object} model.Error
 // @Failure 404 {object} model.Error
+// @Failure 428 {object} model.Error
 // @Failure 500 {object} model.Error
 // @Router /users/reset-password [post]
 func (h *UserHandlerImpl) ResetPassword(ctx echo.Context) error {
@@ -302,3 +317,559 @@ func (h *UserHandlerImpl) VerifyEmail(ctx echo.Context) error {
 
 	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
 }
+
+// Unlock function is a handler to clear the failed login lockout of a user
+// @Summary Unlock user
+// @Description Clear the failed login lockout of a user
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} model.Response[model.VerifyResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /users/{id}/unlock [post]
+func (h *UserHandlerImpl) Unlock(ctx echo.Context) error {
+	request := new(model.UnlockUserRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, domainErrors.ErrBadRequest)
+	}
+
+	response, err := h.User.Unlock(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to unlock user: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrBadRequest):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// Logout function is a handler to log out
+// @Summary Logout user
+// @Description Log out of the current session
+// @Tags user
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.Response[model.VerifyResponse]
+// @Failure 401 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /users/logout [post]
+func (h *UserHandlerImpl) Logout(ctx echo.Context) error {
+	response, err := h.User.Logout(ctx.Request().Context())
+	if err != nil {
+		h.Log.Errorf("failed to log out: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// LogoutAll function is a handler to log out of all sessions
+// @Summary Logout user everywhere
+// @Description Log out of every session of the user
+// @Tags user
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.Response[model.VerifyResponse]
+// @Failure 401 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /users/logout-all [post]
+func (h *UserHandlerImpl) LogoutAll(ctx echo.Context) error {
+	response, err := h.User.LogoutAll(ctx.Request().Context())
+	if err != nil {
+		h.Log.Errorf("failed to log out of all sessions: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// GetSessions function is a handler to list the active sessions of the user
+// @Summary Get sessions
+// @Description List the active sessions of the user, most recently used first. The session making the request is marked current.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.Response[[]model.SessionResponse]
+// @Failure 401 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /users/sessions [get]
+func (h *UserHandlerImpl) GetSessions(ctx echo.Context) error {
+	response, err := h.User.GetSessions(ctx.Request().Context())
+	if err != nil {
+		h.Log.Errorf("failed to get sessions: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// RevokeSession function is a handler to end one of the user's sessions
+// @Summary Revoke session
+// @Description End one of the user's sessions, which logs that device out
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param id path string true "Session ID"
+// @Success 200 {object} model.Response[model.VerifyResponse]
+// @Failure 400 {object} model.Error
+// @Failure 401 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /users/sessions/{id} [delete]
+func (h *UserHandlerImpl) RevokeSession(ctx echo.Context) error {
+	request := new(model.RevokeSessionRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, domainErrors.ErrBadRequest)
+	}
+
+	response, err := h.User.RevokeSession(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to revoke session: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrBadRequest):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// GetUserSessions function is a handler to list the active sessions of any user
+// @Summary Get user sessions @admin
+// @Description List the active sessions of a user, most recently used first
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} model.Response[[]model.SessionResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /users/{id}/sessions [get]
+func (h *UserHandlerImpl) GetUserSessions(ctx echo.Context) error {
+	request := new(model.GetUserSessionsRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, domainErrors.ErrBadRequest)
+	}
+
+	response, err := h.User.GetUserSessions(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to get user sessions: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrBadRequest):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// LoginTwoFactor function is a handler to finish a login with a second factor
+// @Summary Login with two-factor code
+// @Description Exchange the challenge token from /users/login and a TOTP code, or one of the recovery codes, for access and refresh tokens
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body model.LoginTwoFactorRequest true "Challenge token and code"
+// @Param X-Challenge-Token header string false "Bot challenge token, required when the client is challenged"
+// @Success 200 {object} model.Response[model.TokenResponse]
+// @Failure 400 {object} model.Error
+// @Failure 401 {object} model.Error
+// @Failure 428 {object} model.Error
+// @Failure 429 {object} model.Error
+// @Failure 500 {object} model.Error
+// @Router /users/login/2fa [post]
+func (h *UserHandlerImpl) LoginTwoFactor(ctx echo.Context) error {
+	request := new(model.LoginTwoFactorRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, domainErrors.ErrBadRequest)
+	}
+
+	request.IP = ctx.RealIP()
+	request.UserAgent = ctx.Request().UserAgent()
+
+	response, err := h.User.LoginTwoFactor(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to login with two-factor: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrBadRequest):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrTooManyAttempts):
+			return handler.HandleError(ctx, http.StatusTooManyRequests, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// LoginEnrolTwoFactor function is a handler to enrol during login when the user's role requires two-factor authentication
+// @Summary Enrol two-factor at login
+// @Description Start two-factor enrolment with the challenge token from /users/login, for users whose role requires it. Scan the QR code, then finish at /users/login/2fa/confirm.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body model.LoginEnrolTwoFactorRequest true "Challenge token"
+// @Success 200 {object} model.Response[model.EnrolTwoFactorResponse]
+// @Failure 400 {object} model.Error
+// @Failure 401 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @Router /users/login/2fa/enrol [post]
+func (h *UserHandlerImpl) LoginEnrolTwoFactor(ctx echo.Context) error {
+	request := new(model.LoginEnrolTwoFactorRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, domainErrors.ErrBadRequest)
+	}
+
+	response, err := h.User.LoginEnrolTwoFactor(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to enrol two-factor at login: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrBadRequest):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrTwoFactorEnabled):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// LoginConfirmTwoFactor function is a handler to confirm enrolment and finish the login
+// @Summary Confirm two-factor at login
+// @Description Turn on two-factor authentication with a code from the authenticator app and log in. The recovery codes are only shown in this response.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body model.LoginConfirmTwoFactorRequest true "Challenge token and TOTP code"
+// @Param X-Challenge-Token header string false "Bot challenge token, required when the client is challenged"
+// @Success 200 {object} model.Response[model.TokenResponse]
+// @Failure 400 {object} model.Error
+// @Failure 401 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 428 {object} model.Error
+// @Failure 429 {object} model.Error
+// @Failure 500 {object} model.Error
+// @Router /users/login/2fa/confirm [post]
+func (h *UserHandlerImpl) LoginConfirmTwoFactor(ctx echo.Context) error {
+	request := new(model.LoginConfirmTwoFactorRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, domainErrors.ErrBadRequest)
+	}
+
+	request.IP = ctx.RealIP()
+	request.UserAgent = ctx.Request().UserAgent()
+
+	response, err := h.User.LoginConfirmTwoFactor(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to confirm two-factor at login: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrBadRequest), errors.Is(err, domainErrors.ErrInvalidTwoFactor):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrTwoFactorEnabled):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		case errors.Is(err, domainErrors.ErrTooManyAttempts):
+			return handler.HandleError(ctx, http.StatusTooManyRequests, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// EnrolTwoFactor function is a handler to start two-factor enrolment
+// @Summary Enrol two-factor
+// @Description Create a TOTP secret for the user, returned as an otpauth URI and a QR code to scan with an authenticator app. Two-factor authentication stays off until confirmed at /users/2fa/confirm.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.Response[model.EnrolTwoFactorResponse]
+// @Failure 401 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /users/2fa/enrol [post]
+func (h *UserHandlerImpl) EnrolTwoFactor(ctx echo.Context) error {
+	response, err := h.User.EnrolTwoFactor(ctx.Request().Context())
+	if err != nil {
+		h.Log.Errorf("failed to enrol two-factor: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrTwoFactorEnabled):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// ConfirmTwoFactor function is a handler to turn on two-factor authentication
+// @Summary Confirm two-factor
+// @Description Turn on two-factor authentication with a code from the authenticator app. The recovery codes are only shown in this response.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body model.TwoFactorCodeRequest true "TOTP code"
+// @Success 200 {object} model.Response[model.RecoveryCodesResponse]
+// @Failure 400 {object} model.Error
+// @Failure 401 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /users/2fa/confirm [post]
+func (h *UserHandlerImpl) ConfirmTwoFactor(ctx echo.Context) error {
+	request := new(model.TwoFactorCodeRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, domainErrors.ErrBadRequest)
+	}
+
+	response, err := h.User.ConfirmTwoFactor(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to confirm two-factor: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrBadRequest), errors.Is(err, domainErrors.ErrInvalidTwoFactor):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrTwoFactorEnabled):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// DisableTwoFactor function is a handler to turn off two-factor authentication
+// @Summary Disable two-factor
+// @Description Turn off two-factor authentication with a TOTP code or a recovery code. Not allowed when the user's role requires two-factor authentication.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body model.TwoFactorCodeRequest true "TOTP or recovery code"
+// @Success 200 {object} model.Response[model.VerifyResponse]
+// @Failure 400 {object} model.Error
+// @Failure 401 {object} model.Error
+// @Failure 403 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 429 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /users/2fa/disable [post]
+func (h *UserHandlerImpl) DisableTwoFactor(ctx echo.Context) error {
+	request := new(model.TwoFactorCodeRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, domainErrors.ErrBadRequest)
+	}
+
+	response, err := h.User.DisableTwoFactor(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to disable two-factor: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrBadRequest), errors.Is(err, domainErrors.ErrInvalidTwoFactor):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrTwoFactorRequired):
+			return handler.HandleError(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrTooManyAttempts):
+			return handler.HandleError(ctx, http.StatusTooManyRequests, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// RegenerateRecoveryCodes function is a handler to replace the user's recovery codes
+// @Summary Regenerate recovery codes
+// @Description Replace all recovery codes, used or not, with a new set. The codes are only shown in this response.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body model.TwoFactorCodeRequest true "TOTP or recovery code"
+// @Success 200 {object} model.Response[model.RecoveryCodesResponse]
+// @Failure 400 {object} model.Error
+// @Failure 401 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 429 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /users/2fa/recovery-codes [post]
+func (h *UserHandlerImpl) RegenerateRecoveryCodes(ctx echo.Context) error {
+	request := new(model.TwoFactorCodeRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, domainErrors.ErrBadRequest)
+	}
+
+	response, err := h.User.RegenerateRecoveryCodes(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to regenerate recovery codes: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrBadRequest), errors.Is(err, domainErrors.ErrInvalidTwoFactor):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrTooManyAttempts):
+			return handler.HandleError(ctx, http.StatusTooManyRequests, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// GetRolePolicy function is a handler to get the security policy of a role
+// @Summary Get role policy @admin
+// @Description Get the security policy of a role, such as whether two-factor authentication is required
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param role path string true "Role" Enums(admin, buyer)
+// @Success 200 {object} model.Response[model.RolePolicyResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /roles/{role}/policy [get]
+func (h *UserHandlerImpl) GetRolePolicy(ctx echo.Context) error {
+	request := new(model.RolePolicyRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, domainErrors.ErrBadRequest)
+	}
+
+	response, err := h.User.GetRolePolicy(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to get role policy: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrBadRequest):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// UpdateRolePolicy function is a handler to change the security policy of a role
+// @Summary Update role policy @admin
+// @Description Make two-factor authentication mandatory for a role, or optional again. When it becomes mandatory, users of the role who have not enrolled are logged out and have to enrol at their next login. Admins have to enable two-factor authentication themselves before requiring it for their own role.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param role path string true "Role" Enums(admin, buyer)
+// @Param request body model.UpdateRolePolicyRequest true "Policy"
+// @Success 200 {object} model.Response[model.RolePolicyResponse]
+// @Failure 400 {object} model.Error
+// @Failure 401 {object} model.Error
+// @Failure 403 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /roles/{role}/policy [put]
+func (h *UserHandlerImpl) UpdateRolePolicy(ctx echo.Context) error {
+	request := new(model.UpdateRolePolicyRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, domainErrors.ErrBadRequest)
+	}
+
+	response, err := h.User.UpdateRolePolicy(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to update role policy: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrBadRequest):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrTwoFactorRequired):
+			return handler.HandleError(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
@@ -61,7 +61,7 @@ func TestUserHandler_Register(t *testing.T) {
 					}, nil)
 			},
 			expectedStatus: http.StatusCreated,
-			expectedBody:   `{"data":{"id":"1","email":"test@example.com","name":"Test User","role":"","status":false,"created_at":"","updated_at":""}}`,
+			expectedBody:   `{"data":{"id":"1","email":"test@example.com","name":"Test User","role":"","status":false,"two_factor_enabled":false,"created_at":"","updated_at":""}}`,
 		},
 		{
 			name: "Email Already Exists",
@@ -144,6 +144,7 @@ func TestUserHandler_Login(t *testing.T) {
 					Login(gomock.Any(), &model.LoginRequest{
 						Email:    "test@example.com",
 						Password: "password123",
+						IP:       "192.0.2.1",
 					}).
 					Return(&model.TokenResponse{
 						AccessToken:  "access_token",
@@ -153,6 +154,23 @@ func TestUserHandler_Login(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectedBody:   `{"data":{"access_token":"access_token","refresh_token":"refresh_token"}}`,
 		},
+		{
+			name: "Two-Factor Required",
+			requestBody: `{
+				"email": "test@example.com",
+				"password": "password123"
+			}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					Login(gomock.Any(), gomock.Any()).
+					Return(&model.TokenResponse{
+						TwoFactor:      model.TwoFactorRequired,
+						ChallengeToken: "challenge_token",
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"two_factor":"required","challenge_token":"challenge_token"}}`,
+		},
 		{
 			name: "Invalid Credentials",
 			requestBody: `{
@@ -167,6 +185,20 @@ func TestUserHandler_Login(t *testing.T) {
 			expectedStatus: http.StatusUnauthorized,
 			expectedBody:   `{"error":{"code":401, "message":"unauthorized"}}`,
 		},
+		{
+			name: "Locked Out",
+			requestBody: `{
+				"email": "test@example.com",
+				"password": "wrongpassword"
+			}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					Login(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrTooManyAttempts)
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedBody:   `{"error":{"code":429,"message":"too many failed attempts, try again later"}}`,
+		},
 		{
 			name:           "Invalid JSON Request",
 			requestBody:    `{"invalid json`,
@@ -185,6 +217,7 @@ func TestUserHandler_Login(t *testing.T) {
 					Login(gomock.Any(), &model.LoginRequest{
 						Email:    "",
 						Password: "",
+						IP:       "192.0.2.1",
 					}).
 					Return(nil, domainErrors.ErrBadRequest)
 			},
@@ -239,7 +272,7 @@ func TestUserHandler_Profile(t *testing.T) {
 					}, nil)
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody:   `{"data":{"id":"1","email":"test@example.com","name":"Test User","role":"","status":false,"created_at":"","updated_at":""}}`,
+			expectedBody:   `{"data":{"id":"1","email":"test@example.com","name":"Test User","role":"","status":false,"two_factor_enabled":false,"created_at":"","updated_at":""}}`,
 		},
 		{
 			name: "User Not Found",
@@ -316,7 +349,7 @@ func TestUserHandler_Update(t *testing.T) {
 					}, nil)
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody:   `{"data":{"id":"1","email":"updated@example.com","name":"Updated Name","role":"user","status":true,"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}}`,
+			expectedBody:   `{"data":{"id":"1","email":"updated@example.com","name":"Updated Name","role":"user","status":true,"two_factor_enabled":false,"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}}`,
 		},
 		{
 			name: "Invalid Request",
@@ -409,6 +442,7 @@ func TestUserHandler_RefreshToken(t *testing.T) {
 				mockUserService.EXPECT().
 					RefreshToken(gomock.Any(), &model.RefreshTokenRequest{
 						RefreshToken: "valid_refresh_token",
+						IP:           "192.0.2.1",
 					}).
 					Return(&model.TokenResponse{
 						AccessToken:  "new_access_token",
@@ -427,6 +461,7 @@ func TestUserHandler_RefreshToken(t *testing.T) {
 				mockUserService.EXPECT().
 					RefreshToken(gomock.Any(), &model.RefreshTokenRequest{
 						RefreshToken: "invalid_token",
+						IP:           "192.0.2.1",
 					}).
 					Return(nil, domainErrors.ErrUnauthorized)
 			},
@@ -449,6 +484,7 @@ func TestUserHandler_RefreshToken(t *testing.T) {
 				mockUserService.EXPECT().
 					RefreshToken(gomock.Any(), &model.RefreshTokenRequest{
 						RefreshToken: "",
+						IP:           "192.0.2.1",
 					}).
 					Return(nil, domainErrors.ErrBadRequest)
 			},
@@ -725,3 +761,1018 @@ func TestUserHandler_VerifyEmail(t *testing.T) {
 		})
 	}
 }
+
+func TestUserHandler_Unlock(t *testing.T) {
+	handler, mockUserService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		userID         string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "Success",
+			userID: "1",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					Unlock(gomock.Any(), &model.UnlockUserRequest{ID: "1"}).
+					Return(&model.VerifyResponse{Status: "success"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"status":"success"}}`,
+		},
+		{
+			name:   "User Not Found",
+			userID: "999",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					Unlock(gomock.Any(), &model.UnlockUserRequest{ID: "999"}).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+		{
+			name:   "Internal Server Error",
+			userID: "1",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					Unlock(gomock.Any(), &model.UnlockUserRequest{ID: "1"}).
+					Return(nil, domainErrors.ErrInternalServer)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"error":{"code":500,"message":"internal server error"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/users/"+tc.userID+"/unlock", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tc.userID)
+
+			tc.setupMock()
+
+			err := handler.Unlock(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestUserHandler_Logout(t *testing.T) {
+	handler, mockUserService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					Logout(gomock.Any()).
+					Return(&model.VerifyResponse{Status: "success"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"status":"success"}}`,
+		},
+		{
+			name: "Unauthorized",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					Logout(gomock.Any()).
+					Return(nil, domainErrors.ErrUnauthorized)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `{"error":{"code":401,"message":"unauthorized"}}`,
+		},
+		{
+			name: "Internal Server Error",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					Logout(gomock.Any()).
+					Return(nil, domainErrors.ErrInternalServer)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"error":{"code":500,"message":"internal server error"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/users/logout", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.Logout(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestUserHandler_LogoutAll(t *testing.T) {
+	handler, mockUserService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					LogoutAll(gomock.Any()).
+					Return(&model.VerifyResponse{Status: "success"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"status":"success"}}`,
+		},
+		{
+			name: "Unauthorized",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					LogoutAll(gomock.Any()).
+					Return(nil, domainErrors.ErrUnauthorized)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `{"error":{"code":401,"message":"unauthorized"}}`,
+		},
+		{
+			name: "Internal Server Error",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					LogoutAll(gomock.Any()).
+					Return(nil, domainErrors.ErrInternalServer)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"error":{"code":500,"message":"internal server error"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/users/logout-all", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.LogoutAll(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestUserHandler_GetSessions(t *testing.T) {
+	handler, mockUserService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					GetSessions(gomock.Any()).
+					Return([]*model.SessionResponse{
+						{
+							ID:         "session-1",
+							UserAgent:  "Mozilla/5.0",
+							IP:         "192.0.2.1",
+							Current:    true,
+							CreatedAt:  "2026-10-19T10:00:00+07:00",
+							LastSeenAt: "2026-10-19T11:00:00+07:00",
+							ExpiresAt:  "2026-10-26T11:00:00+07:00",
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":[{"id":"session-1","user_agent":"Mozilla/5.0","ip":"192.0.2.1","current":true,"created_at":"2026-10-19T10:00:00+07:00","last_seen_at":"2026-10-19T11:00:00+07:00","expires_at":"2026-10-26T11:00:00+07:00"}]}`,
+		},
+		{
+			name: "Unauthorized",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					GetSessions(gomock.Any()).
+					Return(nil, domainErrors.ErrUnauthorized)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `{"error":{"code":401,"message":"unauthorized"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/users/sessions", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.GetSessions(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestUserHandler_RevokeSession(t *testing.T) {
+	handler, mockUserService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		sessionID      string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:      "Success",
+			sessionID: "session-1",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					RevokeSession(gomock.Any(), &model.RevokeSessionRequest{ID: "session-1"}).
+					Return(&model.VerifyResponse{Status: "success"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"status":"success"}}`,
+		},
+		{
+			name:      "Session Not Found",
+			sessionID: "session-2",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					RevokeSession(gomock.Any(), &model.RevokeSessionRequest{ID: "session-2"}).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/users/sessions/"+tc.sessionID, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tc.sessionID)
+
+			tc.setupMock()
+
+			err := handler.RevokeSession(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestUserHandler_GetUserSessions(t *testing.T) {
+	handler, mockUserService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		userID         string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "Success",
+			userID: "1",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					GetUserSessions(gomock.Any(), &model.GetUserSessionsRequest{ID: "1"}).
+					Return([]*model.SessionResponse{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":[]}`,
+		},
+		{
+			name:   "User Not Found",
+			userID: "999",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					GetUserSessions(gomock.Any(), &model.GetUserSessionsRequest{ID: "999"}).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/users/"+tc.userID+"/sessions", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tc.userID)
+
+			tc.setupMock()
+
+			err := handler.GetUserSessions(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestUserHandler_LoginTwoFactor(t *testing.T) {
+	handler, mockUserService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"challenge_token": "challenge_token", "code": "123456"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					LoginTwoFactor(gomock.Any(), &model.LoginTwoFactorRequest{
+						ChallengeToken: "challenge_token",
+						Code:           "123456",
+						IP:             "192.0.2.1",
+					}).
+					Return(&model.TokenResponse{
+						AccessToken:  "access_token",
+						RefreshToken: "refresh_token",
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"access_token":"access_token","refresh_token":"refresh_token"}}`,
+		},
+		{
+			name:        "Invalid Code",
+			requestBody: `{"challenge_token": "challenge_token", "code": "000000"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					LoginTwoFactor(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrUnauthorized)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `{"error":{"code":401,"message":"unauthorized"}}`,
+		},
+		{
+			name:        "Locked Out",
+			requestBody: `{"challenge_token": "challenge_token", "code": "000000"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					LoginTwoFactor(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrTooManyAttempts)
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedBody:   `{"error":{"code":429,"message":"too many failed attempts, try again later"}}`,
+		},
+		{
+			name:           "Invalid JSON Request",
+			requestBody:    `{"invalid json`,
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"bad request"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/users/login/2fa", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.LoginTwoFactor(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestUserHandler_LoginEnrolTwoFactor(t *testing.T) {
+	handler, mockUserService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"challenge_token": "challenge_token"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					LoginEnrolTwoFactor(gomock.Any(), &model.LoginEnrolTwoFactorRequest{ChallengeToken: "challenge_token"}).
+					Return(&model.EnrolTwoFactorResponse{
+						Secret:     "JBSWY3DPEHPK3PXP",
+						OTPAuthURI: "otpauth://totp/TrinityKnights:test@example.com?issuer=TrinityKnights&secret=JBSWY3DPEHPK3PXP",
+						QRCode:     "data:image/png;base64,iVBORw0KGgo=",
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"secret":"JBSWY3DPEHPK3PXP","otpauth_uri":"otpauth://totp/TrinityKnights:test@example.com?issuer=TrinityKnights&secret=JBSWY3DPEHPK3PXP","qr_code":"data:image/png;base64,iVBORw0KGgo="}}`,
+		},
+		{
+			name:        "Expired Challenge",
+			requestBody: `{"challenge_token": "expired"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					LoginEnrolTwoFactor(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrUnauthorized)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `{"error":{"code":401,"message":"unauthorized"}}`,
+		},
+		{
+			name:        "Already Enabled",
+			requestBody: `{"challenge_token": "challenge_token"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					LoginEnrolTwoFactor(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrTwoFactorEnabled)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"two-factor authentication is already enabled"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/users/login/2fa/enrol", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.LoginEnrolTwoFactor(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestUserHandler_LoginConfirmTwoFactor(t *testing.T) {
+	handler, mockUserService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"challenge_token": "challenge_token", "code": "123456"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					LoginConfirmTwoFactor(gomock.Any(), &model.LoginConfirmTwoFactorRequest{
+						ChallengeToken: "challenge_token",
+						Code:           "123456",
+						IP:             "192.0.2.1",
+					}).
+					Return(&model.TokenResponse{
+						AccessToken:   "access_token",
+						RefreshToken:  "refresh_token",
+						RecoveryCodes: []string{"abcde-fghjk"},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"access_token":"access_token","refresh_token":"refresh_token","recovery_codes":["abcde-fghjk"]}}`,
+		},
+		{
+			name:        "Invalid Code",
+			requestBody: `{"challenge_token": "challenge_token", "code": "000000"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					LoginConfirmTwoFactor(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrInvalidTwoFactor)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"invalid two-factor code"}}`,
+		},
+		{
+			name:        "Expired Challenge",
+			requestBody: `{"challenge_token": "expired", "code": "123456"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					LoginConfirmTwoFactor(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrUnauthorized)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `{"error":{"code":401,"message":"unauthorized"}}`,
+		},
+		{
+			name:        "Locked Out",
+			requestBody: `{"challenge_token": "challenge_token", "code": "000000"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					LoginConfirmTwoFactor(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrTooManyAttempts)
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedBody:   `{"error":{"code":429,"message":"too many failed attempts, try again later"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/users/login/2fa/confirm", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.LoginConfirmTwoFactor(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestUserHandler_EnrolTwoFactor(t *testing.T) {
+	handler, mockUserService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					EnrolTwoFactor(gomock.Any()).
+					Return(&model.EnrolTwoFactorResponse{
+						Secret:     "JBSWY3DPEHPK3PXP",
+						OTPAuthURI: "otpauth://totp/TrinityKnights:test@example.com?issuer=TrinityKnights&secret=JBSWY3DPEHPK3PXP",
+						QRCode:     "data:image/png;base64,iVBORw0KGgo=",
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"secret":"JBSWY3DPEHPK3PXP","otpauth_uri":"otpauth://totp/TrinityKnights:test@example.com?issuer=TrinityKnights&secret=JBSWY3DPEHPK3PXP","qr_code":"data:image/png;base64,iVBORw0KGgo="}}`,
+		},
+		{
+			name: "Already Enabled",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					EnrolTwoFactor(gomock.Any()).
+					Return(nil, domainErrors.ErrTwoFactorEnabled)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"two-factor authentication is already enabled"}}`,
+		},
+		{
+			name: "Internal Server Error",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					EnrolTwoFactor(gomock.Any()).
+					Return(nil, domainErrors.ErrInternalServer)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"error":{"code":500,"message":"internal server error"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/users/2fa/enrol", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.EnrolTwoFactor(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestUserHandler_ConfirmTwoFactor(t *testing.T) {
+	handler, mockUserService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"code": "123456"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					ConfirmTwoFactor(gomock.Any(), &model.TwoFactorCodeRequest{Code: "123456"}).
+					Return(&model.RecoveryCodesResponse{RecoveryCodes: []string{"abcde-fghjk"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"recovery_codes":["abcde-fghjk"]}}`,
+		},
+		{
+			name:        "Invalid Code",
+			requestBody: `{"code": "000000"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					ConfirmTwoFactor(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrInvalidTwoFactor)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"invalid two-factor code"}}`,
+		},
+		{
+			name:        "Already Enabled",
+			requestBody: `{"code": "123456"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					ConfirmTwoFactor(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrTwoFactorEnabled)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"two-factor authentication is already enabled"}}`,
+		},
+		{
+			name:           "Invalid JSON Request",
+			requestBody:    `{"invalid json`,
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"bad request"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/users/2fa/confirm", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.ConfirmTwoFactor(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestUserHandler_DisableTwoFactor(t *testing.T) {
+	handler, mockUserService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"code": "123456"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					DisableTwoFactor(gomock.Any(), &model.TwoFactorCodeRequest{Code: "123456"}).
+					Return(&model.VerifyResponse{Status: "success"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"status":"success"}}`,
+		},
+		{
+			name:        "Required For Role",
+			requestBody: `{"code": "123456"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					DisableTwoFactor(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrTwoFactorRequired)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":{"code":403,"message":"two-factor authentication is required for this role"}}`,
+		},
+		{
+			name:        "Invalid Code",
+			requestBody: `{"code": "000000"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					DisableTwoFactor(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrInvalidTwoFactor)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"invalid two-factor code"}}`,
+		},
+		{
+			name:        "Locked Out",
+			requestBody: `{"code": "000000"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					DisableTwoFactor(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrTooManyAttempts)
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedBody:   `{"error":{"code":429,"message":"too many failed attempts, try again later"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/users/2fa/disable", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.DisableTwoFactor(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestUserHandler_RegenerateRecoveryCodes(t *testing.T) {
+	handler, mockUserService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"code": "123456"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					RegenerateRecoveryCodes(gomock.Any(), &model.TwoFactorCodeRequest{Code: "123456"}).
+					Return(&model.RecoveryCodesResponse{RecoveryCodes: []string{"abcde-fghjk"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"recovery_codes":["abcde-fghjk"]}}`,
+		},
+		{
+			name:        "Not Enabled",
+			requestBody: `{"code": "123456"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					RegenerateRecoveryCodes(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrBadRequest)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"bad request"}}`,
+		},
+		{
+			name:        "Internal Server Error",
+			requestBody: `{"code": "123456"}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					RegenerateRecoveryCodes(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrInternalServer)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"error":{"code":500,"message":"internal server error"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/users/2fa/recovery-codes", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.RegenerateRecoveryCodes(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestUserHandler_GetRolePolicy(t *testing.T) {
+	handler, mockUserService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		role           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			role: "admin",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					GetRolePolicy(gomock.Any(), &model.RolePolicyRequest{Role: "admin"}).
+					Return(&model.RolePolicyResponse{
+						Role:              "admin",
+						TwoFactorRequired: true,
+						UpdatedAt:         "2024-01-01T07:00:00Z",
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"role":"admin","two_factor_required":true,"updated_at":"2024-01-01T07:00:00Z"}}`,
+		},
+		{
+			name: "Unknown Role",
+			role: "guest",
+			setupMock: func() {
+				mockUserService.EXPECT().
+					GetRolePolicy(gomock.Any(), &model.RolePolicyRequest{Role: "guest"}).
+					Return(nil, domainErrors.ErrBadRequest)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"bad request"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/roles/"+tc.role+"/policy", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("role")
+			c.SetParamValues(tc.role)
+
+			tc.setupMock()
+
+			err := handler.GetRolePolicy(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestUserHandler_UpdateRolePolicy(t *testing.T) {
+	handler, mockUserService, e := setupTest(t)
+
+	required := true
+
+	tests := []struct {
+		name           string
+		role           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success",
+			role:        "admin",
+			requestBody: `{"two_factor_required": true}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					UpdateRolePolicy(gomock.Any(), &model.UpdateRolePolicyRequest{
+						Role:              "admin",
+						TwoFactorRequired: &required,
+					}).
+					Return(&model.RolePolicyResponse{
+						Role:              "admin",
+						TwoFactorRequired: true,
+						UpdatedAt:         "2024-01-01T07:00:00Z",
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"role":"admin","two_factor_required":true,"updated_at":"2024-01-01T07:00:00Z"}}`,
+		},
+		{
+			name:        "Admin Without Two-Factor",
+			role:        "admin",
+			requestBody: `{"two_factor_required": true}`,
+			setupMock: func() {
+				mockUserService.EXPECT().
+					UpdateRolePolicy(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrTwoFactorRequired)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":{"code":403,"message":"two-factor authentication is required for this role"}}`,
+		},
+		{
+			name:           "Invalid JSON Request",
+			role:           "admin",
+			requestBody:    `{"invalid json`,
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"bad request"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/roles/"+tc.role+"/policy", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("role")
+			c.SetParamValues(tc.role)
+
+			tc.setupMock()
+
+			err := handler.UpdateRolePolicy(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
@@ -10,4 +10,5 @@ type VenueHandler interface {
 	GetVenueByID(ctx echo.Context) error
 	GetAllVenues(ctx echo.Context) error
 	SearchVenues(ctx echo.Context) error
+	GetCalendar(ctx echo.Context) error
 }
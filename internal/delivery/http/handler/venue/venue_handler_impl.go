@@ -215,3 +215,40 @@ func (h *VenueHandlerImpl) SearchVenues(ctx echo.Context) error {
 
 	return ctx.JSON(http.StatusOK, response)
 }
+
+// GetCalendar function is a handler to list when a venue is booked
+// @Summary Get a venue's booking calendar @admin
+// @Description List the ranges a venue is occupied for by events that are not cancelled, with the turnover buffer kept free after each. Dates are read in the venue's time zone and the range defaults to the next 30 days.
+// @Tags venues
+// @Produce json
+// @Param id path int true "Venue ID"
+// @Param from query string false "First date, inclusive" example(2024-03-01)
+// @Param to query string false "Last date, inclusive" example(2024-03-31)
+// @Success 200 {object} model.Response[model.VenueCalendarResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /venues/{id}/calendar [get]
+func (h *VenueHandlerImpl) GetCalendar(ctx echo.Context) error {
+	request := new(model.VenueCalendarRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.VenueService.GetCalendar(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to get venue calendar: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/venue"
 	"github.com/TrinityKnights/Backend/internal/domain/model"
@@ -64,10 +65,11 @@ func TestVenueHandler_CreateVenue(t *testing.T) {
 						City:     "Test City",
 						State:    "TS",
 						Zip:      "12345",
+						Timezone: "Asia/Jakarta",
 					}, nil)
 			},
 			expectedStatus: http.StatusCreated,
-			expectedBody:   `{"data":{"id":1,"name":"Test Venue","address":"123 Test St","capacity":1000,"city":"Test City","state":"TS","zip":"12345"}}`,
+			expectedBody:   `{"data":{"id":1,"name":"Test Venue","address":"123 Test St","capacity":1000,"city":"Test City","state":"TS","zip":"12345","timezone":"Asia/Jakarta"}}`,
 		},
 		{
 			name:           "Invalid JSON",
@@ -142,10 +144,11 @@ func TestVenueHandler_GetVenueByID(t *testing.T) {
 						City:     "Test City",
 						State:    "TS",
 						Zip:      "12345",
+						Timezone: "Asia/Jakarta",
 					}, nil)
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody:   `{"data":{"id":1,"name":"Test Venue","address":"123 Test St","capacity":1000,"city":"Test City","state":"TS","zip":"12345"}}`,
+			expectedBody:   `{"data":{"id":1,"name":"Test Venue","address":"123 Test St","capacity":1000,"city":"Test City","state":"TS","zip":"12345","timezone":"Asia/Jakarta"}}`,
 		},
 		{
 			name:    "Venue Not Found",
@@ -225,10 +228,11 @@ func TestVenueHandler_UpdateVenue(t *testing.T) {
 						City:     "Update City",
 						State:    "UP",
 						Zip:      "54321",
+						Timezone: "Asia/Jakarta",
 					}, nil)
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody:   `{"data":{"id":1,"name":"Updated Venue","address":"456 Update St","capacity":2000,"city":"Update City","state":"UP","zip":"54321"}}`,
+			expectedBody:   `{"data":{"id":1,"name":"Updated Venue","address":"456 Update St","capacity":2000,"city":"Update City","state":"UP","zip":"54321","timezone":"Asia/Jakarta"}}`,
 		},
 		{
 			name:    "Venue Not Found",
@@ -276,3 +280,91 @@ func TestVenueHandler_UpdateVenue(t *testing.T) {
 		})
 	}
 }
+
+func TestVenueHandler_GetCalendar(t *testing.T) {
+	handler, mockVenueService, e := setupTest(t)
+
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+	endsAt := time.Date(2024, 3, 20, 22, 0, 0, 0, jakarta)
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:  "Success",
+			query: "?from=2024-03-20&to=2024-03-20",
+			setupMock: func() {
+				mockVenueService.EXPECT().
+					GetCalendar(gomock.Any(), &model.VenueCalendarRequest{ID: 1, From: "2024-03-20", To: "2024-03-20"}).
+					Return(&model.VenueCalendarResponse{
+						VenueID:               1,
+						Timezone:              "Asia/Jakarta",
+						TurnoverBufferMinutes: 30,
+						From:                  time.Date(2024, 3, 20, 0, 0, 0, 0, jakarta),
+						To:                    time.Date(2024, 3, 21, 0, 0, 0, 0, jakarta),
+						Bookings: []*model.VenueBookingResponse{
+							{
+								EventID:   7,
+								Name:      "Jazz Night",
+								Status:    "PUBLISHED",
+								StartsAt:  time.Date(2024, 3, 20, 19, 0, 0, 0, jakarta),
+								EndsAt:    &endsAt,
+								BusyUntil: time.Date(2024, 3, 20, 22, 30, 0, 0, jakarta),
+							},
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"data":{"venue_id":1,"timezone":"Asia/Jakarta","turnover_buffer_minutes":30,` +
+				`"from":"2024-03-20T00:00:00+07:00","to":"2024-03-21T00:00:00+07:00","bookings":[{"event_id":7,"name":"Jazz Night",` +
+				`"status":"PUBLISHED","starts_at":"2024-03-20T19:00:00+07:00","ends_at":"2024-03-20T22:00:00+07:00","busy_until":"2024-03-20T22:30:00+07:00"}]}}`,
+		},
+		{
+			name:  "Range Too Long",
+			query: "?from=2024-01-01&to=2026-01-01",
+			setupMock: func() {
+				mockVenueService.EXPECT().
+					GetCalendar(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrValidation)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"validation error"}}`,
+		},
+		{
+			name:  "Venue Not Found",
+			query: "",
+			setupMock: func() {
+				mockVenueService.EXPECT().
+					GetCalendar(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/venues/1/calendar"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+
+			tc.setupMock()
+
+			err := handler.GetCalendar(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package waitingroom
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+type WaitingRoomHandler interface {
+	OpenWaitingRoom(ctx echo.Context) error
+	CloseWaitingRoom(ctx echo.Context) error
+	GetWaitingRoom(ctx echo.Context) error
+	JoinQueue(ctx echo.Context) error
+	GetQueuePosition(ctx echo.Context) error
+}
@@ -0,0 +1,194 @@
+package waitingroom
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/service/waitingroom"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type WaitingRoomHandlerImpl struct {
+	Log                *logrus.Logger
+	WaitingRoomService waitingroom.WaitingRoomService
+}
+
+func NewWaitingRoomHandler(log *logrus.Logger, waitingRoomService waitingroom.WaitingRoomService) WaitingRoomHandler {
+	return &WaitingRoomHandlerImpl{
+		Log:                log,
+		WaitingRoomService: waitingRoomService,
+	}
+}
+
+// @Summary Open an event's waiting room @admin
+// @Description Queue buyers for an event and admit them in order at the given rate per minute. Calling it again on an open waiting room changes the rate without losing anyone's place.
+// @Tags waiting-room
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body model.OpenWaitingRoomRequest true "Admission rate"
+// @Success 200 {object} model.Response[model.WaitingRoomResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/waiting-room [put]
+func (h *WaitingRoomHandlerImpl) OpenWaitingRoom(ctx echo.Context) error {
+	request := new(model.OpenWaitingRoomRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.WaitingRoomService.OpenWaitingRoom(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to open waiting room: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Close an event's waiting room @admin
+// @Description Stop queueing buyers for an event, orders no longer need an admission token
+// @Tags waiting-room
+// @Param id path int true "Event ID"
+// @Success 204
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/waiting-room [delete]
+func (h *WaitingRoomHandlerImpl) CloseWaitingRoom(ctx echo.Context) error {
+	request := new(model.GetWaitingRoomRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	if err := h.WaitingRoomService.CloseWaitingRoom(ctx.Request().Context(), request); err != nil {
+		h.Log.Errorf("failed to close waiting room: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// @Summary Get an event's queue depth @admin
+// @Description Show how many buyers have joined the event's queue, how many were admitted and how many are still waiting
+// @Tags waiting-room
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} model.Response[model.WaitingRoomResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /events/{id}/waiting-room [get]
+func (h *WaitingRoomHandlerImpl) GetWaitingRoom(ctx echo.Context) error {
+	request := new(model.GetWaitingRoomRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.WaitingRoomService.GetWaitingRoom(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to get waiting room: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Join an event's queue
+// @Description Take a place in the event's waiting room. The response has a queue token to poll with and an estimated wait, or an admission token straight away when nobody is ahead.
+// @Tags waiting-room
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 201 {object} model.Response[model.QueuePositionResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @Router /events/{id}/queue [post]
+func (h *WaitingRoomHandlerImpl) JoinQueue(ctx echo.Context) error {
+	request := new(model.GetWaitingRoomRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.WaitingRoomService.JoinQueue(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to join queue: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Get a place in an event's queue
+// @Description Poll a queue token's place in line and estimated wait. Once admitted the response carries the admission token to send with the order.
+// @Tags waiting-room
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param token path string true "Queue token"
+// @Success 200 {object} model.Response[model.QueuePositionResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @Router /events/{id}/queue/{token} [get]
+func (h *WaitingRoomHandlerImpl) GetQueuePosition(ctx echo.Context) error {
+	request := new(model.GetQueuePositionRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.WaitingRoomService.GetQueuePosition(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to get queue position: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
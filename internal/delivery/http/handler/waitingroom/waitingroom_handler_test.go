@@ -0,0 +1,217 @@
+package waitingroom_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/waitingroom"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	mockWaitingRoom "github.com/TrinityKnights/Backend/test/mock/service/waitingroom"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTest(t *testing.T) (*waitingroom.WaitingRoomHandlerImpl, *mockWaitingRoom.MockWaitingRoomService, *echo.Echo) {
+	ctrl := gomock.NewController(t)
+	mockWaitingRoomService := mockWaitingRoom.NewMockWaitingRoomService(ctrl)
+	logger := logrus.New()
+	handler := waitingroom.NewWaitingRoomHandler(logger, mockWaitingRoomService).(*waitingroom.WaitingRoomHandlerImpl)
+	e := echo.New()
+	return handler, mockWaitingRoomService, e
+}
+
+func TestWaitingRoomHandler_OpenWaitingRoom(t *testing.T) {
+	handler, mockWaitingRoomService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"admission_rate":500}`,
+			setupMock: func() {
+				mockWaitingRoomService.EXPECT().
+					OpenWaitingRoom(gomock.Any(), &model.OpenWaitingRoomRequest{EventID: 1, AdmissionRate: 500}).
+					Return(&model.WaitingRoomResponse{EventID: 1, AdmissionRate: 500}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"event_id":1,"admission_rate":500,"joined":0,"admitted":0,"waiting":0,"clears_in_seconds":0}}`,
+		},
+		{
+			name:        "Invalid Rate",
+			requestBody: `{"admission_rate":0}`,
+			setupMock: func() {
+				mockWaitingRoomService.EXPECT().
+					OpenWaitingRoom(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrValidation)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"validation error"}}`,
+		},
+		{
+			name:        "Event Not Found",
+			requestBody: `{"admission_rate":500}`,
+			setupMock: func() {
+				mockWaitingRoomService.EXPECT().
+					OpenWaitingRoom(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/events/1/waiting-room", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+
+			tc.setupMock()
+
+			err := handler.OpenWaitingRoom(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestWaitingRoomHandler_GetWaitingRoom(t *testing.T) {
+	handler, mockWaitingRoomService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Success",
+			setupMock: func() {
+				mockWaitingRoomService.EXPECT().
+					GetWaitingRoom(gomock.Any(), &model.GetWaitingRoomRequest{EventID: 1}).
+					Return(&model.WaitingRoomResponse{EventID: 1, AdmissionRate: 500, Joined: 12000, Admitted: 2000, Waiting: 10000, ClearsInSeconds: 1200}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"event_id":1,"admission_rate":500,"joined":12000,"admitted":2000,"waiting":10000,"clears_in_seconds":1200}}`,
+		},
+		{
+			name: "No Waiting Room",
+			setupMock: func() {
+				mockWaitingRoomService.EXPECT().
+					GetWaitingRoom(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/events/1/waiting-room", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+
+			tc.setupMock()
+
+			err := handler.GetWaitingRoom(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestWaitingRoomHandler_GetQueuePosition(t *testing.T) {
+	handler, mockWaitingRoomService, e := setupTest(t)
+
+	token := "3f0c1d2e-4b5a-4c6d-8e7f-9a0b1c2d3e4f"
+	expiresAt := time.Date(2024, 3, 15, 10, 10, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Still Waiting",
+			setupMock: func() {
+				mockWaitingRoomService.EXPECT().
+					GetQueuePosition(gomock.Any(), &model.GetQueuePositionRequest{EventID: 1, Token: token}).
+					Return(&model.QueuePositionResponse{EventID: 1, QueueToken: token, Position: 4200, Ahead: 1500, EstimatedWaitSeconds: 180}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"data":{"event_id":1,"queue_token":"` + token + `","position":4200,"ahead":1500,` +
+				`"estimated_wait_seconds":180,"admitted":false}}`,
+		},
+		{
+			name: "Admitted",
+			setupMock: func() {
+				mockWaitingRoomService.EXPECT().
+					GetQueuePosition(gomock.Any(), gomock.Any()).
+					Return(&model.QueuePositionResponse{EventID: 1, QueueToken: token, Position: 4200, Admitted: true, AdmissionToken: "signed", AdmissionExpiresAt: &expiresAt}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"data":{"event_id":1,"queue_token":"` + token + `","position":4200,"ahead":0,"estimated_wait_seconds":0,` +
+				`"admitted":true,"admission_token":"signed","admission_expires_at":"2024-03-15T10:10:00Z"}}`,
+		},
+		{
+			name: "Unknown Token",
+			setupMock: func() {
+				mockWaitingRoomService.EXPECT().
+					GetQueuePosition(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":404,"message":"not found"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/events/1/queue/"+token, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id", "token")
+			c.SetParamValues("1", token)
+
+			tc.setupMock()
+
+			err := handler.GetQueuePosition(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package waitlist
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+type WaitlistHandler interface {
+	JoinWaitlist(ctx echo.Context) error
+	LeaveWaitlist(ctx echo.Context) error
+	GetWaitlistByID(ctx echo.Context) error
+	GetWaitlists(ctx echo.Context) error
+	GetOffer(ctx echo.Context) error
+}
@@ -0,0 +1,215 @@
+package waitlist
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/service/waitlist"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type WaitlistHandlerImpl struct {
+	Log             *logrus.Logger
+	WaitlistService waitlist.WaitlistService
+}
+
+func NewWaitlistHandler(log *logrus.Logger, waitlistService waitlist.WaitlistService) WaitlistHandler {
+	return &WaitlistHandlerImpl{
+		Log:             log,
+		WaitlistService: waitlistService,
+	}
+}
+
+// @Summary Join a waitlist
+// @Description Join the waitlist of a sold-out ticket category. Freed tickets are offered in queue order.
+// @Tags waitlists
+// @Accept json
+// @Produce json
+// @Param request body model.JoinWaitlistRequest true "Waitlist details"
+// @Success 201 {object} model.Response[model.WaitlistResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 409 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /waitlists [post]
+func (h *WaitlistHandlerImpl) JoinWaitlist(ctx echo.Context) error {
+	request := new(model.JoinWaitlistRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.WaitlistService.JoinWaitlist(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to join waitlist: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrDuplicateEntry), errors.Is(err, domainErrors.ErrTicketsAvailable):
+			return handler.HandleError(ctx, http.StatusConflict, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, model.NewResponse(response, nil))
+}
+
+// @Summary Leave a waitlist
+// @Description Leave a waitlist. An outstanding offer is declined and passed to the next person in line.
+// @Tags waitlists
+// @Produce json
+// @Param id path int true "Waitlist ID"
+// @Success 200 {object} model.Response[model.WaitlistResponse]
+// @Failure 400 {object} model.Error
+// @Failure 403 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /waitlists/{id} [delete]
+func (h *WaitlistHandlerImpl) LeaveWaitlist(ctx echo.Context) error {
+	request := new(model.GetWaitlistRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.WaitlistService.LeaveWaitlist(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to leave waitlist: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation), errors.Is(err, domainErrors.ErrBadRequest):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrForbidden):
+			return handler.HandleError(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Get waitlist entry by ID
+// @Description Get a waitlist entry with its queue position or current offer
+// @Tags waitlists
+// @Produce json
+// @Param id path int true "Waitlist ID"
+// @Success 200 {object} model.Response[model.WaitlistResponse]
+// @Failure 400 {object} model.Error
+// @Failure 403 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /waitlists/{id} [get]
+func (h *WaitlistHandlerImpl) GetWaitlistByID(ctx echo.Context) error {
+	request := new(model.GetWaitlistRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.WaitlistService.GetWaitlistByID(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to get waitlist: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrForbidden):
+			return handler.HandleError(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
+
+// @Summary Get my waitlists
+// @Description Get a paginated list of the current user's waitlist entries
+// @Tags waitlists
+// @Produce json
+// @Param page query int false "Page number"
+// @Param size query int false "Page size"
+// @Success 200 {object} model.Response[[]model.WaitlistResponse]
+// @Failure 400 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /waitlists [get]
+func (h *WaitlistHandlerImpl) GetWaitlists(ctx echo.Context) error {
+	request := new(model.WaitlistsRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.WaitlistService.GetWaitlists(ctx.Request().Context(), request)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrUnauthorized):
+			return handler.HandleError(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get a waitlist offer
+// @Description Get the tickets held for a waitlist offer. Pass the returned hold_token, ticket_ids and seat_numbers to POST /orders to purchase them.
+// @Tags waitlists
+// @Produce json
+// @Param token path string true "Offer token"
+// @Success 200 {object} model.Response[model.WaitlistOfferResponse]
+// @Failure 400 {object} model.Error
+// @Failure 403 {object} model.Error
+// @Failure 404 {object} model.Error
+// @Failure 410 {object} model.Error
+// @Failure 500 {object} model.Error
+// @security ApiKeyAuth
+// @Router /waitlists/offers/{token} [get]
+func (h *WaitlistHandlerImpl) GetOffer(ctx echo.Context) error {
+	request := new(model.GetWaitlistOfferRequest)
+	if err := ctx.Bind(request); err != nil {
+		h.Log.Errorf("failed to bind request: %v", err)
+		return handler.HandleError(ctx, http.StatusBadRequest, err)
+	}
+
+	response, err := h.WaitlistService.GetOffer(ctx.Request().Context(), request)
+	if err != nil {
+		h.Log.Errorf("failed to get waitlist offer: %v", err)
+		switch {
+		case errors.Is(err, domainErrors.ErrValidation):
+			return handler.HandleError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domainErrors.ErrForbidden):
+			return handler.HandleError(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domainErrors.ErrNotFound):
+			return handler.HandleError(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domainErrors.ErrOfferExpired):
+			return handler.HandleError(ctx, http.StatusGone, err)
+		default:
+			return handler.HandleError(ctx, http.StatusInternalServerError, err)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, model.NewResponse(response, nil))
+}
@@ -0,0 +1,184 @@
+package waitlist_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/waitlist"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	mockWaitlist "github.com/TrinityKnights/Backend/test/mock/service/waitlist"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTest(t *testing.T) (*waitlist.WaitlistHandlerImpl, *mockWaitlist.MockWaitlistService, *echo.Echo) {
+	ctrl := gomock.NewController(t)
+	mockWaitlistService := mockWaitlist.NewMockWaitlistService(ctrl)
+	logger := logrus.New()
+	handler := waitlist.NewWaitlistHandler(logger, mockWaitlistService).(*waitlist.WaitlistHandlerImpl)
+	e := echo.New()
+	return handler, mockWaitlistService, e
+}
+
+func TestWaitlistHandler_JoinWaitlist(t *testing.T) {
+	handler, mockWaitlistService, e := setupTest(t)
+
+	position := 3
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"event_id": 1, "type": "VIP", "quantity": 2}`,
+			setupMock: func() {
+				mockWaitlistService.EXPECT().
+					JoinWaitlist(gomock.Any(), &model.JoinWaitlistRequest{
+						EventID:  1,
+						Type:     "VIP",
+						Quantity: 2,
+					}).
+					Return(&model.WaitlistResponse{
+						ID:        1,
+						EventID:   1,
+						UserID:    "user-1",
+						Type:      "VIP",
+						Quantity:  2,
+						Status:    string(model.WaitlistStatusWaiting),
+						Position:  &position,
+						CreatedAt: "2024-03-20T14:30:00Z",
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"data":{"id":1,"event_id":1,"user_id":"user-1","type":"VIP","quantity":2,"status":"WAITING","position":3,"created_at":"2024-03-20T14:30:00Z"}}`,
+		},
+		{
+			name:           "Invalid JSON",
+			requestBody:    `{"invalid json`,
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":400,"message":"code=400, message=unexpected EOF, internal=unexpected EOF"}}`,
+		},
+		{
+			name:        "Tickets Still Available",
+			requestBody: `{"event_id": 1, "type": "REGULAR"}`,
+			setupMock: func() {
+				mockWaitlistService.EXPECT().
+					JoinWaitlist(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrTicketsAvailable)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"tickets are still available"}}`,
+		},
+		{
+			name:        "Already Waiting",
+			requestBody: `{"event_id": 1, "type": "REGULAR"}`,
+			setupMock: func() {
+				mockWaitlistService.EXPECT().
+					JoinWaitlist(gomock.Any(), gomock.Any()).
+					Return(nil, domainErrors.ErrDuplicateEntry)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error":{"code":409,"message":"duplicate entry"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/waitlists", strings.NewReader(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.JoinWaitlist(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
+
+func TestWaitlistHandler_GetOffer(t *testing.T) {
+	handler, mockWaitlistService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		token          string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:  "Success",
+			token: "offer-token",
+			setupMock: func() {
+				mockWaitlistService.EXPECT().
+					GetOffer(gomock.Any(), &model.GetWaitlistOfferRequest{
+						Token: "offer-token",
+					}).
+					Return(&model.WaitlistOfferResponse{
+						WaitlistID:  1,
+						EventID:     1,
+						Type:        "VIP",
+						HoldToken:   "offer-token",
+						TicketIDs:   []string{"T-abc123"},
+						SeatNumbers: []string{"VIP-1"},
+						TotalPrice:  150000,
+						ExpiresAt:   "2024-03-20T15:00:00Z",
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"waitlist_id":1,"event_id":1,"type":"VIP","hold_token":"offer-token","ticket_ids":["T-abc123"],"seat_numbers":["VIP-1"],"total_price":150000,"expires_at":"2024-03-20T15:00:00Z"}}`,
+		},
+		{
+			name:  "Offer Expired",
+			token: "expired-token",
+			setupMock: func() {
+				mockWaitlistService.EXPECT().
+					GetOffer(gomock.Any(), &model.GetWaitlistOfferRequest{
+						Token: "expired-token",
+					}).
+					Return(nil, domainErrors.ErrOfferExpired)
+			},
+			expectedStatus: http.StatusGone,
+			expectedBody:   `{"error":{"code":410,"message":"offer has expired"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/waitlists/offers/"+tc.token, http.NoBody)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("token")
+			c.SetParamValues(tc.token)
+
+			tc.setupMock()
+
+			err := handler.GetOffer(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
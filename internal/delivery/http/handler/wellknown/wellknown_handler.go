@@ -0,0 +1,7 @@
+package wellknown
+
+import "github.com/labstack/echo/v4"
+
+type WellKnownHandler interface {
+	JWKS(ctx echo.Context) error
+}
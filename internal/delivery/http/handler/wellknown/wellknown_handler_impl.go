@@ -0,0 +1,29 @@
+package wellknown
+
+import (
+	"net/http"
+
+	"github.com/TrinityKnights/Backend/pkg/jwt"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type WellKnownHandlerImpl struct {
+	Log        *logrus.Logger
+	JWTService jwt.JWTService
+}
+
+func NewWellKnownHandler(log *logrus.Logger, jwtService jwt.JWTService) WellKnownHandler {
+	return &WellKnownHandlerImpl{
+		Log:        log,
+		JWTService: jwtService,
+	}
+}
+
+// JWKS serves the public keys tokens are signed with, at /.well-known/jwks.json outside the API
+// base path. It is empty while tokens are signed with a shared secret. The body is a bare JWK Set
+// as RFC 7517 defines it, so it is not wrapped in the usual response envelope.
+func (h *WellKnownHandlerImpl) JWKS(ctx echo.Context) error {
+	ctx.Response().Header().Set("Cache-Control", "public, max-age=300")
+	return ctx.JSON(http.StatusOK, h.JWTService.JWKS())
+}
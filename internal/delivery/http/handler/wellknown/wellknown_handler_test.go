@@ -0,0 +1,83 @@
+package wellknown_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/wellknown"
+	"github.com/TrinityKnights/Backend/pkg/jwt"
+	mockJWT "github.com/TrinityKnights/Backend/test/mock/pkg/jwt"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTest(t *testing.T) (*wellknown.WellKnownHandlerImpl, *mockJWT.MockJWTService, *echo.Echo) {
+	ctrl := gomock.NewController(t)
+	mockJWTService := mockJWT.NewMockJWTService(ctrl)
+	logger := logrus.New()
+	handler := wellknown.NewWellKnownHandler(logger, mockJWTService).(*wellknown.WellKnownHandlerImpl)
+	e := echo.New()
+	return handler, mockJWTService, e
+}
+
+func TestWellKnownHandler_JWKS(t *testing.T) {
+	handler, mockJWTService, e := setupTest(t)
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Signing Keys",
+			setupMock: func() {
+				mockJWTService.EXPECT().
+					JWKS().
+					Return(&jwt.JWKS{Keys: []jwt.JWK{
+						{Kty: "OKP", Kid: "2026-10", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"},
+						{Kty: "RSA", Kid: "2026-04", Use: "sig", Alg: "RS256", N: "sXchDaQebHnPiGvyDOAT4saGEUetSyo9MKLOoWFsueri", E: "AQAB"},
+					}})
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"keys":[
+				{"kty":"OKP","kid":"2026-10","use":"sig","alg":"EdDSA","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"},
+				{"kty":"RSA","kid":"2026-04","use":"sig","alg":"RS256","n":"sXchDaQebHnPiGvyDOAT4saGEUetSyo9MKLOoWFsueri","e":"AQAB"}
+			]}`,
+		},
+		{
+			name: "Shared Secret",
+			setupMock: func() {
+				mockJWTService.EXPECT().
+					JWKS().
+					Return(&jwt.JWKS{Keys: []jwt.JWK{}})
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"keys":[]}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setupMock()
+
+			err := handler.JWKS(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			assert.Equal(t, "public, max-age=300", rec.Header().Get("Cache-Control"))
+
+			var actualBody, expectedBody map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &actualBody)
+			json.Unmarshal([]byte(tc.expectedBody), &expectedBody)
+			assert.Equal(t, expectedBody, actualBody)
+		})
+	}
+}
@@ -7,12 +7,14 @@ import (
 
 	"github.com/TrinityKnights/Backend/internal/domain/model"
 	"github.com/TrinityKnights/Backend/pkg/jwt"
+	"github.com/TrinityKnights/Backend/pkg/tokenstore"
 	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
 )
 
 const contextKey = "claims"
 
-func AuthMiddleware(jwtService jwt.JWTService) echo.MiddlewareFunc {
+func AuthMiddleware(jwtService jwt.JWTService, tokenStore tokenstore.TokenStore, log *logrus.Logger) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			if c.Request().URL.Path == "/api/v1/graphql" {
@@ -33,11 +35,19 @@ func AuthMiddleware(jwtService jwt.JWTService) echo.MiddlewareFunc {
 				return errMessage("Invalid authorization header")
 			}
 
-			claims, err := jwtService.ValidateToken(bearerToken[1])
+			claims, err := jwtService.ValidateAccessToken(bearerToken[1])
 			if err != nil {
 				return errMessage("Invalid token")
 			}
 
+			// A denylist outage should not log everyone out, so lookup errors let the token through
+			denied, err := tokenStore.IsDenied(c.Request().Context(), claims.UserID, claims.FamilyID, claims.ID)
+			if err != nil {
+				log.Warnf("failed to check token revocation, letting it through: %v", err)
+			} else if denied {
+				return errMessage("Token has been revoked")
+			}
+
 			c.Set(contextKey, claims)
 
 			ctx := context.WithValue(c.Request().Context(), contextKey, claims)
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/pkg/challenge"
+	"github.com/TrinityKnights/Backend/pkg/route"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+const ChallengeHeader = "X-Challenge-Token"
+
+const (
+	DefaultChallengeVelocityLimit  = 20
+	DefaultChallengeVelocityWindow = time.Minute
+	DefaultChallengeFailureLimit   = 5
+	DefaultChallengeFailureWindow  = 15 * time.Minute
+)
+
+// ChallengePolicy sets the thresholds at which a client counts as suspicious.
+type ChallengePolicy struct {
+	VelocityLimit  int64
+	VelocityWindow time.Duration
+	FailureLimit   int64
+	FailureWindow  time.Duration
+}
+
+func (p ChallengePolicy) withDefaults() ChallengePolicy {
+	if p.VelocityLimit <= 0 {
+		p.VelocityLimit = DefaultChallengeVelocityLimit
+	}
+	if p.VelocityWindow <= 0 {
+		p.VelocityWindow = DefaultChallengeVelocityWindow
+	}
+	if p.FailureLimit <= 0 {
+		p.FailureLimit = DefaultChallengeFailureLimit
+	}
+	if p.FailureWindow <= 0 {
+		p.FailureWindow = DefaultChallengeFailureWindow
+	}
+	return p
+}
+
+// NewChallengeMiddleware returns a factory of middleware enforcing the given route challenge mode.
+// A request on an OnRisk route is challenged only when its IP is over the velocity limit for the
+// route, or has too many rejected (401/403) requests on challenged routes.
+func NewChallengeMiddleware(verifier challenge.Verifier, risk challenge.Risk, policy ChallengePolicy, log *logrus.Logger) func(route.Challenge) echo.MiddlewareFunc {
+	policy = policy.withDefaults()
+
+	return func(mode route.Challenge) echo.MiddlewareFunc {
+		return func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(c echo.Context) error {
+				if mode == route.ChallengeNever {
+					return next(c)
+				}
+
+				errMessage := func(status int, message string) error {
+					return echo.NewHTTPError(status, model.NewErrorResponse[any](status, message))
+				}
+
+				ctx := c.Request().Context()
+				ip := c.RealIP()
+				failureKey := fmt.Sprintf("failures:%s", ip)
+
+				required := mode == route.ChallengeAlways
+				if !required {
+					velocity, err := risk.Hit(ctx, fmt.Sprintf("velocity:%s %s:%s", c.Request().Method, c.Path(), ip), policy.VelocityWindow)
+					if err != nil {
+						log.Warnf("failed to record request velocity: %+v", err)
+					}
+					failures, err := risk.Count(ctx, failureKey)
+					if err != nil {
+						log.Warnf("failed to read failure count: %+v", err)
+					}
+					required = velocity > policy.VelocityLimit || failures >= policy.FailureLimit
+				}
+
+				if required {
+					token := c.Request().Header.Get(ChallengeHeader)
+					if token == "" {
+						return errMessage(http.StatusPreconditionRequired, "Challenge required")
+					}
+					if err := verifier.Verify(ctx, token, ip); err != nil {
+						if errors.Is(err, challenge.ErrChallengeUnavailable) {
+							log.Errorf("failed to verify challenge: %+v", err)
+							return errMessage(http.StatusServiceUnavailable, "Challenge verification unavailable")
+						}
+						return errMessage(http.StatusForbidden, "Challenge failed")
+					}
+				}
+
+				err := next(c)
+
+				status := c.Response().Status
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					status = httpErr.Code
+				}
+				if status == http.StatusUnauthorized || status == http.StatusForbidden {
+					if _, hitErr := risk.Hit(ctx, failureKey, policy.FailureWindow); hitErr != nil {
+						log.Warnf("failed to record failed request: %+v", hitErr)
+					}
+				}
+
+				return err
+			}
+		}
+	}
+}
@@ -4,12 +4,24 @@ import (
 	"net/http"
 
 	graphql "github.com/TrinityKnights/Backend/internal/delivery/graph/handler"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/allocation"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/attendee"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/cart"
 	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/event"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/exchange"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/group"
 	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/order"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/pass"
 	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/payment"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/product"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/series"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/slot"
 	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/ticket"
 	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/user"
 	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/venue"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/waitingroom"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/waitlist"
+	"github.com/TrinityKnights/Backend/internal/delivery/http/handler/wellknown"
 	"github.com/TrinityKnights/Backend/internal/domain/model"
 	"github.com/TrinityKnights/Backend/pkg/route"
 	"github.com/labstack/echo/v4"
@@ -23,27 +35,58 @@ type RouteConfig interface {
 }
 
 type Config struct {
-	App            *echo.Echo
-	GraphQLHandler *graphql.GraphQLHandler
-	UserHandler    *user.UserHandlerImpl
-	VenueHandler   *venue.VenueHandlerImpl
-	EventHandler   *event.EventHandlerImpl
-	TicketHandler  *ticket.TicketHandlerImpl
-	OrderHandler   *order.OrderHandlerImpl
-	PaymentHandler *payment.PaymentHandlerImpl
+	App                *echo.Echo
+	GraphQLHandler     *graphql.GraphQLHandler
+	UserHandler        *user.UserHandlerImpl
+	VenueHandler       *venue.VenueHandlerImpl
+	EventHandler       *event.EventHandlerImpl
+	TicketHandler      *ticket.TicketHandlerImpl
+	OrderHandler       *order.OrderHandlerImpl
+	PaymentHandler     *payment.PaymentHandlerImpl
+	WaitlistHandler    *waitlist.WaitlistHandlerImpl
+	AttendeeHandler    *attendee.AttendeeHandlerImpl
+	ExchangeHandler    *exchange.ExchangeHandlerImpl
+	AllocationHandler  *allocation.AllocationHandlerImpl
+	ProductHandler     *product.ProductHandlerImpl
+	CartHandler        *cart.CartHandlerImpl
+	GroupHandler       *group.GroupHandlerImpl
+	PassHandler        *pass.PassHandlerImpl
+	SlotHandler        *slot.SlotHandlerImpl
+	SeriesHandler      *series.SeriesHandlerImpl
+	WaitingRoomHandler *waitingroom.WaitingRoomHandlerImpl
+	WellKnownHandler   *wellknown.WellKnownHandlerImpl
 }
 
 func (c Config) PublicRoute() []route.Route {
 	return []route.Route{
 		{
-			Method:  echo.POST,
-			Path:    "/users",
-			Handler: c.UserHandler.Register,
+			Method:    echo.POST,
+			Path:      "/users",
+			Handler:   c.UserHandler.Register,
+			Challenge: route.ChallengeOnRisk,
+		},
+		{
+			Method:    echo.POST,
+			Path:      "/users/login",
+			Handler:   c.UserHandler.Login,
+			Challenge: route.ChallengeOnRisk,
+		},
+		{
+			Method:    echo.POST,
+			Path:      "/users/login/2fa",
+			Handler:   c.UserHandler.LoginTwoFactor,
+			Challenge: route.ChallengeOnRisk,
 		},
 		{
 			Method:  echo.POST,
-			Path:    "/users/login",
-			Handler: c.UserHandler.Login,
+			Path:    "/users/login/2fa/enrol",
+			Handler: c.UserHandler.LoginEnrolTwoFactor,
+		},
+		{
+			Method:    echo.POST,
+			Path:      "/users/login/2fa/confirm",
+			Handler:   c.UserHandler.LoginConfirmTwoFactor,
+			Challenge: route.ChallengeOnRisk,
 		},
 		{
 			Method:  echo.POST,
@@ -51,14 +94,16 @@ func (c Config) PublicRoute() []route.Route {
 			Handler: c.UserHandler.RefreshToken,
 		},
 		{
-			Method:  echo.POST,
-			Path:    "/users/request-reset",
-			Handler: c.UserHandler.RequestReset,
+			Method:    echo.POST,
+			Path:      "/users/request-reset",
+			Handler:   c.UserHandler.RequestReset,
+			Challenge: route.ChallengeOnRisk,
 		},
 		{
-			Method:  echo.POST,
-			Path:    "/users/reset-password/:token",
-			Handler: c.UserHandler.ResetPassword,
+			Method:    echo.POST,
+			Path:      "/users/reset-password/:token",
+			Handler:   c.UserHandler.ResetPassword,
+			Challenge: route.ChallengeOnRisk,
 		},
 		{
 			Method:  echo.GET,
@@ -80,6 +125,51 @@ func (c Config) PublicRoute() []route.Route {
 			Path:    "/events/search",
 			Handler: c.EventHandler.SearchEvents,
 		},
+		{
+			Method:  echo.GET,
+			Path:    "/events/:id/questions",
+			Handler: c.AttendeeHandler.GetQuestions,
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/events/:id/products",
+			Handler: c.ProductHandler.GetProducts,
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/events/:id/slots",
+			Handler: c.SlotHandler.GetSlots,
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/events/:id/availability",
+			Handler: c.TicketHandler.GetEventAvailability,
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/events/:id/queue",
+			Handler: c.WaitingRoomHandler.JoinQueue,
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/events/:id/queue/:token",
+			Handler: c.WaitingRoomHandler.GetQueuePosition,
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/series/:id",
+			Handler: c.SeriesHandler.GetSeriesByID,
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/passes",
+			Handler: c.PassHandler.GetPasses,
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/passes/:id",
+			Handler: c.PassHandler.GetPassByID,
+		},
 		{
 			Method:  echo.GET,
 			Path:    "/tickets/:id",
@@ -95,6 +185,23 @@ func (c Config) PublicRoute() []route.Route {
 			Path:    "/tickets/search",
 			Handler: c.TicketHandler.SearchTickets,
 		},
+		{
+			Method:    echo.POST,
+			Path:      "/guest/orders",
+			Handler:   c.OrderHandler.CreateGuestOrder,
+			Challenge: route.ChallengeOnRisk,
+		},
+		{
+			Method:    echo.POST,
+			Path:      "/guest/orders/lookup",
+			Handler:   c.OrderHandler.RequestGuestOrderLink,
+			Challenge: route.ChallengeOnRisk,
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/guest/orders/:token",
+			Handler: c.OrderHandler.GetGuestOrder,
+		},
 		{
 			Method:  echo.POST,
 			Path:    "/payment/callback",
@@ -117,6 +224,78 @@ func (c Config) PrivateRoute() []route.Route {
 			Handler: c.UserHandler.Update,
 			Roles:   []string{"buyer", "admin"},
 		},
+		{
+			Method:  echo.POST,
+			Path:    "/users/logout",
+			Handler: c.UserHandler.Logout,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/users/logout-all",
+			Handler: c.UserHandler.LogoutAll,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/users/sessions",
+			Handler: c.UserHandler.GetSessions,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.DELETE,
+			Path:    "/users/sessions/:id",
+			Handler: c.UserHandler.RevokeSession,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/users/2fa/enrol",
+			Handler: c.UserHandler.EnrolTwoFactor,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/users/2fa/confirm",
+			Handler: c.UserHandler.ConfirmTwoFactor,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/users/2fa/disable",
+			Handler: c.UserHandler.DisableTwoFactor,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/users/2fa/recovery-codes",
+			Handler: c.UserHandler.RegenerateRecoveryCodes,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/users/:id/sessions",
+			Handler: c.UserHandler.GetUserSessions,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/users/:id/unlock",
+			Handler: c.UserHandler.Unlock,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/roles/:role/policy",
+			Handler: c.UserHandler.GetRolePolicy,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.PUT,
+			Path:    "/roles/:role/policy",
+			Handler: c.UserHandler.UpdateRolePolicy,
+			Roles:   []string{"admin"},
+		},
 		{
 			Method:  echo.POST,
 			Path:    "/venues",
@@ -147,6 +326,12 @@ func (c Config) PrivateRoute() []route.Route {
 			Handler: c.VenueHandler.SearchVenues,
 			Roles:   []string{"admin"},
 		},
+		{
+			Method:  echo.GET,
+			Path:    "/venues/:id/calendar",
+			Handler: c.VenueHandler.GetCalendar,
+			Roles:   []string{"admin"},
+		},
 		{
 			Method:  echo.POST,
 			Path:    "/events",
@@ -159,11 +344,212 @@ func (c Config) PrivateRoute() []route.Route {
 			Handler: c.EventHandler.UpdateEvent,
 			Roles:   []string{"admin"},
 		},
+		{
+			Method:  echo.GET,
+			Path:    "/events/unpublished",
+			Handler: c.EventHandler.GetUnpublishedEvents,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.PUT,
+			Path:    "/events/:id/status",
+			Handler: c.EventHandler.UpdateEventStatus,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/events/:id/capacity",
+			Handler: c.TicketHandler.GetEventCapacity,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.PUT,
+			Path:    "/events/:id/waiting-room",
+			Handler: c.WaitingRoomHandler.OpenWaitingRoom,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/events/:id/waiting-room",
+			Handler: c.WaitingRoomHandler.GetWaitingRoom,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.DELETE,
+			Path:    "/events/:id/waiting-room",
+			Handler: c.WaitingRoomHandler.CloseWaitingRoom,
+			Roles:   []string{"admin"},
+		},
 		{
 			Method:  echo.POST,
-			Path:    "/orders",
-			Handler: c.OrderHandler.CreateOrder,
-			Roles:   []string{"buyer", "admin"},
+			Path:    "/events/:id/questions",
+			Handler: c.AttendeeHandler.CreateQuestion,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.PUT,
+			Path:    "/events/:id/questions/:question_id",
+			Handler: c.AttendeeHandler.UpdateQuestion,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.DELETE,
+			Path:    "/events/:id/questions/:question_id",
+			Handler: c.AttendeeHandler.DeleteQuestion,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/events/:id/attendees",
+			Handler: c.AttendeeHandler.GetAttendees,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/events/:id/attendees/export",
+			Handler: c.AttendeeHandler.ExportAttendees,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/events/:id/products",
+			Handler: c.ProductHandler.CreateProduct,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.PUT,
+			Path:    "/products/:id",
+			Handler: c.ProductHandler.UpdateProduct,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.DELETE,
+			Path:    "/products/:id",
+			Handler: c.ProductHandler.DeleteProduct,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/vouchers/:code",
+			Handler: c.ProductHandler.GetVoucher,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/vouchers/:code/redeem",
+			Handler: c.ProductHandler.RedeemVoucher,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/passes",
+			Handler: c.PassHandler.CreatePass,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.DELETE,
+			Path:    "/passes/:id",
+			Handler: c.PassHandler.DeletePass,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:    echo.POST,
+			Path:      "/passes/:id/orders",
+			Handler:   c.PassHandler.OrderPass,
+			Roles:     []string{"buyer", "admin"},
+			Challenge: route.ChallengeOnRisk,
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/events/:id/passes/scan",
+			Handler: c.PassHandler.ScanPass,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/events/:id/slot-templates",
+			Handler: c.SlotHandler.CreateSlotTemplate,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/events/:id/slot-templates",
+			Handler: c.SlotHandler.GetSlotTemplates,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/slot-templates/:id/slots",
+			Handler: c.SlotHandler.GenerateSlots,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:    echo.POST,
+			Path:      "/slots/:id/orders",
+			Handler:   c.SlotHandler.OrderSlot,
+			Roles:     []string{"buyer", "admin"},
+			Challenge: route.ChallengeOnRisk,
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/series",
+			Handler: c.SeriesHandler.CreateSeries,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/series/:id/exceptions",
+			Handler: c.SeriesHandler.AddException,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/series/:id/ticket-templates",
+			Handler: c.SeriesHandler.AddTicketTemplate,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.PUT,
+			Path:    "/series/:id/occurrences/:event_id",
+			Handler: c.SeriesHandler.UpdateOccurrence,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/events/:id/allocations",
+			Handler: c.AllocationHandler.CreateAllocation,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/events/:id/allocations",
+			Handler: c.AllocationHandler.GetAllocations,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/allocations/:id",
+			Handler: c.AllocationHandler.GetAllocationByID,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/allocations/:id/comps",
+			Handler: c.AllocationHandler.IssueComps,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/allocations/:id/release",
+			Handler: c.AllocationHandler.ReleaseAllocation,
+			Roles:   []string{"admin"},
+		},
+		{
+			Method:    echo.POST,
+			Path:      "/orders",
+			Handler:   c.OrderHandler.CreateOrder,
+			Roles:     []string{"buyer", "admin"},
+			Challenge: route.ChallengeOnRisk,
 		},
 		{
 			Method:  echo.GET,
@@ -189,6 +575,80 @@ func (c Config) PrivateRoute() []route.Route {
 			Handler: c.TicketHandler.UpdateTicket,
 			Roles:   []string{"admin"},
 		},
+		{
+			Method:  echo.PUT,
+			Path:    "/tickets/:id/attendee",
+			Handler: c.AttendeeHandler.UpdateAttendee,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/tickets/:id/exchange",
+			Handler: c.ExchangeHandler.ExchangeTicket,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:    echo.POST,
+			Path:      "/events/:id/groups",
+			Handler:   c.GroupHandler.CreateGroupBooking,
+			Roles:     []string{"buyer", "admin"},
+			Challenge: route.ChallengeOnRisk,
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/groups",
+			Handler: c.GroupHandler.GetGroupBookings,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/groups/:id",
+			Handler: c.GroupHandler.GetGroupBookingByID,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/exchanges",
+			Handler: c.ExchangeHandler.GetExchanges,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/exchanges/:id",
+			Handler: c.ExchangeHandler.GetExchangeByID,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/cart",
+			Handler: c.CartHandler.GetCart,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.DELETE,
+			Path:    "/cart",
+			Handler: c.CartHandler.ClearCart,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.POST,
+			Path:    "/cart/items",
+			Handler: c.CartHandler.AddItem,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.DELETE,
+			Path:    "/cart/items/:ticket_id",
+			Handler: c.CartHandler.RemoveItem,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:    echo.POST,
+			Path:      "/cart/checkout",
+			Handler:   c.CartHandler.Checkout,
+			Roles:     []string{"buyer", "admin"},
+			Challenge: route.ChallengeOnRisk,
+		},
 		{
 			Method:  echo.GET,
 			Path:    "/payment/:id",
@@ -207,6 +667,36 @@ func (c Config) PrivateRoute() []route.Route {
 			Handler: c.PaymentHandler.SearchPayments,
 			Roles:   []string{"admin"},
 		},
+		{
+			Method:  echo.POST,
+			Path:    "/waitlists",
+			Handler: c.WaitlistHandler.JoinWaitlist,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/waitlists",
+			Handler: c.WaitlistHandler.GetWaitlists,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/waitlists/:id",
+			Handler: c.WaitlistHandler.GetWaitlistByID,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.DELETE,
+			Path:    "/waitlists/:id",
+			Handler: c.WaitlistHandler.LeaveWaitlist,
+			Roles:   []string{"buyer", "admin"},
+		},
+		{
+			Method:  echo.GET,
+			Path:    "/waitlists/offers/:token",
+			Handler: c.WaitlistHandler.GetOffer,
+			Roles:   []string{"buyer", "admin"},
+		},
 	}
 }
 
@@ -218,6 +708,10 @@ func (c Config) SwaggerRoutes() {
 	})
 }
 
+func (c Config) WellKnownRoutes() {
+	c.App.GET("/.well-known/jwks.json", c.WellKnownHandler.JWKS)
+}
+
 func (c Config) NotFoundRoute() {
 	c.App.Any("*", func(ctx echo.Context) error {
 		return ctx.JSON(http.StatusNotFound, model.NewErrorResponse[any](http.StatusNotFound, "Route not found"))
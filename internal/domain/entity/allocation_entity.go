@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"gorm.io/gorm"
+)
+
+type Allocation struct {
+	ID          uint                   `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventID     uint                   `json:"event_id" gorm:"not null"`
+	PartnerName string                 `json:"partner_name" gorm:"not null"`
+	Type        string                 `json:"type" gorm:"not null"`
+	Quantity    int                    `json:"quantity" gorm:"not null"`
+	Status      model.AllocationStatus `json:"status" gorm:"not null"`
+	Notes       *string                `json:"notes,omitempty" gorm:"null"`
+	Event       Event                  `json:"event" gorm:"foreignKey:EventID"`
+	Tickets     []Ticket               `json:"tickets,omitempty" gorm:"foreignKey:AllocationID"`
+	gorm.Model
+}
+
+func (a *Allocation) TableName() string {
+	return "allocations"
+}
@@ -0,0 +1,32 @@
+package entity
+
+import "gorm.io/gorm"
+
+type AttendeeQuestion struct {
+	ID       uint     `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventID  uint     `json:"event_id" gorm:"not null"`
+	Label    string   `json:"label" gorm:"not null"`
+	Type     string   `json:"type" gorm:"not null"`
+	Options  []string `json:"options" gorm:"serializer:json"`
+	Required bool     `json:"required" gorm:"not null"`
+	Position int      `json:"position" gorm:"not null"`
+	Event    Event    `json:"event" gorm:"foreignKey:EventID"`
+	gorm.Model
+}
+
+func (q *AttendeeQuestion) TableName() string {
+	return "attendee_questions"
+}
+
+type AttendeeAnswer struct {
+	ID         uint             `json:"id" gorm:"primaryKey;autoIncrement"`
+	TicketID   string           `json:"ticket_id" gorm:"not null"`
+	QuestionID uint             `json:"question_id" gorm:"not null"`
+	Value      string           `json:"value" gorm:"not null"`
+	Question   AttendeeQuestion `json:"question" gorm:"foreignKey:QuestionID"`
+	gorm.Model
+}
+
+func (a *AttendeeAnswer) TableName() string {
+	return "attendee_answers"
+}
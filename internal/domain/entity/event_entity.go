@@ -3,18 +3,34 @@ package entity
 import (
 	"time"
 
+	"github.com/TrinityKnights/Backend/internal/domain/model"
 	"github.com/TrinityKnights/Backend/pkg/helper"
 	"gorm.io/gorm"
 )
 
 type Event struct {
-	ID          uint           `json:"id" gorm:"primaryKey"`
-	Name        string         `json:"name" gorm:"not null"`
-	Description string         `json:"description"`
-	Date        time.Time      `json:"date" gorm:"type:date;not null"`
-	Time        helper.SQLTime `json:"time" gorm:"type:time;not null"`
-	VenueID     uint           `json:"venue_id" gorm:"not null"`
-	Venue       Venue          `json:"venue" gorm:"foreignKey:VenueID"`
+	ID                    uint              `json:"id" gorm:"primaryKey"`
+	Name                  string            `json:"name" gorm:"not null"`
+	Description           string            `json:"description"`
+	Date                  time.Time         `json:"date" gorm:"type:date;not null"`
+	Time                  helper.SQLTime    `json:"time" gorm:"type:time;not null"`
+	VenueID               uint              `json:"venue_id" gorm:"not null"`
+	AttendeeEditCutoff    *time.Time        `json:"attendee_edit_cutoff,omitempty" gorm:"null"`
+	Series                *string           `json:"series,omitempty" gorm:"null"`
+	TimedEntry            bool              `json:"timed_entry" gorm:"not null;default:false"`
+	SeriesID              *uint             `json:"series_id,omitempty" gorm:"null"`
+	OccurrenceDate        *time.Time        `json:"occurrence_date,omitempty" gorm:"type:date;null"`
+	Status                model.EventStatus `json:"status" gorm:"not null"`
+	PublishAt             *time.Time        `json:"publish_at,omitempty" gorm:"null"`
+	StatusReason          *string           `json:"status_reason,omitempty" gorm:"null"`
+	CancelledAt           *time.Time        `json:"cancelled_at,omitempty" gorm:"null"`
+	Timezone              string            `json:"timezone" gorm:"not null"`
+	StartsAt              time.Time         `json:"starts_at" gorm:"not null"`
+	EndsAt                *time.Time        `json:"ends_at,omitempty" gorm:"null"`
+	OverlapOverrideReason *string           `json:"overlap_override_reason,omitempty" gorm:"null"`
+	OverlapOverriddenBy   *string           `json:"overlap_overridden_by,omitempty" gorm:"null"`
+	CapacityOverride      *int              `json:"capacity_override,omitempty" gorm:"null"`
+	Venue                 Venue             `json:"venue" gorm:"foreignKey:VenueID"`
 	gorm.Model
 }
 
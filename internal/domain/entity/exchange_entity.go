@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"gorm.io/gorm"
+)
+
+type TicketExchange struct {
+	ID              uint                       `json:"id" gorm:"primaryKey;autoIncrement"`
+	OrderID         uint                       `json:"order_id" gorm:"not null"`
+	UserID          string                     `json:"user_id" gorm:"not null"`
+	OldTicketID     string                     `json:"old_ticket_id" gorm:"not null"`
+	NewTicketID     string                     `json:"new_ticket_id" gorm:"not null"`
+	OldPrice        float64                    `json:"old_price" gorm:"not null"`
+	NewPrice        float64                    `json:"new_price" gorm:"not null"`
+	PriceDifference float64                    `json:"price_difference" gorm:"not null"`
+	Status          model.TicketExchangeStatus `json:"status" gorm:"not null"`
+	HoldToken       *string                    `json:"-" gorm:"null"`
+	CompletedAt     *time.Time                 `json:"completed_at,omitempty" gorm:"null"`
+	Order           Order                      `json:"order" gorm:"foreignKey:OrderID"`
+	OldTicket       Ticket                     `json:"old_ticket" gorm:"foreignKey:OldTicketID"`
+	NewTicket       Ticket                     `json:"new_ticket" gorm:"foreignKey:NewTicketID"`
+	Payment         *Payment                   `json:"payment,omitempty" gorm:"foreignKey:TicketExchangeID"`
+	gorm.Model
+}
+
+func (e *TicketExchange) TableName() string {
+	return "ticket_exchanges"
+}
+
+type UserCredit struct {
+	ID               uint    `json:"id" gorm:"primaryKey;autoIncrement"`
+	UserID           string  `json:"user_id" gorm:"not null"`
+	Amount           float64 `json:"amount" gorm:"not null"`
+	Description      string  `json:"description" gorm:"not null"`
+	TicketExchangeID *uint   `json:"ticket_exchange_id,omitempty" gorm:"null"`
+	User             User    `json:"user" gorm:"foreignKey:UserID"`
+	gorm.Model
+}
+
+func (c *UserCredit) TableName() string {
+	return "user_credits"
+}
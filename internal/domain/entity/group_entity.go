@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"gorm.io/gorm"
+)
+
+type GroupBooking struct {
+	ID          uint                     `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventID     uint                     `json:"event_id" gorm:"not null"`
+	OrganiserID string                   `json:"organiser_id" gorm:"not null"`
+	Type        string                   `json:"type" gorm:"not null"`
+	Quantity    int                      `json:"quantity" gorm:"not null"`
+	Status      model.GroupBookingStatus `json:"status" gorm:"not null"`
+	Deadline    time.Time                `json:"deadline" gorm:"not null"`
+	Event       Event                    `json:"event" gorm:"foreignKey:EventID"`
+	Organiser   User                     `json:"organiser" gorm:"foreignKey:OrganiserID"`
+	Shares      []GroupShare             `json:"shares,omitempty" gorm:"foreignKey:GroupBookingID"`
+	gorm.Model
+}
+
+func (g *GroupBooking) TableName() string {
+	return "group_bookings"
+}
+
+type GroupShare struct {
+	ID             uint                   `json:"id" gorm:"primaryKey;autoIncrement"`
+	GroupBookingID uint                   `json:"group_booking_id" gorm:"not null"`
+	OrderID        uint                   `json:"order_id" gorm:"not null"`
+	Name           string                 `json:"name" gorm:"not null"`
+	Email          string                 `json:"email" gorm:"not null"`
+	Quantity       int                    `json:"quantity" gorm:"not null"`
+	Status         model.GroupShareStatus `json:"status" gorm:"not null"`
+	Order          Order                  `json:"order" gorm:"foreignKey:OrderID"`
+	gorm.Model
+}
+
+func (g *GroupShare) TableName() string {
+	return "group_shares"
+}
@@ -3,18 +3,32 @@ package entity
 import (
 	"time"
 
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+
 	"gorm.io/gorm"
 )
 
 type Order struct {
-	ID         uint      `json:"id" gorm:"primaryKey;autoIncrement"`
-	UserID     string    `json:"user_id" gorm:"not null"`
-	Date       time.Time `json:"date" gorm:"not null"`
-	TotalPrice float64   `json:"total_price" gorm:"not null"`
-	User       User      `json:"user" gorm:"foreignKey:UserID"`
-	Payment    *Payment  `json:"payment" gorm:"foreignKey:OrderID"`
-	Tickets    []Ticket  `json:"tickets" gorm:"foreignKey:OrderID"`
-	Payments   []Payment `json:"payments" gorm:"foreignKey:OrderID"`
+	ID               uint                `json:"id" gorm:"primaryKey;autoIncrement"`
+	UserID           *string             `json:"user_id" gorm:"null"`
+	Code             *string             `json:"code,omitempty" gorm:"null"`
+	GuestEmail       *string             `json:"guest_email,omitempty" gorm:"null"`
+	GuestName        *string             `json:"guest_name,omitempty" gorm:"null"`
+	Date             time.Time           `json:"date" gorm:"not null"`
+	TotalPrice       float64             `json:"total_price" gorm:"not null"`
+	Complimentary    bool                `json:"complimentary" gorm:"not null;default:false"`
+	AllocationID     *uint               `json:"allocation_id,omitempty" gorm:"null"`
+	RecipientName    *string             `json:"recipient_name,omitempty" gorm:"null"`
+	RecipientEmail   *string             `json:"recipient_email,omitempty" gorm:"null"`
+	PassID           *uint               `json:"pass_id,omitempty" gorm:"null"`
+	RefundStatus     *model.RefundStatus `json:"refund_status,omitempty" gorm:"null"`
+	RefundEligibleAt *time.Time          `json:"refund_eligible_at,omitempty" gorm:"null"`
+	User             User                `json:"user" gorm:"foreignKey:UserID"`
+	Payment          *Payment            `json:"payment" gorm:"foreignKey:OrderID"`
+	Tickets          []Ticket            `json:"tickets" gorm:"foreignKey:OrderID"`
+	Items            []OrderItem         `json:"items,omitempty" gorm:"foreignKey:OrderID"`
+	SlotBookings     []SlotBooking       `json:"slot_bookings,omitempty" gorm:"foreignKey:OrderID"`
+	Payments         []Payment           `json:"payments" gorm:"foreignKey:OrderID"`
 	gorm.Model
 }
 
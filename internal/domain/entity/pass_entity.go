@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"gorm.io/gorm"
+)
+
+type Pass struct {
+	ID          uint    `json:"id" gorm:"primaryKey;autoIncrement"`
+	Name        string  `json:"name" gorm:"not null"`
+	Description *string `json:"description,omitempty" gorm:"null"`
+	Price       float64 `json:"price" gorm:"not null"`
+	Type        string  `json:"type" gorm:"not null"`
+	SeriesID    *uint   `json:"series_id,omitempty" gorm:"null"`
+	Events      []Event `json:"events,omitempty" gorm:"many2many:pass_events"`
+	gorm.Model
+}
+
+func (p *Pass) TableName() string {
+	return "passes"
+}
@@ -6,14 +6,15 @@ import (
 )
 
 type Payment struct {
-	ID            uint                   `json:"id" gorm:"primaryKey;autoIncrement"`
-	OrderID       uint                   `json:"order_id" gorm:"not null"`
-	Method        string                 `json:"method" gorm:"null"`
-	TransactionID string                 `json:"transaction_id" gorm:"not null"`
-	Amount        float64                `json:"amount" gorm:"null"`
-	Status        model.PaymentStatus    `json:"status" gorm:"null"`
-	Order         Order                  `json:"order" gorm:"foreignKey:OrderID"`
-	Metadata      map[string]interface{} `gorm:"-"`
+	ID               uint                   `json:"id" gorm:"primaryKey;autoIncrement"`
+	OrderID          uint                   `json:"order_id" gorm:"not null"`
+	Method           string                 `json:"method" gorm:"null"`
+	TransactionID    string                 `json:"transaction_id" gorm:"not null"`
+	Amount           float64                `json:"amount" gorm:"null"`
+	Status           model.PaymentStatus    `json:"status" gorm:"null"`
+	TicketExchangeID *uint                  `json:"ticket_exchange_id,omitempty" gorm:"null"`
+	Order            Order                  `json:"order" gorm:"foreignKey:OrderID"`
+	Metadata         map[string]interface{} `gorm:"-"`
 	gorm.Model
 }
 
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Product struct {
+	ID          uint    `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventID     uint    `json:"event_id" gorm:"not null"`
+	SKU         string  `json:"sku" gorm:"column:sku;not null"`
+	Name        string  `json:"name" gorm:"not null"`
+	Description *string `json:"description,omitempty" gorm:"null"`
+	Price       float64 `json:"price" gorm:"not null"`
+	Stock       int     `json:"stock" gorm:"not null"`
+	Redeemable  bool    `json:"redeemable" gorm:"not null"`
+	Event       Event   `json:"event" gorm:"foreignKey:EventID"`
+	gorm.Model
+}
+
+func (p *Product) TableName() string {
+	return "products"
+}
+
+type OrderItem struct {
+	ID         uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	OrderID    uint      `json:"order_id" gorm:"not null"`
+	ProductID  uint      `json:"product_id" gorm:"not null"`
+	SKU        string    `json:"sku" gorm:"column:sku;not null"`
+	Name       string    `json:"name" gorm:"not null"`
+	Quantity   int       `json:"quantity" gorm:"not null"`
+	UnitPrice  float64   `json:"unit_price" gorm:"not null"`
+	TotalPrice float64   `json:"total_price" gorm:"not null"`
+	Vouchers   []Voucher `json:"vouchers,omitempty" gorm:"foreignKey:OrderItemID"`
+	gorm.Model
+}
+
+func (i *OrderItem) TableName() string {
+	return "order_items"
+}
+
+type Voucher struct {
+	ID          uint       `json:"id" gorm:"primaryKey;autoIncrement"`
+	Code        string     `json:"code" gorm:"not null"`
+	OrderItemID uint       `json:"order_item_id" gorm:"not null"`
+	RedeemedAt  *time.Time `json:"redeemed_at,omitempty" gorm:"null"`
+	RedeemedBy  *string    `json:"redeemed_by,omitempty" gorm:"null"`
+	OrderItem   OrderItem  `json:"order_item" gorm:"foreignKey:OrderItemID"`
+	gorm.Model
+}
+
+func (v *Voucher) TableName() string {
+	return "vouchers"
+}
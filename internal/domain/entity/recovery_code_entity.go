@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// RecoveryCode is a one-time code that stands in for a TOTP code when the user has lost their
+// authenticator. Only its SHA-256 hash is stored.
+type RecoveryCode struct {
+	ID        uint       `json:"id" gorm:"primaryKey"`
+	UserID    string     `json:"user_id" gorm:"not null"`
+	CodeHash  string     `json:"-" gorm:"not null"`
+	UsedAt    *time.Time `json:"used_at" gorm:"null"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+func (r *RecoveryCode) TableName() string {
+	return "user_recovery_codes"
+}
@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// RolePolicy holds the security settings admins choose for everyone with a role.
+type RolePolicy struct {
+	Role              string    `json:"role" gorm:"primaryKey"`
+	TwoFactorRequired bool      `json:"two_factor_required" gorm:"not null"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+func (p *RolePolicy) TableName() string {
+	return "role_policies"
+}
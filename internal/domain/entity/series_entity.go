@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+	"gorm.io/gorm"
+)
+
+type EventSeries struct {
+	ID              uint                   `json:"id" gorm:"primaryKey;autoIncrement"`
+	Name            string                 `json:"name" gorm:"not null"`
+	Description     string                 `json:"description" gorm:"not null"`
+	VenueID         uint                   `json:"venue_id" gorm:"not null"`
+	Time            helper.SQLTime         `json:"time" gorm:"type:time;not null"`
+	RRule           string                 `json:"rrule" gorm:"column:rrule;not null"`
+	StartsOn        time.Time              `json:"starts_on" gorm:"type:date;not null"`
+	Exceptions      []SeriesException      `json:"exceptions,omitempty" gorm:"foreignKey:SeriesID"`
+	TicketTemplates []SeriesTicketTemplate `json:"ticket_templates,omitempty" gorm:"foreignKey:SeriesID"`
+	Occurrences     []Event                `json:"occurrences,omitempty" gorm:"foreignKey:SeriesID"`
+	gorm.Model
+}
+
+func (e *EventSeries) TableName() string {
+	return "event_series"
+}
+
+type SeriesException struct {
+	ID           uint                      `json:"id" gorm:"primaryKey;autoIncrement"`
+	SeriesID     uint                      `json:"series_id" gorm:"not null"`
+	OriginalDate time.Time                 `json:"original_date" gorm:"type:date;not null"`
+	Action       model.SeriesExceptionType `json:"action" gorm:"not null"`
+	NewDate      *time.Time                `json:"new_date,omitempty" gorm:"type:date;null"`
+	NewTime      *helper.SQLTime           `json:"new_time,omitempty" gorm:"type:time;null"`
+	gorm.Model
+}
+
+func (e *SeriesException) TableName() string {
+	return "series_exceptions"
+}
+
+type SeriesTicketTemplate struct {
+	ID       uint    `json:"id" gorm:"primaryKey;autoIncrement"`
+	SeriesID uint    `json:"series_id" gorm:"not null"`
+	Type     string  `json:"type" gorm:"not null"`
+	Price    float64 `json:"price" gorm:"not null"`
+	Count    int     `json:"count" gorm:"not null"`
+	gorm.Model
+}
+
+func (t *SeriesTicketTemplate) TableName() string {
+	return "series_ticket_templates"
+}
@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+// Session is one login of a user, identified by the refresh token family it started.
+type Session struct {
+	ID             string     `json:"id" gorm:"primaryKey"`
+	UserID         string     `json:"user_id" gorm:"not null"`
+	RefreshTokenID string     `json:"-" gorm:"not null"`
+	UserAgent      string     `json:"user_agent" gorm:"not null"`
+	IP             string     `json:"ip" gorm:"column:ip;not null"`
+	CreatedAt      time.Time  `json:"created_at"`
+	LastSeenAt     time.Time  `json:"last_seen_at" gorm:"not null"`
+	ExpiresAt      time.Time  `json:"expires_at" gorm:"not null"`
+	RevokedAt      *time.Time `json:"revoked_at" gorm:"null"`
+}
+
+func (s *Session) TableName() string {
+	return "user_sessions"
+}
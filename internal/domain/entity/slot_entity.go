@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/pkg/helper"
+	"gorm.io/gorm"
+)
+
+type SlotTemplate struct {
+	ID              uint           `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventID         uint           `json:"event_id" gorm:"not null"`
+	Name            string         `json:"name" gorm:"not null"`
+	StartTime       helper.SQLTime `json:"start_time" gorm:"type:time;not null"`
+	EndTime         helper.SQLTime `json:"end_time" gorm:"type:time;not null"`
+	IntervalMinutes int            `json:"interval_minutes" gorm:"not null"`
+	DurationMinutes int            `json:"duration_minutes" gorm:"not null"`
+	Capacity        int            `json:"capacity" gorm:"not null"`
+	Price           float64        `json:"price" gorm:"not null"`
+	Event           Event          `json:"event" gorm:"foreignKey:EventID"`
+	gorm.Model
+}
+
+func (s *SlotTemplate) TableName() string {
+	return "slot_templates"
+}
+
+type TimeSlot struct {
+	ID         uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventID    uint      `json:"event_id" gorm:"not null"`
+	TemplateID uint      `json:"template_id" gorm:"not null"`
+	StartsAt   time.Time `json:"starts_at" gorm:"not null"`
+	EndsAt     time.Time `json:"ends_at" gorm:"not null"`
+	Capacity   int       `json:"capacity" gorm:"not null"`
+	Reserved   int       `json:"reserved" gorm:"not null;default:0"`
+	Price      float64   `json:"price" gorm:"not null"`
+	Event      Event     `json:"event" gorm:"foreignKey:EventID"`
+	gorm.Model
+}
+
+func (s *TimeSlot) TableName() string {
+	return "time_slots"
+}
+
+type SlotBooking struct {
+	ID         uint     `json:"id" gorm:"primaryKey;autoIncrement"`
+	OrderID    uint     `json:"order_id" gorm:"not null"`
+	SlotID     uint     `json:"slot_id" gorm:"not null"`
+	Quantity   int      `json:"quantity" gorm:"not null"`
+	UnitPrice  float64  `json:"unit_price" gorm:"not null"`
+	TotalPrice float64  `json:"total_price" gorm:"not null"`
+	Slot       TimeSlot `json:"slot" gorm:"foreignKey:SlotID"`
+	gorm.Model
+}
+
+func (s *SlotBooking) TableName() string {
+	return "slot_bookings"
+}
@@ -1,20 +1,50 @@
 package entity
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Ticket struct {
-	ID         string                 `json:"id" gorm:"primaryKey"`
-	EventID    uint                   `json:"event_id" gorm:"not null"`
-	OrderID    *uint                  `json:"order_id,omitempty" gorm:"null"`
-	Price      float64                `json:"price" gorm:"not null"`
-	Type       string                 `json:"type" gorm:"not null"`
-	SeatNumber string                 `json:"seat_number"`
-	Event      Event                  `json:"event" gorm:"foreignKey:EventID"`
-	Order      Order                  `json:"order,omitempty" gorm:"foreignKey:OrderID"`
-	Metadata   map[string]interface{} `gorm:"-"`
+	ID            string                 `json:"id" gorm:"primaryKey"`
+	EventID       uint                   `json:"event_id" gorm:"not null"`
+	OrderID       *uint                  `json:"order_id,omitempty" gorm:"null"`
+	Price         float64                `json:"price" gorm:"not null"`
+	Type          string                 `json:"type" gorm:"not null"`
+	SeatNumber    string                 `json:"seat_number"`
+	HoldToken     *string                `json:"-" gorm:"null"`
+	HeldUntil     *time.Time             `json:"-" gorm:"null"`
+	AttendeeName  *string                `json:"attendee_name,omitempty" gorm:"null"`
+	AttendeeEmail *string                `json:"attendee_email,omitempty" gorm:"null"`
+	AllocationID  *uint                  `json:"allocation_id,omitempty" gorm:"null"`
+	CheckedInAt   *time.Time             `json:"checked_in_at,omitempty" gorm:"null"`
+	Answers       []AttendeeAnswer       `json:"answers,omitempty" gorm:"foreignKey:TicketID"`
+	Event         Event                  `json:"event" gorm:"foreignKey:EventID"`
+	Order         Order                  `json:"order,omitempty" gorm:"foreignKey:OrderID"`
+	Metadata      map[string]interface{} `gorm:"-"`
 	gorm.Model
 }
 
 func (t *Ticket) TableName() string {
 	return "tickets"
 }
+
+// EventTicketCounter is the running tally of an event's tickets in one category. The inventory
+// and the sharded state counts behind it are kept up to date by a trigger on tickets and are
+// never written by the application.
+type EventTicketCounter struct {
+	EventID   uint      `json:"event_id" gorm:"primaryKey"`
+	Type      string    `json:"type" gorm:"primaryKey"`
+	Total     int       `json:"total"`
+	Sold      int       `json:"sold"`
+	Held      int       `json:"held"`
+	Allocated int       `json:"allocated"`
+	MinPrice  *float64  `json:"min_price"`
+	MaxPrice  *float64  `json:"max_price"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (c *EventTicketCounter) TableName() string {
+	return "event_ticket_counters"
+}
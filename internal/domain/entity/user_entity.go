@@ -17,6 +17,9 @@ type User struct {
 	VerifyEmailToken   string     `json:"verify_email_token" gorm:"null"`
 	ResetPasswordToken string     `json:"reset_password_token" gorm:"null"`
 	IsVerified         bool       `json:"is_verified" gorm:"not null"`
+	TwoFactorSecret    string     `json:"-" gorm:"null"`
+	TwoFactorEnabled   bool       `json:"two_factor_enabled" gorm:"not null"`
+	TwoFactorLastStep  int64      `json:"-" gorm:"not null"`
 	gorm.Model
 }
 
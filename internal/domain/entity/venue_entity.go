@@ -10,6 +10,7 @@ type Venue struct {
 	City     string  `json:"city"`
 	State    string  `json:"state"`
 	Zip      string  `json:"zip" gorm:"column:zip_code"`
+	Timezone string  `json:"timezone" gorm:"not null"`
 	Events   []Event `gorm:"foreignKey:VenueID"`
 	gorm.Model
 }
@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+type WaitingRoom struct {
+	EventID       uint      `json:"event_id" gorm:"primaryKey"`
+	AdmissionRate int       `json:"admission_rate" gorm:"not null"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+func (w *WaitingRoom) TableName() string {
+	return "waiting_rooms"
+}
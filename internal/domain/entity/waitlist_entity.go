@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"gorm.io/gorm"
+)
+
+type WaitlistEntry struct {
+	ID             uint                 `json:"id" gorm:"primaryKey;autoIncrement"`
+	EventID        uint                 `json:"event_id" gorm:"not null"`
+	UserID         string               `json:"user_id" gorm:"not null"`
+	Type           string               `json:"type" gorm:"not null"`
+	Quantity       int                  `json:"quantity" gorm:"not null"`
+	Status         model.WaitlistStatus `json:"status" gorm:"not null"`
+	OfferToken     *string              `json:"offer_token,omitempty" gorm:"null"`
+	OfferExpiresAt *time.Time           `json:"offer_expires_at,omitempty" gorm:"null"`
+	Event          Event                `json:"event" gorm:"foreignKey:EventID"`
+	User           User                 `json:"user" gorm:"foreignKey:UserID"`
+	gorm.Model
+}
+
+func (w *WaitlistEntry) TableName() string {
+	return "waitlist_entries"
+}
@@ -0,0 +1,53 @@
+package model
+
+type AllocationStatus string
+
+const (
+	AllocationStatusActive   AllocationStatus = "ACTIVE"
+	AllocationStatusReleased AllocationStatus = "RELEASED"
+)
+
+type AllocationResponse struct {
+	ID          uint    `json:"id"`
+	EventID     uint    `json:"event_id"`
+	PartnerName string  `json:"partner_name"`
+	Type        string  `json:"type"`
+	Quantity    int     `json:"quantity"`
+	Issued      int     `json:"issued"`
+	Remaining   int     `json:"remaining"`
+	Status      string  `json:"status"`
+	Notes       *string `json:"notes,omitempty"`
+	CreatedAt   string  `json:"created_at"`
+}
+
+type CreateAllocationRequest struct {
+	EventID     uint   `param:"id" validate:"required"`
+	PartnerName string `json:"partner_name" validate:"required,max=255"`
+	Type        string `json:"type" validate:"required,oneof=vip regular VIP REGULAR"`
+	Quantity    int    `json:"quantity" validate:"required,min=1"`
+	Notes       string `json:"notes,omitempty" validate:"omitempty,max=1000"`
+}
+
+type GetAllocationRequest struct {
+	ID uint `param:"id" validate:"required"`
+}
+
+type AllocationsRequest struct {
+	EventID uint `param:"id" validate:"required"`
+}
+
+type CompRecipientRequest struct {
+	Name     string `json:"name" validate:"required,max=255"`
+	Email    string `json:"email" validate:"required,email,max=255"`
+	Quantity int    `json:"quantity" validate:"omitempty,min=1,max=10"`
+}
+
+type IssueCompsRequest struct {
+	ID         uint                   `param:"id" validate:"required"`
+	Recipients []CompRecipientRequest `json:"recipients" validate:"required,min=1,dive"`
+}
+
+type ReleaseAllocationRequest struct {
+	ID       uint `param:"id" validate:"required"`
+	Quantity int  `json:"quantity,omitempty" validate:"omitempty,min=1"`
+}
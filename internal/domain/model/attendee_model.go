@@ -0,0 +1,90 @@
+package model
+
+type AttendeeQuestionType string
+
+const (
+	AttendeeQuestionText     AttendeeQuestionType = "TEXT"
+	AttendeeQuestionEmail    AttendeeQuestionType = "EMAIL"
+	AttendeeQuestionNumber   AttendeeQuestionType = "NUMBER"
+	AttendeeQuestionSelect   AttendeeQuestionType = "SELECT"
+	AttendeeQuestionCheckbox AttendeeQuestionType = "CHECKBOX"
+)
+
+type AttendeeQuestionResponse struct {
+	ID       uint     `json:"id"`
+	EventID  uint     `json:"event_id"`
+	Label    string   `json:"label"`
+	Type     string   `json:"type"`
+	Options  []string `json:"options,omitempty"`
+	Required bool     `json:"required"`
+	Position int      `json:"position"`
+}
+
+type CreateAttendeeQuestionRequest struct {
+	EventID  uint     `param:"id" validate:"required"`
+	Label    string   `json:"label" validate:"required,lte=255"`
+	Type     string   `json:"type" validate:"required,oneof=TEXT EMAIL NUMBER SELECT CHECKBOX"`
+	Options  []string `json:"options" validate:"required_if=Type SELECT,omitempty,dive,required,lte=100"`
+	Required bool     `json:"required"`
+	Position int      `json:"position" validate:"omitempty,gte=0"`
+}
+
+type UpdateAttendeeQuestionRequest struct {
+	EventID  uint     `param:"id" validate:"required"`
+	ID       uint     `param:"question_id" validate:"required"`
+	Label    string   `json:"label" validate:"omitempty,lte=255"`
+	Type     string   `json:"type" validate:"omitempty,oneof=TEXT EMAIL NUMBER SELECT CHECKBOX"`
+	Options  []string `json:"options" validate:"omitempty,dive,required,lte=100"`
+	Required *bool    `json:"required,omitempty"`
+	Position *int     `json:"position,omitempty" validate:"omitempty,gte=0"`
+}
+
+type DeleteAttendeeQuestionRequest struct {
+	EventID uint `param:"id" validate:"required"`
+	ID      uint `param:"question_id" validate:"required"`
+}
+
+type GetAttendeeQuestionsRequest struct {
+	EventID uint `param:"id" validate:"required"`
+}
+
+type AttendeeAnswerRequest struct {
+	QuestionID uint   `json:"question_id" validate:"required"`
+	Value      string `json:"value" validate:"lte=1000"`
+}
+
+type AttendeeRequest struct {
+	TicketID string                  `json:"ticket_id" validate:"required"`
+	Name     string                  `json:"name" validate:"required,lte=100"`
+	Email    string                  `json:"email" validate:"omitempty,email,lte=100"`
+	Answers  []AttendeeAnswerRequest `json:"answers" validate:"omitempty,dive"`
+}
+
+type UpdateAttendeeRequest struct {
+	TicketID string                  `param:"id" validate:"required"`
+	Name     string                  `json:"name" validate:"required,lte=100"`
+	Email    string                  `json:"email" validate:"omitempty,email,lte=100"`
+	Answers  []AttendeeAnswerRequest `json:"answers" validate:"omitempty,dive"`
+}
+
+type AttendeeAnswerResponse struct {
+	QuestionID uint   `json:"question_id"`
+	Label      string `json:"label"`
+	Value      string `json:"value"`
+}
+
+type AttendeeResponse struct {
+	Name    string                   `json:"name"`
+	Email   string                   `json:"email,omitempty"`
+	Answers []AttendeeAnswerResponse `json:"answers,omitempty"`
+}
+
+type AttendeesRequest struct {
+	EventID uint `param:"id" validate:"required"`
+	Page    int  `query:"page" validate:"numeric,omitempty,gte=1"`
+	Size    int  `query:"size" validate:"numeric,omitempty,gte=1,lte=100"`
+}
+
+type ExportAttendeesRequest struct {
+	EventID uint `param:"id" validate:"required"`
+}
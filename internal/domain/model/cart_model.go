@@ -0,0 +1,29 @@
+package model
+
+type CartItemResponse struct {
+	TicketID   string  `json:"ticket_id"`
+	EventID    uint    `json:"event_id"`
+	EventName  string  `json:"event_name"`
+	Type       string  `json:"type"`
+	SeatNumber string  `json:"seat_number"`
+	Price      float64 `json:"price"`
+	AddedAt    string  `json:"added_at"`
+}
+
+type CartResponse struct {
+	Items      []CartItemResponse `json:"items"`
+	TotalPrice float64            `json:"total_price"`
+	ExpiresAt  string             `json:"expires_at,omitempty"`
+}
+
+type AddCartItemRequest struct {
+	TicketID string `json:"ticket_id" validate:"required"`
+}
+
+type CheckoutCartRequest struct {
+	AdmissionTokens []EventAdmissionRequest `json:"admission_tokens,omitempty" validate:"omitempty,max=50,dive"`
+}
+
+type RemoveCartItemRequest struct {
+	TicketID string `param:"ticket_id" validate:"required"`
+}
@@ -0,0 +1,30 @@
+package converter
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+)
+
+func AllocationEntityToResponse(allocation *entity.Allocation, issued int64) *model.AllocationResponse {
+	return &model.AllocationResponse{
+		ID:          allocation.ID,
+		EventID:     allocation.EventID,
+		PartnerName: allocation.PartnerName,
+		Type:        allocation.Type,
+		Quantity:    allocation.Quantity,
+		Issued:      int(issued),
+		Remaining:   allocation.Quantity - int(issued),
+		Status:      string(allocation.Status),
+		Notes:       allocation.Notes,
+		CreatedAt:   helper.FormatDate(allocation.CreatedAt),
+	}
+}
+
+func AllocationsToResponses(allocations []entity.Allocation, issued map[uint]int64) []*model.AllocationResponse {
+	responses := make([]*model.AllocationResponse, len(allocations))
+	for i := range allocations {
+		responses[i] = AllocationEntityToResponse(&allocations[i], issued[allocations[i].ID])
+	}
+	return responses
+}
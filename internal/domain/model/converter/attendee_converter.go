@@ -0,0 +1,77 @@
+package converter
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+)
+
+func AttendeeQuestionEntityToResponse(question *entity.AttendeeQuestion) *model.AttendeeQuestionResponse {
+	return &model.AttendeeQuestionResponse{
+		ID:       question.ID,
+		EventID:  question.EventID,
+		Label:    question.Label,
+		Type:     question.Type,
+		Options:  question.Options,
+		Required: question.Required,
+		Position: question.Position,
+	}
+}
+
+func AttendeeQuestionsToResponses(questions []entity.AttendeeQuestion) []*model.AttendeeQuestionResponse {
+	responses := make([]*model.AttendeeQuestionResponse, len(questions))
+	for i := range questions {
+		responses[i] = AttendeeQuestionEntityToResponse(&questions[i])
+	}
+	return responses
+}
+
+// AttendeeEntityToResponse returns nil when no holder details have been captured for the ticket.
+func AttendeeEntityToResponse(ticket *entity.Ticket) *model.AttendeeResponse {
+	if ticket.AttendeeName == nil {
+		return nil
+	}
+
+	response := &model.AttendeeResponse{
+		Name:  *ticket.AttendeeName,
+		Email: helper.StringOrEmpty(ticket.AttendeeEmail),
+	}
+
+	for i := range ticket.Answers {
+		// Answers to deleted questions are kept but no longer shown
+		if ticket.Answers[i].Question.ID == 0 {
+			continue
+		}
+		response.Answers = append(response.Answers, model.AttendeeAnswerResponse{
+			QuestionID: ticket.Answers[i].QuestionID,
+			Label:      ticket.Answers[i].Question.Label,
+			Value:      ticket.Answers[i].Value,
+		})
+	}
+
+	return response
+}
+
+func AttendeesToPaginatedResponse(tickets []*entity.Ticket, totalItems int64, page, size int) *model.Response[[]*model.TicketResponse] {
+	responses := make([]*model.TicketResponse, len(tickets))
+	for i := range tickets {
+		responses[i] = &model.TicketResponse{
+			ID:         tickets[i].ID,
+			EventID:    tickets[i].EventID,
+			OrderID:    helper.UintOrZero(tickets[i].OrderID),
+			Price:      tickets[i].Price,
+			Type:       tickets[i].Type,
+			SeatNumber: tickets[i].SeatNumber,
+			Attendee:   AttendeeEntityToResponse(tickets[i]),
+		}
+	}
+
+	totalPages := (int(totalItems) + size - 1) / size
+
+	return model.NewResponse(responses, &model.PageMetadata{
+		Page:       page,
+		Size:       size,
+		TotalItems: int(totalItems),
+		TotalPages: totalPages,
+	})
+}
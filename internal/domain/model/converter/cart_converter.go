@@ -0,0 +1,19 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+)
+
+func CartToResponse(items []model.CartItemResponse, expiresAt time.Time) *model.CartResponse {
+	response := &model.CartResponse{Items: items}
+	for i := range items {
+		response.TotalPrice += items[i].Price
+	}
+	if !expiresAt.IsZero() {
+		response.ExpiresAt = helper.FormatDate(expiresAt)
+	}
+	return response
+}
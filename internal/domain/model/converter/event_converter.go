@@ -1,18 +1,53 @@
 package converter
 
 import (
+	"time"
+
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
 	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/pkg/helper"
 )
 
+// EventEntityToResponse shows the event's times in the zone of its venue.
 func EventEntityToResponse(event *entity.Event) *model.EventResponse {
-	return &model.EventResponse{
-		ID:          event.ID,
-		Name:        event.Name,
-		Description: event.Description,
-		Date:        event.Date,
-		Time:        event.Time,
-		VenueID:     event.VenueID,
+	loc := helper.LocationOrDefault(event.Timezone)
+
+	response := &model.EventResponse{
+		ID:                    event.ID,
+		Name:                  event.Name,
+		Description:           event.Description,
+		Date:                  event.Date,
+		Time:                  event.Time,
+		VenueID:               event.VenueID,
+		AttendeeEditCutoff:    event.AttendeeEditCutoff,
+		Series:                event.Series,
+		TimedEntry:            event.TimedEntry,
+		SeriesID:              event.SeriesID,
+		Status:                string(event.Status),
+		PublishAt:             event.PublishAt,
+		StatusReason:          event.StatusReason,
+		StartsAt:              event.StartsAt.In(loc),
+		Timezone:              loc.String(),
+		OverlapOverrideReason: event.OverlapOverrideReason,
+		CapacityOverride:      event.CapacityOverride,
+	}
+
+	if event.EndsAt != nil {
+		endsAt := event.EndsAt.In(loc)
+		duration := int(endsAt.Sub(event.StartsAt).Minutes())
+		response.EndsAt = &endsAt
+		response.DurationMinutes = &duration
+	}
+
+	return response
+}
+
+// EventResponseInZone shows the response's times in loc instead of the venue's zone.
+func EventResponseInZone(response *model.EventResponse, loc *time.Location) {
+	response.StartsAt = response.StartsAt.In(loc)
+	if response.EndsAt != nil {
+		endsAt := response.EndsAt.In(loc)
+		response.EndsAt = &endsAt
 	}
 }
 
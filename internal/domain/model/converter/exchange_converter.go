@@ -0,0 +1,51 @@
+package converter
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+)
+
+func TicketExchangeEntityToResponse(exchange *entity.TicketExchange) *model.TicketExchangeResponse {
+	response := &model.TicketExchangeResponse{
+		ID:              exchange.ID,
+		OrderID:         exchange.OrderID,
+		UserID:          exchange.UserID,
+		OldTicketID:     exchange.OldTicketID,
+		NewTicketID:     exchange.NewTicketID,
+		OldSeatNumber:   exchange.OldTicket.SeatNumber,
+		NewSeatNumber:   exchange.NewTicket.SeatNumber,
+		OldPrice:        exchange.OldPrice,
+		NewPrice:        exchange.NewPrice,
+		PriceDifference: exchange.PriceDifference,
+		Status:          string(exchange.Status),
+		CreatedAt:       helper.FormatDate(exchange.CreatedAt),
+	}
+
+	// A cheaper ticket leaves the buyer with a credit for the difference
+	if exchange.Status == model.TicketExchangeStatusCompleted && exchange.PriceDifference < 0 {
+		response.CreditAmount = -exchange.PriceDifference
+	}
+
+	if exchange.CompletedAt != nil {
+		completedAt := helper.FormatDate(*exchange.CompletedAt)
+		response.CompletedAt = &completedAt
+	}
+
+	return response
+}
+
+func TicketExchangesToPaginatedResponse(exchanges []entity.TicketExchange, totalItems int64, page, size int) *model.Response[[]*model.TicketExchangeResponse] {
+	responses := make([]*model.TicketExchangeResponse, len(exchanges))
+	for i := range exchanges {
+		responses[i] = TicketExchangeEntityToResponse(&exchanges[i])
+	}
+	totalPages := (int(totalItems) + size - 1) / size
+
+	return model.NewResponse(responses, &model.PageMetadata{
+		Page:       page,
+		Size:       size,
+		TotalItems: int(totalItems),
+		TotalPages: totalPages,
+	})
+}
@@ -0,0 +1,60 @@
+package converter
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+)
+
+func GroupBookingEntityToResponse(group *entity.GroupBooking) *model.GroupBookingResponse {
+	response := &model.GroupBookingResponse{
+		ID:          group.ID,
+		EventID:     group.EventID,
+		OrganiserID: group.OrganiserID,
+		Type:        group.Type,
+		Quantity:    group.Quantity,
+		Status:      string(group.Status),
+		Deadline:    helper.FormatDate(group.Deadline),
+		CreatedAt:   helper.FormatDate(group.CreatedAt),
+		Shares:      make([]*model.GroupShareResponse, len(group.Shares)),
+	}
+
+	for i := range group.Shares {
+		share := &group.Shares[i]
+		response.Shares[i] = &model.GroupShareResponse{
+			ID:       share.ID,
+			OrderID:  share.OrderID,
+			Name:     share.Name,
+			Email:    share.Email,
+			Quantity: share.Quantity,
+			Amount:   share.Order.TotalPrice,
+			Status:   string(share.Status),
+		}
+
+		// Released shares no longer hold any seats
+		for j := range share.Order.Tickets {
+			response.Shares[i].SeatNumbers = append(response.Shares[i].SeatNumbers, share.Order.Tickets[j].SeatNumber)
+		}
+
+		if share.Status == model.GroupShareStatusPaid {
+			response.Paid += share.Quantity
+		}
+	}
+
+	return response
+}
+
+func GroupBookingsToPaginatedResponse(groups []entity.GroupBooking, totalItems int64, page, size int) *model.Response[[]*model.GroupBookingResponse] {
+	responses := make([]*model.GroupBookingResponse, len(groups))
+	for i := range groups {
+		responses[i] = GroupBookingEntityToResponse(&groups[i])
+	}
+	totalPages := (int(totalItems) + size - 1) / size
+
+	return model.NewResponse(responses, &model.PageMetadata{
+		Page:       page,
+		Size:       size,
+		TotalItems: int(totalItems),
+		TotalPages: totalPages,
+	})
+}
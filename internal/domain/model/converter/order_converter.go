@@ -1,6 +1,8 @@
 package converter
 
 import (
+	"slices"
+
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
 	"github.com/TrinityKnights/Backend/internal/domain/model"
 	"github.com/TrinityKnights/Backend/pkg/helper"
@@ -13,19 +15,57 @@ func OrderEntityToResponse(order *entity.Order) *model.OrderResponse {
 
 	quantity := len(order.Tickets)
 	eventID := uint(0)
-	if len(order.Tickets) > 0 {
-		eventID = order.Tickets[0].EventID
+	var eventIDs []uint
+	for i := range order.Tickets {
+		if i == 0 {
+			eventID = order.Tickets[i].EventID
+		}
+		if !slices.Contains(eventIDs, order.Tickets[i].EventID) {
+			eventIDs = append(eventIDs, order.Tickets[i].EventID)
+		}
 	}
 
 	response := &model.OrderResponse{
 		ID:         order.ID,
 		EventID:    &eventID,
-		UserID:     order.UserID,
+		UserID:     helper.StringOrEmpty(order.UserID),
 		Quantity:   &quantity,
 		TotalPrice: &order.TotalPrice,
 		Date:       helper.FormatDate(order.Date),
 	}
 
+	// Guest orders are looked up by their code and the buyer's email
+	if order.UserID == nil {
+		response.Code = order.Code
+		response.GuestEmail = order.GuestEmail
+	}
+
+	// Passes are scanned by their code at every covered event
+	if order.PassID != nil {
+		response.PassID = order.PassID
+		response.Code = order.Code
+	}
+
+	if order.Payment != nil {
+		response.PaymentStatus = string(order.Payment.Status)
+	}
+
+	if order.RefundStatus != nil {
+		response.RefundStatus = string(*order.RefundStatus)
+	}
+
+	// Cart checkouts can span several events
+	if len(eventIDs) > 1 {
+		response.EventIDs = eventIDs
+	}
+
+	if order.Complimentary {
+		response.Complimentary = true
+		response.AllocationID = order.AllocationID
+		response.RecipientName = order.RecipientName
+		response.RecipientEmail = order.RecipientEmail
+	}
+
 	// Only add tickets if they exist
 	if len(order.Tickets) > 0 {
 		tickets := make([]model.TicketResponse, len(order.Tickets))
@@ -37,11 +77,31 @@ func OrderEntityToResponse(order *entity.Order) *model.OrderResponse {
 				Price:      order.Tickets[i].Price,
 				Type:       order.Tickets[i].Type,
 				SeatNumber: order.Tickets[i].SeatNumber,
+				Attendee:   AttendeeEntityToResponse(&order.Tickets[i]),
 			}
 		}
 		response.Tickets = &tickets
 	}
 
+	if len(order.Items) > 0 {
+		response.Items = make([]*model.OrderItemResponse, len(order.Items))
+		for i := range order.Items {
+			response.Items[i] = OrderItemEntityToResponse(&order.Items[i])
+		}
+	}
+
+	// Timed-entry orders admit a number of people to a slot instead of holding seats
+	if len(order.SlotBookings) > 0 {
+		response.Slots = make([]*model.SlotBookingResponse, len(order.SlotBookings))
+		for i := range order.SlotBookings {
+			response.Slots[i] = SlotBookingEntityToResponse(&order.SlotBookings[i])
+			quantity += order.SlotBookings[i].Quantity
+			if eventID == 0 {
+				eventID = order.SlotBookings[i].Slot.EventID
+			}
+		}
+	}
+
 	return response
 }
 
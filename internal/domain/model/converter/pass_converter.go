@@ -0,0 +1,31 @@
+package converter
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+)
+
+func PassEntityToResponse(pass *entity.Pass) *model.PassResponse {
+	eventIDs := make([]uint, len(pass.Events))
+	for i := range pass.Events {
+		eventIDs[i] = pass.Events[i].ID
+	}
+
+	return &model.PassResponse{
+		ID:          pass.ID,
+		Name:        pass.Name,
+		Description: pass.Description,
+		Price:       pass.Price,
+		Type:        pass.Type,
+		SeriesID:    pass.SeriesID,
+		EventIDs:    eventIDs,
+	}
+}
+
+func PassesToResponses(passes []entity.Pass) []*model.PassResponse {
+	responses := make([]*model.PassResponse, len(passes))
+	for i := range passes {
+		responses[i] = PassEntityToResponse(&passes[i])
+	}
+	return responses
+}
@@ -13,6 +13,7 @@ func PaymentToEntityResponse(payment *entity.Payment) *model.PaymentResponse {
 		TransactionID: payment.TransactionID,
 		Amount:        payment.Amount,
 		Status:        string(payment.Status),
+		ExchangeID:    payment.TicketExchangeID,
 		Order:         OrderEntityToResponse(&payment.Order),
 	}
 }
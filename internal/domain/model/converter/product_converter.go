@@ -0,0 +1,72 @@
+package converter
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+)
+
+func ProductEntityToResponse(product *entity.Product) *model.ProductResponse {
+	return &model.ProductResponse{
+		ID:          product.ID,
+		EventID:     product.EventID,
+		SKU:         product.SKU,
+		Name:        product.Name,
+		Description: product.Description,
+		Price:       product.Price,
+		Stock:       product.Stock,
+		Redeemable:  product.Redeemable,
+	}
+}
+
+func ProductsToResponses(products []entity.Product) []*model.ProductResponse {
+	responses := make([]*model.ProductResponse, len(products))
+	for i := range products {
+		responses[i] = ProductEntityToResponse(&products[i])
+	}
+	return responses
+}
+
+func OrderItemEntityToResponse(item *entity.OrderItem) *model.OrderItemResponse {
+	response := &model.OrderItemResponse{
+		ID:         item.ID,
+		ProductID:  item.ProductID,
+		SKU:        item.SKU,
+		Name:       item.Name,
+		Quantity:   item.Quantity,
+		UnitPrice:  item.UnitPrice,
+		TotalPrice: item.TotalPrice,
+	}
+
+	if len(item.Vouchers) > 0 {
+		response.Vouchers = make([]*model.VoucherResponse, len(item.Vouchers))
+		for i := range item.Vouchers {
+			response.Vouchers[i] = &model.VoucherResponse{Code: item.Vouchers[i].Code}
+			if item.Vouchers[i].RedeemedAt != nil {
+				redeemedAt := helper.FormatDate(*item.Vouchers[i].RedeemedAt)
+				response.Vouchers[i].RedeemedAt = &redeemedAt
+			}
+		}
+	}
+
+	return response
+}
+
+// VoucherEntityToResponse describes a scanned voucher, the order item must be preloaded.
+func VoucherEntityToResponse(voucher *entity.Voucher) *model.VoucherResponse {
+	response := &model.VoucherResponse{
+		Code:       voucher.Code,
+		OrderID:    voucher.OrderItem.OrderID,
+		ProductID:  voucher.OrderItem.ProductID,
+		SKU:        voucher.OrderItem.SKU,
+		Name:       voucher.OrderItem.Name,
+		RedeemedBy: voucher.RedeemedBy,
+	}
+
+	if voucher.RedeemedAt != nil {
+		redeemedAt := helper.FormatDate(*voucher.RedeemedAt)
+		response.RedeemedAt = &redeemedAt
+	}
+
+	return response
+}
@@ -0,0 +1,60 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+)
+
+func SeriesEntityToResponse(series *entity.EventSeries) *model.SeriesResponse {
+	response := &model.SeriesResponse{
+		ID:          series.ID,
+		Name:        series.Name,
+		Description: series.Description,
+		VenueID:     series.VenueID,
+		Time:        series.Time.String(),
+		RRule:       series.RRule,
+		StartsOn:    series.StartsOn.Format("2006-01-02"),
+		Occurrences: EventsToResponses(series.Occurrences),
+	}
+
+	for i := range series.Exceptions {
+		response.Exceptions = append(response.Exceptions, SeriesExceptionEntityToResponse(&series.Exceptions[i]))
+	}
+
+	for i := range series.TicketTemplates {
+		response.TicketTemplates = append(response.TicketTemplates, SeriesTicketTemplateEntityToResponse(&series.TicketTemplates[i]))
+	}
+
+	return response
+}
+
+func SeriesExceptionEntityToResponse(exception *entity.SeriesException) *model.SeriesExceptionResponse {
+	response := &model.SeriesExceptionResponse{
+		ID:     exception.ID,
+		Date:   exception.OriginalDate.Format("2006-01-02"),
+		Action: string(exception.Action),
+	}
+
+	if exception.NewDate != nil {
+		newDate := exception.NewDate.Format("2006-01-02")
+		response.NewDate = &newDate
+	}
+
+	if exception.NewTime != nil {
+		newTime := time.Time(*exception.NewTime).Format("15:04:05")
+		response.NewTime = &newTime
+	}
+
+	return response
+}
+
+func SeriesTicketTemplateEntityToResponse(template *entity.SeriesTicketTemplate) *model.SeriesTicketTemplateResponse {
+	return &model.SeriesTicketTemplateResponse{
+		ID:    template.ID,
+		Type:  template.Type,
+		Price: template.Price,
+		Count: template.Count,
+	}
+}
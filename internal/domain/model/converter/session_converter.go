@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+)
+
+func SessionToResponse(session *entity.Session, currentID string) *model.SessionResponse {
+	return &model.SessionResponse{
+		ID:         session.ID,
+		UserAgent:  session.UserAgent,
+		IP:         session.IP,
+		Current:    session.ID == currentID,
+		CreatedAt:  helper.FormatDate(session.CreatedAt),
+		LastSeenAt: helper.FormatDate(session.LastSeenAt),
+		ExpiresAt:  helper.FormatDate(session.ExpiresAt),
+	}
+}
+
+func SessionsToResponses(sessions []entity.Session, currentID string) []*model.SessionResponse {
+	responses := make([]*model.SessionResponse, len(sessions))
+	for i := range sessions {
+		responses[i] = SessionToResponse(&sessions[i], currentID)
+	}
+	return responses
+}
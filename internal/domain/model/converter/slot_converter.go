@@ -0,0 +1,69 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+)
+
+func SlotTemplateEntityToResponse(template *entity.SlotTemplate) *model.SlotTemplateResponse {
+	return &model.SlotTemplateResponse{
+		ID:              template.ID,
+		EventID:         template.EventID,
+		Name:            template.Name,
+		StartTime:       time.Time(template.StartTime).Format("15:04"),
+		EndTime:         time.Time(template.EndTime).Format("15:04"),
+		IntervalMinutes: template.IntervalMinutes,
+		DurationMinutes: template.DurationMinutes,
+		Capacity:        template.Capacity,
+		Price:           template.Price,
+	}
+}
+
+func SlotTemplatesToResponses(templates []entity.SlotTemplate) []*model.SlotTemplateResponse {
+	responses := make([]*model.SlotTemplateResponse, len(templates))
+	for i := range templates {
+		responses[i] = SlotTemplateEntityToResponse(&templates[i])
+	}
+	return responses
+}
+
+func SlotEntityToResponse(slot *entity.TimeSlot) *model.SlotResponse {
+	return &model.SlotResponse{
+		ID:         slot.ID,
+		EventID:    slot.EventID,
+		TemplateID: slot.TemplateID,
+		StartsAt:   helper.FormatDate(slot.StartsAt),
+		EndsAt:     helper.FormatDate(slot.EndsAt),
+		Capacity:   slot.Capacity,
+		Available:  slot.Capacity - slot.Reserved,
+		Price:      slot.Price,
+	}
+}
+
+func SlotsToResponses(slots []entity.TimeSlot) []*model.SlotResponse {
+	responses := make([]*model.SlotResponse, len(slots))
+	for i := range slots {
+		responses[i] = SlotEntityToResponse(&slots[i])
+	}
+	return responses
+}
+
+func SlotBookingEntityToResponse(booking *entity.SlotBooking) *model.SlotBookingResponse {
+	response := &model.SlotBookingResponse{
+		ID:         booking.ID,
+		SlotID:     booking.SlotID,
+		Quantity:   booking.Quantity,
+		UnitPrice:  booking.UnitPrice,
+		TotalPrice: booking.TotalPrice,
+	}
+
+	if booking.Slot.ID != 0 {
+		response.StartsAt = helper.FormatDate(booking.Slot.StartsAt)
+		response.EndsAt = helper.FormatDate(booking.Slot.EndsAt)
+	}
+
+	return response
+}
@@ -15,7 +15,7 @@ func ticketOrderToResponse(order *entity.Order, eventID *uint) *model.OrderRespo
 	return &model.OrderResponse{
 		ID:         order.ID,
 		EventID:    eventID,
-		UserID:     order.UserID,
+		UserID:     helper.StringOrEmpty(order.UserID),
 		Quantity:   &quantity,
 		TotalPrice: &order.TotalPrice,
 	}
@@ -29,6 +29,7 @@ func TicketEntityToResponse(ticket *entity.Ticket) *model.TicketResponse {
 		Price:      ticket.Price,
 		Type:       ticket.Type,
 		SeatNumber: ticket.SeatNumber,
+		Attendee:   AttendeeEntityToResponse(ticket),
 		Event:      EventEntityToResponse(&ticket.Event),
 		Order:      ticketOrderToResponse(&ticket.Order, &ticket.EventID),
 	}
@@ -63,3 +64,60 @@ func TicketsToPaginatedResponse(tickets []*entity.Ticket, totalItems int64, page
 		TotalPages: totalPages,
 	})
 }
+
+func EventCapacityToResponse(capacity *model.EventCapacity, categories []*model.TicketCategoryCount) *model.EventCapacityResponse {
+	response := &model.EventCapacityResponse{
+		EventID:          capacity.EventID,
+		VenueID:          capacity.VenueID,
+		VenueCapacity:    capacity.VenueCapacity,
+		CapacityOverride: capacity.CapacityOverride,
+		Capacity:         capacity.Capacity,
+		Used:             capacity.Used,
+		Categories:       categories,
+	}
+
+	if capacity.Capacity != nil {
+		available := max(*capacity.Capacity-capacity.Used, 0)
+		response.Available = &available
+	}
+	if response.Categories == nil {
+		response.Categories = []*model.TicketCategoryCount{}
+	}
+
+	return response
+}
+
+func EventAvailabilityToResponse(eventID uint, counters []*entity.EventTicketCounter) *model.EventAvailabilityResponse {
+	response := &model.EventAvailabilityResponse{
+		EventID:    eventID,
+		Categories: make([]*model.CategoryAvailability, len(counters)),
+	}
+
+	for i, counter := range counters {
+		category := &model.CategoryAvailability{
+			Type:      counter.Type,
+			Total:     counter.Total,
+			Held:      counter.Held,
+			Sold:      counter.Sold,
+			Allocated: counter.Allocated,
+			Available: max(counter.Total-counter.Sold-counter.Held-counter.Allocated, 0),
+			MinPrice:  counter.MinPrice,
+			MaxPrice:  counter.MaxPrice,
+		}
+		response.Categories[i] = category
+
+		response.Total += category.Total
+		response.Held += category.Held
+		response.Sold += category.Sold
+		response.Allocated += category.Allocated
+		response.Available += category.Available
+		if category.MinPrice != nil && (response.MinPrice == nil || *category.MinPrice < *response.MinPrice) {
+			response.MinPrice = category.MinPrice
+		}
+		if category.MaxPrice != nil && (response.MaxPrice == nil || *category.MaxPrice > *response.MaxPrice) {
+			response.MaxPrice = category.MaxPrice
+		}
+	}
+
+	return response
+}
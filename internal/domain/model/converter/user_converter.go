@@ -1,28 +1,28 @@
 package converter
 
 import (
-	"time"
-
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
 	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/pkg/helper"
 )
 
 func UserToResponse(user *entity.User) *model.UserResponse {
 	var lastLogin *string
 	if user.LastLogin != nil {
-		formatted := user.LastLogin.UTC().Add(time.Hour * 7).Format(time.RFC3339)
+		formatted := helper.FormatDate(*user.LastLogin)
 		lastLogin = &formatted
 	}
 
 	return &model.UserResponse{
-		ID:        user.ID,
-		Email:     user.Email,
-		Name:      user.Name,
-		Role:      user.Role,
-		Status:    user.Status,
-		LastLogin: lastLogin,
-		CreatedAt: user.CreatedAt.UTC().Add(time.Hour * 7).Format(time.RFC3339),
-		UpdatedAt: user.UpdatedAt.UTC().Add(time.Hour * 7).Format(time.RFC3339),
+		ID:               user.ID,
+		Email:            user.Email,
+		Name:             user.Name,
+		Role:             user.Role,
+		Status:           user.Status,
+		TwoFactorEnabled: user.TwoFactorEnabled,
+		LastLogin:        lastLogin,
+		CreatedAt:        helper.FormatDate(user.CreatedAt),
+		UpdatedAt:        helper.FormatDate(user.UpdatedAt),
 	}
 }
 
@@ -32,3 +32,18 @@ func LoginToTokenResponse(accessToken, refreshToken string) *model.TokenResponse
 		RefreshToken: refreshToken,
 	}
 }
+
+func ChallengeToTokenResponse(twoFactor, challengeToken string) *model.TokenResponse {
+	return &model.TokenResponse{
+		TwoFactor:      twoFactor,
+		ChallengeToken: challengeToken,
+	}
+}
+
+func RolePolicyToResponse(policy *entity.RolePolicy) *model.RolePolicyResponse {
+	return &model.RolePolicyResponse{
+		Role:              policy.Role,
+		TwoFactorRequired: policy.TwoFactorRequired,
+		UpdatedAt:         helper.FormatDate(policy.UpdatedAt),
+	}
+}
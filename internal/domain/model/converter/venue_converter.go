@@ -1,6 +1,8 @@
 package converter
 
 import (
+	"time"
+
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
 	"github.com/TrinityKnights/Backend/internal/domain/model"
 )
@@ -14,6 +16,7 @@ func VenueEntityToResponse(venue *entity.Venue) *model.VenueResponse {
 		City:     venue.City,
 		State:    venue.State,
 		Zip:      venue.Zip,
+		Timezone: venue.Timezone,
 	}
 }
 
@@ -36,3 +39,26 @@ func VenuesToPaginatedResponse(venues []entity.Venue, totalItems int64, page, si
 		TotalPages: totalPages,
 	})
 }
+
+// VenueBookingsToResponses lists the ranges events occupy the venue for, shown in loc.
+func VenueBookingsToResponses(events []entity.Event, buffer time.Duration, loc *time.Location) []*model.VenueBookingResponse {
+	responses := make([]*model.VenueBookingResponse, len(events))
+	for i := range events {
+		event := &events[i]
+		response := &model.VenueBookingResponse{
+			EventID:               event.ID,
+			Name:                  event.Name,
+			Status:                string(event.Status),
+			StartsAt:              event.StartsAt.In(loc),
+			BusyUntil:             event.StartsAt.Add(buffer).In(loc),
+			OverlapOverrideReason: event.OverlapOverrideReason,
+		}
+		if event.EndsAt != nil {
+			endsAt := event.EndsAt.In(loc)
+			response.EndsAt = &endsAt
+			response.BusyUntil = endsAt.Add(buffer)
+		}
+		responses[i] = response
+	}
+	return responses
+}
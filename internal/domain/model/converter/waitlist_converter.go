@@ -0,0 +1,64 @@
+package converter
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+)
+
+func WaitlistEntityToResponse(entry *entity.WaitlistEntry) *model.WaitlistResponse {
+	response := &model.WaitlistResponse{
+		ID:        entry.ID,
+		EventID:   entry.EventID,
+		UserID:    entry.UserID,
+		Type:      entry.Type,
+		Quantity:  entry.Quantity,
+		Status:    string(entry.Status),
+		CreatedAt: helper.FormatDate(entry.CreatedAt),
+	}
+
+	if entry.OfferExpiresAt != nil {
+		expiresAt := helper.FormatDate(*entry.OfferExpiresAt)
+		response.OfferExpiresAt = &expiresAt
+	}
+
+	return response
+}
+
+func WaitlistOfferToResponse(entry *entity.WaitlistEntry, tickets []*entity.Ticket) *model.WaitlistOfferResponse {
+	response := &model.WaitlistOfferResponse{
+		WaitlistID:  entry.ID,
+		EventID:     entry.EventID,
+		Type:        entry.Type,
+		HoldToken:   helper.StringOrEmpty(entry.OfferToken),
+		TicketIDs:   make([]string, len(tickets)),
+		SeatNumbers: make([]string, len(tickets)),
+	}
+
+	if entry.OfferExpiresAt != nil {
+		response.ExpiresAt = helper.FormatDate(*entry.OfferExpiresAt)
+	}
+
+	for i, t := range tickets {
+		response.TicketIDs[i] = t.ID
+		response.SeatNumbers[i] = t.SeatNumber
+		response.TotalPrice += t.Price
+	}
+
+	return response
+}
+
+func WaitlistsToPaginatedResponse(entries []entity.WaitlistEntry, totalItems int64, page, size int) *model.Response[[]*model.WaitlistResponse] {
+	responses := make([]*model.WaitlistResponse, len(entries))
+	for i := range entries {
+		responses[i] = WaitlistEntityToResponse(&entries[i])
+	}
+	totalPages := (int(totalItems) + size - 1) / size
+
+	return model.NewResponse(responses, &model.PageMetadata{
+		Page:       page,
+		Size:       size,
+		TotalItems: int(totalItems),
+		TotalPages: totalPages,
+	})
+}
@@ -6,34 +6,87 @@ import (
 	"github.com/TrinityKnights/Backend/pkg/helper"
 )
 
+type EventStatus string
+
+const (
+	EventStatusDraft     EventStatus = "DRAFT"
+	EventStatusScheduled EventStatus = "SCHEDULED"
+	EventStatusPublished EventStatus = "PUBLISHED"
+	EventStatusCancelled EventStatus = "CANCELLED"
+	EventStatusPostponed EventStatus = "POSTPONED"
+)
+
 type EventResponse struct {
-	ID          uint           `json:"id"`
-	Name        string         `json:"name"`
-	Description string         `json:"description"`
-	Date        time.Time      `json:"date"`
-	Time        helper.SQLTime `json:"time"`
-	VenueID     uint           `json:"venue_id"`
+	ID                    uint           `json:"id"`
+	Name                  string         `json:"name"`
+	Description           string         `json:"description"`
+	Date                  time.Time      `json:"date"`
+	Time                  helper.SQLTime `json:"time"`
+	VenueID               uint           `json:"venue_id"`
+	AttendeeEditCutoff    *time.Time     `json:"attendee_edit_cutoff,omitempty"`
+	Series                *string        `json:"series,omitempty"`
+	TimedEntry            bool           `json:"timed_entry"`
+	SeriesID              *uint          `json:"series_id,omitempty"`
+	Status                string         `json:"status"`
+	PublishAt             *time.Time     `json:"publish_at,omitempty"`
+	StatusReason          *string        `json:"status_reason,omitempty"`
+	StartsAt              time.Time      `json:"starts_at"`
+	EndsAt                *time.Time     `json:"ends_at,omitempty"`
+	DurationMinutes       *int           `json:"duration_minutes,omitempty"`
+	Timezone              string         `json:"timezone"`
+	OverlapOverrideReason *string        `json:"overlap_override_reason,omitempty"`
+	CapacityOverride      *int           `json:"capacity_override,omitempty"`
 }
 
 type CreateEventRequest struct {
-	Name        string `json:"name" validate:"required,lte=100"`
-	Description string `json:"description" validate:"required,lte=255"`
-	Date        string `json:"date" validate:"required" example:"2024-03-20"`
-	Time        string `json:"time" validate:"required" example:"14:30:00"`
-	VenueID     uint   `json:"venue_id" validate:"required"`
+	Name               string `json:"name" validate:"required,lte=100"`
+	Description        string `json:"description" validate:"required,lte=255"`
+	Date               string `json:"date" validate:"required" example:"2024-03-20"`
+	Time               string `json:"time" validate:"required" example:"14:30:00"`
+	VenueID            uint   `json:"venue_id" validate:"required"`
+	AttendeeEditCutoff string `json:"attendee_edit_cutoff,omitempty" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" example:"2024-03-19T23:59:59+07:00"`
+	Series             string `json:"series,omitempty" validate:"omitempty,lte=100" example:"Jakarta Jazz Week 2024"`
+	TimedEntry         bool   `json:"timed_entry,omitempty"`
+	DurationMinutes    int    `json:"duration_minutes,omitempty" validate:"omitempty,min=1,max=10080" example:"180"`
+	Status             string `json:"status,omitempty" validate:"omitempty,oneof=draft scheduled published DRAFT SCHEDULED PUBLISHED"`
+	PublishAt          string `json:"publish_at,omitempty" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" example:"2024-03-01T09:00:00+07:00"`
+	OverrideConflict   bool   `json:"override_conflict,omitempty"`
+	OverrideReason     string `json:"override_reason,omitempty" validate:"required_with=OverrideConflict,max=500" example:"Soundcheck shares the hall with the afternoon matinee"`
+	CapacityOverride   int    `json:"capacity_override,omitempty" validate:"omitempty,min=1" example:"800"`
 }
 
 type UpdateEventRequest struct {
-	ID          uint   `param:"id" validate:"required"`
-	Name        string `json:"name" validate:"omitempty,lte=100"`
-	Description string `json:"description" validate:"omitempty,lte=255"`
-	Date        string `json:"date" validate:"omitempty" example:"2024-03-20"`
-	Time        string `json:"time" validate:"omitempty" example:"14:30:00"`
-	VenueID     uint   `json:"venue_id" validate:"omitempty"`
+	ID                 uint   `param:"id" validate:"required"`
+	Name               string `json:"name" validate:"omitempty,lte=100"`
+	Description        string `json:"description" validate:"omitempty,lte=255"`
+	Date               string `json:"date" validate:"omitempty" example:"2024-03-20"`
+	Time               string `json:"time" validate:"omitempty" example:"14:30:00"`
+	VenueID            uint   `json:"venue_id" validate:"omitempty"`
+	AttendeeEditCutoff string `json:"attendee_edit_cutoff,omitempty" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" example:"2024-03-19T23:59:59+07:00"`
+	Series             string `json:"series,omitempty" validate:"omitempty,lte=100" example:"Jakarta Jazz Week 2024"`
+	TimedEntry         *bool  `json:"timed_entry,omitempty"`
+	DurationMinutes    int    `json:"duration_minutes,omitempty" validate:"omitempty,min=1,max=10080" example:"180"`
+	OverrideConflict   bool   `json:"override_conflict,omitempty"`
+	OverrideReason     string `json:"override_reason,omitempty" validate:"required_with=OverrideConflict,max=500" example:"Soundcheck shares the hall with the afternoon matinee"`
+	CapacityOverride   int    `json:"capacity_override,omitempty" validate:"omitempty,min=1" example:"800"`
+}
+
+type UpdateEventStatusRequest struct {
+	ID        uint   `param:"id" validate:"required"`
+	Status    string `json:"status" validate:"required,oneof=draft scheduled published cancelled postponed DRAFT SCHEDULED PUBLISHED CANCELLED POSTPONED"`
+	PublishAt string `json:"publish_at,omitempty" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" example:"2024-03-01T09:00:00+07:00"`
+	Reason    string `json:"reason,omitempty" validate:"omitempty,lte=255" example:"The headliner is unwell"`
+}
+
+// EventHolder is someone holding a paid or complimentary order for an event.
+type EventHolder struct {
+	Email string
+	Name  string
 }
 
 type GetEventRequest struct {
-	ID uint `param:"id" validate:"required"`
+	ID uint   `param:"id" validate:"required"`
+	TZ string `query:"tz" validate:"omitempty,timezone" example:"Europe/Amsterdam"`
 }
 
 type EventsRequest struct {
@@ -41,6 +94,7 @@ type EventsRequest struct {
 	Size  int    `query:"size" validate:"numeric,omitempty,gte=1,lte=100"`
 	Sort  string `query:"sort" validate:"omitempty,oneof=id name description date time venue_id created_at updated_at"`
 	Order string `query:"order" validate:"omitempty"`
+	TZ    string `query:"tz" validate:"omitempty,timezone" example:"Europe/Amsterdam"`
 }
 
 type EventSearchRequest struct {
@@ -53,6 +107,7 @@ type EventSearchRequest struct {
 	Size        int    `query:"size" validate:"numeric,omitempty,gte=1,lte=100"`
 	Sort        string `query:"sort" validate:"omitempty,oneof=id name description date time venue_id created_at updated_at"`
 	Order       string `query:"order" validate:"omitempty"`
+	TZ          string `query:"tz" validate:"omitempty,timezone" example:"Europe/Amsterdam"`
 }
 
 type EventQueryOptions struct {
@@ -62,6 +117,7 @@ type EventQueryOptions struct {
 	Date        *string
 	Time        *string
 	VenueID     *uint
+	Statuses    []EventStatus
 	Page        int
 	Size        int
 	Sort        string
@@ -0,0 +1,44 @@
+package model
+
+type TicketExchangeStatus string
+
+const (
+	TicketExchangeStatusPending   TicketExchangeStatus = "PENDING"
+	TicketExchangeStatusCompleted TicketExchangeStatus = "COMPLETED"
+	TicketExchangeStatusExpired   TicketExchangeStatus = "EXPIRED"
+	// TicketExchangeStatusFailed marks a paid upgrade whose new ticket was gone by the time the
+	// payment arrived. The difference is credited to the buyer instead.
+	TicketExchangeStatusFailed TicketExchangeStatus = "FAILED"
+)
+
+type TicketExchangeResponse struct {
+	ID              uint                   `json:"id"`
+	OrderID         uint                   `json:"order_id"`
+	UserID          string                 `json:"user_id"`
+	OldTicketID     string                 `json:"old_ticket_id"`
+	NewTicketID     string                 `json:"new_ticket_id"`
+	OldSeatNumber   string                 `json:"old_seat_number,omitempty"`
+	NewSeatNumber   string                 `json:"new_seat_number,omitempty"`
+	OldPrice        float64                `json:"old_price"`
+	NewPrice        float64                `json:"new_price"`
+	PriceDifference float64                `json:"price_difference"`
+	CreditAmount    float64                `json:"credit_amount,omitempty"`
+	Status          string                 `json:"status"`
+	CompletedAt     *string                `json:"completed_at,omitempty"`
+	CreatedAt       string                 `json:"created_at"`
+	Payment         *CreatePaymentResponse `json:"payment,omitempty"`
+}
+
+type ExchangeTicketRequest struct {
+	TicketID    string `param:"id" validate:"required"`
+	NewTicketID string `json:"new_ticket_id" validate:"required"`
+}
+
+type GetTicketExchangeRequest struct {
+	ID uint `param:"id" validate:"required"`
+}
+
+type TicketExchangesRequest struct {
+	Page int `query:"page" validate:"numeric,omitempty,gte=1"`
+	Size int `query:"size" validate:"numeric,omitempty,gte=1,lte=100"`
+}
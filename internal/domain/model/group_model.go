@@ -0,0 +1,66 @@
+package model
+
+type GroupBookingStatus string
+
+const (
+	GroupBookingStatusPending   GroupBookingStatus = "PENDING"
+	GroupBookingStatusCompleted GroupBookingStatus = "COMPLETED"
+	GroupBookingStatusPartial   GroupBookingStatus = "PARTIAL"
+	GroupBookingStatusExpired   GroupBookingStatus = "EXPIRED"
+)
+
+type GroupShareStatus string
+
+const (
+	GroupShareStatusInvited  GroupShareStatus = "INVITED"
+	GroupShareStatusPaid     GroupShareStatus = "PAID"
+	GroupShareStatusReleased GroupShareStatus = "RELEASED"
+)
+
+type GroupShareResponse struct {
+	ID          uint                   `json:"id"`
+	OrderID     uint                   `json:"order_id"`
+	Name        string                 `json:"name"`
+	Email       string                 `json:"email"`
+	Quantity    int                    `json:"quantity"`
+	Amount      float64                `json:"amount"`
+	Status      string                 `json:"status"`
+	SeatNumbers []string               `json:"seat_numbers,omitempty"`
+	Payment     *CreatePaymentResponse `json:"payment,omitempty"`
+}
+
+type GroupBookingResponse struct {
+	ID          uint                  `json:"id"`
+	EventID     uint                  `json:"event_id"`
+	OrganiserID string                `json:"organiser_id"`
+	Type        string                `json:"type"`
+	Quantity    int                   `json:"quantity"`
+	Paid        int                   `json:"paid"`
+	Status      string                `json:"status"`
+	Deadline    string                `json:"deadline"`
+	CreatedAt   string                `json:"created_at"`
+	Shares      []*GroupShareResponse `json:"shares"`
+}
+
+type GroupParticipantRequest struct {
+	Name     string `json:"name" validate:"required,max=255"`
+	Email    string `json:"email" validate:"required,email,max=255"`
+	Quantity int    `json:"quantity" validate:"omitempty,min=1,max=10"`
+}
+
+type CreateGroupBookingRequest struct {
+	EventID        uint                      `param:"id" validate:"required"`
+	Type           string                    `json:"type" validate:"required,oneof=vip regular VIP REGULAR"`
+	Deadline       string                    `json:"deadline" validate:"required" example:"2024-03-20T18:00:00+07:00"`
+	Participants   []GroupParticipantRequest `json:"participants" validate:"required,min=2,max=20,dive"`
+	AdmissionToken string                    `json:"admission_token,omitempty" validate:"omitempty,max=1024"`
+}
+
+type GetGroupBookingRequest struct {
+	ID uint `param:"id" validate:"required"`
+}
+
+type GroupBookingsRequest struct {
+	Page int `query:"page" validate:"numeric,omitempty,gte=1"`
+	Size int `query:"size" validate:"numeric,omitempty,gte=1,lte=100"`
+}
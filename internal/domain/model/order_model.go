@@ -1,20 +1,57 @@
 package model
 
+type RefundStatus string
+
+// RefundStatusEligible marks a paid order whose event was cancelled, or one paid after its
+// invoice had expired, the refund itself is issued from the payment provider's dashboard.
+const RefundStatusEligible RefundStatus = "ELIGIBLE"
+
 type OrderTicketRequest struct {
-	EventID     uint     `json:"event_id" validate:"required,gt=0"`
-	TicketIDs   []string `json:"ticket_ids" validate:"required,min=1"`
-	SeatNumbers []string `json:"seat_numbers" validate:"required,min=1,eqfield=TicketIDs"`
+	EventID        uint                  `json:"event_id" validate:"required,gt=0"`
+	TicketIDs      []string              `json:"ticket_ids" validate:"required,min=1"`
+	SeatNumbers    []string              `json:"seat_numbers" validate:"required,min=1,eqfield=TicketIDs"`
+	HoldToken      string                `json:"hold_token,omitempty" validate:"omitempty,max=64"`
+	AdmissionToken string                `json:"admission_token,omitempty" validate:"omitempty,max=1024"`
+	Attendees      []AttendeeRequest     `json:"attendees,omitempty" validate:"omitempty,dive"`
+	Products       []OrderProductRequest `json:"products,omitempty" validate:"omitempty,dive"`
+}
+
+type GuestOrderRequest struct {
+	OrderTicketRequest
+	Email string `json:"email" validate:"required,email,max=255"`
+	Name  string `json:"name" validate:"required,max=255"`
+}
+
+type GuestOrderLookupRequest struct {
+	Code  string `json:"code" validate:"required,max=16"`
+	Email string `json:"email" validate:"required,email,max=255"`
+}
+
+type GetGuestOrderRequest struct {
+	Token string `param:"token" validate:"required,max=64"`
 }
 
 type OrderResponse struct {
-	ID         uint                   `json:"id"`
-	EventID    *uint                  `json:"event_id,omitempty"`
-	UserID     string                 `json:"user_id"`
-	Quantity   *int                   `json:"quantity,omitempty"`
-	TotalPrice *float64               `json:"total_price,omitempty"`
-	Date       string                 `json:"date"`
-	Tickets    *[]TicketResponse      `json:"tickets,omitempty"`
-	Payment    *CreatePaymentResponse `json:"payment,omitempty"`
+	ID             uint                   `json:"id"`
+	EventID        *uint                  `json:"event_id,omitempty"`
+	EventIDs       []uint                 `json:"event_ids,omitempty"`
+	UserID         string                 `json:"user_id,omitempty"`
+	Code           *string                `json:"code,omitempty"`
+	GuestEmail     *string                `json:"guest_email,omitempty"`
+	Quantity       *int                   `json:"quantity,omitempty"`
+	TotalPrice     *float64               `json:"total_price,omitempty"`
+	Date           string                 `json:"date"`
+	Complimentary  bool                   `json:"complimentary,omitempty"`
+	AllocationID   *uint                  `json:"allocation_id,omitempty"`
+	RecipientName  *string                `json:"recipient_name,omitempty"`
+	RecipientEmail *string                `json:"recipient_email,omitempty"`
+	PassID         *uint                  `json:"pass_id,omitempty"`
+	Tickets        *[]TicketResponse      `json:"tickets,omitempty"`
+	Items          []*OrderItemResponse   `json:"items,omitempty"`
+	Slots          []*SlotBookingResponse `json:"slots,omitempty"`
+	PaymentStatus  string                 `json:"payment_status,omitempty"`
+	RefundStatus   string                 `json:"refund_status,omitempty"`
+	Payment        *CreatePaymentResponse `json:"payment,omitempty"`
 }
 
 type UpdateOrderRequest struct {
@@ -30,4 +67,5 @@ type OrdersRequest struct {
 	Size  int    `query:"size" validate:"numeric,omitempty,gte=1,lte=100"`
 	Sort  string `query:"sort" validate:"omitempty,oneof=date total_price"`
 	Order string `query:"order" validate:"omitempty"`
+	Kind  string `query:"kind" validate:"omitempty,oneof=paid complimentary"`
 }
@@ -0,0 +1,50 @@
+package model
+
+type PassResponse struct {
+	ID          uint    `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Price       float64 `json:"price"`
+	Type        string  `json:"type"`
+	SeriesID    *uint   `json:"series_id,omitempty"`
+	EventIDs    []uint  `json:"event_ids"`
+}
+
+type CreatePassRequest struct {
+	Name        string  `json:"name" validate:"required,max=255"`
+	Description string  `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Price       float64 `json:"price" validate:"gt=0"`
+	Type        string  `json:"type" validate:"required,oneof=vip regular VIP REGULAR"`
+	SeriesID    uint    `json:"series_id,omitempty" validate:"omitempty" example:"3"`
+	EventIDs    []uint  `json:"event_ids,omitempty" validate:"omitempty,max=100,dive,required"`
+}
+
+type GetPassRequest struct {
+	ID uint `param:"id" validate:"required"`
+}
+
+type DeletePassRequest struct {
+	ID uint `param:"id" validate:"required"`
+}
+
+type OrderPassRequest struct {
+	ID              uint                    `param:"id" validate:"required"`
+	AdmissionTokens []EventAdmissionRequest `json:"admission_tokens,omitempty" validate:"omitempty,max=50,dive"`
+}
+
+type ScanPassRequest struct {
+	EventID uint   `param:"id" validate:"required"`
+	Code    string `json:"code" validate:"required,max=16"`
+}
+
+type PassScanResponse struct {
+	Code         string  `json:"code"`
+	PassID       uint    `json:"pass_id"`
+	OrderID      uint    `json:"order_id"`
+	EventID      uint    `json:"event_id"`
+	TicketID     string  `json:"ticket_id"`
+	Type         string  `json:"type"`
+	SeatNumber   string  `json:"seat_number"`
+	AttendeeName *string `json:"attendee_name,omitempty"`
+	CheckedInAt  string  `json:"checked_in_at"`
+}
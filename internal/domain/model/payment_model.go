@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type PaymentStatus string
 
 const (
@@ -15,12 +17,15 @@ type PaymentResponse struct {
 	Amount        float64        `json:"amount"`
 	Method        string         `json:"method"`
 	Status        string         `json:"status"`
+	ExchangeID    *uint          `json:"exchange_id,omitempty"`
 	Order         *OrderResponse `json:"order,omitempty"`
 }
 
 type CreatePaymentRequest struct {
-	OrderID uint    `json:"order_id" validate:"required"`
-	Amount  float64 `json:"amount" validate:"required"`
+	OrderID    uint       `json:"order_id" validate:"required"`
+	Amount     float64    `json:"amount" validate:"required"`
+	ExchangeID uint       `json:"exchange_id,omitempty" validate:"omitempty"`
+	ExpiresAt  *time.Time `json:"-"`
 }
 
 type CreatePaymentResponse struct {
@@ -0,0 +1,73 @@
+package model
+
+type ProductResponse struct {
+	ID          uint    `json:"id"`
+	EventID     uint    `json:"event_id"`
+	SKU         string  `json:"sku"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Price       float64 `json:"price"`
+	Stock       int     `json:"stock"`
+	Redeemable  bool    `json:"redeemable"`
+}
+
+type CreateProductRequest struct {
+	EventID     uint    `param:"id" validate:"required"`
+	SKU         string  `json:"sku" validate:"required,max=64"`
+	Name        string  `json:"name" validate:"required,max=255"`
+	Description string  `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Price       float64 `json:"price" validate:"gte=0"`
+	Stock       int     `json:"stock" validate:"gte=0"`
+	Redeemable  bool    `json:"redeemable"`
+}
+
+type UpdateProductRequest struct {
+	ID          uint     `param:"id" validate:"required"`
+	Name        string   `json:"name,omitempty" validate:"omitempty,max=255"`
+	Description string   `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Price       *float64 `json:"price,omitempty" validate:"omitempty,gte=0"`
+	Stock       *int     `json:"stock,omitempty" validate:"omitempty,gte=0"`
+	Redeemable  *bool    `json:"redeemable,omitempty"`
+}
+
+type GetProductRequest struct {
+	ID uint `param:"id" validate:"required"`
+}
+
+type DeleteProductRequest struct {
+	ID uint `param:"id" validate:"required"`
+}
+
+type ProductsRequest struct {
+	EventID uint `param:"id" validate:"required"`
+}
+
+type OrderProductRequest struct {
+	ProductID uint `json:"product_id" validate:"required"`
+	Quantity  int  `json:"quantity" validate:"required,min=1,max=20"`
+}
+
+type OrderItemResponse struct {
+	ID         uint               `json:"id"`
+	ProductID  uint               `json:"product_id"`
+	SKU        string             `json:"sku"`
+	Name       string             `json:"name"`
+	Quantity   int                `json:"quantity"`
+	UnitPrice  float64            `json:"unit_price"`
+	TotalPrice float64            `json:"total_price"`
+	Vouchers   []*VoucherResponse `json:"vouchers,omitempty"`
+}
+
+type VoucherResponse struct {
+	Code       string  `json:"code"`
+	OrderID    uint    `json:"order_id,omitempty"`
+	ProductID  uint    `json:"product_id,omitempty"`
+	SKU        string  `json:"sku,omitempty"`
+	Name       string  `json:"name,omitempty"`
+	RedeemedAt *string `json:"redeemed_at,omitempty"`
+	RedeemedBy *string `json:"redeemed_by,omitempty"`
+}
+
+type GetVoucherRequest struct {
+	Code string `param:"code" validate:"required"`
+}
@@ -0,0 +1,110 @@
+package model
+
+type SeriesExceptionType string
+
+const (
+	SeriesExceptionSkip SeriesExceptionType = "SKIP"
+	SeriesExceptionMove SeriesExceptionType = "MOVE"
+)
+
+type SeriesEditScope string
+
+const (
+	SeriesEditSingle    SeriesEditScope = "single"
+	SeriesEditFollowing SeriesEditScope = "following"
+	SeriesEditAll       SeriesEditScope = "all"
+)
+
+type SeriesResponse struct {
+	ID              uint                            `json:"id"`
+	Name            string                          `json:"name"`
+	Description     string                          `json:"description"`
+	VenueID         uint                            `json:"venue_id"`
+	Time            string                          `json:"time"`
+	RRule           string                          `json:"rrule"`
+	StartsOn        string                          `json:"starts_on"`
+	Exceptions      []*SeriesExceptionResponse      `json:"exceptions,omitempty"`
+	TicketTemplates []*SeriesTicketTemplateResponse `json:"ticket_templates,omitempty"`
+	Occurrences     []*EventResponse                `json:"occurrences"`
+}
+
+type SeriesExceptionResponse struct {
+	ID      uint    `json:"id"`
+	Date    string  `json:"date"`
+	Action  string  `json:"action"`
+	NewDate *string `json:"new_date,omitempty"`
+	NewTime *string `json:"new_time,omitempty"`
+}
+
+type SeriesTicketTemplateResponse struct {
+	ID    uint    `json:"id"`
+	Type  string  `json:"type"`
+	Price float64 `json:"price"`
+	Count int     `json:"count"`
+}
+
+type SeriesExceptionRequest struct {
+	Date    string `json:"date" validate:"required,datetime=2006-01-02" example:"2024-03-22"`
+	Action  string `json:"action" validate:"required,oneof=skip move SKIP MOVE"`
+	NewDate string `json:"new_date,omitempty" validate:"required_if=Action move,required_if=Action MOVE,omitempty,datetime=2006-01-02" example:"2024-03-23"`
+	NewTime string `json:"new_time,omitempty" validate:"omitempty,datetime=15:04:05" example:"20:00:00"`
+}
+
+type SeriesTicketTemplateRequest struct {
+	Type  string  `json:"type" validate:"required,oneof=vip regular VIP REGULAR"`
+	Price float64 `json:"price" validate:"gte=0"`
+	Count int     `json:"count" validate:"required,min=1,max=1000"`
+}
+
+type CreateSeriesRequest struct {
+	Name             string                        `json:"name" validate:"required,lte=100"`
+	Description      string                        `json:"description" validate:"required,lte=255"`
+	VenueID          uint                          `json:"venue_id" validate:"required"`
+	StartDate        string                        `json:"start_date" validate:"required,datetime=2006-01-02" example:"2024-03-01"`
+	Time             string                        `json:"time" validate:"required,datetime=15:04:05" example:"19:30:00"`
+	RRule            string                        `json:"rrule" validate:"required,max=255" example:"FREQ=WEEKLY;BYDAY=FR,SA;UNTIL=20240630"`
+	Exceptions       []SeriesExceptionRequest      `json:"exceptions,omitempty" validate:"omitempty,max=100,dive"`
+	TicketTemplates  []SeriesTicketTemplateRequest `json:"ticket_templates,omitempty" validate:"omitempty,max=10,dive"`
+	OverrideConflict bool                          `json:"override_conflict,omitempty"`
+	OverrideReason   string                        `json:"override_reason,omitempty" validate:"required_with=OverrideConflict,max=500" example:"Soundcheck shares the hall with the afternoon matinee"`
+}
+
+type GetSeriesRequest struct {
+	ID uint `param:"id" validate:"required"`
+}
+
+type AddSeriesExceptionRequest struct {
+	ID               uint   `param:"id" validate:"required"`
+	Date             string `json:"date" validate:"required,datetime=2006-01-02" example:"2024-03-22"`
+	Action           string `json:"action" validate:"required,oneof=skip move SKIP MOVE"`
+	NewDate          string `json:"new_date,omitempty" validate:"required_if=Action move,required_if=Action MOVE,omitempty,datetime=2006-01-02" example:"2024-03-23"`
+	NewTime          string `json:"new_time,omitempty" validate:"omitempty,datetime=15:04:05" example:"20:00:00"`
+	OverrideConflict bool   `json:"override_conflict,omitempty"`
+	OverrideReason   string `json:"override_reason,omitempty" validate:"required_with=OverrideConflict,max=500" example:"Soundcheck shares the hall with the afternoon matinee"`
+}
+
+type AddSeriesTicketTemplateRequest struct {
+	ID    uint    `param:"id" validate:"required"`
+	Type  string  `json:"type" validate:"required,oneof=vip regular VIP REGULAR"`
+	Price float64 `json:"price" validate:"gte=0"`
+	Count int     `json:"count" validate:"required,min=1,max=1000"`
+}
+
+type SeriesTicketTemplateCopyResponse struct {
+	Template    *SeriesTicketTemplateResponse `json:"template"`
+	Occurrences int                           `json:"occurrences"`
+	Tickets     int                           `json:"tickets"`
+}
+
+type UpdateOccurrenceRequest struct {
+	ID               uint   `param:"id" validate:"required"`
+	EventID          uint   `param:"event_id" validate:"required"`
+	Scope            string `json:"scope" validate:"required,oneof=single following all"`
+	Name             string `json:"name,omitempty" validate:"omitempty,lte=100"`
+	Description      string `json:"description,omitempty" validate:"omitempty,lte=255"`
+	VenueID          uint   `json:"venue_id,omitempty" validate:"omitempty"`
+	Time             string `json:"time,omitempty" validate:"omitempty,datetime=15:04:05" example:"20:00:00"`
+	Date             string `json:"date,omitempty" validate:"omitempty,datetime=2006-01-02" example:"2024-03-23"`
+	OverrideConflict bool   `json:"override_conflict,omitempty"`
+	OverrideReason   string `json:"override_reason,omitempty" validate:"required_with=OverrideConflict,max=500" example:"Soundcheck shares the hall with the afternoon matinee"`
+}
@@ -0,0 +1,73 @@
+package model
+
+type SlotTemplateResponse struct {
+	ID              uint    `json:"id"`
+	EventID         uint    `json:"event_id"`
+	Name            string  `json:"name"`
+	StartTime       string  `json:"start_time"`
+	EndTime         string  `json:"end_time"`
+	IntervalMinutes int     `json:"interval_minutes"`
+	DurationMinutes int     `json:"duration_minutes"`
+	Capacity        int     `json:"capacity"`
+	Price           float64 `json:"price"`
+}
+
+type CreateSlotTemplateRequest struct {
+	EventID         uint    `param:"id" validate:"required"`
+	Name            string  `json:"name" validate:"required,max=100"`
+	StartTime       string  `json:"start_time" validate:"required,datetime=15:04" example:"10:00"`
+	EndTime         string  `json:"end_time" validate:"required,datetime=15:04" example:"17:00"`
+	IntervalMinutes int     `json:"interval_minutes" validate:"required,min=5,max=720" example:"30"`
+	DurationMinutes int     `json:"duration_minutes,omitempty" validate:"omitempty,min=5,max=720" example:"30"`
+	Capacity        int     `json:"capacity" validate:"required,min=1,max=10000" example:"50"`
+	Price           float64 `json:"price" validate:"gt=0"`
+}
+
+type SlotTemplatesRequest struct {
+	EventID uint `param:"id" validate:"required"`
+}
+
+type GenerateSlotsRequest struct {
+	TemplateID uint   `param:"id" validate:"required"`
+	From       string `json:"from" validate:"required,datetime=2006-01-02" example:"2024-03-01"`
+	To         string `json:"to" validate:"required,datetime=2006-01-02" example:"2024-03-31"`
+}
+
+type GenerateSlotsResponse struct {
+	TemplateID uint `json:"template_id"`
+	Created    int  `json:"created"`
+	Skipped    int  `json:"skipped"`
+}
+
+type SlotResponse struct {
+	ID         uint    `json:"id"`
+	EventID    uint    `json:"event_id"`
+	TemplateID uint    `json:"template_id"`
+	StartsAt   string  `json:"starts_at"`
+	EndsAt     string  `json:"ends_at"`
+	Capacity   int     `json:"capacity"`
+	Available  int     `json:"available"`
+	Price      float64 `json:"price"`
+}
+
+type SlotsRequest struct {
+	EventID uint   `param:"id" validate:"required"`
+	From    string `query:"from" validate:"omitempty,datetime=2006-01-02" example:"2024-03-01"`
+	To      string `query:"to" validate:"omitempty,datetime=2006-01-02" example:"2024-03-07"`
+}
+
+type OrderSlotRequest struct {
+	SlotID         uint   `param:"id" validate:"required"`
+	Quantity       int    `json:"quantity" validate:"required,min=1,max=20"`
+	AdmissionToken string `json:"admission_token,omitempty" validate:"omitempty,max=1024"`
+}
+
+type SlotBookingResponse struct {
+	ID         uint    `json:"id"`
+	SlotID     uint    `json:"slot_id"`
+	StartsAt   string  `json:"starts_at,omitempty"`
+	EndsAt     string  `json:"ends_at,omitempty"`
+	Quantity   int     `json:"quantity"`
+	UnitPrice  float64 `json:"unit_price"`
+	TotalPrice float64 `json:"total_price"`
+}
@@ -1,14 +1,15 @@
 package model
 
 type TicketResponse struct {
-	ID         string         `json:"id"`
-	EventID    uint           `json:"event_id"`
-	OrderID    uint           `json:"order_id"`
-	Price      float64        `json:"price"`
-	Type       string         `json:"type"`
-	SeatNumber string         `json:"seat_number"`
-	Event      *EventResponse `json:"event,omitempty"`
-	Order      *OrderResponse `json:"order,omitempty"`
+	ID         string            `json:"id"`
+	EventID    uint              `json:"event_id"`
+	OrderID    uint              `json:"order_id"`
+	Price      float64           `json:"price"`
+	Type       string            `json:"type"`
+	SeatNumber string            `json:"seat_number"`
+	Attendee   *AttendeeResponse `json:"attendee,omitempty"`
+	Event      *EventResponse    `json:"event,omitempty"`
+	Order      *OrderResponse    `json:"order,omitempty"`
 }
 
 type CreateTicketRequest struct {
@@ -63,3 +64,63 @@ type TicketQueryOptions struct {
 	Sort        string    `query:"sort,omitempty" validate:"omitempty,oneof=id event_id eventId order_id orderID price type seat_number seatNumber"`
 	Order       string    `query:"order,omitempty" validate:"omitempty"`
 }
+
+// EventCapacity is how many tickets an event may have, its venue's capacity unless the event
+// overrides it. A nil Capacity means the event is not limited.
+type EventCapacity struct {
+	EventID          uint
+	VenueID          uint
+	VenueCapacity    int
+	CapacityOverride *int
+	Capacity         *int
+	Used             int
+}
+
+type TicketCategoryCount struct {
+	Type  string `json:"type"`
+	Count int    `json:"count"`
+}
+
+type GetEventCapacityRequest struct {
+	EventID uint `param:"id" validate:"required"`
+}
+
+type EventCapacityResponse struct {
+	EventID          uint                   `json:"event_id"`
+	VenueID          uint                   `json:"venue_id"`
+	VenueCapacity    int                    `json:"venue_capacity"`
+	CapacityOverride *int                   `json:"capacity_override,omitempty"`
+	Capacity         *int                   `json:"capacity"`
+	Used             int                    `json:"used"`
+	Available        *int                   `json:"available"`
+	Categories       []*TicketCategoryCount `json:"categories"`
+}
+
+type GetEventAvailabilityRequest struct {
+	EventID uint `param:"id" validate:"required"`
+}
+
+// CategoryAvailability counts a ticket category's seats by state. Sold seats belong to an order,
+// allocated ones are set aside for a partner and held ones are reserved for a pending checkout.
+type CategoryAvailability struct {
+	Type      string   `json:"type"`
+	Total     int      `json:"total"`
+	Held      int      `json:"held"`
+	Sold      int      `json:"sold"`
+	Allocated int      `json:"allocated"`
+	Available int      `json:"available"`
+	MinPrice  *float64 `json:"min_price"`
+	MaxPrice  *float64 `json:"max_price"`
+}
+
+type EventAvailabilityResponse struct {
+	EventID    uint                    `json:"event_id"`
+	Total      int                     `json:"total"`
+	Held       int                     `json:"held"`
+	Sold       int                     `json:"sold"`
+	Allocated  int                     `json:"allocated"`
+	Available  int                     `json:"available"`
+	MinPrice   *float64                `json:"min_price"`
+	MaxPrice   *float64                `json:"max_price"`
+	Categories []*CategoryAvailability `json:"categories"`
+}
@@ -1,14 +1,15 @@
 package model
 
 type UserResponse struct {
-	ID        string  `json:"id"`
-	Name      string  `json:"name"`
-	Email     string  `json:"email"`
-	Role      string  `json:"role"`
-	Status    bool    `json:"status"`
-	LastLogin *string `json:"last_login,omitempty"`
-	CreatedAt string  `json:"created_at"`
-	UpdatedAt string  `json:"updated_at"`
+	ID               string  `json:"id"`
+	Name             string  `json:"name"`
+	Email            string  `json:"email"`
+	Role             string  `json:"role"`
+	Status           bool    `json:"status"`
+	TwoFactorEnabled bool    `json:"two_factor_enabled"`
+	LastLogin        *string `json:"last_login,omitempty"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
 }
 
 type RegisterRequest struct {
@@ -18,8 +19,10 @@ type RegisterRequest struct {
 }
 
 type LoginRequest struct {
-	Email    string `json:"email" validate:"required,email,lte=100"`
-	Password string `json:"password" validate:"required,min=8,lte=255"`
+	Email     string `json:"email" validate:"required,email,lte=100"`
+	Password  string `json:"password" validate:"required,min=8,lte=255"`
+	IP        string `json:"-"`
+	UserAgent string `json:"-"`
 }
 
 type UpdateUserRequest struct {
@@ -28,13 +31,23 @@ type UpdateUserRequest struct {
 	Name     string `json:"name" validate:"omitempty,lte=100"`
 }
 
+// Values of TokenResponse.TwoFactor when a login needs a second step before tokens are issued
+const (
+	TwoFactorRequired          = "required"
+	TwoFactorEnrolmentRequired = "enrolment_required"
+)
+
 type TokenResponse struct {
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
+	AccessToken    string   `json:"access_token,omitempty"`
+	RefreshToken   string   `json:"refresh_token,omitempty"`
+	TwoFactor      string   `json:"two_factor,omitempty"`
+	ChallengeToken string   `json:"challenge_token,omitempty"`
+	RecoveryCodes  []string `json:"recovery_codes,omitempty"`
 }
 
 type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token" validate:"required"`
+	IP           string `json:"-"`
 }
 
 type ReqResetPasswordRequest struct {
@@ -54,3 +67,74 @@ type ResetPasswordRequest struct {
 type VerifyRequest struct {
 	Token string `param:"token" validate:"required"`
 }
+
+type UnlockUserRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+type SessionResponse struct {
+	ID         string `json:"id"`
+	UserAgent  string `json:"user_agent"`
+	IP         string `json:"ip"`
+	Current    bool   `json:"current"`
+	CreatedAt  string `json:"created_at"`
+	LastSeenAt string `json:"last_seen_at"`
+	ExpiresAt  string `json:"expires_at"`
+}
+
+type RevokeSessionRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+type GetUserSessionsRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+type LoginTwoFactorRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	// Code is a TOTP code from the authenticator app or one of the recovery codes
+	Code      string `json:"code" validate:"required,lte=32"`
+	IP        string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+type LoginEnrolTwoFactorRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+}
+
+type LoginConfirmTwoFactorRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	Code           string `json:"code" validate:"required,lte=32"`
+	IP             string `json:"-"`
+	UserAgent      string `json:"-"`
+}
+
+type EnrolTwoFactorResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+	// QRCode is the otpauth URI as a PNG data URI, ready for an img tag
+	QRCode string `json:"qr_code"`
+}
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" validate:"required,lte=32"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type RolePolicyRequest struct {
+	Role string `param:"role" validate:"required,oneof=admin buyer"`
+}
+
+type UpdateRolePolicyRequest struct {
+	Role              string `param:"role" validate:"required,oneof=admin buyer"`
+	TwoFactorRequired *bool  `json:"two_factor_required" validate:"required"`
+}
+
+type RolePolicyResponse struct {
+	Role              string `json:"role"`
+	TwoFactorRequired bool   `json:"two_factor_required"`
+	UpdatedAt         string `json:"updated_at"`
+}
@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type VenueResponse struct {
 	ID       uint   `json:"id"`
 	Name     string `json:"name"`
@@ -8,6 +10,7 @@ type VenueResponse struct {
 	City     string `json:"city"`
 	State    string `json:"state"`
 	Zip      string `json:"zip"`
+	Timezone string `json:"timezone"`
 }
 
 type CreateVenueRequest struct {
@@ -17,6 +20,7 @@ type CreateVenueRequest struct {
 	City     string `json:"city" validate:"required,lte=100"`
 	State    string `json:"state" validate:"required,lte=100"`
 	Zip      string `json:"zip" validate:"required,lte=10"`
+	Timezone string `json:"timezone,omitempty" validate:"omitempty,timezone" example:"Asia/Jakarta"`
 }
 
 type UpdateVenueRequest struct {
@@ -27,6 +31,7 @@ type UpdateVenueRequest struct {
 	City     string `json:"city" validate:"omitempty,lte=100"`
 	State    string `json:"state" validate:"omitempty,lte=100"`
 	Zip      string `json:"zip" validate:"omitempty,lte=10"`
+	Timezone string `json:"timezone,omitempty" validate:"omitempty,timezone" example:"Asia/Makassar"`
 }
 
 type GetVenueRequest struct {
@@ -53,6 +58,32 @@ type VenueSearchRequest struct {
 	Order    string `query:"order" validate:"omitempty"`
 }
 
+type VenueCalendarRequest struct {
+	ID   uint   `param:"id" validate:"required"`
+	From string `query:"from" validate:"omitempty,datetime=2006-01-02" example:"2024-03-01"`
+	To   string `query:"to" validate:"omitempty,datetime=2006-01-02" example:"2024-03-31"`
+}
+
+type VenueCalendarResponse struct {
+	VenueID               uint                    `json:"venue_id"`
+	Timezone              string                  `json:"timezone"`
+	TurnoverBufferMinutes int                     `json:"turnover_buffer_minutes"`
+	From                  time.Time               `json:"from"`
+	To                    time.Time               `json:"to"`
+	Bookings              []*VenueBookingResponse `json:"bookings"`
+}
+
+// VenueBookingResponse is a range the venue is occupied for, BusyUntil includes the turnover buffer.
+type VenueBookingResponse struct {
+	EventID               uint       `json:"event_id"`
+	Name                  string     `json:"name"`
+	Status                string     `json:"status"`
+	StartsAt              time.Time  `json:"starts_at"`
+	EndsAt                *time.Time `json:"ends_at,omitempty"`
+	BusyUntil             time.Time  `json:"busy_until"`
+	OverlapOverrideReason *string    `json:"overlap_override_reason,omitempty"`
+}
+
 type VenueQueryOptions struct {
 	ID       *uint
 	Name     *string
@@ -0,0 +1,47 @@
+package model
+
+import "time"
+
+type OpenWaitingRoomRequest struct {
+	EventID       uint `param:"id" validate:"required"`
+	AdmissionRate int  `json:"admission_rate" validate:"required,min=1,max=100000"`
+}
+
+type GetWaitingRoomRequest struct {
+	EventID uint `param:"id" validate:"required"`
+}
+
+// EventAdmissionRequest is the admission token of one event, for purchases that span several
+// events such as a cart or a pass.
+type EventAdmissionRequest struct {
+	EventID uint   `json:"event_id" validate:"required,gt=0"`
+	Token   string `json:"token" validate:"required,max=1024"`
+}
+
+type GetQueuePositionRequest struct {
+	EventID uint   `param:"id" validate:"required"`
+	Token   string `param:"token" validate:"required,uuid"`
+}
+
+// WaitingRoomResponse shows an event's queue as it stands, AdmissionRate is in buyers per minute.
+type WaitingRoomResponse struct {
+	EventID         uint  `json:"event_id"`
+	AdmissionRate   int   `json:"admission_rate"`
+	Joined          int64 `json:"joined"`
+	Admitted        int64 `json:"admitted"`
+	Waiting         int64 `json:"waiting"`
+	ClearsInSeconds int64 `json:"clears_in_seconds"`
+}
+
+// QueuePositionResponse is a visitor's place in line. Once admitted it carries the admission
+// token to send with the order.
+type QueuePositionResponse struct {
+	EventID              uint       `json:"event_id"`
+	QueueToken           string     `json:"queue_token"`
+	Position             int64      `json:"position"`
+	Ahead                int64      `json:"ahead"`
+	EstimatedWaitSeconds int64      `json:"estimated_wait_seconds"`
+	Admitted             bool       `json:"admitted"`
+	AdmissionToken       string     `json:"admission_token,omitempty"`
+	AdmissionExpiresAt   *time.Time `json:"admission_expires_at,omitempty"`
+}
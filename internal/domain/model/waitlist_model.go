@@ -0,0 +1,54 @@
+package model
+
+type WaitlistStatus string
+
+const (
+	WaitlistStatusWaiting   WaitlistStatus = "WAITING"
+	WaitlistStatusOffered   WaitlistStatus = "OFFERED"
+	WaitlistStatusPurchased WaitlistStatus = "PURCHASED"
+	WaitlistStatusExpired   WaitlistStatus = "EXPIRED"
+	WaitlistStatusCancelled WaitlistStatus = "CANCELLED"
+)
+
+type WaitlistResponse struct {
+	ID             uint                   `json:"id"`
+	EventID        uint                   `json:"event_id"`
+	UserID         string                 `json:"user_id"`
+	Type           string                 `json:"type"`
+	Quantity       int                    `json:"quantity"`
+	Status         string                 `json:"status"`
+	Position       *int                   `json:"position,omitempty"`
+	OfferExpiresAt *string                `json:"offer_expires_at,omitempty"`
+	Offer          *WaitlistOfferResponse `json:"offer,omitempty"`
+	CreatedAt      string                 `json:"created_at"`
+}
+
+type WaitlistOfferResponse struct {
+	WaitlistID  uint     `json:"waitlist_id"`
+	EventID     uint     `json:"event_id"`
+	Type        string   `json:"type"`
+	HoldToken   string   `json:"hold_token"`
+	TicketIDs   []string `json:"ticket_ids"`
+	SeatNumbers []string `json:"seat_numbers"`
+	TotalPrice  float64  `json:"total_price"`
+	ExpiresAt   string   `json:"expires_at"`
+}
+
+type JoinWaitlistRequest struct {
+	EventID  uint   `json:"event_id" validate:"required,gt=0"`
+	Type     string `json:"type" validate:"required,oneof=vip regular VIP REGULAR"`
+	Quantity int    `json:"quantity" validate:"omitempty,min=1,max=10"`
+}
+
+type GetWaitlistRequest struct {
+	ID uint `param:"id" validate:"required"`
+}
+
+type GetWaitlistOfferRequest struct {
+	Token string `param:"token" validate:"required"`
+}
+
+type WaitlistsRequest struct {
+	Page int `query:"page" validate:"numeric,omitempty,gte=1"`
+	Size int `query:"size" validate:"numeric,omitempty,gte=1,lte=100"`
+}
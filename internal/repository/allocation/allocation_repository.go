@@ -0,0 +1,14 @@
+package allocation
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+type AllocationRepository interface {
+	repository.Repository[entity.Allocation]
+	GetByID(db *gorm.DB, allocation *entity.Allocation, id uint) error
+	GetByEventID(db *gorm.DB, allocations *[]entity.Allocation, eventID uint) error
+	CountIssued(db *gorm.DB, allocationIDs []uint) (map[uint]int64, error)
+}
@@ -0,0 +1,51 @@
+package allocation
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type AllocationRepositoryImpl struct {
+	repository.RepositoryImpl[entity.Allocation]
+	Log *logrus.Logger
+}
+
+func NewAllocationRepository(db *gorm.DB, log *logrus.Logger) *AllocationRepositoryImpl {
+	return &AllocationRepositoryImpl{
+		RepositoryImpl: repository.RepositoryImpl[entity.Allocation]{DB: db},
+		Log:            log,
+	}
+}
+
+func (r *AllocationRepositoryImpl) GetByID(db *gorm.DB, allocation *entity.Allocation, id uint) error {
+	return db.Where("id = ?", id).Take(allocation).Error
+}
+
+func (r *AllocationRepositoryImpl) GetByEventID(db *gorm.DB, allocations *[]entity.Allocation, eventID uint) error {
+	return db.Where("event_id = ?", eventID).
+		Order("created_at ASC").
+		Find(allocations).Error
+}
+
+// CountIssued returns how many tickets of each allocation have already been given out.
+func (r *AllocationRepositoryImpl) CountIssued(db *gorm.DB, allocationIDs []uint) (map[uint]int64, error) {
+	var rows []struct {
+		AllocationID uint
+		Count        int64
+	}
+	if err := db.Model(&entity.Ticket{}).
+		Select("allocation_id, COUNT(*) AS count").
+		Where("allocation_id IN ? AND order_id IS NOT NULL", allocationIDs).
+		Group("allocation_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uint]int64, len(rows))
+	for _, row := range rows {
+		counts[row.AllocationID] = row.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,17 @@
+package attendee
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+type AttendeeRepository interface {
+	repository.Repository[entity.AttendeeQuestion]
+	GetQuestionByID(db *gorm.DB, question *entity.AttendeeQuestion, eventID, id uint) error
+	GetQuestionsByEventID(db *gorm.DB, questions *[]entity.AttendeeQuestion, eventID uint) error
+	UpdateTicketAttendee(db *gorm.DB, ticketID, name string, email *string) error
+	ReplaceAnswers(db *gorm.DB, ticketID string, answers []entity.AttendeeAnswer) error
+	GetPaginatedByEvent(db *gorm.DB, tickets *[]*entity.Ticket, eventID uint, page, size int) (int64, error)
+	GetAllByEvent(db *gorm.DB, tickets *[]*entity.Ticket, eventID uint) error
+}
@@ -0,0 +1,80 @@
+package attendee
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type AttendeeRepositoryImpl struct {
+	repository.RepositoryImpl[entity.AttendeeQuestion]
+	Log *logrus.Logger
+}
+
+func NewAttendeeRepository(db *gorm.DB, log *logrus.Logger) *AttendeeRepositoryImpl {
+	return &AttendeeRepositoryImpl{
+		RepositoryImpl: repository.RepositoryImpl[entity.AttendeeQuestion]{DB: db},
+		Log:            log,
+	}
+}
+
+func (r *AttendeeRepositoryImpl) GetQuestionByID(db *gorm.DB, question *entity.AttendeeQuestion, eventID, id uint) error {
+	return db.Where("id = ? AND event_id = ?", id, eventID).Take(question).Error
+}
+
+func (r *AttendeeRepositoryImpl) GetQuestionsByEventID(db *gorm.DB, questions *[]entity.AttendeeQuestion, eventID uint) error {
+	return db.Where("event_id = ?", eventID).
+		Order("position ASC, id ASC").
+		Find(questions).Error
+}
+
+func (r *AttendeeRepositoryImpl) UpdateTicketAttendee(db *gorm.DB, ticketID, name string, email *string) error {
+	return db.Model(&entity.Ticket{}).
+		Where("id = ?", ticketID).
+		Updates(map[string]interface{}{
+			"attendee_name":  name,
+			"attendee_email": email,
+		}).Error
+}
+
+// ReplaceAnswers swaps the whole answer set of a ticket, so callers always submit every answer.
+func (r *AttendeeRepositoryImpl) ReplaceAnswers(db *gorm.DB, ticketID string, answers []entity.AttendeeAnswer) error {
+	if err := db.Unscoped().Where("ticket_id = ?", ticketID).Delete(&entity.AttendeeAnswer{}).Error; err != nil {
+		return err
+	}
+
+	if len(answers) == 0 {
+		return nil
+	}
+
+	return db.Create(&answers).Error
+}
+
+func (r *AttendeeRepositoryImpl) GetPaginatedByEvent(db *gorm.DB, tickets *[]*entity.Ticket, eventID uint, page, size int) (int64, error) {
+	var totalItems int64
+	query := db.Model(&entity.Ticket{}).Where("event_id = ? AND order_id IS NOT NULL", eventID)
+
+	if err := query.Count(&totalItems).Error; err != nil {
+		return 0, err
+	}
+
+	offset := (page - 1) * size
+	if err := query.Preload("Answers.Question").
+		Order("seat_number ASC").
+		Offset(offset).
+		Limit(size).
+		Find(tickets).Error; err != nil {
+		return 0, err
+	}
+
+	return totalItems, nil
+}
+
+func (r *AttendeeRepositoryImpl) GetAllByEvent(db *gorm.DB, tickets *[]*entity.Ticket, eventID uint) error {
+	return db.Preload("Answers.Question").
+		Preload("Order").
+		Where("event_id = ? AND order_id IS NOT NULL", eventID).
+		Order("seat_number ASC").
+		Find(tickets).Error
+}
@@ -1,6 +1,8 @@
 package event
 
 import (
+	"time"
+
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
 	"github.com/TrinityKnights/Backend/internal/domain/model"
 	"github.com/TrinityKnights/Backend/internal/repository"
@@ -10,5 +12,13 @@ import (
 type EventRepository interface {
 	repository.Repository[entity.Event]
 	GetByID(db *gorm.DB, event *entity.Event, id uint) error
+	GetByOrderID(db *gorm.DB, events *[]entity.Event, orderID uint) error
 	GetPaginated(db *gorm.DB, events *[]entity.Event, opts *model.EventQueryOptions) (int64, error)
+	PublishDue(db *gorm.DB, now time.Time) ([]uint, error)
+	FindOnSaleIDs(db *gorm.DB, now time.Time) ([]uint, error)
+	MarkOrdersRefundEligible(db *gorm.DB, eventID uint, at time.Time) (int64, error)
+	GetHolders(db *gorm.DB, eventID uint) ([]model.EventHolder, error)
+	GetVenueTimezone(db *gorm.DB, venueID uint) (string, error)
+	LockVenue(db *gorm.DB, venueID uint) error
+	GetOverlapping(db *gorm.DB, events *[]entity.Event, venueID, excludeID uint, startsAt, endsAt time.Time, buffer time.Duration) error
 }
@@ -3,12 +3,14 @@ package event
 import (
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
 	"github.com/TrinityKnights/Backend/internal/domain/model"
 	"github.com/TrinityKnights/Backend/internal/repository"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type EventRepositoryImpl struct {
@@ -80,6 +82,9 @@ func (r *EventRepositoryImpl) buildPaginatedQuery(db *gorm.DB, opts *model.Event
 	if opts.VenueID != nil && *opts.VenueID != 0 {
 		query = query.Where("venue_id = ?", *opts.VenueID)
 	}
+	if len(opts.Statuses) > 0 {
+		query = query.Where("status IN (?)", opts.Statuses)
+	}
 
 	// Add sorting
 	if opts.Sort != "" {
@@ -109,3 +114,117 @@ func (r *EventRepositoryImpl) buildPaginatedQuery(db *gorm.DB, opts *model.Event
 
 	return query
 }
+
+// PublishDue publishes the scheduled events whose publish time has passed and returns their IDs.
+func (r *EventRepositoryImpl) PublishDue(db *gorm.DB, now time.Time) ([]uint, error) {
+	var events []entity.Event
+	err := db.Model(&events).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("status = ? AND publish_at <= ?", model.EventStatusScheduled, now).
+		Update("status", model.EventStatusPublished).Error
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, len(events))
+	for i := range events {
+		ids[i] = events[i].ID
+	}
+	return ids, nil
+}
+
+// FindOnSaleIDs lists published events that have not finished yet.
+func (r *EventRepositoryImpl) FindOnSaleIDs(db *gorm.DB, now time.Time) ([]uint, error) {
+	var ids []uint
+	err := db.Model(&entity.Event{}).
+		Where("status = ? AND COALESCE(ends_at, starts_at) > ?", model.EventStatusPublished, now).
+		Order("starts_at ASC").
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// eventOrders selects the orders holding tickets or slot places at an event.
+func eventOrders(db *gorm.DB, eventID uint) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true}).Raw(`SELECT order_id FROM tickets
+		WHERE event_id = ? AND order_id IS NOT NULL AND deleted_at IS NULL
+		UNION
+		SELECT slot_bookings.order_id FROM slot_bookings
+		JOIN time_slots ON time_slots.id = slot_bookings.slot_id
+		WHERE time_slots.event_id = ? AND slot_bookings.deleted_at IS NULL`, eventID, eventID)
+}
+
+// GetByOrderID lists the events an order holds tickets or slot places at, in id order so that
+// callers locking them do so in the same order.
+func (r *EventRepositoryImpl) GetByOrderID(db *gorm.DB, events *[]entity.Event, orderID uint) error {
+	orderEvents := db.Session(&gorm.Session{NewDB: true}).Raw(`SELECT event_id FROM tickets
+		WHERE order_id = ? AND deleted_at IS NULL
+		UNION
+		SELECT time_slots.event_id FROM slot_bookings
+		JOIN time_slots ON time_slots.id = slot_bookings.slot_id
+		WHERE slot_bookings.order_id = ? AND slot_bookings.deleted_at IS NULL`, orderID, orderID)
+	return db.Where("id IN (?)", orderEvents).Order("id ASC").Find(events).Error
+}
+
+// MarkOrdersRefundEligible flags every paid order for the event as eligible for a refund.
+// Pass orders are flagged as a whole, how much of a pass to refund is left to the organiser.
+func (r *EventRepositoryImpl) MarkOrdersRefundEligible(db *gorm.DB, eventID uint, at time.Time) (int64, error) {
+	result := db.Model(&entity.Order{}).
+		Where("id IN (?)", eventOrders(db, eventID)).
+		Where("refund_status IS NULL").
+		Where("EXISTS (SELECT 1 FROM payments WHERE payments.order_id = orders.id AND payments.status = ? AND payments.deleted_at IS NULL)", model.PaymentStatusPaid).
+		Updates(map[string]interface{}{
+			"refund_status":      model.RefundStatusEligible,
+			"refund_eligible_at": at,
+		})
+	return result.RowsAffected, result.Error
+}
+
+// GetHolders lists who should hear about a change to the event: the buyers of paid orders,
+// the recipients of complimentary orders and the named attendees of their tickets.
+func (r *EventRepositoryImpl) GetHolders(db *gorm.DB, eventID uint) ([]model.EventHolder, error) {
+	var holders []model.EventHolder
+	err := db.Raw(`SELECT DISTINCT ON (LOWER(email)) email, name FROM (
+			SELECT COALESCE(orders.recipient_email, users.email, orders.guest_email) AS email,
+				COALESCE(orders.recipient_name, users.name, orders.guest_name, '') AS name
+			FROM orders
+			LEFT JOIN users ON users.id = orders.user_id
+			WHERE orders.id IN (?) AND orders.deleted_at IS NULL
+			AND (orders.complimentary OR EXISTS (SELECT 1 FROM payments WHERE payments.order_id = orders.id AND payments.status = ? AND payments.deleted_at IS NULL))
+			UNION ALL
+			SELECT tickets.attendee_email, COALESCE(tickets.attendee_name, '')
+			FROM tickets
+			JOIN orders ON orders.id = tickets.order_id
+			WHERE tickets.event_id = ? AND tickets.attendee_email IS NOT NULL AND tickets.deleted_at IS NULL
+			AND (orders.complimentary OR EXISTS (SELECT 1 FROM payments WHERE payments.order_id = orders.id AND payments.status = ? AND payments.deleted_at IS NULL))
+		) AS holders
+		WHERE email IS NOT NULL AND email <> ''
+		ORDER BY LOWER(email)`,
+		eventOrders(db, eventID), model.PaymentStatusPaid, eventID, model.PaymentStatusPaid).
+		Scan(&holders).Error
+	return holders, err
+}
+
+// GetVenueTimezone returns the IANA time zone of the venue, gorm.ErrRecordNotFound when there is no such venue.
+func (r *EventRepositoryImpl) GetVenueTimezone(db *gorm.DB, venueID uint) (string, error) {
+	var venue entity.Venue
+	if err := db.Select("id", "timezone").Where("id = ?", venueID).Take(&venue).Error; err != nil {
+		return "", err
+	}
+	return venue.Timezone, nil
+}
+
+// LockVenue takes the venue's row lock until the transaction ends, so bookings at one venue are
+// checked for overlaps one at a time. A missing venue is left for the insert to reject.
+func (r *EventRepositoryImpl) LockVenue(db *gorm.DB, venueID uint) error {
+	return db.Exec("SELECT 1 FROM venues WHERE id = ? FOR UPDATE", venueID).Error
+}
+
+// GetOverlapping finds the events at the venue that clash with [startsAt, endsAt] once the
+// turnover buffer is kept free on either side. Events without an end only occupy their start,
+// and two events starting at the same moment always clash. Cancelled events free the venue.
+func (r *EventRepositoryImpl) GetOverlapping(db *gorm.DB, events *[]entity.Event, venueID, excludeID uint, startsAt, endsAt time.Time, buffer time.Duration) error {
+	return db.Where("venue_id = ? AND id <> ? AND status <> ?", venueID, excludeID, model.EventStatusCancelled).
+		Where("((starts_at < ? AND COALESCE(ends_at, starts_at) > ?) OR starts_at = ?)", endsAt.Add(buffer), startsAt.Add(-buffer), startsAt).
+		Order("starts_at ASC").
+		Find(events).Error
+}
@@ -44,8 +44,8 @@ func TestRepository_Create(t *testing.T) {
 	}
 
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `events` (`name`,`description`,`date`,`time`,`venue_id`,`created_at`,`updated_at`,`deleted_at`,`id`) VALUES (?,?,?,?,?,?,?,?,?)")).
-		WithArgs(expectedEvent.Name, expectedEvent.Description, expectedEvent.Date, expectedEvent.Time, expectedEvent.VenueID, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `events` (`name`,`description`,`date`,`time`,`venue_id`,`attendee_edit_cutoff`,`series`,`timed_entry`,`series_id`,`occurrence_date`,`status`,`publish_at`,`status_reason`,`cancelled_at`,`timezone`,`starts_at`,`ends_at`,`overlap_override_reason`,`overlap_overridden_by`,`capacity_override`,`created_at`,`updated_at`,`deleted_at`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)")).
+		WithArgs(expectedEvent.Name, expectedEvent.Description, expectedEvent.Date, expectedEvent.Time, expectedEvent.VenueID, nil, nil, false, nil, nil, "", nil, nil, nil, "", sqlmock.AnyArg(), nil, nil, nil, nil, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1)) 
 	mock.ExpectCommit()
 
@@ -85,8 +85,8 @@ func TestRepositoryImpl_Update(t *testing.T) {
 
 	// Mock the query for Update
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE `events` SET `name`=?,`description`=?,`date`=?,`time`=?,`venue_id`=?,`created_at`=?,`updated_at`=?,`deleted_at`=? WHERE `events`.`deleted_at` IS NULL AND `id` = ?")).
-		WithArgs(expectedEvent.Name, expectedEvent.Description, expectedEvent.Date, expectedEvent.Time, expectedEvent.VenueID, sqlmock.AnyArg(), sqlmock.AnyArg(), nil, expectedEvent.ID).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `events` SET `name`=?,`description`=?,`date`=?,`time`=?,`venue_id`=?,`attendee_edit_cutoff`=?,`series`=?,`timed_entry`=?,`series_id`=?,`occurrence_date`=?,`status`=?,`publish_at`=?,`status_reason`=?,`cancelled_at`=?,`timezone`=?,`starts_at`=?,`ends_at`=?,`overlap_override_reason`=?,`overlap_overridden_by`=?,`capacity_override`=?,`created_at`=?,`updated_at`=?,`deleted_at`=? WHERE `events`.`deleted_at` IS NULL AND `id` = ?")).
+		WithArgs(expectedEvent.Name, expectedEvent.Description, expectedEvent.Date, expectedEvent.Time, expectedEvent.VenueID, nil, nil, false, nil, nil, "", nil, nil, nil, "", sqlmock.AnyArg(), nil, nil, nil, nil, sqlmock.AnyArg(), sqlmock.AnyArg(), nil, expectedEvent.ID).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -0,0 +1,18 @@
+package exchange
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+type ExchangeRepository interface {
+	repository.Repository[entity.TicketExchange]
+	GetByID(db *gorm.DB, exchange *entity.TicketExchange, id uint) error
+	GetPendingByTicketID(db *gorm.DB, exchange *entity.TicketExchange, ticketID string) error
+	GetPaginated(db *gorm.DB, exchanges *[]entity.TicketExchange, userID string, page, size int) (int64, error)
+	Complete(db *gorm.DB, exchange *entity.TicketExchange) error
+	Expire(db *gorm.DB, exchange *entity.TicketExchange) error
+	Fail(db *gorm.DB, exchange *entity.TicketExchange) error
+	CreateCredit(db *gorm.DB, credit *entity.UserCredit) error
+}
@@ -0,0 +1,140 @@
+package exchange
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ExchangeRepositoryImpl struct {
+	repository.RepositoryImpl[entity.TicketExchange]
+	Log *logrus.Logger
+}
+
+func NewExchangeRepository(db *gorm.DB, log *logrus.Logger) *ExchangeRepositoryImpl {
+	return &ExchangeRepositoryImpl{
+		RepositoryImpl: repository.RepositoryImpl[entity.TicketExchange]{DB: db},
+		Log:            log,
+	}
+}
+
+func (r *ExchangeRepositoryImpl) GetByID(db *gorm.DB, exchange *entity.TicketExchange, id uint) error {
+	return db.Preload("OldTicket").
+		Preload("NewTicket").
+		Where("id = ?", id).
+		Take(exchange).Error
+}
+
+func (r *ExchangeRepositoryImpl) GetPendingByTicketID(db *gorm.DB, exchange *entity.TicketExchange, ticketID string) error {
+	return db.Where("old_ticket_id = ? AND status = ?", ticketID, model.TicketExchangeStatusPending).
+		Take(exchange).Error
+}
+
+// GetPaginated lists exchanges newest first, limited to one buyer unless userID is empty.
+func (r *ExchangeRepositoryImpl) GetPaginated(db *gorm.DB, exchanges *[]entity.TicketExchange, userID string, page, size int) (int64, error) {
+	var totalItems int64
+	query := db.Model(&entity.TicketExchange{})
+	if userID != "" {
+		query = query.Where("user_id = ?", userID)
+	}
+
+	if err := query.Count(&totalItems).Error; err != nil {
+		return 0, err
+	}
+
+	offset := (page - 1) * size
+	if err := query.Preload("OldTicket").
+		Preload("NewTicket").
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(size).
+		Find(exchanges).Error; err != nil {
+		return 0, err
+	}
+
+	return totalItems, nil
+}
+
+// Complete moves the order and its holder details onto the new ticket, then releases the old one.
+// It fails with gorm.ErrRecordNotFound, changing nothing, when the new ticket has been sold or is
+// held by someone else since the exchange was started.
+func (r *ExchangeRepositoryImpl) Complete(db *gorm.DB, exchange *entity.TicketExchange) error {
+	var oldTicket entity.Ticket
+	if err := db.Where("id = ?", exchange.OldTicketID).Take(&oldTicket).Error; err != nil {
+		return err
+	}
+
+	result := db.Model(&entity.Ticket{}).
+		Where("id = ? AND order_id IS NULL", exchange.NewTicketID).
+		Where("hold_token = ? OR hold_token IS NULL OR held_until < ?", exchange.HoldToken, time.Now()).
+		Updates(map[string]interface{}{
+			"order_id":       exchange.OrderID,
+			"hold_token":     nil,
+			"held_until":     nil,
+			"attendee_name":  oldTicket.AttendeeName,
+			"attendee_email": oldTicket.AttendeeEmail,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	if err := db.Model(&entity.AttendeeAnswer{}).
+		Where("ticket_id = ?", exchange.OldTicketID).
+		Update("ticket_id", exchange.NewTicketID).Error; err != nil {
+		return err
+	}
+
+	if err := db.Model(&entity.Ticket{}).
+		Where("id = ?", exchange.OldTicketID).
+		Updates(map[string]interface{}{
+			"order_id":       nil,
+			"hold_token":     nil,
+			"held_until":     nil,
+			"attendee_name":  nil,
+			"attendee_email": nil,
+		}).Error; err != nil {
+		return err
+	}
+
+	if err := db.Model(&entity.Order{}).
+		Where("id = ?", exchange.OrderID).
+		Update("total_price", gorm.Expr("total_price + ?", exchange.PriceDifference)).Error; err != nil {
+		return err
+	}
+
+	now := time.Now()
+	exchange.Status = model.TicketExchangeStatusCompleted
+	exchange.CompletedAt = &now
+	return db.Model(&entity.TicketExchange{}).
+		Where("id = ?", exchange.ID).
+		Updates(map[string]interface{}{
+			"status":       exchange.Status,
+			"completed_at": exchange.CompletedAt,
+		}).Error
+}
+
+func (r *ExchangeRepositoryImpl) Expire(db *gorm.DB, exchange *entity.TicketExchange) error {
+	exchange.Status = model.TicketExchangeStatusExpired
+	return db.Model(&entity.TicketExchange{}).
+		Where("id = ?", exchange.ID).
+		Update("status", exchange.Status).Error
+}
+
+// Fail closes an exchange whose new ticket could not be handed over, the buyer keeps the old one.
+func (r *ExchangeRepositoryImpl) Fail(db *gorm.DB, exchange *entity.TicketExchange) error {
+	exchange.Status = model.TicketExchangeStatusFailed
+	return db.Model(&entity.TicketExchange{}).
+		Where("id = ?", exchange.ID).
+		Update("status", exchange.Status).Error
+}
+
+func (r *ExchangeRepositoryImpl) CreateCredit(db *gorm.DB, credit *entity.UserCredit) error {
+	return db.Create(credit).Error
+}
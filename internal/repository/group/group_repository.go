@@ -0,0 +1,21 @@
+package group
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+type GroupRepository interface {
+	repository.Repository[entity.GroupBooking]
+	GetByID(db *gorm.DB, group *entity.GroupBooking, id uint) error
+	GetPaginated(db *gorm.DB, groups *[]entity.GroupBooking, userID, email string, page, size int) (int64, error)
+	GetPastDeadline(db *gorm.DB, now time.Time) ([]entity.GroupBooking, error)
+	CreateShare(db *gorm.DB, share *entity.GroupShare) error
+	LockInvitedShares(db *gorm.DB, groupID uint) ([]entity.GroupShare, error)
+	SettleShare(db *gorm.DB, orderID uint, status model.GroupShareStatus) error
+	Finalize(db *gorm.DB, groupID uint) error
+}
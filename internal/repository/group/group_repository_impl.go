@@ -0,0 +1,149 @@
+package group
+
+import (
+	"errors"
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GroupRepositoryImpl struct {
+	repository.RepositoryImpl[entity.GroupBooking]
+	Log *logrus.Logger
+}
+
+func NewGroupRepository(db *gorm.DB, log *logrus.Logger) *GroupRepositoryImpl {
+	return &GroupRepositoryImpl{
+		RepositoryImpl: repository.RepositoryImpl[entity.GroupBooking]{DB: db},
+		Log:            log,
+	}
+}
+
+func (r *GroupRepositoryImpl) GetByID(db *gorm.DB, group *entity.GroupBooking, id uint) error {
+	return db.Preload("Shares", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).
+		Preload("Shares.Order.Tickets").
+		Where("id = ?", id).
+		Take(group).Error
+}
+
+// GetPaginated lists groups newest first. A buyer sees the groups they organised or were invited to,
+// an empty userID lists every group.
+func (r *GroupRepositoryImpl) GetPaginated(db *gorm.DB, groups *[]entity.GroupBooking, userID, email string, page, size int) (int64, error) {
+	var totalItems int64
+	query := db.Model(&entity.GroupBooking{})
+	if userID != "" {
+		query = query.Where("organiser_id = ? OR id IN (?)", userID,
+			db.Model(&entity.GroupShare{}).Select("group_booking_id").Where("LOWER(email) = LOWER(?)", email))
+	}
+
+	if err := query.Count(&totalItems).Error; err != nil {
+		return 0, err
+	}
+
+	offset := (page - 1) * size
+	if err := query.Preload("Shares", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).
+		Preload("Shares.Order.Tickets").
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(size).
+		Find(groups).Error; err != nil {
+		return 0, err
+	}
+
+	return totalItems, nil
+}
+
+func (r *GroupRepositoryImpl) GetPastDeadline(db *gorm.DB, now time.Time) ([]entity.GroupBooking, error) {
+	var groups []entity.GroupBooking
+	err := db.Where("status = ? AND deadline < ?", model.GroupBookingStatusPending, now).
+		Order("deadline ASC").
+		Find(&groups).Error
+	return groups, err
+}
+
+func (r *GroupRepositoryImpl) CreateShare(db *gorm.DB, share *entity.GroupShare) error {
+	return db.Omit(clause.Associations).Create(share).Error
+}
+
+func (r *GroupRepositoryImpl) LockInvitedShares(db *gorm.DB, groupID uint) ([]entity.GroupShare, error) {
+	var shares []entity.GroupShare
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("group_booking_id = ? AND status = ?", groupID, model.GroupShareStatusInvited).
+		Order("id ASC").
+		Find(&shares).Error
+	return shares, err
+}
+
+// SettleShare records the outcome of a share's invoice and closes its group once no share is
+// outstanding. Orders that are not part of a group are ignored.
+func (r *GroupRepositoryImpl) SettleShare(db *gorm.DB, orderID uint, status model.GroupShareStatus) error {
+	var share entity.GroupShare
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("order_id = ?", orderID).
+		Take(&share).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if share.Status != model.GroupShareStatusInvited {
+		return nil
+	}
+
+	if err := db.Model(&entity.GroupShare{}).
+		Where("id = ?", share.ID).
+		Update("status", status).Error; err != nil {
+		return err
+	}
+
+	return r.Finalize(db, share.GroupBookingID)
+}
+
+// Finalize sets the final status of a pending group when none of its shares is awaiting payment:
+// completed when everyone paid, partial when some shares were released, expired when nobody paid.
+func (r *GroupRepositoryImpl) Finalize(db *gorm.DB, groupID uint) error {
+	var counts []struct {
+		Status model.GroupShareStatus
+		Count  int64
+	}
+	if err := db.Model(&entity.GroupShare{}).
+		Select("status, COUNT(*) AS count").
+		Where("group_booking_id = ?", groupID).
+		Group("status").
+		Scan(&counts).Error; err != nil {
+		return err
+	}
+
+	var total, paid int64
+	for _, c := range counts {
+		if c.Status == model.GroupShareStatusInvited && c.Count > 0 {
+			return nil
+		}
+		if c.Status == model.GroupShareStatusPaid {
+			paid = c.Count
+		}
+		total += c.Count
+	}
+
+	status := model.GroupBookingStatusPartial
+	switch paid {
+	case total:
+		status = model.GroupBookingStatusCompleted
+	case 0:
+		status = model.GroupBookingStatusExpired
+	}
+
+	return db.Model(&entity.GroupBooking{}).
+		Where("id = ? AND status = ?", groupID, model.GroupBookingStatusPending).
+		Update("status", status).Error
+}
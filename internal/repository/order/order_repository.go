@@ -1,6 +1,8 @@
 package order
 
 import (
+	"time"
+
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
 	"github.com/TrinityKnights/Backend/internal/repository"
 	"gorm.io/gorm"
@@ -12,4 +14,7 @@ type OrderRepository interface {
 	GetByIDWithDetails(db *gorm.DB, order *entity.Order, id uint) error
 	GetAllWithDetails(db *gorm.DB, orders *[]entity.Order) error
 	GetPaginatedOrders(db *gorm.DB, orders *[]entity.Order, page, size int, sort, order string) (int64, error)
+	GetGuestOrderByCode(db *gorm.DB, order *entity.Order, code, email string) error
+	AttachGuestOrders(db *gorm.DB, userID, email string) (int64, error)
+	MarkRefundEligible(db *gorm.DB, orderID uint, at time.Time) error
 }
@@ -1,7 +1,10 @@
 package order
 
 import (
+	"time"
+
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
 	"github.com/TrinityKnights/Backend/internal/repository"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
@@ -26,8 +29,11 @@ func (r *OrderRepositoryImpl) GetByID(db *gorm.DB, order *entity.Order, id uint)
 
 func (r *OrderRepositoryImpl) GetByIDWithDetails(db *gorm.DB, order *entity.Order, id uint) error {
 	return db.Preload("Tickets").
+		Preload("Tickets.Answers.Question").
+		Preload("Items.Vouchers").
+		Preload("SlotBookings.Slot").
 		Preload("User").
-		Preload("Payment").
+		Preload("Payment", "ticket_exchange_id IS NULL").
 		Where("orders.id = ?", id).
 		Take(&order).Error
 }
@@ -35,7 +41,7 @@ func (r *OrderRepositoryImpl) GetByIDWithDetails(db *gorm.DB, order *entity.Orde
 func (r *OrderRepositoryImpl) GetAllWithDetails(db *gorm.DB, orders *[]entity.Order) error {
 	return db.Preload("Tickets").
 		Preload("User").
-		Preload("Payment").
+		Preload("Payment", "ticket_exchange_id IS NULL").
 		Find(&orders).Error
 }
 
@@ -71,6 +77,9 @@ func (r *OrderRepositoryImpl) GetPaginatedOrders(db *gorm.DB, orders *[]entity.O
 	offset := (page - 1) * size
 	if err := query.Preload("Tickets").
 		Preload("Tickets.Event").
+		Preload("Tickets.Answers.Question").
+		Preload("Items.Vouchers").
+		Preload("SlotBookings.Slot").
 		Preload("Payments").
 		Offset(offset).
 		Limit(size).
@@ -80,3 +89,27 @@ func (r *OrderRepositoryImpl) GetPaginatedOrders(db *gorm.DB, orders *[]entity.O
 
 	return totalItems, nil
 }
+
+func (r *OrderRepositoryImpl) GetGuestOrderByCode(db *gorm.DB, order *entity.Order, code, email string) error {
+	return db.Where("user_id IS NULL AND UPPER(code) = UPPER(?) AND LOWER(guest_email) = LOWER(?)", code, email).
+		Take(order).Error
+}
+
+// AttachGuestOrders moves every guest order placed with the email onto the account
+func (r *OrderRepositoryImpl) AttachGuestOrders(db *gorm.DB, userID, email string) (int64, error) {
+	result := db.Model(&entity.Order{}).
+		Where("user_id IS NULL AND LOWER(guest_email) = LOWER(?)", email).
+		Update("user_id", userID)
+	return result.RowsAffected, result.Error
+}
+
+// MarkRefundEligible flags a single order as eligible for a refund, an order already flagged
+// keeps the time it was first flagged at.
+func (r *OrderRepositoryImpl) MarkRefundEligible(db *gorm.DB, orderID uint, at time.Time) error {
+	return db.Model(&entity.Order{}).
+		Where("id = ? AND refund_status IS NULL", orderID).
+		Updates(map[string]interface{}{
+			"refund_status":      model.RefundStatusEligible,
+			"refund_eligible_at": at,
+		}).Error
+}
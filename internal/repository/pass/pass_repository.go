@@ -0,0 +1,18 @@
+package pass
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+type PassRepository interface {
+	repository.Repository[entity.Pass]
+	GetByID(db *gorm.DB, pass *entity.Pass, id uint) error
+	GetAll(db *gorm.DB, passes *[]entity.Pass) error
+	GetCoveredEvents(db *gorm.DB, pass *entity.Pass, from time.Time) ([]entity.Event, error)
+	GetOrderByCode(db *gorm.DB, order *entity.Order, code string) error
+	GetOrderTicket(db *gorm.DB, ticket *entity.Ticket, orderID, eventID uint) error
+}
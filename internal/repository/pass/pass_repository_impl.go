@@ -0,0 +1,70 @@
+package pass
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PassRepositoryImpl struct {
+	repository.RepositoryImpl[entity.Pass]
+	Log *logrus.Logger
+}
+
+func NewPassRepository(db *gorm.DB, log *logrus.Logger) *PassRepositoryImpl {
+	return &PassRepositoryImpl{
+		RepositoryImpl: repository.RepositoryImpl[entity.Pass]{DB: db},
+		Log:            log,
+	}
+}
+
+func (r *PassRepositoryImpl) GetByID(db *gorm.DB, pass *entity.Pass, id uint) error {
+	return db.Preload("Events", func(db *gorm.DB) *gorm.DB {
+		return db.Order("starts_at ASC, id ASC")
+	}).
+		Where("id = ?", id).
+		Take(pass).Error
+}
+
+func (r *PassRepositoryImpl) GetAll(db *gorm.DB, passes *[]entity.Pass) error {
+	return db.Preload("Events", func(db *gorm.DB) *gorm.DB {
+		return db.Order("starts_at ASC, id ASC")
+	}).
+		Order("name ASC").
+		Find(passes).Error
+}
+
+// GetCoveredEvents returns the published events a pass grants entry to that start on or after
+// from: the events it was defined with and, for a series pass, every occurrence of the series.
+func (r *PassRepositoryImpl) GetCoveredEvents(db *gorm.DB, pass *entity.Pass, from time.Time) ([]entity.Event, error) {
+	query := db.Where("id IN (?)", db.Table("pass_events").Select("event_id").Where("pass_id = ?", pass.ID))
+	if pass.SeriesID != nil {
+		query = query.Or("series_id = ?", *pass.SeriesID)
+	}
+
+	var events []entity.Event
+	err := db.Where(query).
+		Where("status = ? AND starts_at >= ?", model.EventStatusPublished, from).
+		Order("starts_at ASC, id ASC").
+		Find(&events).Error
+	return events, err
+}
+
+// GetOrderByCode finds the pass order behind a scanned code together with its payment.
+func (r *PassRepositoryImpl) GetOrderByCode(db *gorm.DB, order *entity.Order, code string) error {
+	return db.Preload("Payment", "ticket_exchange_id IS NULL").
+		Where("UPPER(code) = UPPER(?) AND pass_id IS NOT NULL", code).
+		Take(order).Error
+}
+
+// GetOrderTicket locks the seat a pass order holds at one of its events.
+func (r *PassRepositoryImpl) GetOrderTicket(db *gorm.DB, ticket *entity.Ticket, orderID, eventID uint) error {
+	return db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("order_id = ? AND event_id = ?", orderID, eventID).
+		Take(ticket).Error
+}
@@ -1,7 +1,6 @@
 package payment
 
 import (
-	"context"
 	"gorm.io/gorm"
 
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
@@ -9,7 +8,7 @@ import (
 )
 
 type PaymentRepository interface {
-	GetByTransactionID(ctx context.Context, transactionID string) (*entity.Payment, error)
-	UpdatePaymentStatus(ctx context.Context, payment *model.PaymentUpdateRequest) error
+	GetByTransactionID(db *gorm.DB, transactionID string) (*entity.Payment, error)
+	UpdatePaymentStatus(db *gorm.DB, payment *model.PaymentUpdateRequest) error
 	Find(db *gorm.DB, filter *model.PaymentQueryOptions) ([]*entity.Payment, error)
 }
@@ -1,7 +1,6 @@
 package payment
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -23,16 +22,16 @@ func NewPaymentRepository(db *gorm.DB, log *logrus.Logger) PaymentRepository {
 	}
 }
 
-func (r *PaymentRepositoryImpl) GetByTransactionID(ctx context.Context, transactionID string) (*entity.Payment, error) {
+func (r *PaymentRepositoryImpl) GetByTransactionID(db *gorm.DB, transactionID string) (*entity.Payment, error) {
 	var payment entity.Payment
-	if err := r.DB.Where("transaction_id = ?", transactionID).First(&payment).Error; err != nil {
+	if err := db.Where("transaction_id = ?", transactionID).First(&payment).Error; err != nil {
 		return nil, err
 	}
 	return &payment, nil
 }
 
-func (r *PaymentRepositoryImpl) UpdatePaymentStatus(ctx context.Context, payment *model.PaymentUpdateRequest) error {
-	return r.DB.Model(&entity.Payment{}).Where("id = ?", payment.ID).Updates(payment).Error
+func (r *PaymentRepositoryImpl) UpdatePaymentStatus(db *gorm.DB, payment *model.PaymentUpdateRequest) error {
+	return db.Model(&entity.Payment{}).Where("id = ?", payment.ID).Updates(payment).Error
 }
 
 func (r *PaymentRepositoryImpl) Find(db *gorm.DB, opts *model.PaymentQueryOptions) ([]*entity.Payment, error) {
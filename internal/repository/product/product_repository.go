@@ -0,0 +1,20 @@
+package product
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+type ProductRepository interface {
+	repository.Repository[entity.Product]
+	GetByID(db *gorm.DB, product *entity.Product, id uint) error
+	GetByEventID(db *gorm.DB, products *[]entity.Product, eventID uint) error
+	LockByIDs(db *gorm.DB, eventID uint, ids []uint) ([]*entity.Product, error)
+	DecrementStock(db *gorm.DB, id uint, quantity int) error
+	RestockByOrderID(db *gorm.DB, orderID uint) error
+	GetVoucherByCode(db *gorm.DB, voucher *entity.Voucher, code string) error
+	RedeemVoucher(db *gorm.DB, id uint, redeemedBy string, redeemedAt time.Time) error
+}
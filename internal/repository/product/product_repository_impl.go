@@ -0,0 +1,98 @@
+package product
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProductRepositoryImpl struct {
+	repository.RepositoryImpl[entity.Product]
+	Log *logrus.Logger
+}
+
+func NewProductRepository(db *gorm.DB, log *logrus.Logger) *ProductRepositoryImpl {
+	return &ProductRepositoryImpl{
+		RepositoryImpl: repository.RepositoryImpl[entity.Product]{DB: db},
+		Log:            log,
+	}
+}
+
+func (r *ProductRepositoryImpl) GetByID(db *gorm.DB, product *entity.Product, id uint) error {
+	return db.Where("id = ?", id).Take(product).Error
+}
+
+func (r *ProductRepositoryImpl) GetByEventID(db *gorm.DB, products *[]entity.Product, eventID uint) error {
+	return db.Where("event_id = ?", eventID).
+		Order("name ASC").
+		Find(products).Error
+}
+
+// LockByIDs locks the event's products in ID order so concurrent checkouts cannot deadlock.
+func (r *ProductRepositoryImpl) LockByIDs(db *gorm.DB, eventID uint, ids []uint) ([]*entity.Product, error) {
+	var products []*entity.Product
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("event_id = ? AND id IN ?", eventID, ids).
+		Order("id ASC").
+		Find(&products).Error
+	return products, err
+}
+
+func (r *ProductRepositoryImpl) DecrementStock(db *gorm.DB, id uint, quantity int) error {
+	result := db.Model(&entity.Product{}).
+		Where("id = ? AND stock >= ?", id, quantity).
+		Update("stock", gorm.Expr("stock - ?", quantity))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// RestockByOrderID returns an order's add-ons to stock, voiding their vouchers. The line items
+// are removed as well so a repeated release cannot restock twice.
+func (r *ProductRepositoryImpl) RestockByOrderID(db *gorm.DB, orderID uint) error {
+	if err := db.Exec(`UPDATE products SET stock = products.stock + order_items.quantity
+		FROM order_items
+		WHERE order_items.product_id = products.id
+		AND order_items.order_id = ? AND order_items.deleted_at IS NULL`, orderID).Error; err != nil {
+		return err
+	}
+
+	if err := db.Unscoped().
+		Where("order_item_id IN (?)", db.Model(&entity.OrderItem{}).Select("id").Where("order_id = ?", orderID)).
+		Delete(&entity.Voucher{}).Error; err != nil {
+		return err
+	}
+
+	return db.Where("order_id = ?", orderID).Delete(&entity.OrderItem{}).Error
+}
+
+func (r *ProductRepositoryImpl) GetVoucherByCode(db *gorm.DB, voucher *entity.Voucher, code string) error {
+	return db.Preload("OrderItem").
+		Where("UPPER(code) = UPPER(?)", code).
+		Take(voucher).Error
+}
+
+// RedeemVoucher marks a voucher as used, it only succeeds once per voucher.
+func (r *ProductRepositoryImpl) RedeemVoucher(db *gorm.DB, id uint, redeemedBy string, redeemedAt time.Time) error {
+	result := db.Model(&entity.Voucher{}).
+		Where("id = ? AND redeemed_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"redeemed_at": redeemedAt,
+			"redeemed_by": redeemedBy,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
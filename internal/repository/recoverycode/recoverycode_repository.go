@@ -0,0 +1,16 @@
+package recoverycode
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+type RecoveryCodeRepository interface {
+	repository.Repository[entity.RecoveryCode]
+	ReplaceForUser(db *gorm.DB, userID string, hashes []string) error
+	Redeem(db *gorm.DB, userID, hash string, at time.Time) (int64, error)
+	DeleteByUserID(db *gorm.DB, userID string) error
+}
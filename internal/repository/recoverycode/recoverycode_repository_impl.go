@@ -0,0 +1,48 @@
+package recoverycode
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type RecoveryCodeRepositoryImpl struct {
+	repository.RepositoryImpl[entity.RecoveryCode]
+	Log *logrus.Logger
+}
+
+func NewRecoveryCodeRepository(db *gorm.DB, log *logrus.Logger) *RecoveryCodeRepositoryImpl {
+	return &RecoveryCodeRepositoryImpl{
+		RepositoryImpl: repository.RepositoryImpl[entity.RecoveryCode]{DB: db},
+		Log:            log,
+	}
+}
+
+// ReplaceForUser drops the user's previous codes, used or not, and stores the new set.
+func (r *RecoveryCodeRepositoryImpl) ReplaceForUser(db *gorm.DB, userID string, hashes []string) error {
+	if err := r.DeleteByUserID(db, userID); err != nil {
+		return err
+	}
+
+	codes := make([]entity.RecoveryCode, len(hashes))
+	for i, hash := range hashes {
+		codes[i] = entity.RecoveryCode{UserID: userID, CodeHash: hash}
+	}
+	return db.Create(&codes).Error
+}
+
+// Redeem marks an unused code as used. It affects no rows when the code is unknown or was
+// already used, including by a concurrent request.
+func (r *RecoveryCodeRepositoryImpl) Redeem(db *gorm.DB, userID, hash string, at time.Time) (int64, error) {
+	result := db.Model(&entity.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, hash).
+		Update("used_at", at)
+	return result.RowsAffected, result.Error
+}
+
+func (r *RecoveryCodeRepositoryImpl) DeleteByUserID(db *gorm.DB, userID string) error {
+	return db.Where("user_id = ?", userID).Delete(&entity.RecoveryCode{}).Error
+}
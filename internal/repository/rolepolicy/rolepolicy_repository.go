@@ -0,0 +1,12 @@
+package rolepolicy
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+type RolePolicyRepository interface {
+	repository.Repository[entity.RolePolicy]
+	GetByRole(db *gorm.DB, policy *entity.RolePolicy, role string) error
+}
@@ -0,0 +1,24 @@
+package rolepolicy
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type RolePolicyRepositoryImpl struct {
+	repository.RepositoryImpl[entity.RolePolicy]
+	Log *logrus.Logger
+}
+
+func NewRolePolicyRepository(db *gorm.DB, log *logrus.Logger) *RolePolicyRepositoryImpl {
+	return &RolePolicyRepositoryImpl{
+		RepositoryImpl: repository.RepositoryImpl[entity.RolePolicy]{DB: db},
+		Log:            log,
+	}
+}
+
+func (r *RolePolicyRepositoryImpl) GetByRole(db *gorm.DB, policy *entity.RolePolicy, role string) error {
+	return db.Where("role = ?", role).Take(policy).Error
+}
@@ -0,0 +1,22 @@
+package series
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+type SeriesRepository interface {
+	repository.Repository[entity.EventSeries]
+	GetByID(db *gorm.DB, series *entity.EventSeries, id uint) error
+	CreateException(db *gorm.DB, exception *entity.SeriesException) error
+	DeleteException(db *gorm.DB, seriesID uint, date time.Time) error
+	CreateTicketTemplate(db *gorm.DB, template *entity.SeriesTicketTemplate) error
+	GetOccurrences(db *gorm.DB, events *[]entity.Event, seriesID uint, from time.Time) error
+	GetOccurrenceByDate(db *gorm.DB, event *entity.Event, seriesID uint, date time.Time) error
+	UpdateOccurrences(db *gorm.DB, seriesID uint, from time.Time, updates map[string]interface{}) error
+	CountSold(db *gorm.DB, eventID uint) (int64, error)
+	DeleteUnsoldTickets(db *gorm.DB, eventID uint) error
+}
@@ -0,0 +1,83 @@
+package series
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SeriesRepositoryImpl struct {
+	repository.RepositoryImpl[entity.EventSeries]
+	Log *logrus.Logger
+}
+
+func NewSeriesRepository(db *gorm.DB, log *logrus.Logger) *SeriesRepositoryImpl {
+	return &SeriesRepositoryImpl{
+		RepositoryImpl: repository.RepositoryImpl[entity.EventSeries]{DB: db},
+		Log:            log,
+	}
+}
+
+func (r *SeriesRepositoryImpl) GetByID(db *gorm.DB, series *entity.EventSeries, id uint) error {
+	return db.Preload("Exceptions", func(db *gorm.DB) *gorm.DB {
+		return db.Order("original_date ASC")
+	}).
+		Preload("TicketTemplates", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id ASC")
+		}).
+		Preload("Occurrences", func(db *gorm.DB) *gorm.DB {
+			return db.Order("date ASC, id ASC")
+		}).
+		Where("id = ?", id).
+		Take(series).Error
+}
+
+func (r *SeriesRepositoryImpl) CreateException(db *gorm.DB, exception *entity.SeriesException) error {
+	return db.Omit(clause.Associations).Create(exception).Error
+}
+
+func (r *SeriesRepositoryImpl) DeleteException(db *gorm.DB, seriesID uint, date time.Time) error {
+	return db.Where("series_id = ? AND original_date = ?", seriesID, date.Format("2006-01-02")).
+		Delete(&entity.SeriesException{}).Error
+}
+
+func (r *SeriesRepositoryImpl) CreateTicketTemplate(db *gorm.DB, template *entity.SeriesTicketTemplate) error {
+	return db.Omit(clause.Associations).Create(template).Error
+}
+
+// GetOccurrences lists the series' events whose original date is on or after from.
+func (r *SeriesRepositoryImpl) GetOccurrences(db *gorm.DB, events *[]entity.Event, seriesID uint, from time.Time) error {
+	return db.Where("series_id = ? AND occurrence_date >= ?", seriesID, from.Format("2006-01-02")).
+		Order("occurrence_date ASC, id ASC").
+		Find(events).Error
+}
+
+// GetOccurrenceByDate finds the event generated for a date of the rule, wherever it was moved to.
+func (r *SeriesRepositoryImpl) GetOccurrenceByDate(db *gorm.DB, event *entity.Event, seriesID uint, date time.Time) error {
+	return db.Where("series_id = ? AND occurrence_date = ?", seriesID, date.Format("2006-01-02")).
+		Take(event).Error
+}
+
+// UpdateOccurrences applies the same changes to every occurrence originally dated on or after from.
+func (r *SeriesRepositoryImpl) UpdateOccurrences(db *gorm.DB, seriesID uint, from time.Time, updates map[string]interface{}) error {
+	return db.Model(&entity.Event{}).
+		Where("series_id = ? AND occurrence_date >= ?", seriesID, from.Format("2006-01-02")).
+		Updates(updates).Error
+}
+
+func (r *SeriesRepositoryImpl) CountSold(db *gorm.DB, eventID uint) (int64, error) {
+	var count int64
+	err := db.Model(&entity.Ticket{}).
+		Where("event_id = ? AND order_id IS NOT NULL", eventID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *SeriesRepositoryImpl) DeleteUnsoldTickets(db *gorm.DB, eventID uint) error {
+	return db.Where("event_id = ? AND order_id IS NULL", eventID).
+		Delete(&entity.Ticket{}).Error
+}
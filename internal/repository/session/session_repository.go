@@ -0,0 +1,18 @@
+package session
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+type SessionRepository interface {
+	repository.Repository[entity.Session]
+	GetActiveByID(db *gorm.DB, session *entity.Session, id, userID string, now time.Time) error
+	FindActiveByUserID(db *gorm.DB, userID string, now time.Time) ([]entity.Session, error)
+	Touch(db *gorm.DB, id, refreshTokenID, ip string, seenAt, expiresAt time.Time) (int64, error)
+	Revoke(db *gorm.DB, id string, at time.Time) error
+	RevokeByUserID(db *gorm.DB, userID string, at time.Time) (int64, error)
+}
@@ -0,0 +1,62 @@
+package session
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type SessionRepositoryImpl struct {
+	repository.RepositoryImpl[entity.Session]
+	Log *logrus.Logger
+}
+
+func NewSessionRepository(db *gorm.DB, log *logrus.Logger) *SessionRepositoryImpl {
+	return &SessionRepositoryImpl{
+		RepositoryImpl: repository.RepositoryImpl[entity.Session]{DB: db},
+		Log:            log,
+	}
+}
+
+func active(db *gorm.DB, now time.Time) *gorm.DB {
+	return db.Where("revoked_at IS NULL AND expires_at > ?", now)
+}
+
+func (r *SessionRepositoryImpl) GetActiveByID(db *gorm.DB, session *entity.Session, id, userID string, now time.Time) error {
+	return active(db, now).Where("id = ? AND user_id = ?", id, userID).Take(session).Error
+}
+
+func (r *SessionRepositoryImpl) FindActiveByUserID(db *gorm.DB, userID string, now time.Time) ([]entity.Session, error) {
+	var sessions []entity.Session
+	err := active(db, now).Where("user_id = ?", userID).Order("last_seen_at DESC").Find(&sessions).Error
+	return sessions, err
+}
+
+// Touch records a refresh of the session. It affects no rows once the session is revoked.
+func (r *SessionRepositoryImpl) Touch(db *gorm.DB, id, refreshTokenID, ip string, seenAt, expiresAt time.Time) (int64, error) {
+	result := db.Model(&entity.Session{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"refresh_token_id": refreshTokenID,
+			"ip":               ip,
+			"last_seen_at":     seenAt,
+			"expires_at":       expiresAt,
+		})
+	return result.RowsAffected, result.Error
+}
+
+func (r *SessionRepositoryImpl) Revoke(db *gorm.DB, id string, at time.Time) error {
+	return db.Model(&entity.Session{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", at).Error
+}
+
+func (r *SessionRepositoryImpl) RevokeByUserID(db *gorm.DB, userID string, at time.Time) (int64, error) {
+	result := db.Model(&entity.Session{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", at)
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,23 @@
+package slot
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+type SlotRepository interface {
+	repository.Repository[entity.TimeSlot]
+	CreateTemplate(db *gorm.DB, template *entity.SlotTemplate) error
+	GetTemplateByID(db *gorm.DB, template *entity.SlotTemplate, id uint) error
+	GetTemplatesByEventID(db *gorm.DB, templates *[]entity.SlotTemplate, eventID uint) error
+	CreateBatch(db *gorm.DB, slots []*entity.TimeSlot) (int64, error)
+	GetByID(db *gorm.DB, slot *entity.TimeSlot, id uint) error
+	GetByEventID(db *gorm.DB, slots *[]entity.TimeSlot, eventID uint, from, to time.Time) error
+	Reserve(db *gorm.DB, id uint, quantity int) error
+	CreateBooking(db *gorm.DB, booking *entity.SlotBooking) error
+	GetBookingsByOrderID(db *gorm.DB, orderID uint) ([]*entity.SlotBooking, error)
+	ReleaseByOrderID(db *gorm.DB, orderID uint) error
+}
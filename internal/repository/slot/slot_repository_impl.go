@@ -0,0 +1,99 @@
+package slot
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SlotRepositoryImpl struct {
+	repository.RepositoryImpl[entity.TimeSlot]
+	Log *logrus.Logger
+}
+
+func NewSlotRepository(db *gorm.DB, log *logrus.Logger) *SlotRepositoryImpl {
+	return &SlotRepositoryImpl{
+		RepositoryImpl: repository.RepositoryImpl[entity.TimeSlot]{DB: db},
+		Log:            log,
+	}
+}
+
+func (r *SlotRepositoryImpl) CreateTemplate(db *gorm.DB, template *entity.SlotTemplate) error {
+	return db.Omit(clause.Associations).Create(template).Error
+}
+
+func (r *SlotRepositoryImpl) GetTemplateByID(db *gorm.DB, template *entity.SlotTemplate, id uint) error {
+	return db.Preload("Event").
+		Where("id = ?", id).
+		Take(template).Error
+}
+
+func (r *SlotRepositoryImpl) GetTemplatesByEventID(db *gorm.DB, templates *[]entity.SlotTemplate, eventID uint) error {
+	return db.Where("event_id = ?", eventID).
+		Order("start_time ASC, id ASC").
+		Find(templates).Error
+}
+
+// CreateBatch inserts generated slots, skipping those the template already has.
+// It returns how many slots were actually created.
+func (r *SlotRepositoryImpl) CreateBatch(db *gorm.DB, slots []*entity.TimeSlot) (int64, error) {
+	result := db.Omit(clause.Associations).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "template_id"}, {Name: "starts_at"}},
+			DoNothing: true,
+		}).
+		CreateInBatches(slots, 500)
+	return result.RowsAffected, result.Error
+}
+
+func (r *SlotRepositoryImpl) GetByID(db *gorm.DB, slot *entity.TimeSlot, id uint) error {
+	return db.Where("id = ?", id).Take(slot).Error
+}
+
+// GetByEventID lists an event's slots starting within [from, to) in calendar order.
+func (r *SlotRepositoryImpl) GetByEventID(db *gorm.DB, slots *[]entity.TimeSlot, eventID uint, from, to time.Time) error {
+	return db.Where("event_id = ? AND starts_at >= ? AND starts_at < ?", eventID, from, to).
+		Order("starts_at ASC, id ASC").
+		Find(slots).Error
+}
+
+// Reserve takes places in a slot, it fails when the slot does not have enough left.
+func (r *SlotRepositoryImpl) Reserve(db *gorm.DB, id uint, quantity int) error {
+	result := db.Model(&entity.TimeSlot{}).
+		Where("id = ? AND reserved + ? <= capacity", id, quantity).
+		Update("reserved", gorm.Expr("reserved + ?", quantity))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *SlotRepositoryImpl) CreateBooking(db *gorm.DB, booking *entity.SlotBooking) error {
+	return db.Omit(clause.Associations).Create(booking).Error
+}
+
+// ReleaseByOrderID gives an order's slot places back. The bookings are removed as well so a
+// repeated release cannot free places twice.
+func (r *SlotRepositoryImpl) GetBookingsByOrderID(db *gorm.DB, orderID uint) ([]*entity.SlotBooking, error) {
+	var bookings []*entity.SlotBooking
+	err := db.Where("order_id = ?", orderID).Find(&bookings).Error
+	return bookings, err
+}
+
+func (r *SlotRepositoryImpl) ReleaseByOrderID(db *gorm.DB, orderID uint) error {
+	if err := db.Exec(`UPDATE time_slots SET reserved = time_slots.reserved - slot_bookings.quantity
+		FROM slot_bookings
+		WHERE slot_bookings.slot_id = time_slots.id
+		AND slot_bookings.order_id = ? AND slot_bookings.deleted_at IS NULL`, orderID).Error; err != nil {
+		return err
+	}
+
+	return db.Where("order_id = ?", orderID).Delete(&entity.SlotBooking{}).Error
+}
@@ -1,6 +1,8 @@
 package ticket
 
 import (
+	"time"
+
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
 	"github.com/TrinityKnights/Backend/internal/domain/model"
 	"github.com/TrinityKnights/Backend/internal/repository"
@@ -11,5 +13,20 @@ type TicketRepository interface {
 	repository.Repository[entity.Ticket]
 	CreateBatch(db *gorm.DB, tickets []*entity.Ticket) error
 	Find(db *gorm.DB, filter *model.TicketQueryOptions) ([]*entity.Ticket, error)
-	GetLastTicketNumber(db *gorm.DB, eventID uint, ticketType string) (*entity.Ticket, error)
+	ReserveSeatNumbers(db *gorm.DB, eventID uint, ticketType string, count int) (int, error)
+	ReleaseByOrderID(db *gorm.DB, orderID uint) error
+	FindAvailableForHold(db *gorm.DB, eventID uint, ticketType string, limit int, now time.Time) ([]*entity.Ticket, error)
+	CountAvailable(db *gorm.DB, eventID uint, ticketType string, now time.Time) (int64, error)
+	FindByHoldToken(db *gorm.DB, token string) ([]*entity.Ticket, error)
+	Hold(db *gorm.DB, ticketIDs []string, token string, until time.Time) error
+	ReleaseHold(db *gorm.DB, token string) error
+	FindUnissuedByAllocation(db *gorm.DB, allocationID uint, limit int) ([]*entity.Ticket, error)
+	SetAllocation(db *gorm.DB, ticketIDs []string, allocationID *uint) error
+	AssignToOrder(db *gorm.DB, ticketIDs []string, orderID uint, attendeeName, attendeeEmail *string) error
+	CheckIn(db *gorm.DB, ticketID string, at time.Time) error
+	GetCapacity(db *gorm.DB, eventID uint) (*model.EventCapacity, error)
+	CountByCategory(db *gorm.DB, eventID uint) ([]*model.TicketCategoryCount, error)
+	GetCounters(db *gorm.DB, eventID uint) ([]*entity.EventTicketCounter, error)
+	ReleaseExpiredHolds(db *gorm.DB, now time.Time) (int64, error)
+	FindReserved(db *gorm.DB, eventID uint, now time.Time) ([]*entity.Ticket, error)
 }
@@ -2,12 +2,14 @@ package ticket
 
 import (
 	"strings"
+	"time"
 
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
 	"github.com/TrinityKnights/Backend/internal/domain/model"
 	"github.com/TrinityKnights/Backend/internal/repository"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type TicketRepositoryImpl struct {
@@ -55,7 +57,8 @@ func (r *TicketRepositoryImpl) Find(db *gorm.DB, opts *model.TicketQueryOptions)
 	// Main query with preloads
 	query := db.Model(&entity.Ticket{}).
 		Preload("Event").
-		Preload("Order")
+		Preload("Order").
+		Preload("Answers.Question")
 
 	// Apply same filters to main query
 	if opts.ID != nil {
@@ -120,30 +123,213 @@ func (r *TicketRepositoryImpl) Find(db *gorm.DB, opts *model.TicketQueryOptions)
 	return tickets, nil
 }
 
-func (r *TicketRepositoryImpl) GetLastTicketNumber(db *gorm.DB, eventID uint, ticketType string) (*entity.Ticket, error) {
-	var ticket entity.Ticket
+// ReserveSeatNumbers advances the event's counter for a ticket category by count and returns the
+// first number of the reserved range. The counter row stays locked until the transaction ends,
+// so concurrent batches for the same category get consecutive ranges and other events are not
+// affected.
+func (r *TicketRepositoryImpl) ReserveSeatNumbers(db *gorm.DB, eventID uint, ticketType string, count int) (int, error) {
+	var last int
+	err := db.Raw(`INSERT INTO ticket_seat_counters (event_id, type, last_number) VALUES (?, UPPER(?), ?)
+		ON CONFLICT (event_id, type) DO UPDATE
+		SET last_number = ticket_seat_counters.last_number + EXCLUDED.last_number, updated_at = now()
+		RETURNING last_number`, eventID, ticketType, count).
+		Scan(&last).Error
+	if err != nil {
+		return 0, err
+	}
+	return last - count + 1, nil
+}
 
-	err := db.Transaction(func(tx *gorm.DB) error {
-		// Lock the tickets table
-		if err := tx.Exec("LOCK TABLE tickets IN SHARE ROW EXCLUSIVE MODE").Error; err != nil {
-			return err
-		}
+// ReleaseByOrderID returns an order's tickets to sale, dropping the holder details captured for them.
+func (r *TicketRepositoryImpl) ReleaseByOrderID(db *gorm.DB, orderID uint) error {
+	if err := db.Unscoped().
+		Where("ticket_id IN (?)", db.Model(&entity.Ticket{}).Select("id").Where("order_id = ?", orderID)).
+		Delete(&entity.AttendeeAnswer{}).Error; err != nil {
+		return err
+	}
 
-		// Get the last ticket number for the specific event and type
-		result := tx.Where("event_id = ? AND type = ?", eventID, ticketType).
-			Order("CAST(SPLIT_PART(seat_number, '-', 2) AS INTEGER) DESC").
-			First(&ticket)
+	return db.Model(&entity.Ticket{}).
+		Where("order_id = ?", orderID).
+		Updates(map[string]interface{}{
+			"order_id":       nil,
+			"hold_token":     nil,
+			"held_until":     nil,
+			"attendee_name":  nil,
+			"attendee_email": nil,
+			"checked_in_at":  nil,
+		}).Error
+}
 
-		if result.Error != nil && result.Error != gorm.ErrRecordNotFound {
-			return result.Error
-		}
+// FindAvailableForHold locks unsold tickets of a category that are not under an active hold
+// or set aside in a partner allocation.
+// Rows already locked by another transaction are skipped rather than waited on.
+func (r *TicketRepositoryImpl) FindAvailableForHold(db *gorm.DB, eventID uint, ticketType string, limit int, now time.Time) ([]*entity.Ticket, error) {
+	var tickets []*entity.Ticket
+	err := db.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("event_id = ? AND UPPER(type) = UPPER(?) AND order_id IS NULL AND allocation_id IS NULL", eventID, ticketType).
+		Where("held_until IS NULL OR held_until < ?", now).
+		Order("seat_number ASC").
+		Limit(limit).
+		Find(&tickets).Error
+	return tickets, err
+}
+
+func (r *TicketRepositoryImpl) CountAvailable(db *gorm.DB, eventID uint, ticketType string, now time.Time) (int64, error) {
+	var count int64
+	err := db.Model(&entity.Ticket{}).
+		Where("event_id = ? AND UPPER(type) = UPPER(?) AND order_id IS NULL AND allocation_id IS NULL", eventID, ticketType).
+		Where("held_until IS NULL OR held_until < ?", now).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *TicketRepositoryImpl) FindByHoldToken(db *gorm.DB, token string) ([]*entity.Ticket, error) {
+	var tickets []*entity.Ticket
+	err := db.Where("hold_token = ?", token).
+		Order("seat_number ASC").
+		Find(&tickets).Error
+	return tickets, err
+}
+
+func (r *TicketRepositoryImpl) Hold(db *gorm.DB, ticketIDs []string, token string, until time.Time) error {
+	return db.Model(&entity.Ticket{}).
+		Where("id IN ?", ticketIDs).
+		Updates(map[string]interface{}{
+			"hold_token": token,
+			"held_until": until,
+		}).Error
+}
+
+func (r *TicketRepositoryImpl) ReleaseHold(db *gorm.DB, token string) error {
+	return db.Model(&entity.Ticket{}).
+		Where("hold_token = ? AND order_id IS NULL", token).
+		Updates(map[string]interface{}{
+			"hold_token": nil,
+			"held_until": nil,
+		}).Error
+}
+
+// FindUnissuedByAllocation locks the allocation's tickets that have not been issued yet.
+// A non-positive limit returns all of them.
+func (r *TicketRepositoryImpl) FindUnissuedByAllocation(db *gorm.DB, allocationID uint, limit int) ([]*entity.Ticket, error) {
+	query := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("allocation_id = ? AND order_id IS NULL", allocationID).
+		Order("seat_number ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var tickets []*entity.Ticket
+	err := query.Find(&tickets).Error
+	return tickets, err
+}
+
+// SetAllocation moves tickets into an allocation, or back to general sale when allocationID is nil.
+func (r *TicketRepositoryImpl) SetAllocation(db *gorm.DB, ticketIDs []string, allocationID *uint) error {
+	return db.Model(&entity.Ticket{}).
+		Where("id IN ?", ticketIDs).
+		Update("allocation_id", allocationID).Error
+}
 
-		return nil
-	})
+func (r *TicketRepositoryImpl) AssignToOrder(db *gorm.DB, ticketIDs []string, orderID uint, attendeeName, attendeeEmail *string) error {
+	return db.Model(&entity.Ticket{}).
+		Where("id IN ?", ticketIDs).
+		Updates(map[string]interface{}{
+			"order_id":       orderID,
+			"attendee_name":  attendeeName,
+			"attendee_email": attendeeEmail,
+		}).Error
+}
 
+// CheckIn records a ticket's admission at the door, it only succeeds once per ticket.
+func (r *TicketRepositoryImpl) CheckIn(db *gorm.DB, ticketID string, at time.Time) error {
+	result := db.Model(&entity.Ticket{}).
+		Where("id = ? AND checked_in_at IS NULL", ticketID).
+		Update("checked_in_at", at)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetCapacity reports how many tickets the event may have and how many it has. A venue without
+// a capacity leaves the event unlimited unless the event sets its own. Timed-entry slots count
+// towards the used capacity with the most places that are open at the same time, as visitors of
+// consecutive slots do not share the venue.
+func (r *TicketRepositoryImpl) GetCapacity(db *gorm.DB, eventID uint) (*model.EventCapacity, error) {
+	var capacity model.EventCapacity
+	err := db.Table("events").
+		Select(`events.id AS event_id, events.venue_id, venues.capacity AS venue_capacity, events.capacity_override,
+			COALESCE(events.capacity_override, NULLIF(venues.capacity, 0)) AS capacity,
+			(SELECT COUNT(*) FROM tickets WHERE tickets.event_id = events.id AND tickets.deleted_at IS NULL) +
+			COALESCE((SELECT MAX(concurrent.places) FROM (
+				SELECT SUM(open.capacity) AS places FROM time_slots AS slot
+				JOIN time_slots AS open ON open.event_id = slot.event_id AND open.deleted_at IS NULL
+					AND open.starts_at <= slot.starts_at AND open.ends_at > slot.starts_at
+				WHERE slot.event_id = events.id AND slot.deleted_at IS NULL
+				GROUP BY slot.id
+			) AS concurrent), 0) AS used`).
+		Joins("JOIN venues ON venues.id = events.venue_id").
+		Where("events.id = ? AND events.deleted_at IS NULL", eventID).
+		Take(&capacity).Error
 	if err != nil {
 		return nil, err
 	}
+	return &capacity, nil
+}
 
-	return &ticket, nil
+func (r *TicketRepositoryImpl) CountByCategory(db *gorm.DB, eventID uint) ([]*model.TicketCategoryCount, error) {
+	var counts []*model.TicketCategoryCount
+	err := db.Model(&entity.Ticket{}).
+		Select("type, COUNT(*) AS count").
+		Where("event_id = ?", eventID).
+		Group("type").
+		Order("type ASC").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// GetCounters reads the event's per-category tallies, adding up the shards of the state counts.
+// Categories whose tickets were all removed are left out.
+func (r *TicketRepositoryImpl) GetCounters(db *gorm.DB, eventID uint) ([]*entity.EventTicketCounter, error) {
+	var counters []*entity.EventTicketCounter
+	err := db.Table("event_ticket_counters AS c").
+		Select(`c.event_id, c.type, c.total,
+			COALESCE(SUM(s.sold), 0) AS sold,
+			COALESCE(SUM(s.held), 0) AS held,
+			COALESCE(SUM(s.allocated), 0) AS allocated,
+			c.min_price, c.max_price,
+			GREATEST(c.updated_at, MAX(s.updated_at)) AS updated_at`).
+		Joins("LEFT JOIN event_ticket_state_counters AS s ON s.event_id = c.event_id AND s.type = c.type").
+		Where("c.event_id = ? AND c.total > 0", eventID).
+		Group("c.event_id, c.type").
+		Order("c.type ASC").
+		Scan(&counters).Error
+	return counters, err
+}
+
+// ReleaseExpiredHolds returns unsold tickets whose hold lapsed to general sale, so the counters
+// stop reporting them as held.
+func (r *TicketRepositoryImpl) ReleaseExpiredHolds(db *gorm.DB, now time.Time) (int64, error) {
+	result := db.Model(&entity.Ticket{}).
+		Where("order_id IS NULL AND hold_token IS NOT NULL AND held_until < ?", now).
+		Updates(map[string]interface{}{
+			"hold_token": nil,
+			"held_until": nil,
+		})
+	return result.RowsAffected, result.Error
+}
+
+// FindReserved lists the event's tickets that cannot be bought right now, because they are sold,
+// set aside for a partner or under an active hold.
+func (r *TicketRepositoryImpl) FindReserved(db *gorm.DB, eventID uint, now time.Time) ([]*entity.Ticket, error) {
+	var tickets []*entity.Ticket
+	err := db.Select("id", "order_id", "allocation_id", "hold_token", "held_until").
+		Where("event_id = ?", eventID).
+		Where("order_id IS NOT NULL OR allocation_id IS NOT NULL OR (hold_token IS NOT NULL AND held_until >= ?)", now).
+		Find(&tickets).Error
+	return tickets, err
 }
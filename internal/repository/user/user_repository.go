@@ -14,4 +14,6 @@ type UserRepository interface {
 	CountByRole(db *gorm.DB, role string) (int64, error)
 	GetByResetPasswordToken(db *gorm.DB, user *entity.User, token string) error
 	GetByVerifyEmailToken(db *gorm.DB, user *entity.User, token string) error
+	UseTwoFactorStep(db *gorm.DB, id string, step int64) (int64, error)
+	FindIDsWithoutTwoFactor(db *gorm.DB, role string) ([]string, error)
 }
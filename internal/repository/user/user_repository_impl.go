@@ -43,4 +43,19 @@ func (r *UserRepositoryImpl) GetByResetPasswordToken(db *gorm.DB, user *entity.U
 
 func (r *UserRepositoryImpl) GetByVerifyEmailToken(db *gorm.DB, user *entity.User, token string) error  { 
 	return db.Where("verify_email_token = ?", token).Take(&user).Error 
-} 
\ No newline at end of file
+}
+
+// UseTwoFactorStep records the time step of an accepted TOTP code. It affects no rows when that
+// step or a later one was already used, so a code cannot be replayed.
+func (r *UserRepositoryImpl) UseTwoFactorStep(db *gorm.DB, id string, step int64) (int64, error) {
+	result := db.Model(&entity.User{}).
+		Where("id = ? AND two_factor_last_step < ?", id, step).
+		Update("two_factor_last_step", step)
+	return result.RowsAffected, result.Error
+}
+
+func (r *UserRepositoryImpl) FindIDsWithoutTwoFactor(db *gorm.DB, role string) ([]string, error) {
+	var ids []string
+	err := db.Model(&entity.User{}).Where("role = ? AND two_factor_enabled = ?", role, false).Pluck("id", &ids).Error
+	return ids, err
+}
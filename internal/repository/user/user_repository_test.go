@@ -46,7 +46,7 @@ func TestRepositoryImpl_Create(t *testing.T) {
 
 	// Mock the query for Create
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `users` (`id`,`email`,`password`,`name`,`role`,`status`,`last_login`,`verify_email_token`,`reset_password_token`,`is_verified`,`created_at`,`updated_at`,`deleted_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)")).
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `users` (`id`,`email`,`password`,`name`,`role`,`status`,`last_login`,`verify_email_token`,`reset_password_token`,`is_verified`,`two_factor_secret`,`two_factor_enabled`,`two_factor_last_step`,`created_at`,`updated_at`,`deleted_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)")).
 		WithArgs(
 			expectedUser.ID,
 			expectedUser.Email,
@@ -58,6 +58,9 @@ func TestRepositoryImpl_Create(t *testing.T) {
 			expectedUser.VerifyEmailToken,
 			expectedUser.ResetPasswordToken,
 			expectedUser.IsVerified,
+			expectedUser.TwoFactorSecret,
+			expectedUser.TwoFactorEnabled,
+			expectedUser.TwoFactorLastStep,
 			sqlmock.AnyArg(),
 			sqlmock.AnyArg(),
 			nil,
@@ -105,7 +108,7 @@ func TestRepositoryImpl_Update(t *testing.T) {
 
 	// Mock the query for Update
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET `email`=?,`password`=?,`name`=?,`role`=?,`status`=?,`last_login`=?,`verify_email_token`=?,`reset_password_token`=?,`is_verified`=?,`created_at`=?,`updated_at`=?,`deleted_at`=? WHERE `users`.`deleted_at` IS NULL AND `id` = ?")).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET `email`=?,`password`=?,`name`=?,`role`=?,`status`=?,`last_login`=?,`verify_email_token`=?,`reset_password_token`=?,`is_verified`=?,`two_factor_secret`=?,`two_factor_enabled`=?,`two_factor_last_step`=?,`created_at`=?,`updated_at`=?,`deleted_at`=? WHERE `users`.`deleted_at` IS NULL AND `id` = ?")).
 		WithArgs(
 			expectedUser.Email,
 			expectedUser.Password,
@@ -116,6 +119,9 @@ func TestRepositoryImpl_Update(t *testing.T) {
 			expectedUser.VerifyEmailToken,
 			expectedUser.ResetPasswordToken,
 			expectedUser.IsVerified,
+			expectedUser.TwoFactorSecret,
+			expectedUser.TwoFactorEnabled,
+			expectedUser.TwoFactorLastStep,
 			sqlmock.AnyArg(),
 			sqlmock.AnyArg(),
 			nil,
@@ -312,3 +318,68 @@ func TestUserRepository_CountByRole(t *testing.T) {
 	assert.Equal(t, expectedCount, count)
 	mock.ExpectationsWereMet()
 }
+
+func TestUserRepository_UseTwoFactorStep(t *testing.T) {
+	// Create SQL mock
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	// Initialize GORM with sqlmock
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	// Setup repository and logger
+	logger := logrus.New()
+	repo := user.NewUserRepository(gormDB, logger)
+
+	// A step that was already used matches no rows
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET `two_factor_last_step`=?,`updated_at`=? WHERE (id = ? AND two_factor_last_step < ?)")).
+		WithArgs(int64(100), sqlmock.AnyArg(), "123", int64(100)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	// Call the method
+	affected, err := repo.UseTwoFactorStep(gormDB, "123", 100)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), affected)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_FindIDsWithoutTwoFactor(t *testing.T) {
+	// Create SQL mock
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	// Initialize GORM with sqlmock
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	// Setup repository and logger
+	logger := logrus.New()
+	repo := user.NewUserRepository(gormDB, logger)
+
+	rows := sqlmock.NewRows([]string{"id"}).AddRow("1").AddRow("2")
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `users` WHERE (role = ? AND two_factor_enabled = ?)")).
+		WithArgs("admin", false).
+		WillReturnRows(rows)
+
+	// Call the method
+	ids, err := repo.FindIDsWithoutTwoFactor(gormDB, "admin")
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
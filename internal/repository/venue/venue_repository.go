@@ -1,6 +1,8 @@
 package venue
 
 import (
+	"time"
+
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
 	"github.com/TrinityKnights/Backend/internal/domain/model"
 	"github.com/TrinityKnights/Backend/internal/repository"
@@ -11,4 +13,6 @@ type VenueRepository interface {
 	repository.Repository[entity.Venue]
 	GetByID(db *gorm.DB, venue *entity.Venue, id uint) error
 	GetPaginated(db *gorm.DB, venues *[]entity.Venue, opts *model.VenueQueryOptions) (int64, error)
+	RezoneEvents(db *gorm.DB, venueID uint, timezone string) (int64, error)
+	GetBookings(db *gorm.DB, events *[]entity.Event, venueID uint, from, to time.Time) error
 }
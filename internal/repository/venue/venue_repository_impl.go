@@ -2,6 +2,7 @@ package venue
 
 import (
 	"strings"
+	"time"
 
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
 	"github.com/TrinityKnights/Backend/internal/domain/model"
@@ -105,3 +106,26 @@ func (r *VenueRepositoryImpl) buildPaginatedQuery(db *gorm.DB, opts *model.Venue
 
 	return query
 }
+
+// RezoneEvents moves the venue's events into another time zone. Their wall-clock date and time
+// stay as they are, so the instants they start and end at are worked out again.
+func (r *VenueRepositoryImpl) RezoneEvents(db *gorm.DB, venueID uint, timezone string) (int64, error) {
+	startsAt := gorm.Expr("(date + time) AT TIME ZONE ?", timezone)
+	result := db.Model(&entity.Event{}).
+		Where("venue_id = ?", venueID).
+		Updates(map[string]interface{}{
+			"timezone":  timezone,
+			"starts_at": startsAt,
+			"ends_at":   gorm.Expr("? + (ends_at - starts_at)", startsAt),
+		})
+	return result.RowsAffected, result.Error
+}
+
+// GetBookings lists the events occupying the venue at some point in [from, to), cancelled events
+// free the venue and are left out.
+func (r *VenueRepositoryImpl) GetBookings(db *gorm.DB, events *[]entity.Event, venueID uint, from, to time.Time) error {
+	return db.Where("venue_id = ? AND status <> ?", venueID, model.EventStatusCancelled).
+		Where("starts_at < ? AND COALESCE(ends_at, starts_at) >= ?", to, from).
+		Order("starts_at ASC, id ASC").
+		Find(events).Error
+}
@@ -0,0 +1,14 @@
+package waitingroom
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+type WaitingRoomRepository interface {
+	repository.Repository[entity.WaitingRoom]
+	GetByEventID(db *gorm.DB, room *entity.WaitingRoom, eventID uint) error
+	Upsert(db *gorm.DB, room *entity.WaitingRoom) error
+	DeleteByEventID(db *gorm.DB, eventID uint) (int64, error)
+}
@@ -0,0 +1,38 @@
+package waitingroom
+
+import (
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WaitingRoomRepositoryImpl struct {
+	repository.RepositoryImpl[entity.WaitingRoom]
+	Log *logrus.Logger
+}
+
+func NewWaitingRoomRepository(db *gorm.DB, log *logrus.Logger) *WaitingRoomRepositoryImpl {
+	return &WaitingRoomRepositoryImpl{
+		RepositoryImpl: repository.RepositoryImpl[entity.WaitingRoom]{DB: db},
+		Log:            log,
+	}
+}
+
+func (r *WaitingRoomRepositoryImpl) GetByEventID(db *gorm.DB, room *entity.WaitingRoom, eventID uint) error {
+	return db.Where("event_id = ?", eventID).Take(room).Error
+}
+
+// Upsert opens the event's waiting room or changes the admission rate of an open one.
+func (r *WaitingRoomRepositoryImpl) Upsert(db *gorm.DB, room *entity.WaitingRoom) error {
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"admission_rate", "updated_at"}),
+	}).Create(room).Error
+}
+
+func (r *WaitingRoomRepositoryImpl) DeleteByEventID(db *gorm.DB, eventID uint) (int64, error) {
+	result := db.Where("event_id = ?", eventID).Delete(&entity.WaitingRoom{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,20 @@
+package waitlist
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+type WaitlistRepository interface {
+	repository.Repository[entity.WaitlistEntry]
+	GetByID(db *gorm.DB, entry *entity.WaitlistEntry, id uint) error
+	GetByOfferToken(db *gorm.DB, entry *entity.WaitlistEntry, token string) error
+	GetActiveByUser(db *gorm.DB, entry *entity.WaitlistEntry, userID string, eventID uint, ticketType string) error
+	GetNextWaiting(db *gorm.DB, entry *entity.WaitlistEntry, eventID uint, ticketType string) error
+	GetExpiredOffers(db *gorm.DB, now time.Time) ([]entity.WaitlistEntry, error)
+	CountAhead(db *gorm.DB, entry *entity.WaitlistEntry) (int64, error)
+	GetPaginatedByUser(db *gorm.DB, entries *[]entity.WaitlistEntry, userID string, page, size int) (int64, error)
+}
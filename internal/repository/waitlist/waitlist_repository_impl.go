@@ -0,0 +1,84 @@
+package waitlist
+
+import (
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/repository"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WaitlistRepositoryImpl struct {
+	repository.RepositoryImpl[entity.WaitlistEntry]
+	Log *logrus.Logger
+}
+
+func NewWaitlistRepository(db *gorm.DB, log *logrus.Logger) *WaitlistRepositoryImpl {
+	return &WaitlistRepositoryImpl{
+		RepositoryImpl: repository.RepositoryImpl[entity.WaitlistEntry]{DB: db},
+		Log:            log,
+	}
+}
+
+func (r *WaitlistRepositoryImpl) GetByID(db *gorm.DB, entry *entity.WaitlistEntry, id uint) error {
+	return db.Where("id = ?", id).Take(entry).Error
+}
+
+func (r *WaitlistRepositoryImpl) GetByOfferToken(db *gorm.DB, entry *entity.WaitlistEntry, token string) error {
+	return db.Where("offer_token = ?", token).Take(entry).Error
+}
+
+func (r *WaitlistRepositoryImpl) GetActiveByUser(db *gorm.DB, entry *entity.WaitlistEntry, userID string, eventID uint, ticketType string) error {
+	return db.Where("user_id = ? AND event_id = ? AND type = ? AND status IN ?",
+		userID, eventID, ticketType,
+		[]model.WaitlistStatus{model.WaitlistStatusWaiting, model.WaitlistStatusOffered}).
+		Take(entry).Error
+}
+
+// GetNextWaiting locks and returns the oldest waiting entry for the event category.
+// Entries locked by a concurrent offer run are skipped.
+func (r *WaitlistRepositoryImpl) GetNextWaiting(db *gorm.DB, entry *entity.WaitlistEntry, eventID uint, ticketType string) error {
+	return db.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Preload("User").
+		Where("event_id = ? AND type = ? AND status = ?", eventID, ticketType, model.WaitlistStatusWaiting).
+		Order("created_at ASC, id ASC").
+		First(entry).Error
+}
+
+func (r *WaitlistRepositoryImpl) GetExpiredOffers(db *gorm.DB, now time.Time) ([]entity.WaitlistEntry, error) {
+	var entries []entity.WaitlistEntry
+	err := db.Where("status = ? AND offer_expires_at < ?", model.WaitlistStatusOffered, now).
+		Find(&entries).Error
+	return entries, err
+}
+
+func (r *WaitlistRepositoryImpl) CountAhead(db *gorm.DB, entry *entity.WaitlistEntry) (int64, error) {
+	var count int64
+	err := db.Model(&entity.WaitlistEntry{}).
+		Where("event_id = ? AND type = ? AND status = ? AND (created_at < ? OR (created_at = ? AND id < ?))",
+			entry.EventID, entry.Type, model.WaitlistStatusWaiting, entry.CreatedAt, entry.CreatedAt, entry.ID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *WaitlistRepositoryImpl) GetPaginatedByUser(db *gorm.DB, entries *[]entity.WaitlistEntry, userID string, page, size int) (int64, error) {
+	var totalItems int64
+	query := db.Model(&entity.WaitlistEntry{}).Where("user_id = ?", userID)
+
+	if err := query.Count(&totalItems).Error; err != nil {
+		return 0, err
+	}
+
+	offset := (page - 1) * size
+	if err := query.Order("created_at DESC").
+		Offset(offset).
+		Limit(size).
+		Find(entries).Error; err != nil {
+		return 0, err
+	}
+
+	return totalItems, nil
+}
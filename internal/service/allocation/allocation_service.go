@@ -0,0 +1,15 @@
+package allocation
+
+import (
+	"context"
+
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+)
+
+type AllocationService interface {
+	CreateAllocation(ctx context.Context, request *model.CreateAllocationRequest) (*model.AllocationResponse, error)
+	GetAllocations(ctx context.Context, request *model.AllocationsRequest) ([]*model.AllocationResponse, error)
+	GetAllocationByID(ctx context.Context, request *model.GetAllocationRequest) (*model.AllocationResponse, error)
+	IssueComps(ctx context.Context, request *model.IssueCompsRequest) ([]*model.OrderResponse, error)
+	ReleaseAllocation(ctx context.Context, request *model.ReleaseAllocationRequest) (*model.AllocationResponse, error)
+}
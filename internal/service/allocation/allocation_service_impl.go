@@ -0,0 +1,404 @@
+package allocation
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"html/template"
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/domain/model/converter"
+	"github.com/TrinityKnights/Backend/internal/repository/allocation"
+	"github.com/TrinityKnights/Backend/internal/repository/order"
+	"github.com/TrinityKnights/Backend/internal/repository/ticket"
+	"github.com/TrinityKnights/Backend/internal/service/reservation"
+	"github.com/TrinityKnights/Backend/internal/service/waitlist"
+	"github.com/TrinityKnights/Backend/pkg/cache"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/gomail"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//go:embed template/*.html
+var templateFS embed.FS
+
+type AllocationServiceImpl struct {
+	DB                   *gorm.DB
+	Cache                *cache.ImplCache
+	Log                  *logrus.Logger
+	Validate             *validator.Validate
+	AllocationRepository allocation.AllocationRepository
+	TicketRepository     ticket.TicketRepository
+	OrderRepository      order.OrderRepository
+	WaitlistService      waitlist.WaitlistService
+	ReservationService   reservation.ReservationService
+	Gomail               *gomail.ImplGomail
+	helper               *helper.ContextHelper
+}
+
+func NewAllocationServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, allocationRepository allocation.AllocationRepository, ticketRepository ticket.TicketRepository, orderRepository order.OrderRepository, waitlistService waitlist.WaitlistService, reservationService reservation.ReservationService, mail *gomail.ImplGomail) *AllocationServiceImpl {
+	return &AllocationServiceImpl{
+		DB:                   db,
+		Cache:                cacheImpl,
+		Log:                  log,
+		Validate:             validate,
+		AllocationRepository: allocationRepository,
+		TicketRepository:     ticketRepository,
+		OrderRepository:      orderRepository,
+		WaitlistService:      waitlistService,
+		ReservationService:   reservationService,
+		Gomail:               mail,
+		helper:               helper.NewContextHelper(),
+	}
+}
+
+// CreateAllocation sets aside a block of unsold tickets for a partner, taking them off public sale.
+func (s *AllocationServiceImpl) CreateAllocation(ctx context.Context, request *model.CreateAllocationRequest) (*model.AllocationResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	ticketType := helper.TicketUpper(request.Type)
+	if ticketType.Long == "" {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	var event entity.Event
+	if err := tx.First(&event, request.EventID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get event: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	tickets, err := s.TicketRepository.FindAvailableForHold(tx, event.ID, ticketType.Long, request.Quantity, time.Now())
+	if err != nil {
+		s.Log.Errorf("failed to find available tickets: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if len(tickets) < request.Quantity {
+		return nil, domainErrors.ErrNotEnoughTickets
+	}
+
+	data := &entity.Allocation{
+		EventID:     event.ID,
+		PartnerName: request.PartnerName,
+		Type:        ticketType.Long,
+		Quantity:    request.Quantity,
+		Status:      model.AllocationStatusActive,
+	}
+	if request.Notes != "" {
+		data.Notes = &request.Notes
+	}
+
+	if err := s.AllocationRepository.Create(tx.Omit(clause.Associations), data); err != nil {
+		s.Log.Errorf("failed to create allocation: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.TicketRepository.SetAllocation(tx, ticketIDs(tickets), &data.ID); err != nil {
+		s.Log.Errorf("failed to allocate tickets: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	s.deleteTicketCache(tickets)
+
+	return converter.AllocationEntityToResponse(data, 0), nil
+}
+
+func (s *AllocationServiceImpl) GetAllocations(ctx context.Context, request *model.AllocationsRequest) ([]*model.AllocationResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	db := s.DB.WithContext(ctx)
+
+	var allocations []entity.Allocation
+	if err := s.AllocationRepository.GetByEventID(db, &allocations, request.EventID); err != nil {
+		s.Log.Errorf("failed to get allocations: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if len(allocations) == 0 {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	ids := make([]uint, len(allocations))
+	for i := range allocations {
+		ids[i] = allocations[i].ID
+	}
+
+	issued, err := s.AllocationRepository.CountIssued(db, ids)
+	if err != nil {
+		s.Log.Errorf("failed to count issued tickets: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.AllocationsToResponses(allocations, issued), nil
+}
+
+func (s *AllocationServiceImpl) GetAllocationByID(ctx context.Context, request *model.GetAllocationRequest) (*model.AllocationResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	db := s.DB.WithContext(ctx)
+
+	data := &entity.Allocation{}
+	if err := s.AllocationRepository.GetByID(db, data, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get allocation: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	issued, err := s.AllocationRepository.CountIssued(db, []uint{data.ID})
+	if err != nil {
+		s.Log.Errorf("failed to count issued tickets: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.AllocationEntityToResponse(data, issued[data.ID]), nil
+}
+
+// IssueComps creates one zero-priced complimentary order per recipient from the allocation's
+// unissued tickets and emails each recipient their tickets once the orders are committed.
+func (s *AllocationServiceImpl) IssueComps(ctx context.Context, request *model.IssueCompsRequest) ([]*model.OrderResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data := &entity.Allocation{}
+	if err := s.AllocationRepository.GetByID(tx.Clauses(clause.Locking{Strength: "UPDATE"}), data, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get allocation: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if data.Status != model.AllocationStatusActive {
+		return nil, domainErrors.ErrNotEnoughTickets
+	}
+
+	total := 0
+	for i := range request.Recipients {
+		if request.Recipients[i].Quantity <= 0 {
+			request.Recipients[i].Quantity = 1
+		}
+		total += request.Recipients[i].Quantity
+	}
+
+	tickets, err := s.TicketRepository.FindUnissuedByAllocation(tx, data.ID, total)
+	if err != nil {
+		s.Log.Errorf("failed to get allocated tickets: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if len(tickets) < total {
+		return nil, domainErrors.ErrNotEnoughTickets
+	}
+
+	orders := make([]entity.Order, len(request.Recipients))
+	now := time.Now()
+	for i, recipient := range request.Recipients {
+		name, email := recipient.Name, recipient.Email
+		orders[i] = entity.Order{
+			UserID:         &claims.UserID,
+			Date:           now,
+			TotalPrice:     0,
+			Complimentary:  true,
+			AllocationID:   &data.ID,
+			RecipientName:  &name,
+			RecipientEmail: &email,
+		}
+
+		if err := s.OrderRepository.Create(tx.Omit(clause.Associations), &orders[i]); err != nil {
+			s.Log.Errorf("failed to create complimentary order: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		issued := tickets[:recipient.Quantity]
+		tickets = tickets[recipient.Quantity:]
+		if err := s.TicketRepository.AssignToOrder(tx, ticketIDs(issued), orders[i].ID, &name, &email); err != nil {
+			s.Log.Errorf("failed to assign complimentary tickets: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		if err := tx.Preload("Tickets").First(&orders[i], orders[i].ID).Error; err != nil {
+			s.Log.Errorf("failed to reload order: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	s.Log.Infof("issued %d complimentary ticket(s) from allocation %d to %d recipient(s) by %s",
+		total, data.ID, len(orders), claims.UserID)
+
+	if err := s.Cache.DeletePattern("order:get:page:*"); err != nil {
+		s.Log.Errorf("failed to delete cache: %v", err)
+	}
+
+	var event entity.Event
+	if err := s.DB.WithContext(ctx).First(&event, data.EventID).Error; err != nil {
+		s.Log.Errorf("failed to get event: %v", err)
+	}
+
+	responses := make([]*model.OrderResponse, len(orders))
+	for i := range orders {
+		if err := s.sendCompEmail(&event, data, &orders[i]); err != nil {
+			s.Log.Errorf("failed to send complimentary ticket email for order %d: %v", orders[i].ID, err)
+		}
+		responses[i] = converter.OrderEntityToResponse(&orders[i])
+	}
+
+	return responses, nil
+}
+
+// ReleaseAllocation hands unissued tickets back to general sale, all of them unless a quantity is given.
+// Waitlisted buyers for the category are offered the released tickets.
+func (s *AllocationServiceImpl) ReleaseAllocation(ctx context.Context, request *model.ReleaseAllocationRequest) (*model.AllocationResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data := &entity.Allocation{}
+	if err := s.AllocationRepository.GetByID(tx.Clauses(clause.Locking{Strength: "UPDATE"}), data, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get allocation: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	unissued, err := s.TicketRepository.FindUnissuedByAllocation(tx, data.ID, 0)
+	if err != nil {
+		s.Log.Errorf("failed to get allocated tickets: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if len(unissued) == 0 || request.Quantity > len(unissued) {
+		return nil, domainErrors.ErrNotEnoughTickets
+	}
+
+	released := unissued
+	if request.Quantity > 0 {
+		// Give back the highest seats first so the partner keeps a contiguous block
+		released = unissued[len(unissued)-request.Quantity:]
+	}
+
+	if err := s.TicketRepository.SetAllocation(tx, ticketIDs(released), nil); err != nil {
+		s.Log.Errorf("failed to release allocated tickets: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	data.Quantity -= len(released)
+	if len(released) == len(unissued) {
+		data.Status = model.AllocationStatusReleased
+	}
+
+	if err := s.AllocationRepository.Update(tx.Omit(clause.Associations), data); err != nil {
+		s.Log.Errorf("failed to update allocation: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	issued, err := s.AllocationRepository.CountIssued(tx, []uint{data.ID})
+	if err != nil {
+		s.Log.Errorf("failed to count issued tickets: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	s.deleteTicketCache(released)
+	s.ReservationService.Free(ctx, released)
+
+	s.WaitlistService.OfferReleasedTickets(ctx, released)
+
+	return converter.AllocationEntityToResponse(data, issued[data.ID]), nil
+}
+
+func (s *AllocationServiceImpl) sendCompEmail(event *entity.Event, data *entity.Allocation, order *entity.Order) error {
+	var replaceEmail = struct {
+		Name        string
+		EventName   string
+		PartnerName string
+		OrderID     uint
+		Tickets     []entity.Ticket
+	}{
+		Name:        helper.StringOrEmpty(order.RecipientName),
+		EventName:   event.Name,
+		PartnerName: data.PartnerName,
+		OrderID:     order.ID,
+		Tickets:     order.Tickets,
+	}
+
+	tmpl, err := template.ParseFS(templateFS, "template/comp-tickets.html")
+	if err != nil {
+		return err
+	}
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, &replaceEmail); err != nil {
+		return err
+	}
+
+	return s.Gomail.SendEmail(&gomail.SendEmail{
+		EmailTo:   helper.StringOrEmpty(order.RecipientEmail),
+		EmailFrom: s.Gomail.GetFromEmail(),
+		Subject:   "[TrinityKnights] Your Complimentary Tickets",
+		Body:      body,
+	})
+}
+
+func (s *AllocationServiceImpl) deleteTicketCache(tickets []*entity.Ticket) {
+	for _, t := range tickets {
+		if err := s.Cache.Delete(fmt.Sprintf("ticket:get:id:%s", t.ID)); err != nil {
+			s.Log.Errorf("failed to delete cache: %v", err)
+		}
+	}
+}
+
+func ticketIDs(tickets []*entity.Ticket) []string {
+	ids := make([]string, len(tickets))
+	for i, t := range tickets {
+		ids[i] = t.ID
+	}
+	return ids
+}
@@ -0,0 +1,19 @@
+package attendee
+
+import (
+	"context"
+
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"gorm.io/gorm"
+)
+
+type AttendeeService interface {
+	CreateQuestion(ctx context.Context, request *model.CreateAttendeeQuestionRequest) (*model.AttendeeQuestionResponse, error)
+	UpdateQuestion(ctx context.Context, request *model.UpdateAttendeeQuestionRequest) (*model.AttendeeQuestionResponse, error)
+	DeleteQuestion(ctx context.Context, request *model.DeleteAttendeeQuestionRequest) error
+	GetQuestions(ctx context.Context, request *model.GetAttendeeQuestionsRequest) ([]*model.AttendeeQuestionResponse, error)
+	SaveAttendees(ctx context.Context, tx *gorm.DB, eventID, orderID uint, requests []model.AttendeeRequest) error
+	UpdateAttendee(ctx context.Context, request *model.UpdateAttendeeRequest) (*model.TicketResponse, error)
+	GetAttendees(ctx context.Context, request *model.AttendeesRequest) (*model.Response[[]*model.TicketResponse], error)
+	ExportAttendees(ctx context.Context, request *model.ExportAttendeesRequest) ([]byte, error)
+}
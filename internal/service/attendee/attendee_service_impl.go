@@ -0,0 +1,453 @@
+package attendee
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/domain/model/converter"
+	"github.com/TrinityKnights/Backend/internal/repository/attendee"
+	"github.com/TrinityKnights/Backend/internal/repository/ticket"
+	"github.com/TrinityKnights/Backend/pkg/cache"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type AttendeeServiceImpl struct {
+	DB                 *gorm.DB
+	Cache              *cache.ImplCache
+	Log                *logrus.Logger
+	Validate           *validator.Validate
+	AttendeeRepository attendee.AttendeeRepository
+	TicketRepository   ticket.TicketRepository
+	helper             *helper.ContextHelper
+}
+
+func NewAttendeeServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, attendeeRepository attendee.AttendeeRepository, ticketRepository ticket.TicketRepository) *AttendeeServiceImpl {
+	return &AttendeeServiceImpl{
+		DB:                 db,
+		Cache:              cacheImpl,
+		Log:                log,
+		Validate:           validate,
+		AttendeeRepository: attendeeRepository,
+		TicketRepository:   ticketRepository,
+		helper:             helper.NewContextHelper(),
+	}
+}
+
+func (s *AttendeeServiceImpl) CreateQuestion(ctx context.Context, request *model.CreateAttendeeQuestionRequest) (*model.AttendeeQuestionResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	var event entity.Event
+	if err := tx.First(&event, request.EventID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get event: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	data := &entity.AttendeeQuestion{
+		EventID:  event.ID,
+		Label:    request.Label,
+		Type:     request.Type,
+		Options:  request.Options,
+		Required: request.Required,
+		Position: request.Position,
+	}
+
+	if err := s.AttendeeRepository.Create(tx, data); err != nil {
+		s.Log.Errorf("failed to create attendee question: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.AttendeeQuestionEntityToResponse(data), nil
+}
+
+func (s *AttendeeServiceImpl) UpdateQuestion(ctx context.Context, request *model.UpdateAttendeeQuestionRequest) (*model.AttendeeQuestionResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data := &entity.AttendeeQuestion{}
+	if err := s.AttendeeRepository.GetQuestionByID(tx, data, request.EventID, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get attendee question: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if request.Label != "" {
+		data.Label = request.Label
+	}
+	if request.Type != "" {
+		data.Type = request.Type
+	}
+	if request.Options != nil {
+		data.Options = request.Options
+	}
+	if request.Required != nil {
+		data.Required = *request.Required
+	}
+	if request.Position != nil {
+		data.Position = *request.Position
+	}
+
+	if data.Type == string(model.AttendeeQuestionSelect) && len(data.Options) == 0 {
+		return nil, domainErrors.ErrValidation
+	}
+
+	if err := s.AttendeeRepository.Update(tx, data); err != nil {
+		s.Log.Errorf("failed to update attendee question: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.AttendeeQuestionEntityToResponse(data), nil
+}
+
+func (s *AttendeeServiceImpl) DeleteQuestion(ctx context.Context, request *model.DeleteAttendeeQuestionRequest) error {
+	if err := s.Validate.Struct(request); err != nil {
+		return domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data := &entity.AttendeeQuestion{}
+	if err := s.AttendeeRepository.GetQuestionByID(tx, data, request.EventID, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get attendee question: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	if err := s.AttendeeRepository.Delete(tx, data); err != nil {
+		s.Log.Errorf("failed to delete attendee question: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domainErrors.ErrInternalServer
+	}
+
+	return nil
+}
+
+func (s *AttendeeServiceImpl) GetQuestions(ctx context.Context, request *model.GetAttendeeQuestionsRequest) ([]*model.AttendeeQuestionResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	var questions []entity.AttendeeQuestion
+	if err := s.AttendeeRepository.GetQuestionsByEventID(s.DB.WithContext(ctx), &questions, request.EventID); err != nil {
+		s.Log.Errorf("failed to get attendee questions: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.AttendeeQuestionsToResponses(questions), nil
+}
+
+// SaveAttendees stores holder details captured at checkout inside the caller's order transaction.
+func (s *AttendeeServiceImpl) SaveAttendees(ctx context.Context, tx *gorm.DB, eventID, orderID uint, requests []model.AttendeeRequest) error {
+	var questions []entity.AttendeeQuestion
+	if err := s.AttendeeRepository.GetQuestionsByEventID(tx, &questions, eventID); err != nil {
+		s.Log.Errorf("failed to get attendee questions: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	seen := make(map[string]bool, len(requests))
+	for i := range requests {
+		if err := s.Validate.Struct(&requests[i]); err != nil {
+			return domainErrors.ErrValidation
+		}
+		if seen[requests[i].TicketID] {
+			return domainErrors.ErrValidation
+		}
+		seen[requests[i].TicketID] = true
+
+		var t entity.Ticket
+		if err := tx.Where("id = ? AND event_id = ? AND order_id = ?", requests[i].TicketID, eventID, orderID).
+			Take(&t).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domainErrors.ErrValidation
+			}
+			s.Log.Errorf("failed to get ticket: %v", err)
+			return domainErrors.ErrInternalServer
+		}
+
+		if err := s.saveAttendee(tx, t.ID, requests[i].Name, requests[i].Email, requests[i].Answers, questions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *AttendeeServiceImpl) UpdateAttendee(ctx context.Context, request *model.UpdateAttendeeRequest) (*model.TicketResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	var t entity.Ticket
+	if err := tx.Preload("Event").Preload("Order").
+		Where("id = ?", request.TicketID).
+		Take(&t).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get ticket: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if t.OrderID == nil {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	if err := s.helper.VerifyOwnership(ctx, helper.StringOrEmpty(t.Order.UserID)); err != nil {
+		return nil, domainErrors.ErrForbidden
+	}
+
+	// Organisers may still correct details after holders have been locked out
+	if !s.helper.IsAdmin(ctx) && time.Now().After(editCutoff(&t.Event)) {
+		return nil, domainErrors.ErrEditWindowClosed
+	}
+
+	var questions []entity.AttendeeQuestion
+	if err := s.AttendeeRepository.GetQuestionsByEventID(tx, &questions, t.EventID); err != nil {
+		s.Log.Errorf("failed to get attendee questions: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.saveAttendee(tx, t.ID, request.Name, request.Email, request.Answers, questions); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Preload("Answers.Question").Where("id = ?", t.ID).Take(&t).Error; err != nil {
+		s.Log.Errorf("failed to reload ticket: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.Cache.Delete(fmt.Sprintf("ticket:get:id:%s", t.ID)); err != nil {
+		s.Log.Errorf("failed to delete cache: %v", err)
+	}
+
+	return converter.TicketEntityToResponse(&t), nil
+}
+
+func (s *AttendeeServiceImpl) GetAttendees(ctx context.Context, request *model.AttendeesRequest) (*model.Response[[]*model.TicketResponse], error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	if request.Page <= 0 {
+		request.Page = 1
+	}
+	if request.Size <= 0 {
+		request.Size = 10
+	}
+
+	var tickets []*entity.Ticket
+	totalItems, err := s.AttendeeRepository.GetPaginatedByEvent(s.DB.WithContext(ctx), &tickets, request.EventID, request.Page, request.Size)
+	if err != nil {
+		s.Log.Errorf("failed to get attendees: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if totalItems == 0 || len(tickets) == 0 {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	return converter.AttendeesToPaginatedResponse(tickets, totalItems, request.Page, request.Size), nil
+}
+
+// ExportAttendees renders every sold ticket of an event as CSV, one column per attendee question.
+func (s *AttendeeServiceImpl) ExportAttendees(ctx context.Context, request *model.ExportAttendeesRequest) ([]byte, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	db := s.DB.WithContext(ctx)
+
+	var questions []entity.AttendeeQuestion
+	if err := s.AttendeeRepository.GetQuestionsByEventID(db, &questions, request.EventID); err != nil {
+		s.Log.Errorf("failed to get attendee questions: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	var tickets []*entity.Ticket
+	if err := s.AttendeeRepository.GetAllByEvent(db, &tickets, request.EventID); err != nil {
+		s.Log.Errorf("failed to get attendees: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	header := []string{"ticket_id", "seat_number", "type", "order_id", "buyer_id", "complimentary", "name", "email"}
+	for i := range questions {
+		header = append(header, questions[i].Label)
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		s.Log.Errorf("failed to write csv: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	for _, t := range tickets {
+		answers := make(map[uint]string, len(t.Answers))
+		for i := range t.Answers {
+			answers[t.Answers[i].QuestionID] = t.Answers[i].Value
+		}
+
+		row := []string{
+			t.ID,
+			t.SeatNumber,
+			t.Type,
+			strconv.FormatUint(uint64(helper.UintOrZero(t.OrderID)), 10),
+			helper.StringOrEmpty(t.Order.UserID),
+			strconv.FormatBool(t.Order.Complimentary),
+			helper.StringOrEmpty(t.AttendeeName),
+			helper.StringOrEmpty(t.AttendeeEmail),
+		}
+		for i := range questions {
+			row = append(row, answers[questions[i].ID])
+		}
+
+		if err := w.Write(row); err != nil {
+			s.Log.Errorf("failed to write csv: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		s.Log.Errorf("failed to write csv: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *AttendeeServiceImpl) saveAttendee(tx *gorm.DB, ticketID, name, email string, requests []model.AttendeeAnswerRequest, questions []entity.AttendeeQuestion) error {
+	answers, err := s.buildAnswers(ticketID, requests, questions)
+	if err != nil {
+		return err
+	}
+
+	var emailPtr *string
+	if email != "" {
+		emailPtr = &email
+	}
+
+	if err := s.AttendeeRepository.UpdateTicketAttendee(tx, ticketID, name, emailPtr); err != nil {
+		s.Log.Errorf("failed to update ticket attendee: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	if err := s.AttendeeRepository.ReplaceAnswers(tx, ticketID, answers); err != nil {
+		s.Log.Errorf("failed to save attendee answers: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	return nil
+}
+
+// buildAnswers checks submitted answers against the event's questions and rejects
+// unknown questions, malformed values and missing required answers.
+func (s *AttendeeServiceImpl) buildAnswers(ticketID string, requests []model.AttendeeAnswerRequest, questions []entity.AttendeeQuestion) ([]entity.AttendeeAnswer, error) {
+	byID := make(map[uint]*entity.AttendeeQuestion, len(questions))
+	for i := range questions {
+		byID[questions[i].ID] = &questions[i]
+	}
+
+	answered := make(map[uint]bool, len(requests))
+	answers := make([]entity.AttendeeAnswer, 0, len(requests))
+	for _, request := range requests {
+		question, ok := byID[request.QuestionID]
+		if !ok || answered[request.QuestionID] {
+			return nil, domainErrors.ErrValidation
+		}
+		if request.Value == "" {
+			continue
+		}
+		if !s.validAnswer(question, request.Value) {
+			return nil, domainErrors.ErrValidation
+		}
+
+		answered[request.QuestionID] = true
+		answers = append(answers, entity.AttendeeAnswer{
+			TicketID:   ticketID,
+			QuestionID: request.QuestionID,
+			Value:      request.Value,
+		})
+	}
+
+	for i := range questions {
+		if questions[i].Required && !answered[questions[i].ID] {
+			return nil, domainErrors.ErrValidation
+		}
+	}
+
+	return answers, nil
+}
+
+func (s *AttendeeServiceImpl) validAnswer(question *entity.AttendeeQuestion, value string) bool {
+	switch model.AttendeeQuestionType(question.Type) {
+	case model.AttendeeQuestionEmail:
+		return s.Validate.Var(value, "email") == nil
+	case model.AttendeeQuestionNumber:
+		_, err := strconv.ParseFloat(value, 64)
+		return err == nil
+	case model.AttendeeQuestionSelect:
+		return slices.Contains(question.Options, value)
+	case model.AttendeeQuestionCheckbox:
+		_, err := strconv.ParseBool(value)
+		return err == nil
+	default:
+		return true
+	}
+}
+
+// editCutoff is the organiser's cutoff, or the event start when none was set.
+func editCutoff(event *entity.Event) time.Time {
+	if event.AttendeeEditCutoff != nil {
+		return *event.AttendeeEditCutoff
+	}
+
+	return event.StartsAt
+}
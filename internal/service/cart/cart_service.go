@@ -0,0 +1,15 @@
+package cart
+
+import (
+	"context"
+
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+)
+
+type CartService interface {
+	GetCart(ctx context.Context) (*model.CartResponse, error)
+	AddItem(ctx context.Context, request *model.AddCartItemRequest) (*model.CartResponse, error)
+	RemoveItem(ctx context.Context, request *model.RemoveCartItemRequest) (*model.CartResponse, error)
+	ClearCart(ctx context.Context) error
+	Checkout(ctx context.Context, request *model.CheckoutCartRequest) (*model.OrderResponse, error)
+}
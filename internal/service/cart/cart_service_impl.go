@@ -0,0 +1,395 @@
+package cart
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/domain/model/converter"
+	"github.com/TrinityKnights/Backend/internal/repository/order"
+	"github.com/TrinityKnights/Backend/internal/repository/ticket"
+	"github.com/TrinityKnights/Backend/internal/service/payment"
+	"github.com/TrinityKnights/Backend/internal/service/reservation"
+	"github.com/TrinityKnights/Backend/internal/service/waitingroom"
+	"github.com/TrinityKnights/Backend/pkg/cache"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	DefaultCartTTL = 15 * time.Minute
+	MaxCartItems   = 20
+)
+
+// cart is the Redis representation of a buyer's cart. Tickets are not held while in a cart,
+// availability is checked again at checkout.
+type cart struct {
+	Items     []model.CartItemResponse `json:"items"`
+	ExpiresAt time.Time                `json:"expires_at"`
+}
+
+type CartServiceImpl struct {
+	DB                 *gorm.DB
+	Cache              *cache.ImplCache
+	Log                *logrus.Logger
+	Validate           *validator.Validate
+	OrderRepository    order.OrderRepository
+	TicketRepository   ticket.TicketRepository
+	PaymentService     payment.PaymentService
+	WaitingRoomService waitingroom.WaitingRoomService
+	ReservationService reservation.ReservationService
+	TTL                time.Duration
+	helper             *helper.ContextHelper
+}
+
+func NewCartServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, orderRepository order.OrderRepository, ticketRepository ticket.TicketRepository, paymentService payment.PaymentService, waitingRoomService waitingroom.WaitingRoomService, reservationService reservation.ReservationService, ttl time.Duration) *CartServiceImpl {
+	if ttl <= 0 {
+		ttl = DefaultCartTTL
+	}
+
+	return &CartServiceImpl{
+		DB:                 db,
+		Cache:              cacheImpl,
+		Log:                log,
+		Validate:           validate,
+		OrderRepository:    orderRepository,
+		TicketRepository:   ticketRepository,
+		PaymentService:     paymentService,
+		WaitingRoomService: waitingRoomService,
+		ReservationService: reservationService,
+		TTL:                ttl,
+		helper:             helper.NewContextHelper(),
+	}
+}
+
+func (s *CartServiceImpl) GetCart(ctx context.Context) (*model.CartResponse, error) {
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	c, err := s.load(claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.CartToResponse(c.Items, c.ExpiresAt), nil
+}
+
+// AddItem puts an available ticket in the caller's cart and extends the cart's lifetime.
+func (s *CartServiceImpl) AddItem(ctx context.Context, request *model.AddCartItemRequest) (*model.CartResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	c, err := s.load(claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range c.Items {
+		if strings.EqualFold(c.Items[i].TicketID, request.TicketID) {
+			return nil, domainErrors.ErrDuplicateEntry
+		}
+	}
+
+	if len(c.Items) >= MaxCartItems {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tickets, err := s.TicketRepository.Find(s.DB.WithContext(ctx), &model.TicketQueryOptions{
+		ID: &request.TicketID,
+	})
+	if err != nil {
+		s.Log.Errorf("failed to get ticket: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if len(tickets) == 0 {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	t := tickets[0]
+	if t.Event.Status != model.EventStatusPublished {
+		return nil, domainErrors.ErrEventNotOnSale
+	}
+
+	if t.OrderID != nil || t.AllocationID != nil || (t.HeldUntil != nil && t.HeldUntil.After(time.Now())) {
+		return nil, domainErrors.ErrSeatAlreadyTaken
+	}
+
+	c.Items = append(c.Items, model.CartItemResponse{
+		TicketID:   t.ID,
+		EventID:    t.EventID,
+		EventName:  t.Event.Name,
+		Type:       t.Type,
+		SeatNumber: t.SeatNumber,
+		Price:      t.Price,
+		AddedAt:    helper.FormatDate(time.Now()),
+	})
+
+	if err := s.save(claims.UserID, c); err != nil {
+		return nil, err
+	}
+
+	return converter.CartToResponse(c.Items, c.ExpiresAt), nil
+}
+
+func (s *CartServiceImpl) RemoveItem(ctx context.Context, request *model.RemoveCartItemRequest) (*model.CartResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	c, err := s.load(claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	index := slices.IndexFunc(c.Items, func(item model.CartItemResponse) bool {
+		return strings.EqualFold(item.TicketID, request.TicketID)
+	})
+	if index < 0 {
+		return nil, domainErrors.ErrNotFound
+	}
+	c.Items = slices.Delete(c.Items, index, index+1)
+
+	if len(c.Items) == 0 {
+		if err := s.Cache.Delete(cartKey(claims.UserID)); err != nil {
+			s.Log.Errorf("failed to delete cart: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+		return converter.CartToResponse(c.Items, time.Time{}), nil
+	}
+
+	if err := s.save(claims.UserID, c); err != nil {
+		return nil, err
+	}
+
+	return converter.CartToResponse(c.Items, c.ExpiresAt), nil
+}
+
+func (s *CartServiceImpl) ClearCart(ctx context.Context) error {
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		return domainErrors.ErrUnauthorized
+	}
+
+	if err := s.Cache.Delete(cartKey(claims.UserID)); err != nil {
+		s.Log.Errorf("failed to delete cart: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	return nil
+}
+
+// Checkout turns the cart into a single order and invoice, which may span several events.
+// Tickets are locked in ID order so concurrent checkouts of overlapping carts cannot deadlock.
+// A price that changed since the ticket was added is not charged silently, the cart is updated
+// and the buyer has to check out again.
+func (s *CartServiceImpl) Checkout(ctx context.Context, request *model.CheckoutCartRequest) (*model.OrderResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	c, err := s.load(claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.Items) == 0 {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	ticketIDs := make([]string, len(c.Items))
+	items := make(map[string]*model.CartItemResponse, len(c.Items))
+	eventIDs := make([]uint, 0, len(c.Items))
+	for i := range c.Items {
+		ticketIDs[i] = c.Items[i].TicketID
+		items[c.Items[i].TicketID] = &c.Items[i]
+		eventIDs = append(eventIDs, c.Items[i].EventID)
+	}
+	slices.Sort(ticketIDs)
+	slices.Sort(eventIDs)
+	eventIDs = slices.Compact(eventIDs)
+
+	// Every event whose waiting room is open needs its own admission
+	if err := s.WaitingRoomService.ClaimAdmissions(ctx, eventIDs, request.AdmissionTokens); err != nil {
+		return nil, err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			s.WaitingRoomService.ReleaseAdmissions(ctx, eventIDs, request.AdmissionTokens)
+		}
+	}()
+
+	// Seats are claimed in Redis first so that a crowd going for the same seats does not queue on
+	// their row locks
+	seats := make(map[uint][]string, len(eventIDs))
+	for _, id := range ticketIDs {
+		seats[items[id].EventID] = append(seats[items[id].EventID], id)
+	}
+	claimOwner := uuid.NewString()
+	if err := s.ReservationService.ClaimSeats(ctx, seats, claimOwner); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if committed {
+			s.ReservationService.ConfirmSeats(ctx, seats, claimOwner)
+		} else {
+			s.ReservationService.ReleaseSeats(ctx, seats, claimOwner)
+		}
+	}()
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	// Events are share-locked so that none of them stops selling while the order is placed
+	var events []entity.Event
+	if err := tx.Clauses(clause.Locking{Strength: "SHARE"}).Where("id IN ?", eventIDs).Find(&events).Error; err != nil {
+		s.Log.Errorf("failed to get events: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if len(events) != len(eventIDs) {
+		return nil, domainErrors.ErrEventNotOnSale
+	}
+	for i := range events {
+		if events[i].Status != model.EventStatusPublished {
+			s.Log.Warnf("cart checkout for user %s failed, event %d is %s", claims.UserID, events[i].ID, events[i].Status)
+			return nil, domainErrors.ErrEventNotOnSale
+		}
+	}
+
+	now := time.Now()
+	totalPrice := 0.0
+	priceChanged := false
+	for _, ticketID := range ticketIDs {
+		var t entity.Ticket
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND event_id = ? AND order_id IS NULL AND allocation_id IS NULL", ticketID, items[ticketID].EventID).
+			Where("held_until IS NULL OR held_until < ?", now).
+			First(&t).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				s.Log.Warnf("cart checkout for user %s failed, ticket %s is no longer available", claims.UserID, ticketID)
+				return nil, domainErrors.ErrSeatAlreadyTaken
+			}
+			s.Log.Errorf("failed to lock ticket: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		if t.Price != items[ticketID].Price {
+			items[ticketID].Price = t.Price
+			priceChanged = true
+		}
+		totalPrice += t.Price
+	}
+
+	if priceChanged {
+		s.Log.Infof("cart checkout for user %s stopped, prices changed since the tickets were added", claims.UserID)
+		if err := s.save(claims.UserID, c); err != nil {
+			return nil, err
+		}
+		return nil, domainErrors.ErrPriceChanged
+	}
+
+	dataOrder := entity.Order{
+		UserID:     &claims.UserID,
+		Date:       now,
+		TotalPrice: totalPrice,
+	}
+
+	if err := s.OrderRepository.Create(tx.Omit(clause.Associations), &dataOrder); err != nil {
+		s.Log.Errorf("failed to create order: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.TicketRepository.AssignToOrder(tx, ticketIDs, dataOrder.ID, nil, nil); err != nil {
+		s.Log.Errorf("failed to assign tickets: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Preload("Tickets").First(&dataOrder, dataOrder.ID).Error; err != nil {
+		s.Log.Errorf("failed to reload order: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	p, err := s.PaymentService.CreateInvoice(ctx, tx, &model.CreatePaymentRequest{
+		OrderID: dataOrder.ID,
+		Amount:  dataOrder.TotalPrice,
+	})
+	if err != nil {
+		s.Log.Errorf("failed to create payment: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+	committed = true
+
+	if err := s.Cache.Delete(cartKey(claims.UserID)); err != nil {
+		s.Log.Errorf("failed to delete cart: %v", err)
+	}
+
+	for _, id := range ticketIDs {
+		if err := s.Cache.Delete(fmt.Sprintf("ticket:get:id:%s", id)); err != nil {
+			s.Log.Errorf("failed to delete cache: %v", err)
+		}
+	}
+
+	response := converter.OrderEntityToResponse(&dataOrder)
+	response.Payment = p
+
+	return response, nil
+}
+
+func (s *CartServiceImpl) load(userID string) (*cart, error) {
+	c := &cart{Items: []model.CartItemResponse{}}
+	if err := s.Cache.Get(cartKey(userID), c); err != nil && !errors.Is(err, cache.ErrCacheMiss) {
+		s.Log.Errorf("failed to get cart: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+	return c, nil
+}
+
+func (s *CartServiceImpl) save(userID string, c *cart) error {
+	c.ExpiresAt = time.Now().Add(s.TTL)
+	if err := s.Cache.Set(cartKey(userID), c, s.TTL); err != nil {
+		s.Log.Errorf("failed to save cart: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+	return nil
+}
+
+func cartKey(userID string) string {
+	return fmt.Sprintf("cart:%s", userID)
+}
@@ -3,7 +3,9 @@ package event
 import (
 	"context"
 
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
 	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"gorm.io/gorm"
 )
 
 type EventService interface {
@@ -12,4 +14,8 @@ type EventService interface {
 	GetEventByID(ctx context.Context, request *model.GetEventRequest) (*model.EventResponse, error)
 	GetEvents(ctx context.Context, request *model.EventsRequest) (*model.Response[[]*model.EventResponse], error)
 	SearchEvents(ctx context.Context, request *model.EventSearchRequest) (*model.Response[[]*model.EventResponse], error)
+	GetUnpublishedEvents(ctx context.Context, request *model.EventsRequest) (*model.Response[[]*model.EventResponse], error)
+	UpdateEventStatus(ctx context.Context, request *model.UpdateEventStatusRequest) (*model.EventResponse, error)
+	PublishScheduled(ctx context.Context) error
+	CheckOverlap(ctx context.Context, tx *gorm.DB, data *entity.Event, override bool, reason string) error
 }
@@ -1,9 +1,13 @@
 package event
 
 import (
+	"bytes"
 	"context"
+	"embed"
 	"errors"
 	"fmt"
+	"html/template"
+	"strings"
 	"time"
 
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
@@ -12,32 +16,54 @@ import (
 	"github.com/TrinityKnights/Backend/internal/repository/event"
 	"github.com/TrinityKnights/Backend/pkg/cache"
 	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/gomail"
 	"github.com/TrinityKnights/Backend/pkg/helper"
 	"github.com/go-playground/validator/v10"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+//go:embed template/*.html
+var templateFS embed.FS
+
 type EventServiceImpl struct {
 	DB              *gorm.DB
 	Cache           *cache.ImplCache
 	Log             *logrus.Logger
 	Validate        *validator.Validate
 	EventRepository event.EventRepository
+	Gomail          *gomail.ImplGomail
+	TurnoverBuffer  time.Duration
 	helper          *helper.ContextHelper
 }
 
-func NewEventServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, eventRepository event.EventRepository) *EventServiceImpl {
+// NewEventServiceImpl creates the event service. turnoverBuffer is the time a venue is kept free
+// between two events, a negative buffer is treated as none.
+func NewEventServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, eventRepository event.EventRepository, mail *gomail.ImplGomail, turnoverBuffer time.Duration) *EventServiceImpl {
+	if turnoverBuffer < 0 {
+		turnoverBuffer = 0
+	}
+
 	return &EventServiceImpl{
 		DB:              db,
 		Cache:           cacheImpl,
 		Log:             log,
 		Validate:        validate,
 		EventRepository: eventRepository,
+		Gomail:          mail,
+		TurnoverBuffer:  turnoverBuffer,
 		helper:          helper.NewContextHelper(),
 	}
 }
 
+// publicStatuses are the statuses of events listed to buyers, drafts and scheduled events stay hidden
+var publicStatuses = []model.EventStatus{
+	model.EventStatusPublished,
+	model.EventStatusPostponed,
+	model.EventStatusCancelled,
+}
+
 const (
 	dateLayout = "2006-01-02"
 	timeLayout = "15:04:05"
@@ -57,12 +83,40 @@ func (s *EventServiceImpl) CreateEvent(ctx context.Context, request *model.Creat
 		return nil, domainErrors.ErrValidation
 	}
 
+	attendeeEditCutoff, err := parseCutoff(request.AttendeeEditCutoff)
+	if err != nil {
+		s.Log.Errorf("failed to parse attendee edit cutoff: %v", err)
+		return nil, domainErrors.ErrValidation
+	}
+
+	status, publishAt, err := initialStatus(request.Status, request.PublishAt, time.Now())
+	if err != nil {
+		s.Log.Errorf("failed to resolve event status: %v", err)
+		return nil, domainErrors.ErrValidation
+	}
+
 	data := &entity.Event{
-		Name:        request.Name,
-		Description: request.Description,
-		Date:        parsedDateTime,
-		Time:        helper.SQLTime(parsedDateTime),
-		VenueID:     request.VenueID,
+		Name:               request.Name,
+		Description:        request.Description,
+		Date:               parsedDateTime,
+		Time:               helper.SQLTime(parsedDateTime),
+		VenueID:            request.VenueID,
+		AttendeeEditCutoff: attendeeEditCutoff,
+		TimedEntry:         request.TimedEntry,
+		Status:             status,
+		PublishAt:          publishAt,
+	}
+	if err := s.schedule(tx, data, parsedDateTime, request.DurationMinutes); err != nil {
+		return nil, err
+	}
+	if err := s.CheckOverlap(ctx, tx, data, request.OverrideConflict, request.OverrideReason); err != nil {
+		return nil, err
+	}
+	if request.Series != "" {
+		data.Series = &request.Series
+	}
+	if request.CapacityOverride > 0 {
+		data.CapacityOverride = &request.CapacityOverride
 	}
 
 	if err := s.EventRepository.Create(tx, data); err != nil {
@@ -85,30 +139,88 @@ func (s *EventServiceImpl) UpdateEvent(ctx context.Context, request *model.Updat
 		return nil, domainErrors.ErrValidation
 	}
 
-	parsedDateTime, err := parseDateTime(request.Date, request.Time)
+	// Fields left out of the request keep their current value
+	data := &entity.Event{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(data, request.ID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get event: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	dateStr, timeStr := request.Date, request.Time
+	if dateStr == "" {
+		dateStr = data.Date.Format(dateLayout)
+	}
+	if timeStr == "" {
+		timeStr = time.Time(data.Time).Format(timeLayout)
+	}
+
+	parsedDateTime, err := parseDateTime(dateStr, timeStr)
 	if err != nil {
 		s.Log.Errorf("failed to parse date time: %v", err)
 		return nil, domainErrors.ErrValidation
 	}
 
-	data := &entity.Event{
-		ID:          request.ID,
-		Name:        request.Name,
-		Description: request.Description,
-		Date:        parsedDateTime,
-		Time:        helper.SQLTime(parsedDateTime),
-		VenueID:     request.VenueID,
+	if request.AttendeeEditCutoff != "" {
+		attendeeEditCutoff, err := parseCutoff(request.AttendeeEditCutoff)
+		if err != nil {
+			s.Log.Errorf("failed to parse attendee edit cutoff: %v", err)
+			return nil, domainErrors.ErrValidation
+		}
+		data.AttendeeEditCutoff = attendeeEditCutoff
+	}
+
+	durationMinutes := request.DurationMinutes
+	if durationMinutes == 0 && data.EndsAt != nil {
+		durationMinutes = int(data.EndsAt.Sub(data.StartsAt) / time.Minute)
 	}
 
-	if err := s.EventRepository.Update(tx, data); err != nil {
+	data.Date = parsedDateTime
+	data.Time = helper.SQLTime(parsedDateTime)
+	if request.Name != "" {
+		data.Name = request.Name
+	}
+	if request.Description != "" {
+		data.Description = request.Description
+	}
+	if request.VenueID != 0 {
+		data.VenueID = request.VenueID
+	}
+	if request.Series != "" {
+		data.Series = &request.Series
+	}
+	if request.TimedEntry != nil {
+		data.TimedEntry = *request.TimedEntry
+	}
+	if request.CapacityOverride > 0 {
+		data.CapacityOverride = &request.CapacityOverride
+	}
+	if err := s.schedule(tx, data, parsedDateTime, durationMinutes); err != nil {
+		return nil, err
+	}
+	if err := s.CheckOverlap(ctx, tx, data, request.OverrideConflict, request.OverrideReason); err != nil {
+		return nil, err
+	}
+
+	// Series membership and publication are managed through their own endpoints
+	if err := s.EventRepository.Update(tx.Omit(clause.Associations, "SeriesID", "OccurrenceDate", "Status", "PublishAt", "StatusReason", "CancelledAt"), data); err != nil {
 		s.Log.Errorf("failed to update event: %v", err)
 		return nil, domainErrors.ErrInternalServer
 	}
 
+	if err := s.EventRepository.GetByID(tx, data, data.ID); err != nil {
+		s.Log.Errorf("failed to reload event: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		return nil, domainErrors.ErrInternalServer
 	}
 
+	s.invalidateEvents(data.ID)
+
 	return converter.EventEntityToResponse(data), nil
 }
 
@@ -117,9 +229,14 @@ func (s *EventServiceImpl) GetEventByID(ctx context.Context, request *model.GetE
 		return nil, domainErrors.ErrValidation
 	}
 
+	loc, err := clientLocation(request.TZ)
+	if err != nil {
+		return nil, err
+	}
+
 	key := fmt.Sprintf("event:get:id:%d", request.ID)
 	var data *model.EventResponse
-	err := s.Cache.Get(key, &data)
+	err = s.Cache.Get(key, &data)
 	if err != nil && !errors.Is(err, cache.ErrCacheMiss) {
 		s.Log.Errorf("failed to get cache: %v", err)
 	}
@@ -133,13 +250,21 @@ func (s *EventServiceImpl) GetEventByID(ctx context.Context, request *model.GetE
 			return nil, domainErrors.ErrNotFound
 		}
 
+		if !isPublic(eventData.Status) {
+			return nil, domainErrors.ErrNotFound
+		}
+
 		response := converter.EventEntityToResponse(eventData)
 
 		if err := s.Cache.Set(key, response, 5*time.Minute); err != nil {
 			s.Log.Errorf("failed to set cache: %v", err)
 		}
 
-		return response, nil
+		data = response
+	}
+
+	if loc != nil {
+		converter.EventResponseInZone(data, loc)
 	}
 
 	return data, nil
@@ -150,11 +275,17 @@ func (s *EventServiceImpl) GetEvents(ctx context.Context, request *model.EventsR
 		return nil, domainErrors.ErrValidation
 	}
 
+	loc, err := clientLocation(request.TZ)
+	if err != nil {
+		return nil, err
+	}
+
 	opts := model.EventQueryOptions{
-		Page:  request.Page,
-		Size:  request.Size,
-		Sort:  request.Sort,
-		Order: request.Order,
+		Statuses: publicStatuses,
+		Page:     request.Page,
+		Size:     request.Size,
+		Sort:     request.Sort,
+		Order:    request.Order,
 	}
 
 	if opts.Size <= 0 {
@@ -167,7 +298,7 @@ func (s *EventServiceImpl) GetEvents(ctx context.Context, request *model.EventsR
 	cacheKey := fmt.Sprintf("event:get:page:%d:size:%d:sort:%s:order:%s", opts.Page, opts.Size, opts.Sort, opts.Order)
 	var cacheResponse model.Response[[]*model.EventResponse]
 	if err := s.Cache.Get(cacheKey, &cacheResponse); err == nil {
-		return &cacheResponse, nil
+		return eventsInZone(&cacheResponse, loc), nil
 	}
 
 	db := s.DB.WithContext(ctx)
@@ -189,7 +320,7 @@ func (s *EventServiceImpl) GetEvents(ctx context.Context, request *model.EventsR
 		s.Log.Errorf("failed to cache response: %v", err)
 	}
 
-	return response, nil
+	return eventsInZone(response, loc), nil
 }
 
 func (s *EventServiceImpl) SearchEvents(ctx context.Context, request *model.EventSearchRequest) (*model.Response[[]*model.EventResponse], error) {
@@ -197,6 +328,11 @@ func (s *EventServiceImpl) SearchEvents(ctx context.Context, request *model.Even
 		return nil, domainErrors.ErrValidation
 	}
 
+	loc, err := clientLocation(request.TZ)
+	if err != nil {
+		return nil, err
+	}
+
 	opts := model.EventQueryOptions{
 		Page:        request.Page,
 		Size:        request.Size,
@@ -205,6 +341,7 @@ func (s *EventServiceImpl) SearchEvents(ctx context.Context, request *model.Even
 		Date:        &request.Date,
 		Time:        &request.Time,
 		VenueID:     &request.VenueID,
+		Statuses:    publicStatuses,
 		Sort:        request.Sort,
 		Order:       request.Order,
 	}
@@ -230,7 +367,7 @@ func (s *EventServiceImpl) SearchEvents(ctx context.Context, request *model.Even
 
 	var cacheResponse model.Response[[]*model.EventResponse]
 	if err := s.Cache.Get(cacheKey, &cacheResponse); err == nil {
-		return &cacheResponse, nil
+		return eventsInZone(&cacheResponse, loc), nil
 	}
 
 	db := s.DB.WithContext(ctx)
@@ -252,7 +389,355 @@ func (s *EventServiceImpl) SearchEvents(ctx context.Context, request *model.Even
 		s.Log.Errorf("failed to cache search results: %v", err)
 	}
 
-	return response, nil
+	return eventsInZone(response, loc), nil
+}
+
+// GetUnpublishedEvents lists the drafts and scheduled events that buyers cannot see yet.
+func (s *EventServiceImpl) GetUnpublishedEvents(ctx context.Context, request *model.EventsRequest) (*model.Response[[]*model.EventResponse], error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	opts := model.EventQueryOptions{
+		Statuses: []model.EventStatus{model.EventStatusDraft, model.EventStatusScheduled},
+		Page:     request.Page,
+		Size:     request.Size,
+		Sort:     request.Sort,
+		Order:    request.Order,
+	}
+
+	if opts.Size <= 0 {
+		opts.Size = 10
+	}
+	if opts.Page <= 0 {
+		opts.Page = 1
+	}
+
+	var events []entity.Event
+	totalItems, err := s.EventRepository.GetPaginated(s.DB.WithContext(ctx), &events, &opts)
+	if err != nil {
+		s.Log.Errorf("failed to get unpublished events: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if totalItems == 0 || len(events) == 0 {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	return converter.EventsToPaginatedResponse(events, totalItems, opts.Page, opts.Size), nil
+}
+
+// UpdateEventStatus moves an event through its publication workflow. Drafts and scheduled
+// events can be published, published events postponed, and anything but a cancelled event
+// cancelled. Cancelling makes every paid order eligible for a refund, and ticket holders are
+// emailed when an event they hold tickets for is cancelled or postponed.
+func (s *EventServiceImpl) UpdateEventStatus(ctx context.Context, request *model.UpdateEventStatusRequest) (*model.EventResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	status := model.EventStatus(strings.ToUpper(request.Status))
+	now := time.Now()
+
+	var publishAt *time.Time
+	if status == model.EventStatusScheduled {
+		if request.PublishAt == "" {
+			return nil, domainErrors.ErrValidation
+		}
+		parsed, err := time.Parse(time.RFC3339, request.PublishAt)
+		if err != nil || !parsed.After(now) {
+			return nil, domainErrors.ErrValidation
+		}
+		publishAt = &parsed
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data := &entity.Event{}
+	if err := s.EventRepository.GetByID(tx.Clauses(clause.Locking{Strength: "UPDATE"}), data, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get event: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if !canTransition(data.Status, status) {
+		return nil, domainErrors.ErrInvalidStatusChange
+	}
+
+	updates := map[string]interface{}{
+		"status":        status,
+		"publish_at":    publishAt,
+		"status_reason": nil,
+	}
+	if request.Reason != "" {
+		updates["status_reason"] = request.Reason
+	}
+	if status == model.EventStatusCancelled {
+		updates["cancelled_at"] = now
+	}
+
+	if err := tx.Model(data).Updates(updates).Error; err != nil {
+		s.Log.Errorf("failed to update event status: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if status == model.EventStatusCancelled {
+		eligible, err := s.EventRepository.MarkOrdersRefundEligible(tx, data.ID, now)
+		if err != nil {
+			s.Log.Errorf("failed to mark orders refund eligible: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+		s.Log.Infof("event %d cancelled, %d order(s) eligible for a refund", data.ID, eligible)
+	}
+
+	if err := s.EventRepository.GetByID(tx, data, data.ID); err != nil {
+		s.Log.Errorf("failed to reload event: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	var holders []model.EventHolder
+	if status == model.EventStatusCancelled || status == model.EventStatusPostponed {
+		var err error
+		holders, err = s.EventRepository.GetHolders(tx, data.ID)
+		if err != nil {
+			s.Log.Errorf("failed to get ticket holders: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	s.invalidateEvents(data.ID)
+
+	for i := range holders {
+		if err := s.sendStatusEmail(data, &holders[i]); err != nil {
+			s.Log.Errorf("failed to notify %s about event %d: %v", holders[i].Email, data.ID, err)
+		}
+	}
+
+	return converter.EventEntityToResponse(data), nil
+}
+
+// PublishScheduled publishes the scheduled events whose publish time has come.
+func (s *EventServiceImpl) PublishScheduled(ctx context.Context) error {
+	ids, err := s.EventRepository.PublishDue(s.DB.WithContext(ctx), time.Now())
+	if err != nil {
+		s.Log.Errorf("failed to publish scheduled events: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	if len(ids) > 0 {
+		s.Log.Infof("published %d scheduled event(s)", len(ids))
+		s.invalidateEvents(ids...)
+	}
+
+	return nil
+}
+
+func (s *EventServiceImpl) sendStatusEmail(data *entity.Event, holder *model.EventHolder) error {
+	var replaceEmail = struct {
+		Name      string
+		EventName string
+		Date      string
+		Cancelled bool
+		Reason    string
+	}{
+		Name:      holder.Name,
+		EventName: data.Name,
+		Date:      helper.FormatDateIn(data.StartsAt, helper.LocationOrDefault(data.Timezone)),
+		Cancelled: data.Status == model.EventStatusCancelled,
+		Reason:    helper.StringOrEmpty(data.StatusReason),
+	}
+
+	tmpl, err := template.ParseFS(templateFS, "template/event-status.html")
+	if err != nil {
+		return err
+	}
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, &replaceEmail); err != nil {
+		return err
+	}
+
+	subject := "[TrinityKnights] Your Event Has Been Postponed"
+	if replaceEmail.Cancelled {
+		subject = "[TrinityKnights] Your Event Has Been Cancelled"
+	}
+
+	return s.Gomail.SendEmail(&gomail.SendEmail{
+		EmailTo:   holder.Email,
+		EmailFrom: s.Gomail.GetFromEmail(),
+		Subject:   subject,
+		Body:      body,
+	})
+}
+
+func (s *EventServiceImpl) invalidateEvents(ids ...uint) {
+	for _, id := range ids {
+		if err := s.Cache.Delete(fmt.Sprintf("event:get:id:%d", id)); err != nil {
+			s.Log.Errorf("failed to delete cache: %v", err)
+		}
+	}
+
+	for _, pattern := range []string{"event:get:page:*", "event:search:*"} {
+		if err := s.Cache.DeletePattern(pattern); err != nil {
+			s.Log.Errorf("failed to delete cache: %v", err)
+		}
+	}
+}
+
+// initialStatus works out the status of a new event. Events given a publish time are scheduled,
+// events without a status are published straight away as they were before drafts existed.
+func initialStatus(status, publishAtStr string, now time.Time) (model.EventStatus, *time.Time, error) {
+	result := model.EventStatus(strings.ToUpper(status))
+	if result == "" {
+		result = model.EventStatusPublished
+		if publishAtStr != "" {
+			result = model.EventStatusScheduled
+		}
+	}
+
+	if result != model.EventStatusScheduled {
+		if publishAtStr != "" {
+			return "", nil, fmt.Errorf("publish time given for a %s event", result)
+		}
+		return result, nil, nil
+	}
+
+	publishAt, err := time.Parse(time.RFC3339, publishAtStr)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid publish time: %w", err)
+	}
+	if !publishAt.After(now) {
+		return "", nil, fmt.Errorf("publish time %s is not in the future", publishAtStr)
+	}
+
+	return result, &publishAt, nil
+}
+
+// canTransition reports whether an event may move from one status to another. Cancelling is
+// final, and an event that went public cannot be taken back to a draft.
+func canTransition(from, to model.EventStatus) bool {
+	switch to {
+	case model.EventStatusDraft, model.EventStatusScheduled:
+		return from == model.EventStatusDraft || from == model.EventStatusScheduled
+	case model.EventStatusPublished:
+		return from == model.EventStatusDraft || from == model.EventStatusScheduled || from == model.EventStatusPostponed
+	case model.EventStatusPostponed:
+		return from == model.EventStatusPublished
+	case model.EventStatusCancelled:
+		return from != model.EventStatusCancelled
+	default:
+		return false
+	}
+}
+
+func isPublic(status model.EventStatus) bool {
+	for _, s := range publicStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// schedule places the event in the time zone of its venue, working out the instants it starts
+// and, when a duration is given, ends at from the venue's wall clock.
+func (s *EventServiceImpl) schedule(tx *gorm.DB, data *entity.Event, wallClock time.Time, durationMinutes int) error {
+	// Locking the venue makes bookings at the same venue wait for each other's overlap check
+	timezone, err := s.EventRepository.GetVenueTimezone(tx.Clauses(clause.Locking{Strength: "UPDATE"}), data.VenueID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get venue timezone: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	loc := helper.LocationOrDefault(timezone)
+	data.Timezone = loc.String()
+	data.StartsAt = helper.InZone(wallClock, wallClock, loc)
+	data.EndsAt = nil
+	if durationMinutes > 0 {
+		endsAt := data.StartsAt.Add(time.Duration(durationMinutes) * time.Minute)
+		data.EndsAt = &endsAt
+	}
+
+	return nil
+}
+
+// CheckOverlap rejects an event that clashes with another event at its venue, turnover buffer
+// included. An admin can book it anyway by overriding the clash, the reason and who gave it are
+// kept with the event. The venue stays locked until tx ends, so the event has to be saved in tx.
+func (s *EventServiceImpl) CheckOverlap(ctx context.Context, tx *gorm.DB, data *entity.Event, override bool, reason string) error {
+	endsAt := data.StartsAt
+	if data.EndsAt != nil {
+		endsAt = *data.EndsAt
+	}
+
+	if err := s.EventRepository.LockVenue(tx, data.VenueID); err != nil {
+		s.Log.Errorf("failed to lock venue: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	var clashes []entity.Event
+	if err := s.EventRepository.GetOverlapping(tx, &clashes, data.VenueID, data.ID, data.StartsAt, endsAt, s.TurnoverBuffer); err != nil {
+		s.Log.Errorf("failed to get overlapping events: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	data.OverlapOverrideReason = nil
+	data.OverlapOverriddenBy = nil
+	if len(clashes) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(clashes))
+	for i := range clashes {
+		ids[i] = fmt.Sprint(clashes[i].ID)
+	}
+
+	if !override {
+		s.Log.Warnf("venue %d is already booked by event(s) %s at %s", data.VenueID, strings.Join(ids, ", "), data.StartsAt.Format(time.RFC3339))
+		return domainErrors.ErrVenueConflict
+	}
+
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		return domainErrors.ErrUnauthorized
+	}
+
+	s.Log.Warnf("user %s booked venue %d over event(s) %s: %s", claims.UserID, data.VenueID, strings.Join(ids, ", "), reason)
+	data.OverlapOverrideReason = &reason
+	data.OverlapOverriddenBy = &claims.UserID
+
+	return nil
+}
+
+// clientLocation resolves the tz query parameter, nil keeps times in the venue's zone.
+func clientLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return nil, nil
+	}
+	loc, err := helper.LoadTimezone(name)
+	if err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+	return loc, nil
+}
+
+func eventsInZone(response *model.Response[[]*model.EventResponse], loc *time.Location) *model.Response[[]*model.EventResponse] {
+	if loc != nil && response.Data != nil {
+		for _, event := range *response.Data {
+			converter.EventResponseInZone(event, loc)
+		}
+	}
+	return response
 }
 
 func parseDateTime(dateStr, timeStr string) (time.Time, error) {
@@ -275,3 +760,16 @@ func parseDateTime(dateStr, timeStr string) (time.Time, error) {
 		0, time.Local,
 	), nil
 }
+
+func parseCutoff(cutoffStr string) (*time.Time, error) {
+	if cutoffStr == "" {
+		return nil, nil
+	}
+
+	cutoff, err := time.Parse(time.RFC3339, cutoffStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cutoff format: %w", err)
+	}
+
+	return &cutoff, nil
+}
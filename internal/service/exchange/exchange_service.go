@@ -0,0 +1,13 @@
+package exchange
+
+import (
+	"context"
+
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+)
+
+type ExchangeService interface {
+	ExchangeTicket(ctx context.Context, request *model.ExchangeTicketRequest) (*model.TicketExchangeResponse, error)
+	GetExchangeByID(ctx context.Context, request *model.GetTicketExchangeRequest) (*model.TicketExchangeResponse, error)
+	GetExchanges(ctx context.Context, request *model.TicketExchangesRequest) (*model.Response[[]*model.TicketExchangeResponse], error)
+}
@@ -0,0 +1,284 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/domain/model/converter"
+	"github.com/TrinityKnights/Backend/internal/repository/exchange"
+	"github.com/TrinityKnights/Backend/internal/repository/ticket"
+	"github.com/TrinityKnights/Backend/internal/service/payment"
+	"github.com/TrinityKnights/Backend/internal/service/reservation"
+	"github.com/TrinityKnights/Backend/internal/service/waitlist"
+	"github.com/TrinityKnights/Backend/pkg/cache"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// defaultHoldDuration covers the invoice lifetime when the payment provider does not report one
+const defaultHoldDuration = 24 * time.Hour
+
+type ExchangeServiceImpl struct {
+	DB                 *gorm.DB
+	Cache              *cache.ImplCache
+	Log                *logrus.Logger
+	Validate           *validator.Validate
+	ExchangeRepository exchange.ExchangeRepository
+	TicketRepository   ticket.TicketRepository
+	PaymentService     payment.PaymentService
+	WaitlistService    waitlist.WaitlistService
+	ReservationService reservation.ReservationService
+	helper             *helper.ContextHelper
+}
+
+func NewExchangeServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, exchangeRepository exchange.ExchangeRepository, ticketRepository ticket.TicketRepository, paymentService payment.PaymentService, waitlistService waitlist.WaitlistService, reservationService reservation.ReservationService) *ExchangeServiceImpl {
+	return &ExchangeServiceImpl{
+		DB:                 db,
+		Cache:              cacheImpl,
+		Log:                log,
+		Validate:           validate,
+		ExchangeRepository: exchangeRepository,
+		TicketRepository:   ticketRepository,
+		PaymentService:     paymentService,
+		WaitlistService:    waitlistService,
+		ReservationService: reservationService,
+		helper:             helper.NewContextHelper(),
+	}
+}
+
+// ExchangeTicket swaps a paid ticket for an available one of the same event. Upgrades are
+// held until a differential invoice is paid; equal or cheaper swaps complete immediately
+// and record any difference as a credit for the buyer.
+func (s *ExchangeServiceImpl) ExchangeTicket(ctx context.Context, request *model.ExchangeTicketRequest) (*model.TicketExchangeResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	if request.TicketID == request.NewTicketID {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	var oldTicket entity.Ticket
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND order_id IS NOT NULL", request.TicketID).
+		Take(&oldTicket).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get ticket: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	var order entity.Order
+	if err := tx.Preload("Payment", "ticket_exchange_id IS NULL").
+		Where("id = ?", *oldTicket.OrderID).
+		Take(&order).Error; err != nil {
+		s.Log.Errorf("failed to get order: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	// Exchanges and credits belong to an account, guest orders qualify once attached to one
+	if order.UserID == nil {
+		return nil, domainErrors.ErrNotExchangeable
+	}
+
+	if err := s.helper.VerifyOwnership(ctx, *order.UserID); err != nil {
+		return nil, domainErrors.ErrForbidden
+	}
+
+	// Only settled orders can be exchanged, unpaid ones can simply be re-ordered
+	if order.Payment == nil || order.Payment.Status != model.PaymentStatusPaid {
+		return nil, domainErrors.ErrNotExchangeable
+	}
+
+	pending := &entity.TicketExchange{}
+	err := s.ExchangeRepository.GetPendingByTicketID(tx, pending, oldTicket.ID)
+	if err == nil {
+		return nil, domainErrors.ErrDuplicateEntry
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.Log.Errorf("failed to check pending exchange: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	now := time.Now()
+	var newTicket entity.Ticket
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND order_id IS NULL AND allocation_id IS NULL", request.NewTicketID).
+		Where("held_until IS NULL OR held_until < ?", now).
+		Take(&newTicket).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrSeatAlreadyTaken
+		}
+		s.Log.Errorf("failed to lock ticket: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if newTicket.EventID != oldTicket.EventID {
+		return nil, domainErrors.ErrValidation
+	}
+
+	data := &entity.TicketExchange{
+		OrderID:         order.ID,
+		UserID:          *order.UserID,
+		OldTicketID:     oldTicket.ID,
+		NewTicketID:     newTicket.ID,
+		OldPrice:        oldTicket.Price,
+		NewPrice:        newTicket.Price,
+		PriceDifference: newTicket.Price - oldTicket.Price,
+		Status:          model.TicketExchangeStatusPending,
+	}
+
+	if data.PriceDifference > 0 {
+		holdToken := uuid.NewString()
+		data.HoldToken = &holdToken
+	}
+
+	if err := s.ExchangeRepository.Create(tx.Omit(clause.Associations), data); err != nil {
+		s.Log.Errorf("failed to create ticket exchange: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	var p *model.CreatePaymentResponse
+	var released *entity.Ticket
+	if data.PriceDifference > 0 {
+		p, err = s.PaymentService.CreateInvoice(ctx, tx, &model.CreatePaymentRequest{
+			OrderID:    order.ID,
+			Amount:     data.PriceDifference,
+			ExchangeID: data.ID,
+		})
+		if err != nil {
+			s.Log.Errorf("failed to create exchange invoice: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		// Keep the new ticket off sale for as long as the invoice can be paid
+		heldUntil := now.Add(defaultHoldDuration)
+		if expiry, err := time.Parse(time.RFC3339, p.ExpiryDate); err == nil {
+			heldUntil = expiry
+		}
+
+		if err := s.TicketRepository.Hold(tx, []string{newTicket.ID}, *data.HoldToken, heldUntil); err != nil {
+			s.Log.Errorf("failed to hold ticket: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+	} else {
+		if err := s.ExchangeRepository.Complete(tx, data); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, domainErrors.ErrSeatAlreadyTaken
+			}
+			s.Log.Errorf("failed to complete ticket exchange: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		if data.PriceDifference < 0 {
+			credit := &entity.UserCredit{
+				UserID:           *order.UserID,
+				Amount:           -data.PriceDifference,
+				Description:      fmt.Sprintf("Credit from ticket exchange #%d", data.ID),
+				TicketExchangeID: &data.ID,
+			}
+			if err := s.ExchangeRepository.CreateCredit(tx, credit); err != nil {
+				s.Log.Errorf("failed to record exchange credit: %v", err)
+				return nil, domainErrors.ErrInternalServer
+			}
+		}
+
+		released = &oldTicket
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	s.Log.Infof("ticket exchange %d: %s -> %s for order %d (%s, difference %.2f)",
+		data.ID, oldTicket.ID, newTicket.ID, order.ID, data.Status, data.PriceDifference)
+
+	for _, id := range []string{oldTicket.ID, newTicket.ID} {
+		if err := s.Cache.Delete(fmt.Sprintf("ticket:get:id:%s", id)); err != nil {
+			s.Log.Errorf("failed to delete cache: %v", err)
+		}
+	}
+
+	if released != nil {
+		s.ReservationService.Free(ctx, []*entity.Ticket{released})
+		s.WaitlistService.OfferReleasedTickets(ctx, []*entity.Ticket{released})
+	}
+
+	data.OldTicket = oldTicket
+	data.NewTicket = newTicket
+	response := converter.TicketExchangeEntityToResponse(data)
+	response.Payment = p
+
+	return response, nil
+}
+
+func (s *ExchangeServiceImpl) GetExchangeByID(ctx context.Context, request *model.GetTicketExchangeRequest) (*model.TicketExchangeResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	data := &entity.TicketExchange{}
+	if err := s.ExchangeRepository.GetByID(s.DB.WithContext(ctx), data, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get ticket exchange: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.helper.VerifyOwnership(ctx, data.UserID); err != nil {
+		return nil, domainErrors.ErrForbidden
+	}
+
+	return converter.TicketExchangeEntityToResponse(data), nil
+}
+
+// GetExchanges lists the caller's exchanges, or every exchange for admins.
+func (s *ExchangeServiceImpl) GetExchanges(ctx context.Context, request *model.TicketExchangesRequest) (*model.Response[[]*model.TicketExchangeResponse], error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	if request.Size <= 0 {
+		request.Size = 10
+	}
+	if request.Page <= 0 {
+		request.Page = 1
+	}
+
+	userID := claims.UserID
+	if s.helper.IsAdmin(ctx) {
+		userID = ""
+	}
+
+	var exchanges []entity.TicketExchange
+	totalItems, err := s.ExchangeRepository.GetPaginated(s.DB.WithContext(ctx), &exchanges, userID, request.Page, request.Size)
+	if err != nil {
+		s.Log.Errorf("failed to get ticket exchanges: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if len(exchanges) == 0 {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	return converter.TicketExchangesToPaginatedResponse(exchanges, totalItems, request.Page, request.Size), nil
+}
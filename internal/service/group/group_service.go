@@ -0,0 +1,14 @@
+package group
+
+import (
+	"context"
+
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+)
+
+type GroupService interface {
+	CreateGroupBooking(ctx context.Context, request *model.CreateGroupBookingRequest) (*model.GroupBookingResponse, error)
+	GetGroupBookings(ctx context.Context, request *model.GroupBookingsRequest) (*model.Response[[]*model.GroupBookingResponse], error)
+	GetGroupBookingByID(ctx context.Context, request *model.GetGroupBookingRequest) (*model.GroupBookingResponse, error)
+	ReleaseUnpaidShares(ctx context.Context) error
+}
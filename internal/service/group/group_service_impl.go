@@ -0,0 +1,494 @@
+package group
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/domain/model/converter"
+	"github.com/TrinityKnights/Backend/internal/repository/group"
+	"github.com/TrinityKnights/Backend/internal/repository/order"
+	"github.com/TrinityKnights/Backend/internal/repository/ticket"
+	"github.com/TrinityKnights/Backend/internal/repository/user"
+	"github.com/TrinityKnights/Backend/internal/service/payment"
+	"github.com/TrinityKnights/Backend/internal/service/reservation"
+	"github.com/TrinityKnights/Backend/internal/service/waitingroom"
+	"github.com/TrinityKnights/Backend/internal/service/waitlist"
+	"github.com/TrinityKnights/Backend/pkg/cache"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/gomail"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//go:embed template/*.html
+var templateFS embed.FS
+
+const (
+	MinGroupDeadline = time.Hour
+	MaxGroupDeadline = 7 * 24 * time.Hour
+)
+
+type GroupServiceImpl struct {
+	DB                 *gorm.DB
+	Cache              *cache.ImplCache
+	Log                *logrus.Logger
+	Validate           *validator.Validate
+	GroupRepository    group.GroupRepository
+	OrderRepository    order.OrderRepository
+	TicketRepository   ticket.TicketRepository
+	UserRepository     user.UserRepository
+	PaymentService     payment.PaymentService
+	WaitlistService    waitlist.WaitlistService
+	WaitingRoomService waitingroom.WaitingRoomService
+	ReservationService reservation.ReservationService
+	Gomail             *gomail.ImplGomail
+	helper             *helper.ContextHelper
+}
+
+func NewGroupServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, groupRepository group.GroupRepository, orderRepository order.OrderRepository, ticketRepository ticket.TicketRepository, userRepository user.UserRepository, paymentService payment.PaymentService, waitlistService waitlist.WaitlistService, waitingRoomService waitingroom.WaitingRoomService, reservationService reservation.ReservationService, mail *gomail.ImplGomail) *GroupServiceImpl {
+	return &GroupServiceImpl{
+		DB:                 db,
+		Cache:              cacheImpl,
+		Log:                log,
+		Validate:           validate,
+		GroupRepository:    groupRepository,
+		OrderRepository:    orderRepository,
+		TicketRepository:   ticketRepository,
+		UserRepository:     userRepository,
+		PaymentService:     paymentService,
+		WaitlistService:    waitlistService,
+		WaitingRoomService: waitingRoomService,
+		ReservationService: reservationService,
+		Gomail:             mail,
+		helper:             helper.NewContextHelper(),
+	}
+}
+
+// CreateGroupBooking reserves a block of seats for the organiser and splits it into one order
+// and invoice per participant. Invoices expire at the group's deadline.
+func (s *GroupServiceImpl) CreateGroupBooking(ctx context.Context, request *model.CreateGroupBookingRequest) (*model.GroupBookingResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	ticketType := helper.TicketUpper(request.Type)
+	if ticketType.Long == "" {
+		return nil, domainErrors.ErrValidation
+	}
+
+	deadline, err := time.Parse(time.RFC3339, request.Deadline)
+	if err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	now := time.Now()
+	if deadline.Before(now.Add(MinGroupDeadline)) || deadline.After(now.Add(MaxGroupDeadline)) {
+		return nil, domainErrors.ErrValidation
+	}
+
+	total := 0
+	emails := make(map[string]bool, len(request.Participants))
+	for i := range request.Participants {
+		email := strings.ToLower(request.Participants[i].Email)
+		if emails[email] {
+			return nil, domainErrors.ErrValidation
+		}
+		emails[email] = true
+
+		if request.Participants[i].Quantity <= 0 {
+			request.Participants[i].Quantity = 1
+		}
+		total += request.Participants[i].Quantity
+	}
+
+	// A group booking takes seats like any other order, so it queues like one too
+	if err := s.WaitingRoomService.ClaimAdmission(ctx, request.EventID, request.AdmissionToken); err != nil {
+		return nil, err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			s.WaitingRoomService.ReleaseAdmission(ctx, request.EventID, request.AdmissionToken)
+		}
+	}()
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	var event entity.Event
+	if err := tx.First(&event, request.EventID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get event: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	// Drafts are not public yet, cancelled and postponed events stop selling
+	switch event.Status {
+	case model.EventStatusPublished:
+	case model.EventStatusDraft, model.EventStatusScheduled:
+		return nil, domainErrors.ErrNotFound
+	default:
+		return nil, domainErrors.ErrEventNotOnSale
+	}
+
+	// Everyone has to have paid before the event day begins at the venue
+	loc := helper.LocationOrDefault(event.Timezone)
+	startsAt := event.StartsAt.In(loc)
+	if !deadline.Before(time.Date(startsAt.Year(), startsAt.Month(), startsAt.Day(), 0, 0, 0, 0, loc)) {
+		return nil, domainErrors.ErrValidation
+	}
+
+	organiser := &entity.User{}
+	if err := s.UserRepository.GetByID(tx, organiser, claims.UserID); err != nil {
+		s.Log.Errorf("failed to get organiser: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	tickets, err := s.TicketRepository.FindAvailableForHold(tx, event.ID, ticketType.Long, total, now)
+	if err != nil {
+		s.Log.Errorf("failed to find available tickets: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if len(tickets) < total {
+		return nil, domainErrors.ErrNotEnoughTickets
+	}
+
+	// The picked seats are claimed in Redis too, so that checkouts choosing them are turned away
+	// before they reach the database
+	claimed := reservation.Seats(tickets)
+	claimOwner := uuid.NewString()
+	if err := s.ReservationService.ClaimSeats(ctx, claimed, claimOwner); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if committed {
+			s.ReservationService.ConfirmSeats(ctx, claimed, claimOwner)
+		} else {
+			s.ReservationService.ReleaseSeats(ctx, claimed, claimOwner)
+		}
+	}()
+
+	data := &entity.GroupBooking{
+		EventID:     event.ID,
+		OrganiserID: claims.UserID,
+		Type:        ticketType.Long,
+		Quantity:    total,
+		Status:      model.GroupBookingStatusPending,
+		Deadline:    deadline,
+	}
+
+	if err := s.GroupRepository.Create(tx.Omit(clause.Associations), data); err != nil {
+		s.Log.Errorf("failed to create group booking: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	reserved := tickets
+	data.Shares = make([]entity.GroupShare, len(request.Participants))
+	payments := make([]*model.CreatePaymentResponse, len(request.Participants))
+	for i, participant := range request.Participants {
+		name, email := participant.Name, participant.Email
+		seats := tickets[:participant.Quantity]
+		tickets = tickets[participant.Quantity:]
+
+		amount := 0.0
+		for _, t := range seats {
+			amount += t.Price
+		}
+
+		// Registered participants find their share among their own orders
+		userID := claims.UserID
+		participantUser := &entity.User{}
+		if err := s.UserRepository.GetByEmail(tx, participantUser, email); err == nil {
+			userID = participantUser.ID
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.Log.Errorf("failed to get participant: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		dataOrder := entity.Order{
+			UserID:         &userID,
+			Date:           now,
+			TotalPrice:     amount,
+			RecipientName:  &name,
+			RecipientEmail: &email,
+		}
+
+		if err := s.OrderRepository.Create(tx.Omit(clause.Associations), &dataOrder); err != nil {
+			s.Log.Errorf("failed to create order: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		if err := s.TicketRepository.AssignToOrder(tx, ticketIDs(seats), dataOrder.ID, &name, &email); err != nil {
+			s.Log.Errorf("failed to assign tickets: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		data.Shares[i] = entity.GroupShare{
+			GroupBookingID: data.ID,
+			OrderID:        dataOrder.ID,
+			Name:           name,
+			Email:          email,
+			Quantity:       participant.Quantity,
+			Status:         model.GroupShareStatusInvited,
+		}
+
+		if err := s.GroupRepository.CreateShare(tx, &data.Shares[i]); err != nil {
+			s.Log.Errorf("failed to create group share: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		payments[i], err = s.PaymentService.CreateInvoice(ctx, tx, &model.CreatePaymentRequest{
+			OrderID:   dataOrder.ID,
+			Amount:    amount,
+			ExpiresAt: &deadline,
+		})
+		if err != nil {
+			s.Log.Errorf("failed to create payment: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+	committed = true
+
+	s.Log.Infof("group booking %d created by %s with %d seat(s) for %d participant(s)",
+		data.ID, claims.UserID, total, len(data.Shares))
+
+	if err := s.Cache.DeletePattern("order:get:page:*"); err != nil {
+		s.Log.Errorf("failed to delete cache: %v", err)
+	}
+	s.deleteTicketCache(reserved)
+
+	if err := s.GroupRepository.GetByID(s.DB.WithContext(ctx), data, data.ID); err != nil {
+		s.Log.Errorf("failed to reload group booking: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	response := converter.GroupBookingEntityToResponse(data)
+	for i := range data.Shares {
+		response.Shares[i].Payment = payments[i]
+		if err := s.sendInviteEmail(&event, organiser, data, &data.Shares[i], payments[i]); err != nil {
+			s.Log.Errorf("failed to send group invite email for share %d: %v", data.Shares[i].ID, err)
+		}
+	}
+
+	return response, nil
+}
+
+func (s *GroupServiceImpl) GetGroupBookings(ctx context.Context, request *model.GroupBookingsRequest) (*model.Response[[]*model.GroupBookingResponse], error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	if request.Size <= 0 {
+		request.Size = 10
+	}
+	if request.Page <= 0 {
+		request.Page = 1
+	}
+
+	userID := claims.UserID
+	if s.helper.IsAdmin(ctx) {
+		userID = ""
+	}
+
+	var groups []entity.GroupBooking
+	totalItems, err := s.GroupRepository.GetPaginated(s.DB.WithContext(ctx), &groups, userID, claims.Email, request.Page, request.Size)
+	if err != nil {
+		s.Log.Errorf("failed to get group bookings: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if len(groups) == 0 {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	return converter.GroupBookingsToPaginatedResponse(groups, totalItems, request.Page, request.Size), nil
+}
+
+// GetGroupBookingByID is open to the organiser, the invited participants and admins
+func (s *GroupServiceImpl) GetGroupBookingByID(ctx context.Context, request *model.GetGroupBookingRequest) (*model.GroupBookingResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	data := &entity.GroupBooking{}
+	if err := s.GroupRepository.GetByID(s.DB.WithContext(ctx), data, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get group booking: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.helper.VerifyOwnership(ctx, data.OrganiserID); err != nil {
+		invited := false
+		for i := range data.Shares {
+			if strings.EqualFold(data.Shares[i].Email, claims.Email) {
+				invited = true
+				break
+			}
+		}
+		if !invited {
+			return nil, domainErrors.ErrForbidden
+		}
+	}
+
+	return converter.GroupBookingEntityToResponse(data), nil
+}
+
+// ReleaseUnpaidShares gives the seats of shares still unpaid at their group's deadline back to
+// general sale. Paid shares keep their seats. The share invoices expire at the same deadline,
+// a payment that still lands on a released share is flagged for a refund by the payment callback.
+func (s *GroupServiceImpl) ReleaseUnpaidShares(ctx context.Context) error {
+	db := s.DB.WithContext(ctx)
+
+	groups, err := s.GroupRepository.GetPastDeadline(db, time.Now())
+	if err != nil {
+		s.Log.Errorf("failed to get group bookings past deadline: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	var released []*entity.Ticket
+	for i := range groups {
+		g := &groups[i]
+
+		var freed []*entity.Ticket
+		err := db.Transaction(func(tx *gorm.DB) error {
+			shares, err := s.GroupRepository.LockInvitedShares(tx, g.ID)
+			if err != nil {
+				return err
+			}
+
+			for j := range shares {
+				tickets, err := s.TicketRepository.Find(tx, &model.TicketQueryOptions{
+					OrderID: &shares[j].OrderID,
+				})
+				if err != nil {
+					return err
+				}
+
+				if err := s.TicketRepository.ReleaseByOrderID(tx, shares[j].OrderID); err != nil {
+					return err
+				}
+
+				if err := tx.Model(&entity.Payment{}).
+					Where("order_id = ? AND status = ?", shares[j].OrderID, model.PaymentStatusPending).
+					Update("status", model.PaymentStatusExpired).Error; err != nil {
+					return err
+				}
+
+				if err := tx.Model(&entity.GroupShare{}).
+					Where("id = ?", shares[j].ID).
+					Update("status", model.GroupShareStatusReleased).Error; err != nil {
+					return err
+				}
+
+				freed = append(freed, tickets...)
+			}
+
+			return s.GroupRepository.Finalize(tx, g.ID)
+		})
+		if err != nil {
+			s.Log.Errorf("failed to release unpaid shares of group booking %d: %v", g.ID, err)
+			continue
+		}
+
+		released = append(released, freed...)
+	}
+
+	s.deleteTicketCache(released)
+	s.ReservationService.Free(ctx, released)
+
+	// Freed seats go to the waitlist first, like any other released inventory
+	s.WaitlistService.OfferReleasedTickets(ctx, released)
+
+	return nil
+}
+
+func (s *GroupServiceImpl) sendInviteEmail(event *entity.Event, organiser *entity.User, data *entity.GroupBooking, share *entity.GroupShare, p *model.CreatePaymentResponse) error {
+	var replaceEmail = struct {
+		Name          string
+		OrganiserName string
+		EventName     string
+		Amount        float64
+		Deadline      string
+		PaymentURL    string
+		Tickets       []entity.Ticket
+	}{
+		Name:          share.Name,
+		OrganiserName: organiser.Name,
+		EventName:     event.Name,
+		Amount:        share.Order.TotalPrice,
+		Deadline:      helper.FormatDate(data.Deadline),
+		PaymentURL:    p.PaymentURL,
+		Tickets:       share.Order.Tickets,
+	}
+
+	tmpl, err := template.ParseFS(templateFS, "template/group-invite.html")
+	if err != nil {
+		return err
+	}
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, &replaceEmail); err != nil {
+		return err
+	}
+
+	return s.Gomail.SendEmail(&gomail.SendEmail{
+		EmailTo:   share.Email,
+		EmailFrom: s.Gomail.GetFromEmail(),
+		Subject:   "[TrinityKnights] You Are Invited to a Group Booking",
+		Body:      body,
+	})
+}
+
+func (s *GroupServiceImpl) deleteTicketCache(tickets []*entity.Ticket) {
+	for _, t := range tickets {
+		if err := s.Cache.Delete(fmt.Sprintf("ticket:get:id:%s", t.ID)); err != nil {
+			s.Log.Errorf("failed to delete cache: %v", err)
+		}
+	}
+}
+
+func ticketIDs(tickets []*entity.Ticket) []string {
+	ids := make([]string, len(tickets))
+	for i, t := range tickets {
+		ids[i] = t.ID
+	}
+	return ids
+}
@@ -8,6 +8,9 @@ import (
 
 type OrderService interface {
 	CreateOrder(ctx context.Context, request *model.OrderTicketRequest) (*model.OrderResponse, error)
+	CreateGuestOrder(ctx context.Context, request *model.GuestOrderRequest) (*model.OrderResponse, error)
+	RequestGuestOrderLink(ctx context.Context, request *model.GuestOrderLookupRequest) (*model.VerifyResponse, error)
+	GetGuestOrder(ctx context.Context, request *model.GetGuestOrderRequest) (*model.OrderResponse, error)
 	GetOrderByID(ctx context.Context, request *model.GetOrderRequest) (*model.OrderResponse, error)
 	GetOrders(ctx context.Context, request *model.OrdersRequest) (*model.Response[[]*model.OrderResponse], error)
 }
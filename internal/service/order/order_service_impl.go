@@ -1,9 +1,13 @@
 package order
 
 import (
+	"bytes"
 	"context"
+	"embed"
 	"errors"
 	"fmt"
+	"html/template"
+	"strings"
 	"time"
 
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
@@ -11,37 +15,67 @@ import (
 	"github.com/TrinityKnights/Backend/internal/domain/model/converter"
 	"github.com/TrinityKnights/Backend/internal/repository/order"
 	"github.com/TrinityKnights/Backend/internal/repository/ticket"
+	"github.com/TrinityKnights/Backend/internal/repository/waitlist"
+	"github.com/TrinityKnights/Backend/internal/service/attendee"
 	"github.com/TrinityKnights/Backend/internal/service/payment"
+	"github.com/TrinityKnights/Backend/internal/service/product"
+	"github.com/TrinityKnights/Backend/internal/service/reservation"
+	"github.com/TrinityKnights/Backend/internal/service/waitingroom"
 	"github.com/TrinityKnights/Backend/pkg/cache"
 	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/gomail"
 	"github.com/TrinityKnights/Backend/pkg/helper"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
+//go:embed template/*.html
+var templateFS embed.FS
+
+const DefaultGuestLinkTTL = 24 * time.Hour
+
 type OrderServiceImpl struct {
-	DB               *gorm.DB
-	Cache            *cache.ImplCache
-	Log              *logrus.Logger
-	Validate         *validator.Validate
-	OrderRepository  order.OrderRepository
-	TicketRepository ticket.TicketRepository
-	PaymentService   payment.PaymentService
-	helper           *helper.ContextHelper
+	DB                 *gorm.DB
+	Cache              *cache.ImplCache
+	Log                *logrus.Logger
+	Validate           *validator.Validate
+	OrderRepository    order.OrderRepository
+	TicketRepository   ticket.TicketRepository
+	WaitlistRepository waitlist.WaitlistRepository
+	PaymentService     payment.PaymentService
+	AttendeeService    attendee.AttendeeService
+	ProductService     product.ProductService
+	ReservationService reservation.ReservationService
+	WaitingRoomService waitingroom.WaitingRoomService
+	Gomail             *gomail.ImplGomail
+	GuestLinkTTL       time.Duration
+	helper             *helper.ContextHelper
 }
 
-func NewOrderServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, orderRepository order.OrderRepository, ticketRepository ticket.TicketRepository, paymentService payment.PaymentService) *OrderServiceImpl {
+func NewOrderServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, orderRepository order.OrderRepository, ticketRepository ticket.TicketRepository, waitlistRepository waitlist.WaitlistRepository, paymentService payment.PaymentService, attendeeService attendee.AttendeeService, productService product.ProductService, reservationService reservation.ReservationService, waitingRoomService waitingroom.WaitingRoomService, mail *gomail.ImplGomail, guestLinkTTL time.Duration) *OrderServiceImpl {
+	if guestLinkTTL <= 0 {
+		guestLinkTTL = DefaultGuestLinkTTL
+	}
+
 	return &OrderServiceImpl{
-		DB:               db,
-		Cache:            cacheImpl,
-		Log:              log,
-		Validate:         validate,
-		OrderRepository:  orderRepository,
-		TicketRepository: ticketRepository,
-		PaymentService:   paymentService,
-		helper:           helper.NewContextHelper(),
+		DB:                 db,
+		Cache:              cacheImpl,
+		Log:                log,
+		Validate:           validate,
+		OrderRepository:    orderRepository,
+		TicketRepository:   ticketRepository,
+		WaitlistRepository: waitlistRepository,
+		PaymentService:     paymentService,
+		AttendeeService:    attendeeService,
+		ProductService:     productService,
+		ReservationService: reservationService,
+		WaitingRoomService: waitingRoomService,
+		Gomail:             mail,
+		GuestLinkTTL:       guestLinkTTL,
+		helper:             helper.NewContextHelper(),
 	}
 }
 
@@ -56,6 +90,137 @@ func (s *OrderServiceImpl) CreateOrder(ctx context.Context, request *model.Order
 		return nil, domainErrors.ErrUnauthorized
 	}
 
+	dataOrder, p, err := s.placeOrder(ctx, request, entity.Order{UserID: &claims.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	response := converter.OrderEntityToResponse(dataOrder)
+	response.Payment = p
+
+	return response, nil
+}
+
+// CreateGuestOrder places an order for a buyer without an account. The buyer is emailed a link to
+// view the order and can request a new one later with the order code and their email.
+func (s *OrderServiceImpl) CreateGuestOrder(ctx context.Context, request *model.GuestOrderRequest) (*model.OrderResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	// Waitlist offers are made to accounts
+	if request.HoldToken != "" {
+		return nil, domainErrors.ErrForbidden
+	}
+
+	code := fmt.Sprintf("TK-%s", strings.ToUpper(uuid.NewString()[:8]))
+	email, name := request.Email, request.Name
+	dataOrder, p, err := s.placeOrder(ctx, &request.OrderTicketRequest, entity.Order{
+		Code:       &code,
+		GuestEmail: &email,
+		GuestName:  &name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sendGuestOrderLink(dataOrder); err != nil {
+		s.Log.Errorf("failed to send guest order link for order %d: %v", dataOrder.ID, err)
+	}
+
+	response := converter.OrderEntityToResponse(dataOrder)
+	response.Payment = p
+
+	return response, nil
+}
+
+// RequestGuestOrderLink emails a fresh order link when the code and email match a guest order.
+// It reports success either way so the endpoint cannot be used to probe for orders.
+func (s *OrderServiceImpl) RequestGuestOrderLink(ctx context.Context, request *model.GuestOrderLookupRequest) (*model.VerifyResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	var dataOrder entity.Order
+	if err := s.OrderRepository.GetGuestOrderByCode(s.DB.WithContext(ctx), &dataOrder, request.Code, request.Email); err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.Log.Errorf("failed to get guest order: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+		s.Log.Warnf("guest order lookup did not match any order for code %s", request.Code)
+		return &model.VerifyResponse{Status: "success"}, nil
+	}
+
+	if err := s.sendGuestOrderLink(&dataOrder); err != nil {
+		s.Log.Errorf("failed to send guest order link for order %d: %v", dataOrder.ID, err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return &model.VerifyResponse{Status: "success"}, nil
+}
+
+// GetGuestOrder shows the tickets and payment status of the order behind an emailed link
+func (s *OrderServiceImpl) GetGuestOrder(ctx context.Context, request *model.GetGuestOrderRequest) (*model.OrderResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	var orderID uint
+	if err := s.Cache.Get(guestLinkKey(request.Token), &orderID); err != nil {
+		if errors.Is(err, cache.ErrCacheMiss) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get guest order link: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	var dataOrder entity.Order
+	if err := s.OrderRepository.GetByIDWithDetails(s.DB.WithContext(ctx), &dataOrder, orderID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get order: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.OrderEntityToResponse(&dataOrder), nil
+}
+
+// placeOrder reserves the requested tickets and add-ons for the given owner, an account or a guest,
+// and creates the order together with its invoice.
+func (s *OrderServiceImpl) placeOrder(ctx context.Context, request *model.OrderTicketRequest, owner entity.Order) (*entity.Order, *model.CreatePaymentResponse, error) {
+	// While the event's waiting room is open only admitted buyers get through, waitlist offers
+	// were already given their seats
+	committed := false
+	if request.HoldToken == "" {
+		if err := s.WaitingRoomService.ClaimAdmission(ctx, request.EventID, request.AdmissionToken); err != nil {
+			return nil, nil, err
+		}
+		defer func() {
+			if !committed {
+				s.WaitingRoomService.ReleaseAdmission(ctx, request.EventID, request.AdmissionToken)
+			}
+		}()
+	}
+
+	// Seats are claimed in Redis first so that a crowd going for the same seats does not queue on
+	// their row locks. A waitlist offer's claims belong to its hold token.
+	claimOwner := request.HoldToken
+	if claimOwner == "" {
+		claimOwner = uuid.NewString()
+	}
+	if err := s.ReservationService.Claim(ctx, request.EventID, request.TicketIDs, claimOwner); err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		if committed {
+			s.ReservationService.Confirm(ctx, request.EventID, request.TicketIDs, claimOwner)
+		} else {
+			s.ReservationService.Release(ctx, request.EventID, request.TicketIDs, claimOwner)
+		}
+	}()
+
 	tx := s.DB.WithContext(ctx).Begin()
 	defer tx.Rollback()
 
@@ -63,10 +228,41 @@ func (s *OrderServiceImpl) CreateOrder(ctx context.Context, request *model.Order
 	var event entity.Event
 	if err := tx.First(&event, request.EventID).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, domainErrors.ErrNotFound
+			return nil, nil, domainErrors.ErrNotFound
 		}
 		s.Log.Errorf("failed to get event: %v", err)
-		return nil, domainErrors.ErrInternalServer
+		return nil, nil, domainErrors.ErrInternalServer
+	}
+
+	// Drafts are not public yet, cancelled and postponed events stop selling
+	switch event.Status {
+	case model.EventStatusPublished:
+	case model.EventStatusDraft, model.EventStatusScheduled:
+		return nil, nil, domainErrors.ErrNotFound
+	default:
+		return nil, nil, domainErrors.ErrEventNotOnSale
+	}
+
+	// Tickets held for a waitlist offer can only be bought with the offer's hold token
+	var offer *entity.WaitlistEntry
+	if request.HoldToken != "" {
+		offer = &entity.WaitlistEntry{}
+		if err := s.WaitlistRepository.GetByOfferToken(tx.Clauses(clause.Locking{Strength: "UPDATE"}), offer, request.HoldToken); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, nil, domainErrors.ErrNotFound
+			}
+			s.Log.Errorf("failed to get waitlist offer: %v", err)
+			return nil, nil, domainErrors.ErrInternalServer
+		}
+
+		if offer.UserID != helper.StringOrEmpty(owner.UserID) {
+			return nil, nil, domainErrors.ErrForbidden
+		}
+
+		if offer.EventID != event.ID || offer.Status != model.WaitlistStatusOffered ||
+			offer.OfferExpiresAt == nil || offer.OfferExpiresAt.Before(time.Now()) {
+			return nil, nil, domainErrors.ErrOfferExpired
+		}
 	}
 
 	// First check if tickets exist and are available (without locking)
@@ -77,15 +273,15 @@ func (s *OrderServiceImpl) CreateOrder(ctx context.Context, request *model.Order
 	if err != nil {
 		s.Log.Errorf("failed to get tickets: %v", err)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, domainErrors.ErrNotFound
+			return nil, nil, domainErrors.ErrNotFound
 		}
-		return nil, domainErrors.ErrInternalServer
+		return nil, nil, domainErrors.ErrInternalServer
 	}
 
 	// Check if any seats are already taken
 	for _, ticket := range tickets {
 		if ticket.OrderID != nil {
-			return nil, domainErrors.ErrSeatAlreadyTaken
+			return nil, nil, domainErrors.ErrSeatAlreadyTaken
 		}
 	}
 
@@ -95,17 +291,20 @@ func (s *OrderServiceImpl) CreateOrder(ctx context.Context, request *model.Order
 
 	s.Log.Infof("Verifying tickets - IDs: %v, Seats: %v", request.TicketIDs, request.SeatNumbers)
 
+	now := time.Now()
 	for _, ticketID := range request.TicketIDs {
 		var t entity.Ticket
-		// Lock individual ticket for update
+		// Lock individual ticket for update, skipping tickets held for someone else or set aside for partners.
+		// The ticket has to belong to the event whose status and admission were checked above.
 		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
-			Where("id = ? AND order_id IS NULL", ticketID).
+			Where("id = ? AND event_id = ? AND order_id IS NULL AND allocation_id IS NULL", ticketID, event.ID).
+			Where("held_until IS NULL OR held_until < ? OR hold_token = ?", now, request.HoldToken).
 			First(&t).Error; err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return nil, domainErrors.ErrSeatAlreadyTaken
+				return nil, nil, domainErrors.ErrSeatAlreadyTaken
 			}
 			s.Log.Errorf("failed to lock ticket: %v", err)
-			return nil, domainErrors.ErrInternalServer
+			return nil, nil, domainErrors.ErrInternalServer
 		}
 
 		// Add ticket to our target tickets slice
@@ -113,22 +312,33 @@ func (s *OrderServiceImpl) CreateOrder(ctx context.Context, request *model.Order
 		totalPrice += t.Price
 	}
 
+	// Add-ons are reserved in the same transaction that holds the ticket locks
+	var items []entity.OrderItem
+	if len(request.Products) > 0 {
+		items, err = s.ProductService.ReserveProducts(ctx, tx, event.ID, request.Products)
+		if err != nil {
+			return nil, nil, err
+		}
+		for i := range items {
+			totalPrice += items[i].TotalPrice
+		}
+	}
+
 	// Convert pointer slice to value slice
 	orderTickets := make([]entity.Ticket, len(targetTickets))
 	for i, t := range targetTickets {
 		orderTickets[i] = *t
 	}
 
-	dataOrder := entity.Order{
-		UserID:     claims.UserID,
-		Date:       time.Now(),
-		TotalPrice: totalPrice,
-		Tickets:    orderTickets,
-	}
+	dataOrder := owner
+	dataOrder.Date = time.Now()
+	dataOrder.TotalPrice = totalPrice
+	dataOrder.Tickets = orderTickets
+	dataOrder.Items = items
 
 	if err := s.OrderRepository.Create(tx, &dataOrder); err != nil {
 		s.Log.Errorf("failed to create order: %v", err)
-		return nil, domainErrors.ErrInternalServer
+		return nil, nil, domainErrors.ErrInternalServer
 	}
 
 	// Update tickets one by one
@@ -145,14 +355,29 @@ func (s *OrderServiceImpl) CreateOrder(ctx context.Context, request *model.Order
 
 		if err := s.TicketRepository.Update(tx, &updateTicket); err != nil {
 			s.Log.Errorf("failed to update ticket: %v", err)
-			return nil, domainErrors.ErrInternalServer
+			return nil, nil, domainErrors.ErrInternalServer
+		}
+	}
+
+	if offer != nil {
+		offer.Status = model.WaitlistStatusPurchased
+		if err := s.WaitlistRepository.Update(tx, offer); err != nil {
+			s.Log.Errorf("failed to update waitlist offer: %v", err)
+			return nil, nil, domainErrors.ErrInternalServer
+		}
+	}
+
+	// Holder details are optional at checkout and can be completed later
+	if len(request.Attendees) > 0 {
+		if err := s.AttendeeService.SaveAttendees(ctx, tx, event.ID, dataOrder.ID, request.Attendees); err != nil {
+			return nil, nil, err
 		}
 	}
 
 	// Reload order with tickets
-	if err := tx.Preload("Tickets").First(&dataOrder, dataOrder.ID).Error; err != nil {
+	if err := tx.Preload("Tickets").Preload("Tickets.Answers.Question").Preload("Items.Vouchers").First(&dataOrder, dataOrder.ID).Error; err != nil {
 		s.Log.Errorf("failed to reload order: %v", err)
-		return nil, domainErrors.ErrInternalServer
+		return nil, nil, domainErrors.ErrInternalServer
 	}
 
 	// After creating the order and updating the tickets, create payment
@@ -164,18 +389,16 @@ func (s *OrderServiceImpl) CreateOrder(ctx context.Context, request *model.Order
 	p, err := s.PaymentService.CreateInvoice(ctx, tx, paymentRequest)
 	if err != nil {
 		s.Log.Errorf("failed to create payment: %v", err)
-		return nil, domainErrors.ErrInternalServer
+		return nil, nil, domainErrors.ErrInternalServer
 	}
 
 	if err := tx.Commit().Error; err != nil {
 		s.Log.Errorf("failed to commit transaction: %v", err)
-		return nil, domainErrors.ErrInternalServer
+		return nil, nil, domainErrors.ErrInternalServer
 	}
+	committed = true
 
-	response := converter.OrderEntityToResponse(&dataOrder)
-	response.Payment = p
-
-	return response, nil
+	return &dataOrder, p, nil
 }
 
 func (s *OrderServiceImpl) GetOrderByID(ctx context.Context, request *model.GetOrderRequest) (*model.OrderResponse, error) {
@@ -229,16 +452,25 @@ func (s *OrderServiceImpl) GetOrders(ctx context.Context, request *model.OrdersR
 	}
 
 	// Try to get from cache first
-	cacheKey := fmt.Sprintf("order:get:page:%d:size:%d:sort:%s:order:%s",
-		request.Page, request.Size, request.Sort, request.Order)
+	cacheKey := fmt.Sprintf("order:get:page:%d:size:%d:sort:%s:order:%s:kind:%s",
+		request.Page, request.Size, request.Sort, request.Order, request.Kind)
 	var cacheResponse model.Response[[]*model.OrderResponse]
 	if err := s.Cache.Get(cacheKey, &cacheResponse); err == nil {
 		return &cacheResponse, nil
 	}
 
+	// Complimentary orders are kept apart from paid sales in reports
+	db := s.DB.WithContext(ctx)
+	switch request.Kind {
+	case "paid":
+		db = db.Where("complimentary = ?", false)
+	case "complimentary":
+		db = db.Where("complimentary = ?", true)
+	}
+
 	var orders []entity.Order
 	totalItems, err := s.OrderRepository.GetPaginatedOrders(
-		s.DB.WithContext(ctx),
+		db,
 		&orders,
 		request.Page,
 		request.Size,
@@ -263,3 +495,42 @@ func (s *OrderServiceImpl) GetOrders(ctx context.Context, request *model.OrdersR
 
 	return response, nil
 }
+
+func (s *OrderServiceImpl) sendGuestOrderLink(order *entity.Order) error {
+	token := uuid.NewString()
+	if err := s.Cache.Set(guestLinkKey(token), order.ID, s.GuestLinkTTL); err != nil {
+		return err
+	}
+
+	var replaceEmail = struct {
+		Name      string
+		Code      string
+		Token     string
+		ExpiresAt string
+	}{
+		Name:      helper.StringOrEmpty(order.GuestName),
+		Code:      helper.StringOrEmpty(order.Code),
+		Token:     token,
+		ExpiresAt: helper.FormatDate(time.Now().Add(s.GuestLinkTTL)),
+	}
+
+	tmpl, err := template.ParseFS(templateFS, "template/guest-order-link.html")
+	if err != nil {
+		return err
+	}
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, &replaceEmail); err != nil {
+		return err
+	}
+
+	return s.Gomail.SendEmail(&gomail.SendEmail{
+		EmailTo:   helper.StringOrEmpty(order.GuestEmail),
+		EmailFrom: s.Gomail.GetFromEmail(),
+		Subject:   fmt.Sprintf("[TrinityKnights] Your Order %s", helper.StringOrEmpty(order.Code)),
+		Body:      body,
+	})
+}
+
+func guestLinkKey(token string) string {
+	return fmt.Sprintf("order:guest:link:%s", token)
+}
@@ -0,0 +1,16 @@
+package pass
+
+import (
+	"context"
+
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+)
+
+type PassService interface {
+	CreatePass(ctx context.Context, request *model.CreatePassRequest) (*model.PassResponse, error)
+	DeletePass(ctx context.Context, request *model.DeletePassRequest) error
+	GetPasses(ctx context.Context) ([]*model.PassResponse, error)
+	GetPassByID(ctx context.Context, request *model.GetPassRequest) (*model.PassResponse, error)
+	OrderPass(ctx context.Context, request *model.OrderPassRequest) (*model.OrderResponse, error)
+	ScanPass(ctx context.Context, request *model.ScanPassRequest) (*model.PassScanResponse, error)
+}
@@ -0,0 +1,440 @@
+package pass
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/domain/model/converter"
+	"github.com/TrinityKnights/Backend/internal/repository/order"
+	"github.com/TrinityKnights/Backend/internal/repository/pass"
+	"github.com/TrinityKnights/Backend/internal/repository/ticket"
+	"github.com/TrinityKnights/Backend/internal/service/payment"
+	"github.com/TrinityKnights/Backend/internal/service/reservation"
+	"github.com/TrinityKnights/Backend/internal/service/waitingroom"
+	"github.com/TrinityKnights/Backend/pkg/cache"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PassServiceImpl struct {
+	DB                 *gorm.DB
+	Cache              *cache.ImplCache
+	Log                *logrus.Logger
+	Validate           *validator.Validate
+	PassRepository     pass.PassRepository
+	OrderRepository    order.OrderRepository
+	TicketRepository   ticket.TicketRepository
+	PaymentService     payment.PaymentService
+	WaitingRoomService waitingroom.WaitingRoomService
+	ReservationService reservation.ReservationService
+	helper             *helper.ContextHelper
+}
+
+func NewPassServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, passRepository pass.PassRepository, orderRepository order.OrderRepository, ticketRepository ticket.TicketRepository, paymentService payment.PaymentService, waitingRoomService waitingroom.WaitingRoomService, reservationService reservation.ReservationService) *PassServiceImpl {
+	return &PassServiceImpl{
+		DB:                 db,
+		Cache:              cacheImpl,
+		Log:                log,
+		Validate:           validate,
+		PassRepository:     passRepository,
+		OrderRepository:    orderRepository,
+		TicketRepository:   ticketRepository,
+		PaymentService:     paymentService,
+		WaitingRoomService: waitingRoomService,
+		ReservationService: reservationService,
+		helper:             helper.NewContextHelper(),
+	}
+}
+
+// CreatePass defines a pass over a fixed set of events, every event of a series, or both.
+func (s *PassServiceImpl) CreatePass(ctx context.Context, request *model.CreatePassRequest) (*model.PassResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	if request.SeriesID == 0 && len(request.EventIDs) == 0 {
+		return nil, domainErrors.ErrValidation
+	}
+
+	ticketType := helper.TicketUpper(request.Type)
+	if ticketType.Long == "" {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if request.SeriesID != 0 {
+		if err := tx.First(&entity.EventSeries{}, request.SeriesID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, domainErrors.ErrNotFound
+			}
+			s.Log.Errorf("failed to get series: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+	}
+
+	var events []entity.Event
+	if len(request.EventIDs) > 0 {
+		if err := tx.Where("id IN ?", request.EventIDs).Find(&events).Error; err != nil {
+			s.Log.Errorf("failed to get events: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		seen := make(map[uint]bool, len(request.EventIDs))
+		for _, id := range request.EventIDs {
+			seen[id] = true
+		}
+		if len(events) != len(seen) {
+			return nil, domainErrors.ErrNotFound
+		}
+	}
+
+	data := &entity.Pass{
+		Name:   request.Name,
+		Price:  request.Price,
+		Type:   ticketType.Long,
+		Events: events,
+	}
+	if request.Description != "" {
+		data.Description = &request.Description
+	}
+	if request.SeriesID != 0 {
+		data.SeriesID = &request.SeriesID
+	}
+
+	// Only the pass_events links are written, the events themselves are left untouched
+	if err := s.PassRepository.Create(tx.Omit("Events.*"), data); err != nil {
+		s.Log.Errorf("failed to create pass: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return s.toResponse(s.DB.WithContext(ctx), data)
+}
+
+func (s *PassServiceImpl) DeletePass(ctx context.Context, request *model.DeletePassRequest) error {
+	if err := s.Validate.Struct(request); err != nil {
+		return domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data := &entity.Pass{}
+	if err := s.PassRepository.GetByID(tx, data, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get pass: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	// Passes already sold keep their seats and can still be scanned
+	if err := s.PassRepository.Delete(tx, data); err != nil {
+		s.Log.Errorf("failed to delete pass: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domainErrors.ErrInternalServer
+	}
+
+	return nil
+}
+
+func (s *PassServiceImpl) GetPasses(ctx context.Context) ([]*model.PassResponse, error) {
+	db := s.DB.WithContext(ctx)
+
+	var passes []entity.Pass
+	if err := s.PassRepository.GetAll(db, &passes); err != nil {
+		s.Log.Errorf("failed to get passes: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if len(passes) == 0 {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	responses := make([]*model.PassResponse, len(passes))
+	for i := range passes {
+		response, err := s.toResponse(db, &passes[i])
+		if err != nil {
+			return nil, err
+		}
+		responses[i] = response
+	}
+
+	return responses, nil
+}
+
+func (s *PassServiceImpl) GetPassByID(ctx context.Context, request *model.GetPassRequest) (*model.PassResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	db := s.DB.WithContext(ctx)
+
+	data := &entity.Pass{}
+	if err := s.PassRepository.GetByID(db, data, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get pass: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return s.toResponse(db, data)
+}
+
+// OrderPass buys a pass in a single order. One seat of the pass's category is taken at every
+// upcoming covered event, so a pass counts against each event's capacity like any other ticket.
+func (s *PassServiceImpl) OrderPass(ctx context.Context, request *model.OrderPassRequest) (*model.OrderResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data := &entity.Pass{}
+	if err := s.PassRepository.GetByID(tx, data, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get pass: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	now := time.Now()
+
+	// A pass is sold for all of the events it was defined with or not at all, one of them going
+	// off sale stops the pass rather than quietly covering less
+	for i := range data.Events {
+		if !data.Events[i].StartsAt.Before(now) && data.Events[i].Status != model.EventStatusPublished {
+			s.Log.Warnf("pass %d cannot be sold, event %d is %s", data.ID, data.Events[i].ID, data.Events[i].Status)
+			return nil, domainErrors.ErrEventNotOnSale
+		}
+	}
+
+	// Covered events are share-locked so that none of them is cancelled while the pass is sold
+	events, err := s.PassRepository.GetCoveredEvents(tx.Clauses(clause.Locking{Strength: "SHARE"}), data, now)
+	if err != nil {
+		s.Log.Errorf("failed to get covered events: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if len(events) == 0 {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	// A pass takes a seat at every covered event, so it needs admission wherever a queue is open
+	eventIDs := make([]uint, len(events))
+	for i := range events {
+		eventIDs[i] = events[i].ID
+	}
+	if err := s.WaitingRoomService.ClaimAdmissions(ctx, eventIDs, request.AdmissionTokens); err != nil {
+		return nil, err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			s.WaitingRoomService.ReleaseAdmissions(ctx, eventIDs, request.AdmissionTokens)
+		}
+	}()
+
+	seats := make([]*entity.Ticket, 0, len(events))
+	for i := range events {
+		tickets, err := s.TicketRepository.FindAvailableForHold(tx, events[i].ID, data.Type, 1, now)
+		if err != nil {
+			s.Log.Errorf("failed to find available tickets: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		if len(tickets) == 0 {
+			s.Log.Warnf("pass %d cannot be sold, event %d has no %s seats left", data.ID, events[i].ID, data.Type)
+			return nil, domainErrors.ErrNotEnoughTickets
+		}
+		seats = append(seats, tickets[0])
+	}
+
+	// The picked seats are claimed in Redis too, so that checkouts choosing them are turned away
+	// before they reach the database
+	claimed := reservation.Seats(seats)
+	claimOwner := uuid.NewString()
+	if err := s.ReservationService.ClaimSeats(ctx, claimed, claimOwner); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if committed {
+			s.ReservationService.ConfirmSeats(ctx, claimed, claimOwner)
+		} else {
+			s.ReservationService.ReleaseSeats(ctx, claimed, claimOwner)
+		}
+	}()
+
+	code := fmt.Sprintf("PS-%s", strings.ToUpper(uuid.NewString()[:8]))
+	dataOrder := &entity.Order{
+		UserID:     &claims.UserID,
+		Code:       &code,
+		Date:       now,
+		TotalPrice: data.Price,
+		PassID:     &data.ID,
+	}
+
+	if err := s.OrderRepository.Create(tx.Omit(clause.Associations), dataOrder); err != nil {
+		s.Log.Errorf("failed to create order: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	ids := make([]string, len(seats))
+	for i, t := range seats {
+		ids[i] = t.ID
+	}
+
+	if err := s.TicketRepository.AssignToOrder(tx, ids, dataOrder.ID, nil, nil); err != nil {
+		s.Log.Errorf("failed to assign tickets: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	p, err := s.PaymentService.CreateInvoice(ctx, tx, &model.CreatePaymentRequest{
+		OrderID: dataOrder.ID,
+		Amount:  dataOrder.TotalPrice,
+	})
+	if err != nil {
+		s.Log.Errorf("failed to create payment: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Preload("Tickets", func(db *gorm.DB) *gorm.DB {
+		return db.Order("event_id ASC")
+	}).First(dataOrder, dataOrder.ID).Error; err != nil {
+		s.Log.Errorf("failed to reload order: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+	committed = true
+
+	s.Log.Infof("pass %d sold to %s as order %d covering %d event(s)", data.ID, claims.UserID, dataOrder.ID, len(events))
+
+	if err := s.Cache.DeletePattern("order:get:page:*"); err != nil {
+		s.Log.Errorf("failed to delete cache: %v", err)
+	}
+	s.deleteTicketCache(ids)
+
+	response := converter.OrderEntityToResponse(dataOrder)
+	response.Payment = p
+
+	return response, nil
+}
+
+// ScanPass admits a pass holder at one of the covered events. A pass is valid once per event and
+// only after its order has been paid.
+func (s *PassServiceImpl) ScanPass(ctx context.Context, request *model.ScanPassRequest) (*model.PassScanResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	var dataOrder entity.Order
+	if err := s.PassRepository.GetOrderByCode(tx, &dataOrder, request.Code); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get pass order: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if !dataOrder.Complimentary && (dataOrder.Payment == nil || dataOrder.Payment.Status != model.PaymentStatusPaid) {
+		return nil, domainErrors.ErrOrderNotPaid
+	}
+
+	var dataTicket entity.Ticket
+	if err := s.PassRepository.GetOrderTicket(tx, &dataTicket, dataOrder.ID, request.EventID); err != nil {
+		// The pass does not cover this event
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrForbidden
+		}
+		s.Log.Errorf("failed to get pass ticket: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if dataTicket.CheckedInAt != nil {
+		return nil, domainErrors.ErrAlreadyCheckedIn
+	}
+
+	now := time.Now()
+	if err := s.TicketRepository.CheckIn(tx, dataTicket.ID, now); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrAlreadyCheckedIn
+		}
+		s.Log.Errorf("failed to check in ticket: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	s.deleteTicketCache([]string{dataTicket.ID})
+
+	return &model.PassScanResponse{
+		Code:         helper.StringOrEmpty(dataOrder.Code),
+		PassID:       helper.UintOrZero(dataOrder.PassID),
+		OrderID:      dataOrder.ID,
+		EventID:      dataTicket.EventID,
+		TicketID:     dataTicket.ID,
+		Type:         dataTicket.Type,
+		SeatNumber:   dataTicket.SeatNumber,
+		AttendeeName: dataTicket.AttendeeName,
+		CheckedInAt:  helper.FormatDate(now),
+	}, nil
+}
+
+// toResponse lists the upcoming events a pass currently covers, series events included.
+func (s *PassServiceImpl) toResponse(db *gorm.DB, data *entity.Pass) (*model.PassResponse, error) {
+	events, err := s.PassRepository.GetCoveredEvents(db, data, time.Now())
+	if err != nil {
+		s.Log.Errorf("failed to get covered events: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	data.Events = events
+	return converter.PassEntityToResponse(data), nil
+}
+
+func (s *PassServiceImpl) deleteTicketCache(ids []string) {
+	for _, id := range ids {
+		if err := s.Cache.Delete(fmt.Sprintf("ticket:get:id:%s", id)); err != nil {
+			s.Log.Errorf("failed to delete cache: %v", err)
+		}
+	}
+}
@@ -3,12 +3,22 @@ package payment
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
 	"github.com/TrinityKnights/Backend/internal/domain/model"
 	"github.com/TrinityKnights/Backend/internal/domain/model/converter"
+	"github.com/TrinityKnights/Backend/internal/repository/event"
+	"github.com/TrinityKnights/Backend/internal/repository/exchange"
+	"github.com/TrinityKnights/Backend/internal/repository/group"
+	"github.com/TrinityKnights/Backend/internal/repository/order"
 	"github.com/TrinityKnights/Backend/internal/repository/payment"
+	"github.com/TrinityKnights/Backend/internal/repository/product"
+	"github.com/TrinityKnights/Backend/internal/repository/slot"
+	"github.com/TrinityKnights/Backend/internal/repository/ticket"
+	"github.com/TrinityKnights/Backend/internal/service/reservation"
+	"github.com/TrinityKnights/Backend/internal/service/waitlist"
 	"github.com/TrinityKnights/Backend/pkg/cache"
 	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
 	"github.com/TrinityKnights/Backend/pkg/helper"
@@ -17,27 +27,46 @@ import (
 	xendit "github.com/xendit/xendit-go/v6"
 	invoice "github.com/xendit/xendit-go/v6/invoice"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PaymentServiceImpl struct {
-	DB                *gorm.DB
-	Cache             *cache.ImplCache
-	Log               *logrus.Logger
-	Validate          *validator.Validate
-	PaymentRepository payment.PaymentRepository
-	Xendit            *xendit.APIClient
-	helper            *helper.ContextHelper
+	DB                 *gorm.DB
+	Cache              *cache.ImplCache
+	Log                *logrus.Logger
+	Validate           *validator.Validate
+	PaymentRepository  payment.PaymentRepository
+	TicketRepository   ticket.TicketRepository
+	ExchangeRepository exchange.ExchangeRepository
+	ProductRepository  product.ProductRepository
+	GroupRepository    group.GroupRepository
+	SlotRepository     slot.SlotRepository
+	EventRepository    event.EventRepository
+	OrderRepository    order.OrderRepository
+	WaitlistService    waitlist.WaitlistService
+	ReservationService reservation.ReservationService
+	Xendit             *xendit.APIClient
+	helper             *helper.ContextHelper
 }
 
-func NewPaymentServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, paymentRepository payment.PaymentRepository, x *xendit.APIClient) *PaymentServiceImpl {
+func NewPaymentServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, paymentRepository payment.PaymentRepository, ticketRepository ticket.TicketRepository, exchangeRepository exchange.ExchangeRepository, productRepository product.ProductRepository, groupRepository group.GroupRepository, slotRepository slot.SlotRepository, eventRepository event.EventRepository, orderRepository order.OrderRepository, waitlistService waitlist.WaitlistService, reservationService reservation.ReservationService, x *xendit.APIClient) *PaymentServiceImpl {
 	return &PaymentServiceImpl{
-		DB:                db,
-		Cache:             cacheImpl,
-		Log:               log,
-		Validate:          validate,
-		PaymentRepository: paymentRepository,
-		Xendit:            x,
-		helper:            helper.NewContextHelper(),
+		DB:                 db,
+		Cache:              cacheImpl,
+		Log:                log,
+		Validate:           validate,
+		PaymentRepository:  paymentRepository,
+		TicketRepository:   ticketRepository,
+		ExchangeRepository: exchangeRepository,
+		ProductRepository:  productRepository,
+		GroupRepository:    groupRepository,
+		SlotRepository:     slotRepository,
+		EventRepository:    eventRepository,
+		OrderRepository:    orderRepository,
+		WaitlistService:    waitlistService,
+		ReservationService: reservationService,
+		Xendit:             x,
+		helper:             helper.NewContextHelper(),
 	}
 }
 
@@ -56,25 +85,60 @@ func (s *PaymentServiceImpl) CreateInvoice(ctx context.Context, tx *gorm.DB, req
 		return nil, domainErrors.ErrInternalServer
 	}
 
-	// Verify amount matches order total
-	if order.TotalPrice != request.Amount {
+	expectedAmount := order.TotalPrice
+	externalID := fmt.Sprintf("order_%d", order.ID)
+	description := fmt.Sprintf("Payment for Order #%d", order.ID)
+
+	// A ticket exchange is billed for the price difference only
+	var exchangeID *uint
+	if request.ExchangeID != 0 {
+		var e entity.TicketExchange
+		if err := tx.Where("id = ? AND order_id = ?", request.ExchangeID, order.ID).Take(&e).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil, domainErrors.ErrNotFound
+			}
+			s.Log.Errorf("failed to get ticket exchange: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		expectedAmount = e.PriceDifference
+		externalID = fmt.Sprintf("exchange_%d", e.ID)
+		description = fmt.Sprintf("Ticket exchange #%d for Order #%d", e.ID, order.ID)
+		exchangeID = &e.ID
+	}
+
+	// Verify amount matches what is owed
+	if expectedAmount != request.Amount {
 		return nil, domainErrors.ErrInvalidAmount
 	}
 
-	description := fmt.Sprintf("Payment for Order #%d", order.ID)
 	currency := "IDR"
 	shouldSendEmail := true
 
+	// Orders placed on someone else's behalf are paid by the recipient, guest orders by the guest
+	payerEmail := order.User.Email
+	switch {
+	case order.RecipientEmail != nil:
+		payerEmail = *order.RecipientEmail
+	case order.GuestEmail != nil:
+		payerEmail = *order.GuestEmail
+	}
+
 	// Create Xendit invoice
 	createInvoiceRequest := invoice.CreateInvoiceRequest{
-		ExternalId:      fmt.Sprintf("order_%d", order.ID),
+		ExternalId:      externalID,
 		Amount:          float64(request.Amount),
-		PayerEmail:      &order.User.Email,
+		PayerEmail:      &payerEmail,
 		Description:     &description,
 		Currency:        &currency,
 		ShouldSendEmail: &shouldSendEmail,
 	}
 
+	if request.ExpiresAt != nil {
+		duration := strconv.Itoa(int(time.Until(*request.ExpiresAt).Seconds()))
+		createInvoiceRequest.InvoiceDuration = &duration
+	}
+
 	i, _, err := s.Xendit.InvoiceApi.CreateInvoice(ctx).
 		CreateInvoiceRequest(createInvoiceRequest).
 		Execute()
@@ -85,10 +149,11 @@ func (s *PaymentServiceImpl) CreateInvoice(ctx context.Context, tx *gorm.DB, req
 
 	// Create p record
 	p := &entity.Payment{
-		OrderID:       order.ID,
-		TransactionID: *i.Id,
-		Amount:        request.Amount,
-		Status:        model.PaymentStatus(i.Status),
+		OrderID:          order.ID,
+		TransactionID:    *i.Id,
+		Amount:           request.Amount,
+		Status:           model.PaymentStatus(i.Status),
+		TicketExchangeID: exchangeID,
 	}
 
 	if err := tx.Create(p).Error; err != nil {
@@ -110,25 +175,138 @@ func (s *PaymentServiceImpl) Callback(ctx context.Context, request *model.Paymen
 	tx := s.DB.WithContext(ctx).Begin()
 	defer tx.Rollback()
 
-	// Check if id is valid
-	dataPayment, err := s.PaymentRepository.GetByTransactionID(ctx, request.ID)
+	// Lock the payment so that concurrent deliveries of the same callback settle it only once
+	dataPayment, err := s.PaymentRepository.GetByTransactionID(tx.Clauses(clause.Locking{Strength: "UPDATE"}), request.ID)
 	if err != nil {
 		s.Log.Errorf("failed to get payment by transaction id: %v", err)
+		if err == gorm.ErrRecordNotFound {
+			return nil, domainErrors.ErrNotFound
+		}
 		return nil, domainErrors.ErrInternalServer
 	}
 
 	updatePayment := &model.PaymentUpdateRequest{
 		ID:     dataPayment.ID,
-		Method: *request.PaymentMethod,
+		Method: helper.StringOrEmpty(request.PaymentMethod),
 		Status: model.PaymentStatus(request.Status),
 	}
 
+	// Unpaid group shares are expired here at their deadline, and a payment started just before it
+	// can still land at Xendit. The seats are gone by then, so the money is recorded and the order
+	// flagged for a refund
+	if dataPayment.Status == model.PaymentStatusExpired && updatePayment.Status == model.PaymentStatusPaid &&
+		dataPayment.TicketExchangeID == nil {
+		return s.refundLatePayment(tx, dataPayment, updatePayment)
+	}
+
+	// Xendit retries callbacks and may deliver them out of order. Only a pending invoice can be
+	// settled, otherwise a late EXPIRED would put seats that were paid for back on sale
+	if dataPayment.Status != model.PaymentStatusPending ||
+		(updatePayment.Status != model.PaymentStatusPaid && updatePayment.Status != model.PaymentStatusExpired) {
+		s.Log.Warnf("ignored %s callback for payment %d, which is %s", updatePayment.Status, dataPayment.ID, dataPayment.Status)
+		return &model.PaymentCallbackResponse{
+			Status: string(dataPayment.Status),
+		}, nil
+	}
+
 	// Update payment status
-	if err := s.PaymentRepository.UpdatePaymentStatus(ctx, updatePayment); err != nil {
+	if err := s.PaymentRepository.UpdatePaymentStatus(tx, updatePayment); err != nil {
 		s.Log.Errorf("failed to update payment status: %v", err)
-		if err == gorm.ErrRecordNotFound {
-			return nil, domainErrors.ErrNotFound
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	// An expired invoice gives its tickets, add-ons and slot places back to general sale
+	var released []*entity.Ticket
+	var bookings []*entity.SlotBooking
+	if dataPayment.TicketExchangeID != nil {
+		released, err = s.settleExchange(tx, *dataPayment.TicketExchangeID, updatePayment.Status)
+		if err != nil {
+			return nil, err
+		}
+	} else if updatePayment.Status == model.PaymentStatusExpired {
+		released, err = s.TicketRepository.Find(tx, &model.TicketQueryOptions{
+			OrderID: &dataPayment.OrderID,
+		})
+		if err != nil {
+			s.Log.Errorf("failed to get order tickets: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		if err := s.TicketRepository.ReleaseByOrderID(tx, dataPayment.OrderID); err != nil {
+			s.Log.Errorf("failed to release order tickets: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		if err := s.ProductRepository.RestockByOrderID(tx, dataPayment.OrderID); err != nil {
+			s.Log.Errorf("failed to restock order products: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		bookings, err = s.SlotRepository.GetBookingsByOrderID(tx, dataPayment.OrderID)
+		if err != nil {
+			s.Log.Errorf("failed to get order slot bookings: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		if err := s.SlotRepository.ReleaseByOrderID(tx, dataPayment.OrderID); err != nil {
+			s.Log.Errorf("failed to release order slots: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+	}
+
+	// An invoice paid after its event was cancelled missed the refund flagging done on cancel.
+	// Share locking the events orders this against the cancellation, which locks its event
+	if dataPayment.TicketExchangeID == nil && updatePayment.Status == model.PaymentStatusPaid {
+		if err := s.flagCancelledEvents(tx, dataPayment); err != nil {
+			return nil, err
+		}
+	}
+
+	// A settled group booking share may complete or close its group
+	if dataPayment.TicketExchangeID == nil {
+		var shareStatus model.GroupShareStatus
+		switch updatePayment.Status {
+		case model.PaymentStatusPaid:
+			shareStatus = model.GroupShareStatusPaid
+		case model.PaymentStatusExpired:
+			shareStatus = model.GroupShareStatusReleased
+		}
+		if shareStatus != "" {
+			if err := s.GroupRepository.SettleShare(tx, dataPayment.OrderID, shareStatus); err != nil {
+				s.Log.Errorf("failed to settle group share: %v", err)
+				return nil, domainErrors.ErrInternalServer
+			}
 		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	s.ReservationService.Free(ctx, released)
+	for _, b := range bookings {
+		s.ReservationService.ReleasePlaces(ctx, b.SlotID, b.Quantity)
+	}
+	s.WaitlistService.OfferReleasedTickets(ctx, released)
+
+	return &model.PaymentCallbackResponse{
+		Status: string(updatePayment.Status),
+	}, nil
+}
+
+// refundLatePayment records a payment made after its invoice was expired locally and flags its
+// order for a refund, the order's tickets having already gone back on sale.
+func (s *PaymentServiceImpl) refundLatePayment(tx *gorm.DB, dataPayment *entity.Payment, updatePayment *model.PaymentUpdateRequest) (*model.PaymentCallbackResponse, error) {
+	s.Log.Warnf("payment %d was paid after it expired, marking order %d refund eligible", dataPayment.ID, dataPayment.OrderID)
+
+	if err := s.PaymentRepository.UpdatePaymentStatus(tx, updatePayment); err != nil {
+		s.Log.Errorf("failed to update payment status: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.OrderRepository.MarkRefundEligible(tx, dataPayment.OrderID, time.Now()); err != nil {
+		s.Log.Errorf("failed to mark order refund eligible: %v", err)
 		return nil, domainErrors.ErrInternalServer
 	}
 
@@ -142,6 +320,29 @@ func (s *PaymentServiceImpl) Callback(ctx context.Context, request *model.Paymen
 	}, nil
 }
 
+// flagCancelledEvents makes an order refund eligible when one of its events is cancelled.
+func (s *PaymentServiceImpl) flagCancelledEvents(tx *gorm.DB, dataPayment *entity.Payment) error {
+	var events []entity.Event
+	if err := s.EventRepository.GetByOrderID(tx.Clauses(clause.Locking{Strength: "SHARE"}), &events, dataPayment.OrderID); err != nil {
+		s.Log.Errorf("failed to get order events: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	for i := range events {
+		if events[i].Status != model.EventStatusCancelled {
+			continue
+		}
+		s.Log.Warnf("payment %d settled order %d for cancelled event %d, marking it refund eligible", dataPayment.ID, dataPayment.OrderID, events[i].ID)
+		if err := s.OrderRepository.MarkRefundEligible(tx, dataPayment.OrderID, time.Now()); err != nil {
+			s.Log.Errorf("failed to mark order refund eligible: %v", err)
+			return domainErrors.ErrInternalServer
+		}
+		break
+	}
+
+	return nil
+}
+
 func (s *PaymentServiceImpl) GetPaymentByID(ctx context.Context, request *model.GetPaymentRequest) (*model.PaymentResponse, error) {
 	if err := s.Validate.Struct(request); err != nil {
 		return nil, domainErrors.ErrValidation
@@ -292,3 +493,69 @@ func (s *PaymentServiceImpl) SearchPayments(ctx context.Context, request *model.
 
 	return response, nil
 }
+
+// settleExchange completes a ticket exchange once its differential invoice is paid,
+// or gives up the held ticket when the invoice expires. It returns the freed tickets.
+func (s *PaymentServiceImpl) settleExchange(tx *gorm.DB, exchangeID uint, status model.PaymentStatus) ([]*entity.Ticket, error) {
+	e := &entity.TicketExchange{}
+	if err := s.ExchangeRepository.GetByID(tx.Clauses(clause.Locking{Strength: "UPDATE"}), e, exchangeID); err != nil {
+		s.Log.Errorf("failed to get ticket exchange: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if e.Status != model.TicketExchangeStatusPending {
+		return nil, nil
+	}
+
+	switch status {
+	case model.PaymentStatusPaid:
+		err := s.ExchangeRepository.Complete(tx, e)
+		if err == gorm.ErrRecordNotFound {
+			return nil, s.failExchange(tx, e)
+		}
+		if err != nil {
+			s.Log.Errorf("failed to complete ticket exchange: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+		return []*entity.Ticket{&e.OldTicket}, nil
+	case model.PaymentStatusExpired:
+		if err := s.ExchangeRepository.Expire(tx, e); err != nil {
+			s.Log.Errorf("failed to expire ticket exchange: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+		if e.HoldToken != nil {
+			if err := s.TicketRepository.ReleaseHold(tx, *e.HoldToken); err != nil {
+				s.Log.Errorf("failed to release exchange hold: %v", err)
+				return nil, domainErrors.ErrInternalServer
+			}
+		}
+		return []*entity.Ticket{&e.NewTicket}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// failExchange handles an upgrade paid for after its hold ran out and the new ticket was sold to
+// someone else. The buyer keeps their old ticket and the difference they paid becomes a credit.
+func (s *PaymentServiceImpl) failExchange(tx *gorm.DB, e *entity.TicketExchange) error {
+	s.Log.Warnf("ticket exchange %d was paid but ticket %s is no longer available, crediting %.2f to user %s",
+		e.ID, e.NewTicketID, e.PriceDifference, e.UserID)
+
+	if err := s.ExchangeRepository.Fail(tx, e); err != nil {
+		s.Log.Errorf("failed to fail ticket exchange: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	credit := &entity.UserCredit{
+		UserID:           e.UserID,
+		Amount:           e.PriceDifference,
+		Description:      fmt.Sprintf("Refund for ticket exchange #%d, the new ticket was no longer available", e.ID),
+		TicketExchangeID: &e.ID,
+	}
+	if err := s.ExchangeRepository.CreateCredit(tx, credit); err != nil {
+		s.Log.Errorf("failed to record exchange credit: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package product
+
+import (
+	"context"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"gorm.io/gorm"
+)
+
+type ProductService interface {
+	CreateProduct(ctx context.Context, request *model.CreateProductRequest) (*model.ProductResponse, error)
+	UpdateProduct(ctx context.Context, request *model.UpdateProductRequest) (*model.ProductResponse, error)
+	DeleteProduct(ctx context.Context, request *model.DeleteProductRequest) error
+	GetProducts(ctx context.Context, request *model.ProductsRequest) ([]*model.ProductResponse, error)
+	ReserveProducts(ctx context.Context, tx *gorm.DB, eventID uint, requests []model.OrderProductRequest) ([]entity.OrderItem, error)
+	GetVoucher(ctx context.Context, request *model.GetVoucherRequest) (*model.VoucherResponse, error)
+	RedeemVoucher(ctx context.Context, request *model.GetVoucherRequest) (*model.VoucherResponse, error)
+}
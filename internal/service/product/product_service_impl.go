@@ -0,0 +1,325 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/domain/model/converter"
+	"github.com/TrinityKnights/Backend/internal/repository/product"
+	"github.com/TrinityKnights/Backend/pkg/cache"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProductServiceImpl struct {
+	DB                *gorm.DB
+	Cache             *cache.ImplCache
+	Log               *logrus.Logger
+	Validate          *validator.Validate
+	ProductRepository product.ProductRepository
+	helper            *helper.ContextHelper
+}
+
+func NewProductServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, productRepository product.ProductRepository) *ProductServiceImpl {
+	return &ProductServiceImpl{
+		DB:                db,
+		Cache:             cacheImpl,
+		Log:               log,
+		Validate:          validate,
+		ProductRepository: productRepository,
+		helper:            helper.NewContextHelper(),
+	}
+}
+
+func (s *ProductServiceImpl) CreateProduct(ctx context.Context, request *model.CreateProductRequest) (*model.ProductResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	var event entity.Event
+	if err := tx.First(&event, request.EventID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get event: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	var count int64
+	if err := tx.Model(&entity.Product{}).
+		Where("event_id = ? AND UPPER(sku) = UPPER(?)", event.ID, request.SKU).
+		Count(&count).Error; err != nil {
+		s.Log.Errorf("failed to check product sku: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+	if count > 0 {
+		return nil, domainErrors.ErrDuplicateEntry
+	}
+
+	data := &entity.Product{
+		EventID:    event.ID,
+		SKU:        request.SKU,
+		Name:       request.Name,
+		Price:      request.Price,
+		Stock:      request.Stock,
+		Redeemable: request.Redeemable,
+	}
+	if request.Description != "" {
+		data.Description = &request.Description
+	}
+
+	if err := s.ProductRepository.Create(tx.Omit(clause.Associations), data); err != nil {
+		s.Log.Errorf("failed to create product: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.ProductEntityToResponse(data), nil
+}
+
+func (s *ProductServiceImpl) UpdateProduct(ctx context.Context, request *model.UpdateProductRequest) (*model.ProductResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data := &entity.Product{}
+	if err := s.ProductRepository.GetByID(tx.Clauses(clause.Locking{Strength: "UPDATE"}), data, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get product: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if request.Name != "" {
+		data.Name = request.Name
+	}
+	if request.Description != "" {
+		data.Description = &request.Description
+	}
+	if request.Price != nil {
+		data.Price = *request.Price
+	}
+	if request.Stock != nil {
+		data.Stock = *request.Stock
+	}
+	if request.Redeemable != nil {
+		data.Redeemable = *request.Redeemable
+	}
+
+	if err := s.ProductRepository.Update(tx.Omit(clause.Associations), data); err != nil {
+		s.Log.Errorf("failed to update product: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.ProductEntityToResponse(data), nil
+}
+
+func (s *ProductServiceImpl) DeleteProduct(ctx context.Context, request *model.DeleteProductRequest) error {
+	if err := s.Validate.Struct(request); err != nil {
+		return domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data := &entity.Product{}
+	if err := s.ProductRepository.GetByID(tx, data, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get product: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	// Sold line items keep their own copy of the SKU, name and price
+	if err := s.ProductRepository.Delete(tx, data); err != nil {
+		s.Log.Errorf("failed to delete product: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domainErrors.ErrInternalServer
+	}
+
+	return nil
+}
+
+func (s *ProductServiceImpl) GetProducts(ctx context.Context, request *model.ProductsRequest) ([]*model.ProductResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	var products []entity.Product
+	if err := s.ProductRepository.GetByEventID(s.DB.WithContext(ctx), &products, request.EventID); err != nil {
+		s.Log.Errorf("failed to get products: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if len(products) == 0 {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	return converter.ProductsToResponses(products), nil
+}
+
+// ReserveProducts decrements stock for the requested add-ons within the caller's transaction and
+// returns the order line items to save with the order. Redeemable products get one voucher per unit.
+func (s *ProductServiceImpl) ReserveProducts(ctx context.Context, tx *gorm.DB, eventID uint, requests []model.OrderProductRequest) ([]entity.OrderItem, error) {
+	quantities := make(map[uint]int, len(requests))
+	ids := make([]uint, 0, len(requests))
+	for i := range requests {
+		if err := s.Validate.Struct(&requests[i]); err != nil {
+			return nil, domainErrors.ErrValidation
+		}
+		if _, ok := quantities[requests[i].ProductID]; !ok {
+			ids = append(ids, requests[i].ProductID)
+		}
+		quantities[requests[i].ProductID] += requests[i].Quantity
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	products, err := s.ProductRepository.LockByIDs(tx, eventID, ids)
+	if err != nil {
+		s.Log.Errorf("failed to lock products: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if len(products) != len(ids) {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	items := make([]entity.OrderItem, len(products))
+	for i, p := range products {
+		quantity := quantities[p.ID]
+		if err := s.ProductRepository.DecrementStock(tx, p.ID, quantity); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, domainErrors.ErrOutOfStock
+			}
+			s.Log.Errorf("failed to decrement product stock: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		items[i] = entity.OrderItem{
+			ProductID:  p.ID,
+			SKU:        p.SKU,
+			Name:       p.Name,
+			Quantity:   quantity,
+			UnitPrice:  p.Price,
+			TotalPrice: p.Price * float64(quantity),
+		}
+
+		if p.Redeemable {
+			items[i].Vouchers = make([]entity.Voucher, quantity)
+			for j := range items[i].Vouchers {
+				items[i].Vouchers[j] = entity.Voucher{
+					Code: fmt.Sprintf("V-%s", uuid.NewString()[:8]),
+				}
+			}
+		}
+	}
+
+	return items, nil
+}
+
+func (s *ProductServiceImpl) GetVoucher(ctx context.Context, request *model.GetVoucherRequest) (*model.VoucherResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	data := &entity.Voucher{}
+	if err := s.ProductRepository.GetVoucherByCode(s.DB.WithContext(ctx), data, request.Code); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get voucher: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.VoucherEntityToResponse(data), nil
+}
+
+// RedeemVoucher marks a scanned voucher as used. Only vouchers of paid or complimentary
+// orders can be redeemed, and each only once.
+func (s *ProductServiceImpl) RedeemVoucher(ctx context.Context, request *model.GetVoucherRequest) (*model.VoucherResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data := &entity.Voucher{}
+	if err := s.ProductRepository.GetVoucherByCode(tx, data, request.Code); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get voucher: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if data.RedeemedAt != nil {
+		return nil, domainErrors.ErrVoucherRedeemed
+	}
+
+	var order entity.Order
+	if err := tx.Preload("Payment", "ticket_exchange_id IS NULL").
+		Where("id = ?", data.OrderItem.OrderID).
+		Take(&order).Error; err != nil {
+		s.Log.Errorf("failed to get order: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if !order.Complimentary && (order.Payment == nil || order.Payment.Status != model.PaymentStatusPaid) {
+		return nil, domainErrors.ErrOrderNotPaid
+	}
+
+	now := time.Now()
+	if err := s.ProductRepository.RedeemVoucher(tx, data.ID, claims.UserID, now); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrVoucherRedeemed
+		}
+		s.Log.Errorf("failed to redeem voucher: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	data.RedeemedAt = &now
+	data.RedeemedBy = &claims.UserID
+
+	return converter.VoucherEntityToResponse(data), nil
+}
@@ -0,0 +1,20 @@
+package reservation
+
+import (
+	"context"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+)
+
+type ReservationService interface {
+	Claim(ctx context.Context, eventID uint, ticketIDs []string, owner string) error
+	Release(ctx context.Context, eventID uint, ticketIDs []string, owner string)
+	Confirm(ctx context.Context, eventID uint, ticketIDs []string, owner string)
+	ClaimSeats(ctx context.Context, seats map[uint][]string, owner string) error
+	ReleaseSeats(ctx context.Context, seats map[uint][]string, owner string)
+	ConfirmSeats(ctx context.Context, seats map[uint][]string, owner string)
+	Free(ctx context.Context, tickets []*entity.Ticket)
+	ClaimPlaces(ctx context.Context, slotID uint, quantity int) error
+	ReleasePlaces(ctx context.Context, slotID uint, quantity int)
+	Reconcile(ctx context.Context) error
+}
@@ -0,0 +1,223 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/repository/event"
+	"github.com/TrinityKnights/Backend/internal/repository/slot"
+	"github.com/TrinityKnights/Backend/internal/repository/ticket"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/reservation"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultClaimTTL = 2 * time.Minute
+	syncLockTTL     = 10 * time.Second
+	// placesTTL bounds how long places claimed by a checkout that never finished stay counted
+	placesTTL = 10 * time.Minute
+)
+
+// ReservationServiceImpl fronts checkouts with seat claims in Redis. Redis only filters out
+// requests that would lose anyway, so when it is unreachable or has lost its data the checkout
+// carries on against Postgres, which still takes the row locks that decide the sale.
+type ReservationServiceImpl struct {
+	DB               *gorm.DB
+	Log              *logrus.Logger
+	Reservation      reservation.Reservation
+	EventRepository  event.EventRepository
+	TicketRepository ticket.TicketRepository
+	SlotRepository   slot.SlotRepository
+	ClaimTTL         time.Duration
+}
+
+func NewReservationServiceImpl(db *gorm.DB, log *logrus.Logger, seatReservation reservation.Reservation, eventRepository event.EventRepository, ticketRepository ticket.TicketRepository, slotRepository slot.SlotRepository, claimTTL time.Duration) *ReservationServiceImpl {
+	if claimTTL <= 0 {
+		claimTTL = DefaultClaimTTL
+	}
+
+	return &ReservationServiceImpl{
+		DB:               db,
+		Log:              log,
+		Reservation:      seatReservation,
+		EventRepository:  eventRepository,
+		TicketRepository: ticketRepository,
+		SlotRepository:   slotRepository,
+		ClaimTTL:         claimTTL,
+	}
+}
+
+// Claim reserves the seats for the owner before the checkout touches the database. Only a seat
+// claimed by someone else, or already taken, is an error.
+func (s *ReservationServiceImpl) Claim(ctx context.Context, eventID uint, ticketIDs []string, owner string) error {
+	err := s.Reservation.Claim(ctx, eventID, ticketIDs, owner, s.ClaimTTL)
+	if errors.Is(err, reservation.ErrNotSynced) {
+		// Redis restarted or never saw this event, one caller loads it while the rest go to Postgres
+		locked, lockErr := s.Reservation.TryLockSync(ctx, eventID, syncLockTTL)
+		if lockErr != nil || !locked {
+			return nil
+		}
+		if syncErr := s.sync(ctx, eventID); syncErr != nil {
+			s.Log.Errorf("failed to sync seat reservations for event %d: %v", eventID, syncErr)
+			return nil
+		}
+		err = s.Reservation.Claim(ctx, eventID, ticketIDs, owner, s.ClaimTTL)
+	}
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, reservation.ErrClaimed):
+		return domainErrors.ErrSeatAlreadyTaken
+	case errors.Is(err, reservation.ErrNotSynced):
+		return nil
+	default:
+		s.Log.Warnf("seat reservation unavailable for event %d, falling back to the database: %v", eventID, err)
+		return nil
+	}
+}
+
+// Release gives back the owner's claims after a checkout failed.
+func (s *ReservationServiceImpl) Release(ctx context.Context, eventID uint, ticketIDs []string, owner string) {
+	if err := s.Reservation.Release(context.WithoutCancel(ctx), eventID, ticketIDs, owner); err != nil {
+		s.Log.Warnf("failed to release seat claims for event %d: %v", eventID, err)
+	}
+}
+
+// Confirm marks the seats as taken once the order holding them is committed.
+func (s *ReservationServiceImpl) Confirm(ctx context.Context, eventID uint, ticketIDs []string, owner string) {
+	if err := s.Reservation.Confirm(context.WithoutCancel(ctx), eventID, ticketIDs, owner); err != nil {
+		s.Log.Warnf("failed to confirm seat claims for event %d: %v", eventID, err)
+	}
+}
+
+// ClaimSeats claims the seats of several events for the owner, all of them or none.
+func (s *ReservationServiceImpl) ClaimSeats(ctx context.Context, seats map[uint][]string, owner string) error {
+	claimed := make(map[uint][]string, len(seats))
+	for eventID, ticketIDs := range seats {
+		if err := s.Claim(ctx, eventID, ticketIDs, owner); err != nil {
+			s.ReleaseSeats(ctx, claimed, owner)
+			return err
+		}
+		claimed[eventID] = ticketIDs
+	}
+	return nil
+}
+
+func (s *ReservationServiceImpl) ReleaseSeats(ctx context.Context, seats map[uint][]string, owner string) {
+	for eventID, ticketIDs := range seats {
+		s.Release(ctx, eventID, ticketIDs, owner)
+	}
+}
+
+func (s *ReservationServiceImpl) ConfirmSeats(ctx context.Context, seats map[uint][]string, owner string) {
+	for eventID, ticketIDs := range seats {
+		s.Confirm(ctx, eventID, ticketIDs, owner)
+	}
+}
+
+// Free puts tickets that went back on sale out of the taken seats, so that checkouts for them are
+// not turned away until the next reconcile.
+func (s *ReservationServiceImpl) Free(ctx context.Context, tickets []*entity.Ticket) {
+	for eventID, ticketIDs := range Seats(tickets) {
+		if err := s.Reservation.Free(context.WithoutCancel(ctx), eventID, ticketIDs); err != nil {
+			s.Log.Warnf("failed to free seats for event %d: %v", eventID, err)
+		}
+	}
+}
+
+// ClaimPlaces takes places of a timed-entry slot before the checkout locks its row. Only a slot
+// with too few places left is an error.
+func (s *ReservationServiceImpl) ClaimPlaces(ctx context.Context, slotID uint, quantity int) error {
+	err := s.Reservation.ClaimPlaces(ctx, slotID, quantity)
+	if errors.Is(err, reservation.ErrNotSynced) {
+		data := &entity.TimeSlot{}
+		if loadErr := s.SlotRepository.GetByID(s.DB.WithContext(ctx), data, slotID); loadErr != nil {
+			// A missing slot is reported by the checkout itself
+			return nil
+		}
+		if syncErr := s.Reservation.SyncPlaces(ctx, slotID, max(data.Capacity-data.Reserved, 0), placesTTL); syncErr != nil {
+			s.Log.Warnf("failed to sync places of slot %d: %v", slotID, syncErr)
+			return nil
+		}
+		err = s.Reservation.ClaimPlaces(ctx, slotID, quantity)
+	}
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, reservation.ErrClaimed):
+		return domainErrors.ErrNotEnoughTickets
+	case errors.Is(err, reservation.ErrNotSynced):
+		return nil
+	default:
+		s.Log.Warnf("slot reservation unavailable for slot %d, falling back to the database: %v", slotID, err)
+		return nil
+	}
+}
+
+// ReleasePlaces gives back places after a checkout failed or a booking was released.
+func (s *ReservationServiceImpl) ReleasePlaces(ctx context.Context, slotID uint, quantity int) {
+	if err := s.Reservation.ReleasePlaces(context.WithoutCancel(ctx), slotID, quantity); err != nil {
+		s.Log.Warnf("failed to release places of slot %d: %v", slotID, err)
+	}
+}
+
+// Seats groups tickets by their event, the way seats are claimed.
+func Seats(tickets []*entity.Ticket) map[uint][]string {
+	seats := make(map[uint][]string)
+	for _, t := range tickets {
+		seats[t.EventID] = append(seats[t.EventID], t.ID)
+	}
+	return seats
+}
+
+// Reconcile rebuilds the Redis view of every event on sale from Postgres. It repairs what the
+// checkouts and releases failed to report, such as a write lost while Redis was unreachable.
+func (s *ReservationServiceImpl) Reconcile(ctx context.Context) error {
+	ids, err := s.EventRepository.FindOnSaleIDs(s.DB.WithContext(ctx), time.Now())
+	if err != nil {
+		s.Log.Errorf("failed to get events on sale: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	failed := 0
+	for _, id := range ids {
+		if err := s.sync(ctx, id); err != nil {
+			s.Log.Errorf("failed to sync seat reservations for event %d: %v", id, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return domainErrors.ErrInternalServer
+	}
+	return nil
+}
+
+func (s *ReservationServiceImpl) sync(ctx context.Context, eventID uint) error {
+	now := time.Now()
+	tickets, err := s.TicketRepository.FindReserved(s.DB.WithContext(ctx), eventID, now)
+	if err != nil {
+		return err
+	}
+
+	state := &reservation.State{}
+	for _, t := range tickets {
+		if t.OrderID != nil || t.AllocationID != nil {
+			state.Taken = append(state.Taken, t.ID)
+			continue
+		}
+		state.Holds = append(state.Holds, reservation.Hold{
+			TicketID: t.ID,
+			Owner:    *t.HoldToken,
+			Until:    *t.HeldUntil,
+		})
+	}
+
+	return s.Reservation.Sync(ctx, eventID, state)
+}
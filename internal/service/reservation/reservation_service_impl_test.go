@@ -0,0 +1,223 @@
+package reservation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/service/reservation"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	pkgReservation "github.com/TrinityKnights/Backend/pkg/reservation"
+	mockReservation "github.com/TrinityKnights/Backend/test/mock/pkg/reservation"
+	mockEvent "github.com/TrinityKnights/Backend/test/mock/repository/event"
+	mockSlot "github.com/TrinityKnights/Backend/test/mock/repository/slot"
+	mockTicket "github.com/TrinityKnights/Backend/test/mock/repository/ticket"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type mocks struct {
+	reservation *mockReservation.MockReservation
+	ticket      *mockTicket.MockTicketRepository
+	slot        *mockSlot.MockSlotRepository
+}
+
+func setupTest(t *testing.T) (*reservation.ReservationServiceImpl, *mocks) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		reservation: mockReservation.NewMockReservation(ctrl),
+		ticket:      mockTicket.NewMockTicketRepository(ctrl),
+		slot:        mockSlot.NewMockSlotRepository(ctrl),
+	}
+	service := reservation.NewReservationServiceImpl(gormDB, logrus.New(), m.reservation, mockEvent.NewMockEventRepository(ctrl), m.ticket, m.slot, time.Minute)
+	return service, m
+}
+
+func TestReservationService_Claim(t *testing.T) {
+	ticketIDs := []string{"T-1", "T-2"}
+
+	tests := []struct {
+		name        string
+		setupMock   func(m *mocks)
+		expectedErr error
+	}{
+		{
+			name: "Success",
+			setupMock: func(m *mocks) {
+				m.reservation.EXPECT().Claim(gomock.Any(), uint(1), ticketIDs, "alice", time.Minute).Return(nil)
+			},
+		},
+		{
+			name: "Seat Claimed By Someone Else",
+			setupMock: func(m *mocks) {
+				m.reservation.EXPECT().Claim(gomock.Any(), uint(1), ticketIDs, "alice", time.Minute).Return(pkgReservation.ErrClaimed)
+			},
+			expectedErr: domainErrors.ErrSeatAlreadyTaken,
+		},
+		{
+			name: "Redis Unavailable Falls Back To The Database",
+			setupMock: func(m *mocks) {
+				m.reservation.EXPECT().Claim(gomock.Any(), uint(1), ticketIDs, "alice", time.Minute).Return(errors.New("connection refused"))
+			},
+		},
+		{
+			name: "Not Synced While Another Caller Syncs",
+			setupMock: func(m *mocks) {
+				m.reservation.EXPECT().Claim(gomock.Any(), uint(1), ticketIDs, "alice", time.Minute).Return(pkgReservation.ErrNotSynced)
+				m.reservation.EXPECT().TryLockSync(gomock.Any(), uint(1), gomock.Any()).Return(false, nil)
+			},
+		},
+		{
+			name: "Not Synced Loads The Event And Claims Again",
+			setupMock: func(m *mocks) {
+				orderID := uint(5)
+				holdToken := "offer"
+				heldUntil := time.Now().Add(time.Minute)
+
+				m.reservation.EXPECT().Claim(gomock.Any(), uint(1), ticketIDs, "alice", time.Minute).Return(pkgReservation.ErrNotSynced)
+				m.reservation.EXPECT().TryLockSync(gomock.Any(), uint(1), gomock.Any()).Return(true, nil)
+				m.ticket.EXPECT().FindReserved(gomock.Any(), uint(1), gomock.Any()).Return([]*entity.Ticket{
+					{ID: "T-2", EventID: 1, OrderID: &orderID},
+					{ID: "T-3", EventID: 1, HoldToken: &holdToken, HeldUntil: &heldUntil},
+				}, nil)
+				m.reservation.EXPECT().Sync(gomock.Any(), uint(1), &pkgReservation.State{
+					Taken: []string{"T-2"},
+					Holds: []pkgReservation.Hold{{TicketID: "T-3", Owner: holdToken, Until: heldUntil}},
+				}).Return(nil)
+				m.reservation.EXPECT().Claim(gomock.Any(), uint(1), ticketIDs, "alice", time.Minute).Return(pkgReservation.ErrClaimed)
+			},
+			expectedErr: domainErrors.ErrSeatAlreadyTaken,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			service, m := setupTest(t)
+			tc.setupMock(m)
+
+			err := service.Claim(context.Background(), 1, ticketIDs, "alice")
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReservationService_ClaimSeats(t *testing.T) {
+	service, m := setupTest(t)
+
+	seats := map[uint][]string{
+		1: {"T-1"},
+		2: {"T-2"},
+	}
+
+	// Whichever event is claimed first, a conflict at the other gives back what was claimed
+	m.reservation.EXPECT().Claim(gomock.Any(), uint(1), []string{"T-1"}, "alice", time.Minute).Return(nil).MaxTimes(1)
+	m.reservation.EXPECT().Claim(gomock.Any(), uint(2), []string{"T-2"}, "alice", time.Minute).Return(pkgReservation.ErrClaimed)
+	m.reservation.EXPECT().Release(gomock.Any(), uint(1), []string{"T-1"}, "alice").Return(nil).MaxTimes(1)
+
+	err := service.ClaimSeats(context.Background(), seats, "alice")
+	assert.ErrorIs(t, err, domainErrors.ErrSeatAlreadyTaken)
+}
+
+func TestReservationService_Free(t *testing.T) {
+	service, m := setupTest(t)
+
+	m.reservation.EXPECT().Free(gomock.Any(), uint(1), []string{"T-1", "T-3"}).Return(nil)
+	m.reservation.EXPECT().Free(gomock.Any(), uint(2), []string{"T-2"}).Return(errors.New("connection refused"))
+
+	service.Free(context.Background(), []*entity.Ticket{
+		{ID: "T-1", EventID: 1},
+		{ID: "T-2", EventID: 2},
+		{ID: "T-3", EventID: 1},
+	})
+}
+
+func TestReservationService_ClaimPlaces(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupMock   func(m *mocks)
+		expectedErr error
+	}{
+		{
+			name: "Success",
+			setupMock: func(m *mocks) {
+				m.reservation.EXPECT().ClaimPlaces(gomock.Any(), uint(10), 3).Return(nil)
+			},
+		},
+		{
+			name: "Slot Full",
+			setupMock: func(m *mocks) {
+				m.reservation.EXPECT().ClaimPlaces(gomock.Any(), uint(10), 3).Return(pkgReservation.ErrClaimed)
+			},
+			expectedErr: domainErrors.ErrNotEnoughTickets,
+		},
+		{
+			name: "Not Synced Loads The Slot And Claims Again",
+			setupMock: func(m *mocks) {
+				m.reservation.EXPECT().ClaimPlaces(gomock.Any(), uint(10), 3).Return(pkgReservation.ErrNotSynced)
+				m.slot.EXPECT().GetByID(gomock.Any(), gomock.Any(), uint(10)).
+					DoAndReturn(func(_ *gorm.DB, slot *entity.TimeSlot, _ uint) error {
+						slot.Capacity = 50
+						slot.Reserved = 48
+						return nil
+					})
+				m.reservation.EXPECT().SyncPlaces(gomock.Any(), uint(10), 2, gomock.Any()).Return(nil)
+				m.reservation.EXPECT().ClaimPlaces(gomock.Any(), uint(10), 3).Return(pkgReservation.ErrClaimed)
+			},
+			expectedErr: domainErrors.ErrNotEnoughTickets,
+		},
+		{
+			name: "Missing Slot Is Left To The Checkout",
+			setupMock: func(m *mocks) {
+				m.reservation.EXPECT().ClaimPlaces(gomock.Any(), uint(10), 3).Return(pkgReservation.ErrNotSynced)
+				m.slot.EXPECT().GetByID(gomock.Any(), gomock.Any(), uint(10)).Return(gorm.ErrRecordNotFound)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			service, m := setupTest(t)
+			tc.setupMock(m)
+
+			err := service.ClaimPlaces(context.Background(), 10, 3)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSeats(t *testing.T) {
+	seats := reservation.Seats([]*entity.Ticket{
+		{ID: "T-1", EventID: 1},
+		{ID: "T-2", EventID: 2},
+		{ID: "T-3", EventID: 1},
+	})
+
+	assert.Equal(t, map[uint][]string{1: {"T-1", "T-3"}, 2: {"T-2"}}, seats)
+}
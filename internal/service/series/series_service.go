@@ -0,0 +1,15 @@
+package series
+
+import (
+	"context"
+
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+)
+
+type SeriesService interface {
+	CreateSeries(ctx context.Context, request *model.CreateSeriesRequest) (*model.SeriesResponse, error)
+	GetSeriesByID(ctx context.Context, request *model.GetSeriesRequest) (*model.SeriesResponse, error)
+	AddException(ctx context.Context, request *model.AddSeriesExceptionRequest) (*model.SeriesExceptionResponse, error)
+	AddTicketTemplate(ctx context.Context, request *model.AddSeriesTicketTemplateRequest) (*model.SeriesTicketTemplateCopyResponse, error)
+	UpdateOccurrence(ctx context.Context, request *model.UpdateOccurrenceRequest) (*model.SeriesResponse, error)
+}
@@ -0,0 +1,771 @@
+package series
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/domain/model/converter"
+	"github.com/TrinityKnights/Backend/internal/repository/event"
+	"github.com/TrinityKnights/Backend/internal/repository/series"
+	"github.com/TrinityKnights/Backend/internal/repository/ticket"
+	serviceEvent "github.com/TrinityKnights/Backend/internal/service/event"
+	"github.com/TrinityKnights/Backend/pkg/cache"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	dateLayout = "2006-01-02"
+	timeLayout = "15:04:05"
+
+	// MaxOccurrences bounds how many events a single series may generate
+	MaxOccurrences = 366
+)
+
+type SeriesServiceImpl struct {
+	DB               *gorm.DB
+	Cache            *cache.ImplCache
+	Log              *logrus.Logger
+	Validate         *validator.Validate
+	SeriesRepository series.SeriesRepository
+	EventRepository  event.EventRepository
+	TicketRepository ticket.TicketRepository
+	EventService     serviceEvent.EventService
+	helper           *helper.ContextHelper
+}
+
+func NewSeriesServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, seriesRepository series.SeriesRepository, eventRepository event.EventRepository, ticketRepository ticket.TicketRepository, eventService serviceEvent.EventService) *SeriesServiceImpl {
+	return &SeriesServiceImpl{
+		DB:               db,
+		Cache:            cacheImpl,
+		Log:              log,
+		Validate:         validate,
+		SeriesRepository: seriesRepository,
+		EventRepository:  eventRepository,
+		TicketRepository: ticketRepository,
+		EventService:     eventService,
+		helper:           helper.NewContextHelper(),
+	}
+}
+
+// CreateSeries stores a recurring event and generates one event per date of its rule, for
+// example every Friday and Saturday until the end of June. Exceptions skip or move single
+// dates and the ticket templates are copied onto every occurrence.
+func (s *SeriesServiceImpl) CreateSeries(ctx context.Context, request *model.CreateSeriesRequest) (*model.SeriesResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	startsOn, err := time.Parse(dateLayout, request.StartDate)
+	if err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	startTime, err := time.Parse(timeLayout, request.Time)
+	if err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	dates, err := occurrenceDates(request.RRule, startsOn)
+	if err != nil {
+		s.Log.Errorf("failed to expand recurrence rule: %v", err)
+		return nil, domainErrors.ErrValidation
+	}
+
+	exceptions := make(map[string]*entity.SeriesException, len(request.Exceptions))
+	for i := range request.Exceptions {
+		exception, err := buildException(request.Exceptions[i].Date, request.Exceptions[i].Action, request.Exceptions[i].NewDate, request.Exceptions[i].NewTime)
+		if err != nil {
+			return nil, domainErrors.ErrValidation
+		}
+
+		key := exception.OriginalDate.Format(dateLayout)
+		if _, ok := exceptions[key]; ok || !containsDate(dates, exception.OriginalDate) {
+			return nil, domainErrors.ErrValidation
+		}
+		exceptions[key] = exception
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	venue := &entity.Venue{}
+	if err := tx.First(venue, request.VenueID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get venue: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+	loc := helper.LocationOrDefault(venue.Timezone)
+
+	data := &entity.EventSeries{
+		Name:        request.Name,
+		Description: request.Description,
+		VenueID:     request.VenueID,
+		Time:        helper.SQLTime(startTime),
+		RRule:       strings.ToUpper(strings.TrimSpace(request.RRule)),
+		StartsOn:    startsOn,
+	}
+
+	if err := s.SeriesRepository.Create(tx.Omit(clause.Associations), data); err != nil {
+		s.Log.Errorf("failed to create series: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	for _, exception := range exceptions {
+		exception.SeriesID = data.ID
+		if err := s.SeriesRepository.CreateException(tx, exception); err != nil {
+			s.Log.Errorf("failed to create series exception: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+		data.Exceptions = append(data.Exceptions, *exception)
+	}
+
+	for i := range request.TicketTemplates {
+		template := entity.SeriesTicketTemplate{
+			SeriesID: data.ID,
+			Type:     helper.TicketUpper(request.TicketTemplates[i].Type).Long,
+			Price:    request.TicketTemplates[i].Price,
+			Count:    request.TicketTemplates[i].Count,
+		}
+		if err := s.SeriesRepository.CreateTicketTemplate(tx, &template); err != nil {
+			s.Log.Errorf("failed to create series ticket template: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+		data.TicketTemplates = append(data.TicketTemplates, template)
+	}
+
+	for _, date := range dates {
+		exception := exceptions[date.Format(dateLayout)]
+		if exception != nil && exception.Action == model.SeriesExceptionSkip {
+			continue
+		}
+
+		occurrence := buildOccurrence(data, date, exception, loc)
+		if err := s.EventService.CheckOverlap(ctx, tx, occurrence, request.OverrideConflict, request.OverrideReason); err != nil {
+			return nil, err
+		}
+
+		if err := s.EventRepository.Create(tx.Omit(clause.Associations), occurrence); err != nil {
+			s.Log.Errorf("failed to create occurrence: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		for i := range data.TicketTemplates {
+			if _, err := s.copyTemplate(tx, occurrence.ID, &data.TicketTemplates[i]); err != nil {
+				if errors.Is(err, domainErrors.ErrCapacityExceeded) {
+					return nil, err
+				}
+				s.Log.Errorf("failed to copy ticket template: %v", err)
+				return nil, domainErrors.ErrInternalServer
+			}
+		}
+
+		data.Occurrences = append(data.Occurrences, *occurrence)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	s.Log.Infof("created series %d with %d occurrence(s)", data.ID, len(data.Occurrences))
+	s.invalidateEvents()
+
+	return converter.SeriesEntityToResponse(data), nil
+}
+
+func (s *SeriesServiceImpl) GetSeriesByID(ctx context.Context, request *model.GetSeriesRequest) (*model.SeriesResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	data := &entity.EventSeries{}
+	if err := s.SeriesRepository.GetByID(s.DB.WithContext(ctx), data, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get series: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.SeriesEntityToResponse(data), nil
+}
+
+// AddException skips or moves one date of an existing series. A date that already has tickets
+// sold cannot be skipped, the event has to be cancelled instead.
+func (s *SeriesServiceImpl) AddException(ctx context.Context, request *model.AddSeriesExceptionRequest) (*model.SeriesExceptionResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	exception, err := buildException(request.Date, request.Action, request.NewDate, request.NewTime)
+	if err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data := &entity.EventSeries{}
+	if err := s.SeriesRepository.GetByID(tx.Clauses(clause.Locking{Strength: "UPDATE"}), data, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get series: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	dates, err := occurrenceDates(data.RRule, data.StartsOn)
+	if err != nil {
+		s.Log.Errorf("failed to expand recurrence rule of series %d: %v", data.ID, err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if !containsDate(dates, exception.OriginalDate) {
+		return nil, domainErrors.ErrValidation
+	}
+
+	for i := range data.Exceptions {
+		if data.Exceptions[i].OriginalDate.Format(dateLayout) == exception.OriginalDate.Format(dateLayout) {
+			return nil, domainErrors.ErrDuplicateEntry
+		}
+	}
+
+	exception.SeriesID = data.ID
+	if err := s.SeriesRepository.CreateException(tx, exception); err != nil {
+		s.Log.Errorf("failed to create series exception: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	occurrence := &entity.Event{}
+	err = s.SeriesRepository.GetOccurrenceByDate(tx.Clauses(clause.Locking{Strength: "UPDATE"}), occurrence, data.ID, exception.OriginalDate)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.Log.Errorf("failed to get occurrence: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err == nil {
+		switch exception.Action {
+		case model.SeriesExceptionSkip:
+			sold, err := s.SeriesRepository.CountSold(tx, occurrence.ID)
+			if err != nil {
+				s.Log.Errorf("failed to count sold tickets: %v", err)
+				return nil, domainErrors.ErrInternalServer
+			}
+			if sold > 0 {
+				return nil, domainErrors.ErrEventHasSales
+			}
+
+			if err := s.SeriesRepository.DeleteUnsoldTickets(tx, occurrence.ID); err != nil {
+				s.Log.Errorf("failed to delete tickets: %v", err)
+				return nil, domainErrors.ErrInternalServer
+			}
+
+			if err := s.EventRepository.Delete(tx, occurrence); err != nil {
+				s.Log.Errorf("failed to delete occurrence: %v", err)
+				return nil, domainErrors.ErrInternalServer
+			}
+		default:
+			moved := buildOccurrence(data, exception.OriginalDate, exception, helper.LocationOrDefault(occurrence.Timezone))
+			updates := map[string]interface{}{
+				"date":      moved.Date,
+				"time":      moved.Time,
+				"starts_at": moved.StartsAt,
+			}
+			if occurrence.EndsAt != nil {
+				updates["ends_at"] = moved.StartsAt.Add(occurrence.EndsAt.Sub(occurrence.StartsAt))
+			}
+			if err := tx.Model(occurrence).Updates(updates).Error; err != nil {
+				s.Log.Errorf("failed to move occurrence: %v", err)
+				return nil, domainErrors.ErrInternalServer
+			}
+
+			if err := s.checkOccurrences(ctx, tx, []uint{occurrence.ID}, request.OverrideConflict, request.OverrideReason); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	s.invalidateEvents(occurrence.ID)
+
+	return converter.SeriesExceptionEntityToResponse(exception), nil
+}
+
+// AddTicketTemplate adds a ticket layout to a series and copies it onto every upcoming
+// occurrence. Occurrences that already took place are left alone.
+func (s *SeriesServiceImpl) AddTicketTemplate(ctx context.Context, request *model.AddSeriesTicketTemplateRequest) (*model.SeriesTicketTemplateCopyResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data := &entity.EventSeries{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(data, request.ID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get series: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	template := &entity.SeriesTicketTemplate{
+		SeriesID: data.ID,
+		Type:     helper.TicketUpper(request.Type).Long,
+		Price:    request.Price,
+		Count:    request.Count,
+	}
+	if err := s.SeriesRepository.CreateTicketTemplate(tx, template); err != nil {
+		s.Log.Errorf("failed to create series ticket template: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	var occurrences []entity.Event
+	if err := s.SeriesRepository.GetOccurrences(tx, &occurrences, data.ID, time.Now()); err != nil {
+		s.Log.Errorf("failed to get occurrences: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	response := &model.SeriesTicketTemplateCopyResponse{
+		Template: converter.SeriesTicketTemplateEntityToResponse(template),
+	}
+
+	today := time.Now().Format(dateLayout)
+	for i := range occurrences {
+		// Moved occurrences are selected by their original date, check where they actually are
+		if occurrences[i].Date.Format(dateLayout) < today {
+			continue
+		}
+
+		created, err := s.copyTemplate(tx, occurrences[i].ID, template)
+		if err != nil {
+			if errors.Is(err, domainErrors.ErrCapacityExceeded) {
+				return nil, err
+			}
+			s.Log.Errorf("failed to copy ticket template: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+		response.Occurrences++
+		response.Tickets += created
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return response, nil
+}
+
+// UpdateOccurrence edits one occurrence, that occurrence and all following ones, or the whole
+// series. Only a single occurrence can be moved to another date, the move is recorded as an
+// exception of the series.
+func (s *SeriesServiceImpl) UpdateOccurrence(ctx context.Context, request *model.UpdateOccurrenceRequest) (*model.SeriesResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	scope := model.SeriesEditScope(request.Scope)
+	if request.Date != "" && scope != model.SeriesEditSingle {
+		return nil, domainErrors.ErrValidation
+	}
+
+	updates := make(map[string]interface{})
+	if request.Name != "" {
+		updates["name"] = request.Name
+	}
+	if request.Description != "" {
+		updates["description"] = request.Description
+	}
+	if request.VenueID != 0 {
+		updates["venue_id"] = request.VenueID
+	}
+
+	var newTime *helper.SQLTime
+	if request.Time != "" {
+		parsed, err := time.Parse(timeLayout, request.Time)
+		if err != nil {
+			return nil, domainErrors.ErrValidation
+		}
+		t := helper.SQLTime(parsed)
+		newTime = &t
+		updates["time"] = t
+	}
+
+	var newDate *time.Time
+	if request.Date != "" {
+		parsed, err := time.Parse(dateLayout, request.Date)
+		if err != nil {
+			return nil, domainErrors.ErrValidation
+		}
+		newDate = &parsed
+	}
+
+	if len(updates) == 0 && newDate == nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data := &entity.EventSeries{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(data, request.ID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get series: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	occurrence := &entity.Event{}
+	if err := tx.Where("series_id = ?", data.ID).First(occurrence, request.EventID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get occurrence: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	var newTimezone *string
+	if request.VenueID != 0 {
+		venue := &entity.Venue{}
+		if err := tx.First(venue, request.VenueID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, domainErrors.ErrNotFound
+			}
+			s.Log.Errorf("failed to get venue: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+		timezone := helper.LocationOrDefault(venue.Timezone).String()
+		newTimezone = &timezone
+	}
+
+	// Occurrences keep their own instants, so they are worked out again from whatever changed
+	occurrenceUpdates := make(map[string]interface{}, len(updates)+3)
+	for column, value := range updates {
+		occurrenceUpdates[column] = value
+	}
+	if newDate != nil || newTime != nil || newTimezone != nil {
+		startsAt := startsAtExpr(newDate, newTime, newTimezone)
+		occurrenceUpdates["starts_at"] = startsAt
+		occurrenceUpdates["ends_at"] = gorm.Expr("? + (ends_at - starts_at)", startsAt)
+		if newTimezone != nil {
+			occurrenceUpdates["timezone"] = *newTimezone
+		}
+	}
+
+	from := time.Time{}
+	if scope == model.SeriesEditFollowing && occurrence.OccurrenceDate != nil {
+		from = *occurrence.OccurrenceDate
+	}
+
+	switch scope {
+	case model.SeriesEditSingle:
+		if newDate != nil {
+			occurrenceUpdates["date"] = *newDate
+		}
+
+		if err := tx.Model(occurrence).Updates(occurrenceUpdates).Error; err != nil {
+			s.Log.Errorf("failed to update occurrence: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		if (newDate != nil || newTime != nil) && occurrence.OccurrenceDate != nil {
+			if err := s.recordMove(tx, data.ID, occurrence, newDate, newTime); err != nil {
+				s.Log.Errorf("failed to record moved occurrence: %v", err)
+				return nil, domainErrors.ErrInternalServer
+			}
+		}
+	default:
+		if err := s.SeriesRepository.UpdateOccurrences(tx, data.ID, from, occurrenceUpdates); err != nil {
+			s.Log.Errorf("failed to update occurrences: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+
+		// Editing the whole series also changes what the series itself describes
+		if scope == model.SeriesEditAll {
+			if err := tx.Model(data).Updates(updates).Error; err != nil {
+				s.Log.Errorf("failed to update series: %v", err)
+				return nil, domainErrors.ErrInternalServer
+			}
+		}
+	}
+
+	// Occurrences that moved in time or to another venue have to fit around its other bookings
+	if newDate != nil || newTime != nil || newTimezone != nil {
+		ids := []uint{occurrence.ID}
+		if scope != model.SeriesEditSingle {
+			var moved []entity.Event
+			if err := s.SeriesRepository.GetOccurrences(tx, &moved, data.ID, from); err != nil {
+				s.Log.Errorf("failed to get occurrences: %v", err)
+				return nil, domainErrors.ErrInternalServer
+			}
+			ids = make([]uint, len(moved))
+			for i := range moved {
+				ids[i] = moved[i].ID
+			}
+		}
+
+		if err := s.checkOccurrences(ctx, tx, ids, request.OverrideConflict, request.OverrideReason); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	response := &entity.EventSeries{}
+	if err := s.SeriesRepository.GetByID(s.DB.WithContext(ctx), response, data.ID); err != nil {
+		s.Log.Errorf("failed to get series: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	ids := make([]uint, len(response.Occurrences))
+	for i := range response.Occurrences {
+		ids[i] = response.Occurrences[i].ID
+	}
+	s.invalidateEvents(ids...)
+
+	return converter.SeriesEntityToResponse(response), nil
+}
+
+// checkOccurrences runs the venue overlap check on occurrences that were just moved, reading them
+// back so that their new start and end are checked. Occurrences that already took place are left
+// alone.
+func (s *SeriesServiceImpl) checkOccurrences(ctx context.Context, tx *gorm.DB, ids []uint, override bool, reason string) error {
+	now := time.Now()
+	for _, id := range ids {
+		occurrence := &entity.Event{}
+		if err := tx.First(occurrence, id).Error; err != nil {
+			s.Log.Errorf("failed to get occurrence: %v", err)
+			return domainErrors.ErrInternalServer
+		}
+		if occurrence.StartsAt.Before(now) {
+			continue
+		}
+
+		if err := s.EventService.CheckOverlap(ctx, tx, occurrence, override, reason); err != nil {
+			return err
+		}
+
+		if err := tx.Model(occurrence).Select("overlap_override_reason", "overlap_overridden_by").Updates(occurrence).Error; err != nil {
+			s.Log.Errorf("failed to update occurrence: %v", err)
+			return domainErrors.ErrInternalServer
+		}
+	}
+
+	return nil
+}
+
+// recordMove keeps the series' exceptions in line with an occurrence edited on its own, so the
+// series still shows which dates differ from the rule.
+func (s *SeriesServiceImpl) recordMove(tx *gorm.DB, seriesID uint, occurrence *entity.Event, newDate *time.Time, newTime *helper.SQLTime) error {
+	if err := s.SeriesRepository.DeleteException(tx, seriesID, *occurrence.OccurrenceDate); err != nil {
+		return err
+	}
+
+	date := occurrence.Date
+	if newDate != nil {
+		date = *newDate
+	}
+
+	t := occurrence.Time
+	if newTime != nil {
+		t = *newTime
+	}
+
+	return s.SeriesRepository.CreateException(tx, &entity.SeriesException{
+		SeriesID:     seriesID,
+		OriginalDate: *occurrence.OccurrenceDate,
+		Action:       model.SeriesExceptionMove,
+		NewDate:      &date,
+		NewTime:      &t,
+	})
+}
+
+// copyTemplate creates the template's tickets at an event, numbering the seats after the
+// ones the event already has. It returns how many tickets were created, or a wrapped
+// ErrCapacityExceeded when they would not fit the event's capacity.
+func (s *SeriesServiceImpl) copyTemplate(tx *gorm.DB, eventID uint, template *entity.SeriesTicketTemplate) (int, error) {
+	ticketType := helper.TicketUpper(template.Type)
+	if ticketType.Short == "" || ticketType.Long == "" {
+		return 0, fmt.Errorf("unknown ticket type %s", template.Type)
+	}
+
+	capacity, err := s.TicketRepository.GetCapacity(tx.Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "events"}}), eventID)
+	if err != nil {
+		return 0, err
+	}
+	if capacity.Capacity != nil && capacity.Used+template.Count > *capacity.Capacity {
+		remaining := max(*capacity.Capacity-capacity.Used, 0)
+		return 0, fmt.Errorf("%w: %d seat(s) remaining of %d at event %d", domainErrors.ErrCapacityExceeded, remaining, *capacity.Capacity, eventID)
+	}
+
+	first, err := s.TicketRepository.ReserveSeatNumbers(tx, eventID, ticketType.Long, template.Count)
+	if err != nil {
+		return 0, err
+	}
+
+	tickets := make([]*entity.Ticket, template.Count)
+	for i := range tickets {
+		tickets[i] = &entity.Ticket{
+			ID:         helper.NewTicketID(),
+			EventID:    eventID,
+			Price:      template.Price,
+			Type:       ticketType.Long,
+			SeatNumber: fmt.Sprintf("%s-%d", ticketType.Short, first+i),
+		}
+	}
+
+	if err := s.TicketRepository.CreateBatch(tx, tickets); err != nil {
+		return 0, err
+	}
+
+	return len(tickets), nil
+}
+
+func (s *SeriesServiceImpl) invalidateEvents(ids ...uint) {
+	for _, id := range ids {
+		if err := s.Cache.Delete(fmt.Sprintf("event:get:id:%d", id)); err != nil {
+			s.Log.Errorf("failed to delete cache: %v", err)
+		}
+	}
+
+	for _, pattern := range []string{"event:get:page:*", "event:search:*"} {
+		if err := s.Cache.DeletePattern(pattern); err != nil {
+			s.Log.Errorf("failed to delete cache: %v", err)
+		}
+	}
+}
+
+func occurrenceDates(rule string, startsOn time.Time) ([]time.Time, error) {
+	recurrence, err := helper.ParseRecurrence(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	dates, err := recurrence.Dates(startsOn, MaxOccurrences)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dates) == 0 {
+		return nil, helper.ErrInvalidRecurrence
+	}
+
+	return dates, nil
+}
+
+func buildException(date, action, newDate, newTime string) (*entity.SeriesException, error) {
+	originalDate, err := time.Parse(dateLayout, date)
+	if err != nil {
+		return nil, err
+	}
+
+	exception := &entity.SeriesException{
+		OriginalDate: originalDate,
+		Action:       model.SeriesExceptionType(strings.ToUpper(action)),
+	}
+
+	if exception.Action == model.SeriesExceptionSkip {
+		return exception, nil
+	}
+
+	moved, err := time.Parse(dateLayout, newDate)
+	if err != nil {
+		return nil, err
+	}
+	exception.NewDate = &moved
+
+	if newTime != "" {
+		t, err := time.Parse(timeLayout, newTime)
+		if err != nil {
+			return nil, err
+		}
+		sqlTime := helper.SQLTime(t)
+		exception.NewTime = &sqlTime
+	}
+
+	return exception, nil
+}
+
+// buildOccurrence creates the event for one date of the series at its venue in loc, placed where
+// its exception moved it if it has one.
+func buildOccurrence(series *entity.EventSeries, date time.Time, exception *entity.SeriesException, loc *time.Location) *entity.Event {
+	startTime := time.Time(series.Time)
+	day := date
+	if exception != nil && exception.Action == model.SeriesExceptionMove {
+		day = *exception.NewDate
+		if exception.NewTime != nil {
+			startTime = time.Time(*exception.NewTime)
+		}
+	}
+
+	startsAt := time.Date(day.Year(), day.Month(), day.Day(), startTime.Hour(), startTime.Minute(), startTime.Second(), 0, loc)
+	occurrenceDate := date
+	label := series.Name
+
+	return &entity.Event{
+		Name:           series.Name,
+		Description:    series.Description,
+		Date:           startsAt,
+		Time:           helper.SQLTime(startsAt),
+		VenueID:        series.VenueID,
+		Series:         &label,
+		SeriesID:       &series.ID,
+		OccurrenceDate: &occurrenceDate,
+		Status:         model.EventStatusPublished,
+		Timezone:       loc.String(),
+		StartsAt:       startsAt,
+	}
+}
+
+// startsAtExpr works out an occurrence's start in SQL from its wall-clock date, time and zone,
+// taking the new value of each that is being changed and the stored one otherwise.
+func startsAtExpr(date *time.Time, t *helper.SQLTime, timezone *string) clause.Expr {
+	dateSQL, timeSQL, zoneSQL := "date", "time", "timezone"
+	var args []interface{}
+	if date != nil {
+		dateSQL = "CAST(? AS date)"
+		args = append(args, date.Format(dateLayout))
+	}
+	if t != nil {
+		timeSQL = "CAST(? AS time)"
+		args = append(args, time.Time(*t).Format(timeLayout))
+	}
+	if timezone != nil {
+		zoneSQL = "?"
+		args = append(args, *timezone)
+	}
+
+	return gorm.Expr(fmt.Sprintf("(%s + %s) AT TIME ZONE %s", dateSQL, timeSQL, zoneSQL), args...)
+}
+
+func containsDate(dates []time.Time, date time.Time) bool {
+	key := date.Format(dateLayout)
+	for _, d := range dates {
+		if d.Format(dateLayout) == key {
+			return true
+		}
+	}
+	return false
+}
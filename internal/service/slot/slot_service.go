@@ -0,0 +1,15 @@
+package slot
+
+import (
+	"context"
+
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+)
+
+type SlotService interface {
+	CreateSlotTemplate(ctx context.Context, request *model.CreateSlotTemplateRequest) (*model.SlotTemplateResponse, error)
+	GetSlotTemplates(ctx context.Context, request *model.SlotTemplatesRequest) ([]*model.SlotTemplateResponse, error)
+	GenerateSlots(ctx context.Context, request *model.GenerateSlotsRequest) (*model.GenerateSlotsResponse, error)
+	GetSlots(ctx context.Context, request *model.SlotsRequest) ([]*model.SlotResponse, error)
+	OrderSlot(ctx context.Context, request *model.OrderSlotRequest) (*model.OrderResponse, error)
+}
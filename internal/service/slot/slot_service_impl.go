@@ -0,0 +1,430 @@
+package slot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/domain/model/converter"
+	"github.com/TrinityKnights/Backend/internal/repository/order"
+	"github.com/TrinityKnights/Backend/internal/repository/slot"
+	"github.com/TrinityKnights/Backend/internal/repository/ticket"
+	"github.com/TrinityKnights/Backend/internal/service/payment"
+	"github.com/TrinityKnights/Backend/internal/service/reservation"
+	"github.com/TrinityKnights/Backend/internal/service/waitingroom"
+	"github.com/TrinityKnights/Backend/pkg/cache"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	dateLayout = "2006-01-02"
+	timeLayout = "15:04"
+
+	// MaxGenerateDays bounds how many days of slots a single request may generate
+	MaxGenerateDays = 92
+	// MaxCalendarDays bounds the date range of an availability query
+	MaxCalendarDays = 31
+	// DefaultCalendarDays is the range shown when no end date is given
+	DefaultCalendarDays = 7
+)
+
+type SlotServiceImpl struct {
+	DB                 *gorm.DB
+	Cache              *cache.ImplCache
+	Log                *logrus.Logger
+	Validate           *validator.Validate
+	SlotRepository     slot.SlotRepository
+	TicketRepository   ticket.TicketRepository
+	OrderRepository    order.OrderRepository
+	PaymentService     payment.PaymentService
+	WaitingRoomService waitingroom.WaitingRoomService
+	ReservationService reservation.ReservationService
+	helper             *helper.ContextHelper
+}
+
+func NewSlotServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, slotRepository slot.SlotRepository, ticketRepository ticket.TicketRepository, orderRepository order.OrderRepository, paymentService payment.PaymentService, waitingRoomService waitingroom.WaitingRoomService, reservationService reservation.ReservationService) *SlotServiceImpl {
+	return &SlotServiceImpl{
+		DB:                 db,
+		Cache:              cacheImpl,
+		Log:                log,
+		Validate:           validate,
+		SlotRepository:     slotRepository,
+		TicketRepository:   ticketRepository,
+		OrderRepository:    orderRepository,
+		PaymentService:     paymentService,
+		WaitingRoomService: waitingRoomService,
+		ReservationService: reservationService,
+		helper:             helper.NewContextHelper(),
+	}
+}
+
+// CreateSlotTemplate defines a daily schedule of entry slots for a timed-entry event, for example
+// every 30 minutes from 10:00 to 17:00 for 50 people each.
+func (s *SlotServiceImpl) CreateSlotTemplate(ctx context.Context, request *model.CreateSlotTemplateRequest) (*model.SlotTemplateResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	startTime, err := time.Parse(timeLayout, request.StartTime)
+	if err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	endTime, err := time.Parse(timeLayout, request.EndTime)
+	if err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	if request.DurationMinutes == 0 {
+		request.DurationMinutes = request.IntervalMinutes
+	}
+
+	// The window has to fit at least one slot
+	if startTime.Add(time.Duration(request.DurationMinutes) * time.Minute).After(endTime) {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	var event entity.Event
+	if err := tx.First(&event, request.EventID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get event: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if !event.TimedEntry {
+		return nil, domainErrors.ErrValidation
+	}
+
+	data := &entity.SlotTemplate{
+		EventID:         event.ID,
+		Name:            request.Name,
+		StartTime:       helper.SQLTime(startTime),
+		EndTime:         helper.SQLTime(endTime),
+		IntervalMinutes: request.IntervalMinutes,
+		DurationMinutes: request.DurationMinutes,
+		Capacity:        request.Capacity,
+		Price:           request.Price,
+	}
+
+	if err := s.SlotRepository.CreateTemplate(tx, data); err != nil {
+		s.Log.Errorf("failed to create slot template: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.SlotTemplateEntityToResponse(data), nil
+}
+
+func (s *SlotServiceImpl) GetSlotTemplates(ctx context.Context, request *model.SlotTemplatesRequest) ([]*model.SlotTemplateResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	var templates []entity.SlotTemplate
+	if err := s.SlotRepository.GetTemplatesByEventID(s.DB.WithContext(ctx), &templates, request.EventID); err != nil {
+		s.Log.Errorf("failed to get slot templates: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if len(templates) == 0 {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	return converter.SlotTemplatesToResponses(templates), nil
+}
+
+// GenerateSlots creates the template's slots for every day in [from, to]. Slots that already
+// exist or would start in the past are skipped, so a range can safely be generated again. The
+// slots together with the event's tickets have to fit the event's capacity.
+func (s *SlotServiceImpl) GenerateSlots(ctx context.Context, request *model.GenerateSlotsRequest) (*model.GenerateSlotsResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	from, err := time.ParseInLocation(dateLayout, request.From, time.Local)
+	if err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	to, err := time.ParseInLocation(dateLayout, request.To, time.Local)
+	if err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	if to.Before(from) || to.After(from.AddDate(0, 0, MaxGenerateDays-1)) {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	template := &entity.SlotTemplate{}
+	if err := s.SlotRepository.GetTemplateByID(tx, template, request.TemplateID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get slot template: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	// Locking the event keeps ticket batches and other slots from taking the same capacity
+	if _, err := s.TicketRepository.GetCapacity(tx.Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "events"}}), template.EventID); err != nil {
+		s.Log.Errorf("failed to get event capacity: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	now := time.Now()
+	slots := buildSlots(template, from, to, now)
+
+	created := int64(0)
+	if len(slots) > 0 {
+		created, err = s.SlotRepository.CreateBatch(tx, slots)
+		if err != nil {
+			s.Log.Errorf("failed to create slots: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+	}
+
+	// The concurrent places depend on how the new slots overlap, so they are counted once created
+	capacity, err := s.TicketRepository.GetCapacity(tx, template.EventID)
+	if err != nil {
+		s.Log.Errorf("failed to get event capacity: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if capacity.Capacity != nil && capacity.Used > *capacity.Capacity {
+		return nil, fmt.Errorf("%w: %d place(s) in use of %d", domainErrors.ErrCapacityExceeded, capacity.Used, *capacity.Capacity)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	s.Log.Infof("generated %d slot(s) for template %d from %s to %s", created, template.ID, request.From, request.To)
+
+	return &model.GenerateSlotsResponse{
+		TemplateID: template.ID,
+		Created:    int(created),
+		Skipped:    len(slots) - int(created),
+	}, nil
+}
+
+// GetSlots reports the remaining places of an event's slots for a calendar view.
+func (s *SlotServiceImpl) GetSlots(ctx context.Context, request *model.SlotsRequest) ([]*model.SlotResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	now := time.Now()
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	if request.From != "" {
+		parsed, err := time.ParseInLocation(dateLayout, request.From, time.Local)
+		if err != nil {
+			return nil, domainErrors.ErrValidation
+		}
+		from = parsed
+	}
+
+	to := from.AddDate(0, 0, DefaultCalendarDays)
+	if request.To != "" {
+		parsed, err := time.ParseInLocation(dateLayout, request.To, time.Local)
+		if err != nil {
+			return nil, domainErrors.ErrValidation
+		}
+		// The end date is inclusive
+		to = parsed.AddDate(0, 0, 1)
+	}
+
+	if !to.After(from) || to.After(from.AddDate(0, 0, MaxCalendarDays)) {
+		return nil, domainErrors.ErrValidation
+	}
+
+	var slots []entity.TimeSlot
+	if err := s.SlotRepository.GetByEventID(s.DB.WithContext(ctx), &slots, request.EventID, from, to); err != nil {
+		s.Log.Errorf("failed to get slots: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if len(slots) == 0 {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	return converter.SlotsToResponses(slots), nil
+}
+
+// OrderSlot books a number of places in a slot and bills them in a single invoice. Places go
+// back to the slot if the invoice expires.
+func (s *SlotServiceImpl) OrderSlot(ctx context.Context, request *model.OrderSlotRequest) (*model.OrderResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	// Places are claimed in Redis first so that a crowd going for the same slot does not queue on
+	// its row lock
+	if err := s.ReservationService.ClaimPlaces(ctx, request.SlotID, request.Quantity); err != nil {
+		return nil, err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			s.ReservationService.ReleasePlaces(ctx, request.SlotID, request.Quantity)
+		}
+	}()
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data := &entity.TimeSlot{}
+	if err := s.SlotRepository.GetByID(tx.Clauses(clause.Locking{Strength: "UPDATE"}), data, request.SlotID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get slot: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	now := time.Now()
+	if !data.StartsAt.After(now) {
+		return nil, domainErrors.ErrValidation
+	}
+
+	// The event is share-locked so that it cannot stop selling while the places are booked
+	var event entity.Event
+	if err := tx.Clauses(clause.Locking{Strength: "SHARE"}).First(&event, data.EventID).Error; err != nil {
+		s.Log.Errorf("failed to get event: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	switch event.Status {
+	case model.EventStatusPublished:
+	case model.EventStatusDraft, model.EventStatusScheduled:
+		return nil, domainErrors.ErrNotFound
+	default:
+		return nil, domainErrors.ErrEventNotOnSale
+	}
+
+	if err := s.WaitingRoomService.ClaimAdmission(ctx, data.EventID, request.AdmissionToken); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if !committed {
+			s.WaitingRoomService.ReleaseAdmission(ctx, data.EventID, request.AdmissionToken)
+		}
+	}()
+
+	if err := s.SlotRepository.Reserve(tx, data.ID, request.Quantity); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotEnoughTickets
+		}
+		s.Log.Errorf("failed to reserve slot: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	total := data.Price * float64(request.Quantity)
+	dataOrder := &entity.Order{
+		UserID:     &claims.UserID,
+		Date:       now,
+		TotalPrice: total,
+	}
+
+	if err := s.OrderRepository.Create(tx.Omit(clause.Associations), dataOrder); err != nil {
+		s.Log.Errorf("failed to create order: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	booking := entity.SlotBooking{
+		OrderID:    dataOrder.ID,
+		SlotID:     data.ID,
+		Quantity:   request.Quantity,
+		UnitPrice:  data.Price,
+		TotalPrice: total,
+	}
+
+	if err := s.SlotRepository.CreateBooking(tx, &booking); err != nil {
+		s.Log.Errorf("failed to create slot booking: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	p, err := s.PaymentService.CreateInvoice(ctx, tx, &model.CreatePaymentRequest{
+		OrderID: dataOrder.ID,
+		Amount:  total,
+	})
+	if err != nil {
+		s.Log.Errorf("failed to create payment: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+	committed = true
+
+	if err := s.Cache.DeletePattern("order:get:page:*"); err != nil {
+		s.Log.Errorf("failed to delete cache: %v", err)
+	}
+
+	booking.Slot = *data
+	dataOrder.SlotBookings = []entity.SlotBooking{booking}
+
+	response := converter.OrderEntityToResponse(dataOrder)
+	response.Payment = p
+
+	return response, nil
+}
+
+// buildSlots lays the template's daily schedule over every day in [from, to], read in the time
+// zone of the event's venue, leaving out slots that have already started.
+func buildSlots(template *entity.SlotTemplate, from, to, now time.Time) []*entity.TimeSlot {
+	loc := helper.LocationOrDefault(template.Event.Timezone)
+	start := time.Time(template.StartTime)
+	end := time.Time(template.EndTime)
+	interval := time.Duration(template.IntervalMinutes) * time.Minute
+	duration := time.Duration(template.DurationMinutes) * time.Minute
+
+	var slots []*entity.TimeSlot
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		opens := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, loc)
+		closes := time.Date(day.Year(), day.Month(), day.Day(), end.Hour(), end.Minute(), 0, 0, loc)
+
+		for startsAt := opens; !startsAt.Add(duration).After(closes); startsAt = startsAt.Add(interval) {
+			if !startsAt.After(now) {
+				continue
+			}
+			slots = append(slots, &entity.TimeSlot{
+				EventID:    template.EventID,
+				TemplateID: template.ID,
+				StartsAt:   startsAt,
+				EndsAt:     startsAt.Add(duration),
+				Capacity:   template.Capacity,
+				Price:      template.Price,
+			})
+		}
+	}
+
+	return slots
+}
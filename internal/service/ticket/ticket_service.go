@@ -8,6 +8,9 @@ import (
 
 type TicketService interface {
 	CreateTicket(ctx context.Context, request *model.CreateTicketRequest) ([]*model.TicketResponse, error)
+	GetEventCapacity(ctx context.Context, request *model.GetEventCapacityRequest) (*model.EventCapacityResponse, error)
+	GetEventAvailability(ctx context.Context, request *model.GetEventAvailabilityRequest) (*model.EventAvailabilityResponse, error)
+	ReleaseExpiredHolds(ctx context.Context) error
 	UpdateTicket(ctx context.Context, request *model.UpdateTicketRequest) (*model.TicketResponse, error)
 	GetTicketByID(ctx context.Context, request *model.GetTicketRequest) (*model.TicketResponse, error)
 	GetTickets(ctx context.Context, request *model.TicketsRequest) (*model.Response[[]*model.TicketResponse], error)
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
@@ -16,9 +15,9 @@ import (
 	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
 	"github.com/TrinityKnights/Backend/pkg/helper"
 	"github.com/go-playground/validator/v10"
-	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type TicketServiceImpl struct {
@@ -60,28 +59,32 @@ func (s *TicketServiceImpl) CreateTicket(ctx context.Context, request *model.Cre
 		return nil, domainErrors.ErrBadRequest
 	}
 
-	// Get the last ticket number using the repository method
-	lastTicket, err := s.TicketRepository.GetLastTicketNumber(tx, request.EventID, ticketType.Short)
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		s.Log.Errorf("failed to get last ticket: %v", err)
+	// Locking the event keeps two batches for it from both fitting into the same remaining capacity
+	capacity, err := s.TicketRepository.GetCapacity(tx.Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "events"}}), request.EventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get event capacity: %v", err)
 		return nil, domainErrors.ErrInternalServer
 	}
 
-	// Initialize starting seat number
-	startingNumber := 1
-	if lastTicket != nil {
-		parts := strings.Split(lastTicket.SeatNumber, "-")
-		if len(parts) == 2 {
-			if num, err := strconv.Atoi(parts[1]); err == nil {
-				startingNumber = num + 1
-			}
-		}
+	if capacity.Capacity != nil && capacity.Used+request.Count > *capacity.Capacity {
+		remaining := max(*capacity.Capacity-capacity.Used, 0)
+		return nil, fmt.Errorf("%w: %d seat(s) remaining of %d", domainErrors.ErrCapacityExceeded, remaining, *capacity.Capacity)
+	}
+
+	// Seat numbers come from the event's own counter, batches for other events do not wait on it
+	startingNumber, err := s.TicketRepository.ReserveSeatNumbers(tx, request.EventID, ticketType.Long, request.Count)
+	if err != nil {
+		s.Log.Errorf("failed to reserve seat numbers: %v", err)
+		return nil, domainErrors.ErrInternalServer
 	}
 
 	tickets := make([]*entity.Ticket, request.Count)
 	for i := 0; i < request.Count; i++ {
 		tickets[i] = &entity.Ticket{
-			ID:         fmt.Sprintf("T-%s", uuid.NewString()[:6]),
+			ID:         helper.NewTicketID(),
 			EventID:    request.EventID,
 			Price:      request.Price,
 			Type:       ticketType.Long,
@@ -101,6 +104,78 @@ func (s *TicketServiceImpl) CreateTicket(ctx context.Context, request *model.Cre
 	return converter.TicketsToResponses(tickets), nil
 }
 
+// GetEventCapacity reports how much of an event's capacity its ticket inventory uses.
+func (s *TicketServiceImpl) GetEventCapacity(ctx context.Context, request *model.GetEventCapacityRequest) (*model.EventCapacityResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	db := s.DB.WithContext(ctx)
+
+	capacity, err := s.TicketRepository.GetCapacity(db, request.EventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get event capacity: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	categories, err := s.TicketRepository.CountByCategory(db, request.EventID)
+	if err != nil {
+		s.Log.Errorf("failed to count tickets by category: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.EventCapacityToResponse(capacity, categories), nil
+}
+
+// GetEventAvailability summarises how many seats of each category are left. It reads the
+// trigger-maintained counters instead of the tickets, so it stays cheap while a sale is busy.
+func (s *TicketServiceImpl) GetEventAvailability(ctx context.Context, request *model.GetEventAvailabilityRequest) (*model.EventAvailabilityResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	db := s.DB.WithContext(ctx)
+
+	// Drafts and scheduled events are not listed to buyers, so neither is their inventory
+	var event entity.Event
+	err := db.Select("id", "status").
+		Where("id = ? AND status NOT IN ?", request.EventID, []model.EventStatus{model.EventStatusDraft, model.EventStatusScheduled}).
+		Take(&event).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get event: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	counters, err := s.TicketRepository.GetCounters(db, request.EventID)
+	if err != nil {
+		s.Log.Errorf("failed to get ticket counters: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.EventAvailabilityToResponse(request.EventID, counters), nil
+}
+
+// ReleaseExpiredHolds clears lapsed holds so they stop counting against availability.
+func (s *TicketServiceImpl) ReleaseExpiredHolds(ctx context.Context) error {
+	released, err := s.TicketRepository.ReleaseExpiredHolds(s.DB.WithContext(ctx), time.Now())
+	if err != nil {
+		s.Log.Errorf("failed to release expired holds: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	if released > 0 {
+		s.Log.Infof("released %d expired ticket hold(s)", released)
+	}
+
+	return nil
+}
+
 func (s *TicketServiceImpl) UpdateTicket(ctx context.Context, request *model.UpdateTicketRequest) (*model.TicketResponse, error) {
 	tx := s.DB.WithContext(ctx).Begin()
 	defer tx.Rollback()
@@ -109,9 +184,10 @@ func (s *TicketServiceImpl) UpdateTicket(ctx context.Context, request *model.Upd
 		return nil, domainErrors.ErrBadRequest
 	}
 
-	if _, err := s.TicketRepository.Find(tx, &model.TicketQueryOptions{
+	existing, err := s.TicketRepository.Find(tx, &model.TicketQueryOptions{
 		ID: &request.ID,
-	}); err != nil {
+	})
+	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, domainErrors.ErrNotFound
 		}
@@ -127,6 +203,16 @@ func (s *TicketServiceImpl) UpdateTicket(ctx context.Context, request *model.Upd
 		SeatNumber: request.SeatNumber,
 	}
 
+	// Holds, allocations and holder details are managed elsewhere, keep them across the save
+	if len(existing) > 0 {
+		data.HoldToken = existing[0].HoldToken
+		data.HeldUntil = existing[0].HeldUntil
+		data.AttendeeName = existing[0].AttendeeName
+		data.AttendeeEmail = existing[0].AttendeeEmail
+		data.AllocationID = existing[0].AllocationID
+		data.CheckedInAt = existing[0].CheckedInAt
+	}
+
 	if err := s.TicketRepository.Update(tx, data); err != nil {
 		s.Log.Errorf("failed to update ticket: %v", err)
 		return nil, domainErrors.ErrInternalServer
@@ -147,6 +233,7 @@ func (s *TicketServiceImpl) GetTicketByID(ctx context.Context, request *model.Ge
 	key := fmt.Sprintf("ticket:get:id:%s", request.ID)
 	var cacheResponse *model.TicketResponse
 	if err := s.Cache.Get(key, &cacheResponse); err == nil {
+		s.redactAttendee(ctx, cacheResponse)
 		return cacheResponse, nil
 	}
 
@@ -173,6 +260,7 @@ func (s *TicketServiceImpl) GetTicketByID(ctx context.Context, request *model.Ge
 		s.Log.Errorf("failed to set cache: %v", err)
 	}
 
+	s.redactAttendee(ctx, response)
 	return response, nil
 }
 
@@ -198,6 +286,7 @@ func (s *TicketServiceImpl) GetTickets(ctx context.Context, request *model.Ticke
 	cacheKey := fmt.Sprintf("ticket:get:page:%d:size:%d:sort:%s:order:%s", opts.Page, opts.Size, opts.Sort, opts.Order)
 	var cacheResponse model.Response[[]*model.TicketResponse]
 	if err := s.Cache.Get(cacheKey, &cacheResponse); err == nil {
+		s.redactAttendees(ctx, cacheResponse.Data)
 		return &cacheResponse, nil
 	}
 
@@ -227,6 +316,7 @@ func (s *TicketServiceImpl) GetTickets(ctx context.Context, request *model.Ticke
 		s.Log.Errorf("failed to set cache: %v", err)
 	}
 
+	s.redactAttendees(ctx, response.Data)
 	return response, nil
 }
 
@@ -252,6 +342,7 @@ func (s *TicketServiceImpl) SearchTickets(ctx context.Context, request *model.Ti
 	var cacheResponse model.Response[[]*model.TicketResponse]
 	err := s.Cache.Get(cacheKey, &cacheResponse)
 	if err == nil {
+		s.redactAttendees(ctx, cacheResponse.Data)
 		return &cacheResponse, nil
 	}
 
@@ -303,5 +394,27 @@ func (s *TicketServiceImpl) SearchTickets(ctx context.Context, request *model.Ti
 		s.Log.Errorf("failed to set cache: %v", err)
 	}
 
+	s.redactAttendees(ctx, response.Data)
 	return response, nil
 }
+
+// redactAttendee hides holder details from anyone but the ticket owner or an admin.
+func (s *TicketServiceImpl) redactAttendee(ctx context.Context, response *model.TicketResponse) {
+	if response == nil || response.Attendee == nil || s.helper.IsAdmin(ctx) {
+		return
+	}
+
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil || response.Order == nil || response.Order.UserID != claims.UserID {
+		response.Attendee = nil
+	}
+}
+
+func (s *TicketServiceImpl) redactAttendees(ctx context.Context, responses *[]*model.TicketResponse) {
+	if responses == nil {
+		return
+	}
+	for _, response := range *responses {
+		s.redactAttendee(ctx, response)
+	}
+}
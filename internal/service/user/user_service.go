@@ -15,4 +15,19 @@ type UserService interface {
 	RequestReset(ctx context.Context, request *model.ReqResetPasswordRequest) (*model.VerifyResponse, error)
 	ResetPassword(ctx context.Context, request *model.ResetPasswordRequest) (*model.VerifyResponse, error)
 	VerifyEmail(ctx context.Context, request *model.VerifyRequest) (*model.VerifyResponse, error)
+	Unlock(ctx context.Context, request *model.UnlockUserRequest) (*model.VerifyResponse, error)
+	Logout(ctx context.Context) (*model.VerifyResponse, error)
+	LogoutAll(ctx context.Context) (*model.VerifyResponse, error)
+	GetSessions(ctx context.Context) ([]*model.SessionResponse, error)
+	RevokeSession(ctx context.Context, request *model.RevokeSessionRequest) (*model.VerifyResponse, error)
+	GetUserSessions(ctx context.Context, request *model.GetUserSessionsRequest) ([]*model.SessionResponse, error)
+	LoginTwoFactor(ctx context.Context, request *model.LoginTwoFactorRequest) (*model.TokenResponse, error)
+	LoginEnrolTwoFactor(ctx context.Context, request *model.LoginEnrolTwoFactorRequest) (*model.EnrolTwoFactorResponse, error)
+	LoginConfirmTwoFactor(ctx context.Context, request *model.LoginConfirmTwoFactorRequest) (*model.TokenResponse, error)
+	EnrolTwoFactor(ctx context.Context) (*model.EnrolTwoFactorResponse, error)
+	ConfirmTwoFactor(ctx context.Context, request *model.TwoFactorCodeRequest) (*model.RecoveryCodesResponse, error)
+	DisableTwoFactor(ctx context.Context, request *model.TwoFactorCodeRequest) (*model.VerifyResponse, error)
+	RegenerateRecoveryCodes(ctx context.Context, request *model.TwoFactorCodeRequest) (*model.RecoveryCodesResponse, error)
+	GetRolePolicy(ctx context.Context, request *model.RolePolicyRequest) (*model.RolePolicyResponse, error)
+	UpdateRolePolicy(ctx context.Context, request *model.UpdateRolePolicyRequest) (*model.RolePolicyResponse, error)
 }
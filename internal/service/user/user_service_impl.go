@@ -3,8 +3,15 @@ package user
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"html/template"
+	"strings"
+	"sync"
 	"time"
 
 	"embed"
@@ -12,11 +19,20 @@ import (
 	"github.com/TrinityKnights/Backend/internal/domain/entity"
 	"github.com/TrinityKnights/Backend/internal/domain/model"
 	"github.com/TrinityKnights/Backend/internal/domain/model/converter"
+	"github.com/TrinityKnights/Backend/internal/repository/order"
+	"github.com/TrinityKnights/Backend/internal/repository/recoverycode"
+	"github.com/TrinityKnights/Backend/internal/repository/rolepolicy"
+	"github.com/TrinityKnights/Backend/internal/repository/session"
 	"github.com/TrinityKnights/Backend/internal/repository/user"
+	"github.com/TrinityKnights/Backend/pkg/encryption"
 	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
 	"github.com/TrinityKnights/Backend/pkg/gomail"
 	"github.com/TrinityKnights/Backend/pkg/helper"
 	"github.com/TrinityKnights/Backend/pkg/jwt"
+	"github.com/TrinityKnights/Backend/pkg/lockout"
+	"github.com/TrinityKnights/Backend/pkg/qrcode"
+	"github.com/TrinityKnights/Backend/pkg/tokenstore"
+	"github.com/TrinityKnights/Backend/pkg/totp"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
@@ -28,27 +44,189 @@ import (
 var templateFS embed.FS
 
 type UserServiceImpl struct {
-	DB             *gorm.DB
-	Log            *logrus.Logger
-	Validate       *validator.Validate
-	UserRepository *user.UserRepositoryImpl
-	JWTService     jwt.JWTService
-	Gomail         *gomail.ImplGomail
-	helper         *helper.ContextHelper
+	DB                     *gorm.DB
+	Log                    *logrus.Logger
+	Validate               *validator.Validate
+	UserRepository         user.UserRepository
+	OrderRepository        order.OrderRepository
+	SessionRepository      session.SessionRepository
+	RecoveryCodeRepository recoverycode.RecoveryCodeRepository
+	RolePolicyRepository   rolepolicy.RolePolicyRepository
+	JWTService             jwt.JWTService
+	Gomail                 *gomail.ImplGomail
+	AccountLockout         lockout.Lockout
+	IPLockout              lockout.Lockout
+	TokenStore             tokenstore.TokenStore
+	Encryption             encryption.Encryption
+	helper                 *helper.ContextHelper
 }
 
-func NewUserServiceImpl(db *gorm.DB, log *logrus.Logger, validate *validator.Validate, userRepository *user.UserRepositoryImpl, jwtService jwt.JWTService, mail *gomail.ImplGomail) *UserServiceImpl {
+func NewUserServiceImpl(db *gorm.DB, log *logrus.Logger, validate *validator.Validate, userRepository user.UserRepository, orderRepository order.OrderRepository, sessionRepository session.SessionRepository, recoveryCodeRepository recoverycode.RecoveryCodeRepository, rolePolicyRepository rolepolicy.RolePolicyRepository, jwtService jwt.JWTService, mail *gomail.ImplGomail, accountLockout, ipLockout lockout.Lockout, tokenStore tokenstore.TokenStore, encryption encryption.Encryption) *UserServiceImpl {
 	return &UserServiceImpl{
-		DB:             db,
-		Log:            log,
-		Validate:       validate,
-		UserRepository: userRepository,
-		JWTService:     jwtService,
-		Gomail:         mail,
-		helper:         helper.NewContextHelper(),
+		DB:                     db,
+		Log:                    log,
+		Validate:               validate,
+		UserRepository:         userRepository,
+		OrderRepository:        orderRepository,
+		SessionRepository:      sessionRepository,
+		RecoveryCodeRepository: recoveryCodeRepository,
+		RolePolicyRepository:   rolePolicyRepository,
+		JWTService:             jwtService,
+		Gomail:                 mail,
+		AccountLockout:         accountLockout,
+		IPLockout:              ipLockout,
+		TokenStore:             tokenStore,
+		Encryption:             encryption,
+		helper:                 helper.NewContextHelper(),
 	}
 }
 
+// maxUserAgentLength matches the user_agent column
+const maxUserAgentLength = 512
+
+var (
+	dummyHashOnce sync.Once
+	dummyHash     []byte
+)
+
+// compareDummyPassword spends the same bcrypt work as a real check so that unknown emails
+// cannot be told apart by response time.
+func compareDummyPassword(password string) {
+	dummyHashOnce.Do(func() {
+		dummyHash, _ = bcrypt.GenerateFromPassword([]byte(uuid.NewString()), bcrypt.DefaultCost)
+	})
+	_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+}
+
+func accountSubject(email string) string {
+	return fmt.Sprintf("account:%s", strings.ToLower(email))
+}
+
+func ipSubject(ip string) string {
+	return fmt.Sprintf("ip:%s", ip)
+}
+
+// checkLockout rejects the attempt while the account or the IP is blocked. Lockouts are keyed
+// by the submitted email, so unknown addresses are throttled exactly like existing ones.
+func (s *UserServiceImpl) checkLockout(ctx context.Context, email, ip string) error {
+	retryAfter, err := s.AccountLockout.Locked(ctx, accountSubject(email))
+	if err != nil {
+		s.Log.Warnf("failed to check account lockout: %v", err)
+	}
+	if retryAfter > 0 {
+		return domainErrors.ErrTooManyAttempts
+	}
+
+	if ip == "" {
+		return nil
+	}
+	retryAfter, err = s.IPLockout.Locked(ctx, ipSubject(ip))
+	if err != nil {
+		s.Log.Warnf("failed to check ip lockout: %v", err)
+	}
+	if retryAfter > 0 {
+		return domainErrors.ErrTooManyAttempts
+	}
+
+	return nil
+}
+
+// recordFailure counts a failed attempt against the account and the IP, and tells the owner
+// when their account gets locked.
+func (s *UserServiceImpl) recordFailure(ctx context.Context, email, ip string, owner *entity.User) {
+	failure, err := s.AccountLockout.Fail(ctx, accountSubject(email))
+	if err != nil {
+		s.Log.Warnf("failed to record failed login: %v", err)
+	} else if failure.Locked && owner != nil {
+		s.sendLockedEmail(owner, failure)
+	}
+
+	if ip != "" {
+		if _, err := s.IPLockout.Fail(ctx, ipSubject(ip)); err != nil {
+			s.Log.Warnf("failed to record failed login: %v", err)
+		}
+	}
+}
+
+func (s *UserServiceImpl) sendLockedEmail(owner *entity.User, failure *lockout.Failure) {
+	var replaceEmail = struct {
+		Attempts int64
+		Until    string
+	}{
+		Attempts: failure.Count,
+		Until:    time.Now().Add(failure.RetryAfter).UTC().Format("2006-01-02 15:04 MST"),
+	}
+
+	tmpl, err := template.ParseFS(templateFS, "template/account-locked.html")
+	if err != nil {
+		s.Log.Errorf("failed to parse template: %v", err)
+		return
+	}
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, &replaceEmail); err != nil {
+		s.Log.Errorf("failed to execute template: %v", err)
+		return
+	}
+
+	emailRequest := &gomail.SendEmail{
+		EmailTo:   owner.Email,
+		EmailFrom: s.Gomail.GetFromEmail(),
+		Subject:   "[TrinityKnights] Account Locked",
+		Body:      body,
+	}
+	if err := s.Gomail.SendEmail(emailRequest); err != nil {
+		s.Log.Errorf("failed to send account locked email: %v", err)
+	}
+}
+
+// issueSession starts a new session for a user who has fully authenticated. The caller commits tx.
+func (s *UserServiceImpl) issueSession(ctx context.Context, tx *gorm.DB, data *entity.User, ip, userAgent string) (*model.TokenResponse, error) {
+	// Each login starts a new family of refresh tokens
+	familyID := uuid.NewString()
+	accessToken, _, err := s.JWTService.GenerateAccessToken(data.ID, data.Email, data.Role, familyID)
+	if err != nil {
+		s.Log.Errorf("failed to generate access token: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	refreshToken, refreshClaims, err := s.JWTService.GenerateRefreshToken(data.ID, data.Email, data.Role, familyID)
+	if err != nil {
+		s.Log.Errorf("failed to generate refresh token: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.TokenStore.Issue(ctx, data.ID, familyID, refreshClaims.ID, time.Until(refreshClaims.ExpiresAt.Time)); err != nil {
+		s.Log.Errorf("failed to store refresh token: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	t := time.Now()
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+	sessionData := &entity.Session{
+		ID:             familyID,
+		UserID:         data.ID,
+		RefreshTokenID: refreshClaims.ID,
+		UserAgent:      userAgent,
+		IP:             ip,
+		LastSeenAt:     t,
+		ExpiresAt:      refreshClaims.ExpiresAt.Time,
+	}
+	if err := s.SessionRepository.Create(tx, sessionData); err != nil {
+		s.Log.Errorf("failed to create session: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	data.LastLogin = &t
+	if err := s.UserRepository.Update(tx, data); err != nil {
+		s.Log.Errorf("failed to update user: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.LoginToTokenResponse(accessToken, refreshToken), nil
+}
+
 func (s *UserServiceImpl) Register(ctx context.Context, request *model.RegisterRequest) (*model.UserResponse, error) {
 	if err := s.Validate.Struct(request); err != nil {
 		return nil, domainErrors.ErrBadRequest
@@ -147,10 +325,16 @@ func (s *UserServiceImpl) Login(ctx context.Context, request *model.LoginRequest
 		return nil, domainErrors.ErrBadRequest
 	}
 
+	if err := s.checkLockout(ctx, request.Email, request.IP); err != nil {
+		return nil, err
+	}
+
 	data := &entity.User{}
 	if err := s.UserRepository.GetByEmail(tx, data, request.Email); err != nil {
 		s.Log.Errorf("failed to get user by email: %v", err)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
+			compareDummyPassword(request.Password)
+			s.recordFailure(ctx, request.Email, request.IP, nil)
 			return nil, domainErrors.ErrUnauthorized
 		}
 		return nil, domainErrors.ErrInternalServer
@@ -158,26 +342,27 @@ func (s *UserServiceImpl) Login(ctx context.Context, request *model.LoginRequest
 
 	if err := bcrypt.CompareHashAndPassword([]byte(data.Password), []byte(request.Password)); err != nil {
 		s.Log.Errorf("failed to compare password: %v", err)
+		s.recordFailure(ctx, request.Email, request.IP, data)
 		return nil, domainErrors.ErrUnauthorized
 	}
 
-	accessToken, err := s.JWTService.GenerateAccessToken(data.ID, data.Email, data.Role)
-	if err != nil {
-		s.Log.Errorf("failed to generate access token: %v", err)
-		return nil, domainErrors.ErrInternalServer
+	// The password alone is not enough for these users, so the lockout is only reset once the
+	// second step succeeds
+	if data.TwoFactorEnabled {
+		return s.challenge(data, model.TwoFactorRequired)
 	}
 
-	refreshToken, err := s.JWTService.GenerateRefreshToken(data.ID, data.Email, data.Role)
+	required, err := s.twoFactorRequired(tx, data.Role)
 	if err != nil {
-		s.Log.Errorf("failed to generate refresh token: %v", err)
-		return nil, domainErrors.ErrInternalServer
+		return nil, err
+	}
+	if required {
+		return s.challenge(data, model.TwoFactorEnrolmentRequired)
 	}
 
-	t := time.Now()
-	data.LastLogin = &t
-	if err := s.UserRepository.Update(tx, data); err != nil {
-		s.Log.Errorf("failed to update user: %v", err)
-		return nil, domainErrors.ErrInternalServer
+	response, err := s.issueSession(ctx, tx, data, request.IP, request.UserAgent)
+	if err != nil {
+		return nil, err
 	}
 
 	if err := tx.Commit().Error; err != nil {
@@ -185,7 +370,11 @@ func (s *UserServiceImpl) Login(ctx context.Context, request *model.LoginRequest
 		return nil, domainErrors.ErrInternalServer
 	}
 
-	return converter.LoginToTokenResponse(accessToken, refreshToken), nil
+	if err := s.AccountLockout.Reset(ctx, accountSubject(request.Email)); err != nil {
+		s.Log.Warnf("failed to reset lockout: %v", err)
+	}
+
+	return response, nil
 }
 
 func (s *UserServiceImpl) Profile(ctx context.Context) (*model.UserResponse, error) {
@@ -273,24 +462,73 @@ func (s *UserServiceImpl) RefreshToken(ctx context.Context, request *model.Refre
 		return nil, domainErrors.ErrBadRequest
 	}
 
-	claims, err := s.JWTService.ValidateToken(request.RefreshToken)
+	claims, err := s.JWTService.ValidateRefreshToken(request.RefreshToken)
 	if err != nil {
 		s.Log.Errorf("failed to validate token: %v", err)
 		return nil, domainErrors.ErrUnauthorized
 	}
 
-	accessToken, err := s.JWTService.GenerateAccessToken(claims.UserID, claims.Email, claims.Role)
+	// Tokens issued before rotation have no family and cannot be rotated
+	if claims.FamilyID == "" {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	// The new tokens carry the account as it is now, so a demotion or a deactivation takes
+	// effect at the next refresh rather than when the login expires
+	data := &entity.User{}
+	if err := s.UserRepository.GetByID(s.DB.WithContext(ctx), data, claims.UserID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrUnauthorized
+		}
+		s.Log.Errorf("failed to get user by id: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+	if !data.Status {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	accessToken, _, err := s.JWTService.GenerateAccessToken(data.ID, data.Email, data.Role, claims.FamilyID)
 	if err != nil {
 		s.Log.Errorf("failed to generate access token: %v", err)
 		return nil, domainErrors.ErrInternalServer
 	}
 
-	refreshToken, err := s.JWTService.GenerateRefreshToken(claims.UserID, claims.Email, claims.Role)
+	refreshToken, refreshClaims, err := s.JWTService.GenerateRefreshToken(data.ID, data.Email, data.Role, claims.FamilyID)
 	if err != nil {
 		s.Log.Errorf("failed to generate refresh token: %v", err)
 		return nil, domainErrors.ErrInternalServer
 	}
 
+	if err := s.TokenStore.Rotate(ctx, claims.UserID, claims.FamilyID, claims.ID, refreshClaims.ID, time.Until(refreshClaims.ExpiresAt.Time)); err != nil {
+		switch {
+		case errors.Is(err, tokenstore.ErrTokenReused):
+			s.Log.Warnf("refresh token reused, revoked family %s of user %s", claims.FamilyID, claims.UserID)
+			if err := s.SessionRepository.Revoke(s.DB.WithContext(ctx), claims.FamilyID, time.Now()); err != nil {
+				s.Log.Errorf("failed to revoke session: %v", err)
+			}
+			return nil, domainErrors.ErrUnauthorized
+		case errors.Is(err, tokenstore.ErrTokenRevoked):
+			return nil, domainErrors.ErrUnauthorized
+		default:
+			s.Log.Errorf("failed to rotate refresh token: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+	}
+
+	// The session row has the final say, so a session revoked while Redis lost track of it
+	// still ends here
+	touched, err := s.SessionRepository.Touch(s.DB.WithContext(ctx), claims.FamilyID, refreshClaims.ID, request.IP, time.Now(), refreshClaims.ExpiresAt.Time)
+	if err != nil {
+		s.Log.Errorf("failed to update session: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+	if touched == 0 {
+		if err := s.TokenStore.RevokeFamily(ctx, claims.UserID, claims.FamilyID); err != nil {
+			s.Log.Errorf("failed to revoke token family: %v", err)
+		}
+		return nil, domainErrors.ErrUnauthorized
+	}
+
 	return converter.LoginToTokenResponse(accessToken, refreshToken), nil
 }
 
@@ -306,24 +544,21 @@ func (s *UserServiceImpl) RequestReset(ctx context.Context, request *model.ReqRe
 		return nil, domainErrors.ErrBadRequest
 	}
 
+	// Unknown addresses get the same reply, so this cannot be used to probe for accounts
 	existingUser := &entity.User{}
 	if err := s.UserRepository.GetByEmail(tx, existingUser, request.Email); err != nil {
-		s.Log.Errorf("email already exists: %v", request.Email)
-		return nil, domainErrors.ErrNotFound
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &model.VerifyResponse{Status: "success"}, nil
+		}
+		s.Log.Errorf("failed to get user by email: %v", err)
+		return nil, domainErrors.ErrInternalServer
 	}
 
 	token := uuid.NewString()
 
-	update := &entity.User{
-		ID:                 existingUser.ID,
-		Email:              existingUser.Email,
-		Password:           existingUser.Password,
-		Name:               existingUser.Name,
-		Role:               existingUser.Role,
-		Status:             existingUser.Status,
-		ResetPasswordToken: token,
-	}
-	if err := s.UserRepository.Update(tx, update); err != nil {
+	// Update saves every column, so it is given the full row
+	existingUser.ResetPasswordToken = token
+	if err := s.UserRepository.Update(tx, existingUser); err != nil {
 		s.Log.Errorf("failed to update user: %v", err)
 		return nil, domainErrors.ErrInternalServer
 	}
@@ -398,11 +633,25 @@ func (s *UserServiceImpl) ResetPassword(ctx context.Context, request *model.Rese
 		return nil, domainErrors.ErrInternalServer
 	}
 
+	if _, err := s.SessionRepository.RevokeByUserID(tx, u.ID, time.Now()); err != nil {
+		s.Log.Errorf("failed to revoke sessions: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		s.Log.Errorf("failed to commit transaction: %v", err)
 		return nil, domainErrors.ErrInternalServer
 	}
 
+	if err := s.AccountLockout.Reset(ctx, accountSubject(u.Email)); err != nil {
+		s.Log.Warnf("failed to reset lockout: %v", err)
+	}
+
+	// Whoever knew the old password may still hold tokens
+	if err := s.TokenStore.RevokeUser(ctx, u.ID); err != nil {
+		s.Log.Errorf("failed to revoke tokens: %v", err)
+	}
+
 	return &model.VerifyResponse{Status: "success"}, nil
 }
 
@@ -433,6 +682,16 @@ func (s *UserServiceImpl) VerifyEmail(ctx context.Context, request *model.Verify
 		return nil, domainErrors.ErrInternalServer
 	}
 
+	// Guest orders are only attached once the address is proven to belong to the account
+	attached, err := s.OrderRepository.AttachGuestOrders(tx, u.ID, u.Email)
+	if err != nil {
+		s.Log.Errorf("failed to attach guest orders: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+	if attached > 0 {
+		s.Log.Infof("attached %d guest order(s) to user %s", attached, u.ID)
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		s.Log.Errorf("failed to commit transaction: %v", err)
 		return nil, domainErrors.ErrInternalServer
@@ -440,3 +699,778 @@ func (s *UserServiceImpl) VerifyEmail(ctx context.Context, request *model.Verify
 
 	return &model.VerifyResponse{Status: "success"}, nil
 }
+
+func (s *UserServiceImpl) Unlock(ctx context.Context, request *model.UnlockUserRequest) (*model.VerifyResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrBadRequest
+	}
+
+	u := &entity.User{}
+	if err := s.UserRepository.GetByID(s.DB.WithContext(ctx), u, request.ID); err != nil {
+		s.Log.Errorf("failed to get user by id: %v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.AccountLockout.Reset(ctx, accountSubject(u.Email)); err != nil {
+		s.Log.Errorf("failed to reset lockout: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return &model.VerifyResponse{Status: "success"}, nil
+}
+
+func (s *UserServiceImpl) Logout(ctx context.Context) (*model.VerifyResponse, error) {
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		s.Log.Errorf("failed to get jwt claims: %v", err)
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	if claims.FamilyID != "" {
+		if err := s.revokeSession(ctx, claims.UserID, claims.FamilyID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.denyAccessToken(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	return &model.VerifyResponse{Status: "success"}, nil
+}
+
+func (s *UserServiceImpl) LogoutAll(ctx context.Context) (*model.VerifyResponse, error) {
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		s.Log.Errorf("failed to get jwt claims: %v", err)
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	if _, err := s.SessionRepository.RevokeByUserID(s.DB.WithContext(ctx), claims.UserID, time.Now()); err != nil {
+		s.Log.Errorf("failed to revoke sessions: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.TokenStore.RevokeUser(ctx, claims.UserID); err != nil {
+		s.Log.Errorf("failed to revoke tokens: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.denyAccessToken(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	return &model.VerifyResponse{Status: "success"}, nil
+}
+
+// denyAccessToken blocks the presented access token until it expires, which also covers
+// tokens issued before families existed.
+func (s *UserServiceImpl) denyAccessToken(ctx context.Context, claims *jwt.JWTClaims) error {
+	if claims.ID == "" || claims.ExpiresAt == nil {
+		return nil
+	}
+
+	if err := s.TokenStore.Deny(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+		s.Log.Errorf("failed to deny access token: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	return nil
+}
+
+// revokeSession ends one session in the database and its refresh token family in Redis.
+func (s *UserServiceImpl) revokeSession(ctx context.Context, userID, sessionID string) error {
+	if err := s.SessionRepository.Revoke(s.DB.WithContext(ctx), sessionID, time.Now()); err != nil {
+		s.Log.Errorf("failed to revoke session: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	if err := s.TokenStore.RevokeFamily(ctx, userID, sessionID); err != nil {
+		s.Log.Errorf("failed to revoke token family: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	return nil
+}
+
+func (s *UserServiceImpl) GetSessions(ctx context.Context) ([]*model.SessionResponse, error) {
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		s.Log.Errorf("failed to get jwt claims: %v", err)
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	sessions, err := s.SessionRepository.FindActiveByUserID(s.DB.WithContext(ctx), claims.UserID, time.Now())
+	if err != nil {
+		s.Log.Errorf("failed to find sessions: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.SessionsToResponses(sessions, claims.FamilyID), nil
+}
+
+func (s *UserServiceImpl) RevokeSession(ctx context.Context, request *model.RevokeSessionRequest) (*model.VerifyResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrBadRequest
+	}
+
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		s.Log.Errorf("failed to get jwt claims: %v", err)
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	// Sessions of other users are reported as missing rather than forbidden
+	data := &entity.Session{}
+	if err := s.SessionRepository.GetActiveByID(s.DB.WithContext(ctx), data, request.ID, claims.UserID, time.Now()); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get session: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.revokeSession(ctx, claims.UserID, data.ID); err != nil {
+		return nil, err
+	}
+
+	return &model.VerifyResponse{Status: "success"}, nil
+}
+
+func (s *UserServiceImpl) GetUserSessions(ctx context.Context, request *model.GetUserSessionsRequest) ([]*model.SessionResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrBadRequest
+	}
+
+	u := &entity.User{}
+	if err := s.UserRepository.GetByID(s.DB.WithContext(ctx), u, request.ID); err != nil {
+		s.Log.Errorf("failed to get user by id: %v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	sessions, err := s.SessionRepository.FindActiveByUserID(s.DB.WithContext(ctx), u.ID, time.Now())
+	if err != nil {
+		s.Log.Errorf("failed to find sessions: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.SessionsToResponses(sessions, ""), nil
+}
+
+const (
+	// twoFactorIssuer labels the account in authenticator apps
+	twoFactorIssuer   = "TrinityKnights"
+	qrCodeScale       = 6
+	recoveryCodeCount = 10
+	// recoveryCodeAlphabet is Crockford's base32, which leaves out letters easily misread as
+	// digits. Having 32 characters, it takes exactly 5 random bits each
+	recoveryCodeAlphabet = "0123456789abcdefghjkmnpqrstvwxyz"
+	recoveryCodeLength   = 10
+)
+
+// generateRecoveryCodes returns codes formatted for display along with the hashes to store.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	random := make([]byte, recoveryCodeLength)
+	for i := range codes {
+		if _, err := rand.Read(random); err != nil {
+			return nil, nil, err
+		}
+		code := make([]byte, recoveryCodeLength)
+		for j, b := range random {
+			code[j] = recoveryCodeAlphabet[b&31]
+		}
+		half := recoveryCodeLength / 2
+		codes[i] = string(code[:half]) + "-" + string(code[half:])
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode ignores case, spaces and dashes, since codes are often typed by hand.
+func hashRecoveryCode(code string) string {
+	normalized := strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// twoFactorRequired reports whether admins made two-factor authentication mandatory for role.
+func (s *UserServiceImpl) twoFactorRequired(db *gorm.DB, role string) (bool, error) {
+	policy := &entity.RolePolicy{}
+	if err := s.RolePolicyRepository.GetByRole(db, policy, role); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		s.Log.Errorf("failed to get role policy: %v", err)
+		return false, domainErrors.ErrInternalServer
+	}
+	return policy.TwoFactorRequired, nil
+}
+
+// challenge answers a correct password with a short-lived token to exchange for real tokens
+// once the second step is done.
+func (s *UserServiceImpl) challenge(data *entity.User, twoFactor string) (*model.TokenResponse, error) {
+	challengeToken, _, err := s.JWTService.GenerateChallengeToken(data.ID)
+	if err != nil {
+		s.Log.Errorf("failed to generate challenge token: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+	return converter.ChallengeToTokenResponse(twoFactor, challengeToken), nil
+}
+
+// redeemChallenge loads the user a challenge token was issued to. Tokens are single use, see
+// consumeChallenge.
+func (s *UserServiceImpl) redeemChallenge(ctx context.Context, db *gorm.DB, challengeToken string) (*jwt.JWTClaims, *entity.User, error) {
+	claims, err := s.JWTService.ValidateChallengeToken(challengeToken)
+	if err != nil {
+		s.Log.Errorf("failed to validate challenge token: %v", err)
+		return nil, nil, domainErrors.ErrUnauthorized
+	}
+
+	// Unlike access tokens, a challenge must not be accepted when the denylist cannot be read
+	denied, err := s.TokenStore.IsDenied(ctx, claims.UserID, "", claims.ID)
+	if err != nil {
+		s.Log.Errorf("failed to check challenge token: %v", err)
+		return nil, nil, domainErrors.ErrInternalServer
+	}
+	if denied {
+		return nil, nil, domainErrors.ErrUnauthorized
+	}
+
+	data := &entity.User{}
+	if err := s.UserRepository.GetByID(db, data, claims.UserID); err != nil {
+		s.Log.Errorf("failed to get user by id: %v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, domainErrors.ErrUnauthorized
+		}
+		return nil, nil, domainErrors.ErrInternalServer
+	}
+
+	return claims, data, nil
+}
+
+func (s *UserServiceImpl) consumeChallenge(ctx context.Context, claims *jwt.JWTClaims) error {
+	if err := s.TokenStore.Deny(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+		s.Log.Errorf("failed to deny challenge token: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+	return nil
+}
+
+// verifySecondFactor accepts a TOTP code whose time step is newer than the last one used, or an
+// unused recovery code.
+func (s *UserServiceImpl) verifySecondFactor(db *gorm.DB, data *entity.User, code string) (bool, error) {
+	secret, err := s.twoFactorSecret(data)
+	if err != nil {
+		return false, err
+	}
+
+	if step, ok := totp.Validate(secret, code, time.Now()); ok {
+		used, err := s.UserRepository.UseTwoFactorStep(db, data.ID, step)
+		if err != nil {
+			s.Log.Errorf("failed to record two-factor step: %v", err)
+			return false, domainErrors.ErrInternalServer
+		}
+		if used == 0 {
+			return false, nil
+		}
+		// Keep the entity in step, as Update saves every column
+		data.TwoFactorLastStep = step
+		return true, nil
+	}
+
+	redeemed, err := s.RecoveryCodeRepository.Redeem(db, data.ID, hashRecoveryCode(code), time.Now())
+	if err != nil {
+		s.Log.Errorf("failed to redeem recovery code: %v", err)
+		return false, domainErrors.ErrInternalServer
+	}
+	if redeemed > 0 {
+		s.Log.Infof("user %s signed in with a recovery code", data.ID)
+	}
+	return redeemed > 0, nil
+}
+
+// enrolTwoFactor gives the user a new secret. It is not used for logins until confirmed.
+func (s *UserServiceImpl) enrolTwoFactor(db *gorm.DB, data *entity.User) (*model.EnrolTwoFactorResponse, error) {
+	if data.TwoFactorEnabled {
+		return nil, domainErrors.ErrTwoFactorEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		s.Log.Errorf("failed to generate two-factor secret: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	uri := totp.URI(twoFactorIssuer, data.Email, secret)
+	image, err := qrcode.PNG(uri, qrCodeScale)
+	if err != nil {
+		s.Log.Errorf("failed to render qr code: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	sealed, err := s.Encryption.Encrypt(secret)
+	if err != nil {
+		s.Log.Errorf("failed to encrypt two-factor secret: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	data.TwoFactorSecret = sealed
+	if err := s.UserRepository.Update(db, data); err != nil {
+		s.Log.Errorf("failed to update user: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return &model.EnrolTwoFactorResponse{
+		Secret:     secret,
+		OTPAuthURI: uri,
+		QRCode:     "data:image/png;base64," + base64.StdEncoding.EncodeToString(image),
+	}, nil
+}
+
+// confirmTwoFactor turns two-factor authentication on once the user proves their authenticator
+// produces the right codes, and returns their recovery codes.
+func (s *UserServiceImpl) confirmTwoFactor(db *gorm.DB, data *entity.User, code string) ([]string, error) {
+	if data.TwoFactorEnabled {
+		return nil, domainErrors.ErrTwoFactorEnabled
+	}
+	if data.TwoFactorSecret == "" {
+		return nil, domainErrors.ErrBadRequest
+	}
+
+	secret, err := s.twoFactorSecret(data)
+	if err != nil {
+		return nil, err
+	}
+
+	step, ok := totp.Validate(secret, code, time.Now())
+	if !ok {
+		return nil, domainErrors.ErrInvalidTwoFactor
+	}
+
+	data.TwoFactorEnabled = true
+	data.TwoFactorLastStep = step
+	if err := s.UserRepository.Update(db, data); err != nil {
+		s.Log.Errorf("failed to update user: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return s.replaceRecoveryCodes(db, data.ID)
+}
+
+// twoFactorSecret opens the stored secret. Secrets enrolled before they were encrypted are still
+// plaintext and are used as they are.
+func (s *UserServiceImpl) twoFactorSecret(data *entity.User) (string, error) {
+	secret, err := s.Encryption.Decrypt(data.TwoFactorSecret)
+	if errors.Is(err, encryption.ErrNotEncrypted) {
+		return data.TwoFactorSecret, nil
+	}
+	if err != nil {
+		s.Log.Errorf("failed to decrypt two-factor secret: %v", err)
+		return "", domainErrors.ErrInternalServer
+	}
+	return secret, nil
+}
+
+func (s *UserServiceImpl) replaceRecoveryCodes(db *gorm.DB, userID string) ([]string, error) {
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		s.Log.Errorf("failed to generate recovery codes: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.RecoveryCodeRepository.ReplaceForUser(db, userID, hashes); err != nil {
+		s.Log.Errorf("failed to store recovery codes: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return codes, nil
+}
+
+// getCurrentUser loads the user the request is authenticated as.
+func (s *UserServiceImpl) getCurrentUser(ctx context.Context, db *gorm.DB) (*entity.User, error) {
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		s.Log.Errorf("failed to get jwt claims: %v", err)
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	data := &entity.User{}
+	if err := s.UserRepository.GetByID(db, data, claims.UserID); err != nil {
+		s.Log.Errorf("failed to get user by id: %v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return data, nil
+}
+
+// checkSecondFactor verifies code for an authenticated user, with failures counted towards the
+// account lockout like failed logins.
+func (s *UserServiceImpl) checkSecondFactor(ctx context.Context, db *gorm.DB, data *entity.User, code string) error {
+	if err := s.checkLockout(ctx, data.Email, ""); err != nil {
+		return err
+	}
+
+	ok, err := s.verifySecondFactor(db, data, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		s.recordFailure(ctx, data.Email, "", data)
+		return domainErrors.ErrInvalidTwoFactor
+	}
+
+	return nil
+}
+
+func (s *UserServiceImpl) LoginTwoFactor(ctx context.Context, request *model.LoginTwoFactorRequest) (*model.TokenResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrBadRequest
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	claims, data, err := s.redeemChallenge(ctx, tx, request.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.TwoFactorEnabled {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	if err := s.checkLockout(ctx, data.Email, request.IP); err != nil {
+		return nil, err
+	}
+
+	ok, err := s.verifySecondFactor(tx, data, request.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		s.recordFailure(ctx, data.Email, request.IP, data)
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	response, err := s.issueSession(ctx, tx, data, request.IP, request.UserAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.consumeChallenge(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.AccountLockout.Reset(ctx, accountSubject(data.Email)); err != nil {
+		s.Log.Warnf("failed to reset lockout: %v", err)
+	}
+
+	return response, nil
+}
+
+func (s *UserServiceImpl) LoginEnrolTwoFactor(ctx context.Context, request *model.LoginEnrolTwoFactorRequest) (*model.EnrolTwoFactorResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrBadRequest
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	_, data, err := s.redeemChallenge(ctx, tx, request.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.enrolTwoFactor(tx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return response, nil
+}
+
+func (s *UserServiceImpl) LoginConfirmTwoFactor(ctx context.Context, request *model.LoginConfirmTwoFactorRequest) (*model.TokenResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrBadRequest
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	claims, data, err := s.redeemChallenge(ctx, tx, request.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkLockout(ctx, data.Email, request.IP); err != nil {
+		return nil, err
+	}
+
+	codes, err := s.confirmTwoFactor(tx, data, request.Code)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidTwoFactor) {
+			s.recordFailure(ctx, data.Email, request.IP, data)
+		}
+		return nil, err
+	}
+
+	response, err := s.issueSession(ctx, tx, data, request.IP, request.UserAgent)
+	if err != nil {
+		return nil, err
+	}
+	response.RecoveryCodes = codes
+
+	if err := s.consumeChallenge(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.AccountLockout.Reset(ctx, accountSubject(data.Email)); err != nil {
+		s.Log.Warnf("failed to reset lockout: %v", err)
+	}
+
+	return response, nil
+}
+
+func (s *UserServiceImpl) EnrolTwoFactor(ctx context.Context) (*model.EnrolTwoFactorResponse, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data, err := s.getCurrentUser(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.enrolTwoFactor(tx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return response, nil
+}
+
+func (s *UserServiceImpl) ConfirmTwoFactor(ctx context.Context, request *model.TwoFactorCodeRequest) (*model.RecoveryCodesResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrBadRequest
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data, err := s.getCurrentUser(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := s.confirmTwoFactor(tx, data, request.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return &model.RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+func (s *UserServiceImpl) DisableTwoFactor(ctx context.Context, request *model.TwoFactorCodeRequest) (*model.VerifyResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrBadRequest
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data, err := s.getCurrentUser(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.TwoFactorEnabled {
+		return nil, domainErrors.ErrBadRequest
+	}
+
+	required, err := s.twoFactorRequired(tx, data.Role)
+	if err != nil {
+		return nil, err
+	}
+	if required {
+		return nil, domainErrors.ErrTwoFactorRequired
+	}
+
+	if err := s.checkSecondFactor(ctx, tx, data, request.Code); err != nil {
+		return nil, err
+	}
+
+	data.TwoFactorEnabled = false
+	data.TwoFactorSecret = ""
+	data.TwoFactorLastStep = 0
+	if err := s.UserRepository.Update(tx, data); err != nil {
+		s.Log.Errorf("failed to update user: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.RecoveryCodeRepository.DeleteByUserID(tx, data.ID); err != nil {
+		s.Log.Errorf("failed to delete recovery codes: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return &model.VerifyResponse{Status: "success"}, nil
+}
+
+func (s *UserServiceImpl) RegenerateRecoveryCodes(ctx context.Context, request *model.TwoFactorCodeRequest) (*model.RecoveryCodesResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrBadRequest
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data, err := s.getCurrentUser(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.TwoFactorEnabled {
+		return nil, domainErrors.ErrBadRequest
+	}
+
+	if err := s.checkSecondFactor(ctx, tx, data, request.Code); err != nil {
+		return nil, err
+	}
+
+	codes, err := s.replaceRecoveryCodes(tx, data.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return &model.RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+func (s *UserServiceImpl) GetRolePolicy(ctx context.Context, request *model.RolePolicyRequest) (*model.RolePolicyResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrBadRequest
+	}
+
+	policy := &entity.RolePolicy{}
+	if err := s.RolePolicyRepository.GetByRole(s.DB.WithContext(ctx), policy, request.Role); err != nil {
+		s.Log.Errorf("failed to get role policy: %v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.RolePolicyToResponse(policy), nil
+}
+
+func (s *UserServiceImpl) UpdateRolePolicy(ctx context.Context, request *model.UpdateRolePolicyRequest) (*model.RolePolicyResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrBadRequest
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	actor, err := s.getCurrentUser(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &entity.RolePolicy{}
+	if err := s.RolePolicyRepository.GetByRole(tx, policy, request.Role); err != nil {
+		s.Log.Errorf("failed to get role policy: %v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	enabling := *request.TwoFactorRequired && !policy.TwoFactorRequired
+
+	// Otherwise the admin would sign themselves out along with everyone else
+	if enabling && actor.Role == request.Role && !actor.TwoFactorEnabled {
+		return nil, domainErrors.ErrTwoFactorRequired
+	}
+
+	policy.TwoFactorRequired = *request.TwoFactorRequired
+	if err := s.RolePolicyRepository.Update(tx, policy); err != nil {
+		s.Log.Errorf("failed to update role policy: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	// Users who have not enrolled are signed out, so the policy applies to them at their next
+	// login instead of whenever their refresh tokens run out
+	var signedOut []string
+	if enabling {
+		signedOut, err = s.UserRepository.FindIDsWithoutTwoFactor(tx, request.Role)
+		if err != nil {
+			s.Log.Errorf("failed to find users without two-factor: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+		for _, userID := range signedOut {
+			if _, err := s.SessionRepository.RevokeByUserID(tx, userID, time.Now()); err != nil {
+				s.Log.Errorf("failed to revoke sessions: %v", err)
+				return nil, domainErrors.ErrInternalServer
+			}
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	for _, userID := range signedOut {
+		if err := s.TokenStore.RevokeUser(ctx, userID); err != nil {
+			s.Log.Errorf("failed to revoke tokens of user %s: %v", userID, err)
+		}
+	}
+	if len(signedOut) > 0 {
+		s.Log.Infof("two-factor now required for role %s, signed out %d user(s)", request.Role, len(signedOut))
+	}
+
+	return converter.RolePolicyToResponse(policy), nil
+}
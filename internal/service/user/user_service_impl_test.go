@@ -0,0 +1,220 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/service/user"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/jwt"
+	"github.com/TrinityKnights/Backend/pkg/tokenstore"
+	mockJWT "github.com/TrinityKnights/Backend/test/mock/pkg/jwt"
+	mockTokenStore "github.com/TrinityKnights/Backend/test/mock/pkg/tokenstore"
+	mockSession "github.com/TrinityKnights/Backend/test/mock/repository/session"
+	mockUser "github.com/TrinityKnights/Backend/test/mock/repository/user"
+	"github.com/go-playground/validator/v10"
+	golangJWT "github.com/golang-jwt/jwt/v5"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type mocks struct {
+	jwt        *mockJWT.MockJWTService
+	tokenStore *mockTokenStore.MockTokenStore
+	session    *mockSession.MockSessionRepository
+	user       *mockUser.MockUserRepository
+}
+
+func setupTest(t *testing.T) (*user.UserServiceImpl, *mocks) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		jwt:        mockJWT.NewMockJWTService(ctrl),
+		tokenStore: mockTokenStore.NewMockTokenStore(ctrl),
+		session:    mockSession.NewMockSessionRepository(ctrl),
+		user:       mockUser.NewMockUserRepository(ctrl),
+	}
+	service := user.NewUserServiceImpl(gormDB, logrus.New(), validator.New(), m.user, nil, m.session, nil, nil, m.jwt, nil, nil, nil, m.tokenStore, nil)
+	return service, m
+}
+
+func TestUserService_RefreshToken(t *testing.T) {
+	request := &model.RefreshTokenRequest{RefreshToken: "refresh-1", IP: "10.0.0.1"}
+	presented := &jwt.JWTClaims{
+		UserID:           "user-1",
+		Email:            "alice@example.com",
+		Role:             "user",
+		FamilyID:         "family-1",
+		TokenType:        jwt.TokenTypeRefresh,
+		RegisteredClaims: golangJWT.RegisteredClaims{ID: "jti-1"},
+	}
+	next := &jwt.JWTClaims{
+		FamilyID: "family-1",
+		RegisteredClaims: golangJWT.RegisteredClaims{
+			ID:        "jti-2",
+			ExpiresAt: golangJWT.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+
+	reload := func(m *mocks, data *entity.User) {
+		m.user.EXPECT().GetByID(gomock.Any(), gomock.Any(), "user-1").DoAndReturn(func(_ *gorm.DB, u *entity.User, _ string) error {
+			*u = *data
+			return nil
+		})
+	}
+	account := &entity.User{ID: "user-1", Email: "alice@example.com", Role: "user", Status: true}
+
+	generate := func(m *mocks) {
+		m.jwt.EXPECT().ValidateRefreshToken("refresh-1").Return(presented, nil)
+		reload(m, account)
+		m.jwt.EXPECT().GenerateAccessToken("user-1", "alice@example.com", "user", "family-1").Return("access-2", &jwt.JWTClaims{}, nil)
+		m.jwt.EXPECT().GenerateRefreshToken("user-1", "alice@example.com", "user", "family-1").Return("refresh-2", next, nil)
+	}
+
+	tests := []struct {
+		name        string
+		request     *model.RefreshTokenRequest
+		setupMock   func(m *mocks)
+		expected    *model.TokenResponse
+		expectedErr error
+	}{
+		{
+			name:    "Success",
+			request: request,
+			setupMock: func(m *mocks) {
+				generate(m)
+				m.tokenStore.EXPECT().Rotate(gomock.Any(), "user-1", "family-1", "jti-1", "jti-2", gomock.Any()).Return(nil)
+				m.session.EXPECT().Touch(gomock.Any(), "family-1", "jti-2", "10.0.0.1", gomock.Any(), next.ExpiresAt.Time).Return(int64(1), nil)
+			},
+			expected: &model.TokenResponse{AccessToken: "access-2", RefreshToken: "refresh-2"},
+		},
+		{
+			name:        "Missing Token",
+			request:     &model.RefreshTokenRequest{},
+			setupMock:   func(m *mocks) {},
+			expectedErr: domainErrors.ErrBadRequest,
+		},
+		{
+			name:    "Invalid Token",
+			request: request,
+			setupMock: func(m *mocks) {
+				m.jwt.EXPECT().ValidateRefreshToken("refresh-1").Return(nil, errors.New("token has invalid audience"))
+			},
+			expectedErr: domainErrors.ErrUnauthorized,
+		},
+		{
+			name:    "Token Without Family",
+			request: request,
+			setupMock: func(m *mocks) {
+				m.jwt.EXPECT().ValidateRefreshToken("refresh-1").Return(&jwt.JWTClaims{UserID: "user-1", TokenType: jwt.TokenTypeRefresh}, nil)
+			},
+			expectedErr: domainErrors.ErrUnauthorized,
+		},
+		{
+			name:    "Role Changed Since Login",
+			request: request,
+			setupMock: func(m *mocks) {
+				m.jwt.EXPECT().ValidateRefreshToken("refresh-1").Return(presented, nil)
+				reload(m, &entity.User{ID: "user-1", Email: "alice@example.com", Role: "admin", Status: true})
+				m.jwt.EXPECT().GenerateAccessToken("user-1", "alice@example.com", "admin", "family-1").Return("access-2", &jwt.JWTClaims{}, nil)
+				m.jwt.EXPECT().GenerateRefreshToken("user-1", "alice@example.com", "admin", "family-1").Return("refresh-2", next, nil)
+				m.tokenStore.EXPECT().Rotate(gomock.Any(), "user-1", "family-1", "jti-1", "jti-2", gomock.Any()).Return(nil)
+				m.session.EXPECT().Touch(gomock.Any(), "family-1", "jti-2", "10.0.0.1", gomock.Any(), next.ExpiresAt.Time).Return(int64(1), nil)
+			},
+			expected: &model.TokenResponse{AccessToken: "access-2", RefreshToken: "refresh-2"},
+		},
+		{
+			name:    "Deactivated User",
+			request: request,
+			setupMock: func(m *mocks) {
+				m.jwt.EXPECT().ValidateRefreshToken("refresh-1").Return(presented, nil)
+				reload(m, &entity.User{ID: "user-1", Email: "alice@example.com", Role: "user"})
+			},
+			expectedErr: domainErrors.ErrUnauthorized,
+		},
+		{
+			name:    "Deleted User",
+			request: request,
+			setupMock: func(m *mocks) {
+				m.jwt.EXPECT().ValidateRefreshToken("refresh-1").Return(presented, nil)
+				m.user.EXPECT().GetByID(gomock.Any(), gomock.Any(), "user-1").Return(gorm.ErrRecordNotFound)
+			},
+			expectedErr: domainErrors.ErrUnauthorized,
+		},
+		{
+			name:    "Reused Token Revokes The Session",
+			request: request,
+			setupMock: func(m *mocks) {
+				generate(m)
+				m.tokenStore.EXPECT().Rotate(gomock.Any(), "user-1", "family-1", "jti-1", "jti-2", gomock.Any()).Return(tokenstore.ErrTokenReused)
+				m.session.EXPECT().Revoke(gomock.Any(), "family-1", gomock.Any()).Return(nil)
+			},
+			expectedErr: domainErrors.ErrUnauthorized,
+		},
+		{
+			name:    "Revoked Family",
+			request: request,
+			setupMock: func(m *mocks) {
+				generate(m)
+				m.tokenStore.EXPECT().Rotate(gomock.Any(), "user-1", "family-1", "jti-1", "jti-2", gomock.Any()).Return(tokenstore.ErrTokenRevoked)
+			},
+			expectedErr: domainErrors.ErrUnauthorized,
+		},
+		{
+			name:    "Redis Unavailable",
+			request: request,
+			setupMock: func(m *mocks) {
+				generate(m)
+				m.tokenStore.EXPECT().Rotate(gomock.Any(), "user-1", "family-1", "jti-1", "jti-2", gomock.Any()).Return(errors.New("connection refused"))
+			},
+			expectedErr: domainErrors.ErrInternalServer,
+		},
+		{
+			name:    "Session Revoked In The Database",
+			request: request,
+			setupMock: func(m *mocks) {
+				generate(m)
+				m.tokenStore.EXPECT().Rotate(gomock.Any(), "user-1", "family-1", "jti-1", "jti-2", gomock.Any()).Return(nil)
+				m.session.EXPECT().Touch(gomock.Any(), "family-1", "jti-2", "10.0.0.1", gomock.Any(), next.ExpiresAt.Time).Return(int64(0), nil)
+				m.tokenStore.EXPECT().RevokeFamily(gomock.Any(), "user-1", "family-1").Return(nil)
+			},
+			expectedErr: domainErrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			service, m := setupTest(t)
+			tc.setupMock(m)
+
+			result, err := service.RefreshToken(context.Background(), tc.request)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
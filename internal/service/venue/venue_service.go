@@ -11,4 +11,5 @@ type VenueService interface {
 	GetVenueByID(ctx context.Context, request *model.GetVenueRequest) (*model.VenueResponse, error)
 	GetVenues(ctx context.Context, request *model.VenuesRequest) (*model.Response[[]*model.VenueResponse], error)
 	SearchVenues(ctx context.Context, request *model.VenueSearchRequest) (*model.Response[[]*model.VenueResponse], error)
+	GetCalendar(ctx context.Context, request *model.VenueCalendarRequest) (*model.VenueCalendarResponse, error)
 }
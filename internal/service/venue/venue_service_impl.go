@@ -24,16 +24,31 @@ type VenueServiceImpl struct {
 	Log             *logrus.Logger
 	Validate        *validator.Validate
 	VenueRepository venue.VenueRepository
+	TurnoverBuffer  time.Duration
 	helper          *helper.ContextHelper
 }
 
-func NewVenueServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, venueRepository venue.VenueRepository) *VenueServiceImpl {
+const (
+	dateLayout = "2006-01-02"
+
+	// DefaultCalendarDays is how far ahead the calendar looks when no end date is given
+	DefaultCalendarDays = 30
+	// MaxCalendarDays caps the range of a single calendar request
+	MaxCalendarDays = 366
+)
+
+func NewVenueServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, venueRepository venue.VenueRepository, turnoverBuffer time.Duration) *VenueServiceImpl {
+	if turnoverBuffer < 0 {
+		turnoverBuffer = 0
+	}
+
 	return &VenueServiceImpl{
 		DB:              db,
 		Cache:           cacheImpl,
 		Log:             log,
 		Validate:        validate,
 		VenueRepository: venueRepository,
+		TurnoverBuffer:  turnoverBuffer,
 		helper:          helper.NewContextHelper(),
 	}
 }
@@ -53,6 +68,14 @@ func (s *VenueServiceImpl) CreateVenue(ctx context.Context, request *model.Creat
 		City:     request.City,
 		State:    request.State,
 		Zip:      request.Zip,
+		Timezone: helper.DefaultTimezone,
+	}
+	if request.Timezone != "" {
+		loc, err := helper.LoadTimezone(request.Timezone)
+		if err != nil {
+			return nil, domainErrors.ErrValidation
+		}
+		data.Timezone = loc.String()
 	}
 
 	if err := s.VenueRepository.Create(tx, data); err != nil {
@@ -75,6 +98,15 @@ func (s *VenueServiceImpl) UpdateVenue(ctx context.Context, request *model.Updat
 		return nil, domainErrors.ErrValidation
 	}
 
+	current := &entity.Venue{}
+	if err := s.VenueRepository.GetByID(tx, current, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get venue: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
 	data := &entity.Venue{
 		ID:       request.ID,
 		Name:     request.Name,
@@ -83,6 +115,14 @@ func (s *VenueServiceImpl) UpdateVenue(ctx context.Context, request *model.Updat
 		City:     request.City,
 		State:    request.State,
 		Zip:      request.Zip,
+		Timezone: current.Timezone,
+	}
+	if request.Timezone != "" {
+		loc, err := helper.LoadTimezone(request.Timezone)
+		if err != nil {
+			return nil, domainErrors.ErrValidation
+		}
+		data.Timezone = loc.String()
 	}
 
 	if err := s.VenueRepository.Update(tx, data); err != nil {
@@ -90,10 +130,32 @@ func (s *VenueServiceImpl) UpdateVenue(ctx context.Context, request *model.Updat
 		return nil, domainErrors.ErrInternalServer
 	}
 
+	rezoned := data.Timezone != current.Timezone
+	if rezoned {
+		count, err := s.VenueRepository.RezoneEvents(tx, data.ID, data.Timezone)
+		if err != nil {
+			s.Log.Errorf("failed to move events to time zone %s: %v", data.Timezone, err)
+			return nil, domainErrors.ErrInternalServer
+		}
+		s.Log.Infof("venue %d moved to %s with %d event(s)", data.ID, data.Timezone, count)
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		return nil, domainErrors.ErrInternalServer
 	}
 
+	if err := s.Cache.Delete(fmt.Sprintf("venue:get:id:%d", data.ID)); err != nil {
+		s.Log.Errorf("failed to delete cache: %v", err)
+	}
+	if rezoned {
+		// Events show their times in the venue's zone, so every cached event may be stale
+		for _, pattern := range []string{"event:get:*", "event:search:*"} {
+			if err := s.Cache.DeletePattern(pattern); err != nil {
+				s.Log.Errorf("failed to delete cache: %v", err)
+			}
+		}
+	}
+
 	return converter.VenueEntityToResponse(data), nil
 }
 
@@ -240,3 +302,62 @@ func (s *VenueServiceImpl) SearchVenues(ctx context.Context, request *model.Venu
 
 	return response, nil
 }
+
+// GetCalendar lists the ranges the venue is occupied for between two dates, read in the venue's
+// time zone. The end date is inclusive.
+func (s *VenueServiceImpl) GetCalendar(ctx context.Context, request *model.VenueCalendarRequest) (*model.VenueCalendarResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	db := s.DB.WithContext(ctx)
+
+	data := &entity.Venue{}
+	if err := s.VenueRepository.GetByID(db, data, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get venue: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	loc := helper.LocationOrDefault(data.Timezone)
+
+	now := time.Now().In(loc)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	if request.From != "" {
+		parsed, err := time.ParseInLocation(dateLayout, request.From, loc)
+		if err != nil {
+			return nil, domainErrors.ErrValidation
+		}
+		from = parsed
+	}
+
+	to := from.AddDate(0, 0, DefaultCalendarDays)
+	if request.To != "" {
+		parsed, err := time.ParseInLocation(dateLayout, request.To, loc)
+		if err != nil {
+			return nil, domainErrors.ErrValidation
+		}
+		to = parsed.AddDate(0, 0, 1)
+	}
+
+	if !to.After(from) || to.After(from.AddDate(0, 0, MaxCalendarDays)) {
+		return nil, domainErrors.ErrValidation
+	}
+
+	var events []entity.Event
+	if err := s.VenueRepository.GetBookings(db, &events, data.ID, from, to); err != nil {
+		s.Log.Errorf("failed to get venue bookings: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return &model.VenueCalendarResponse{
+		VenueID:               data.ID,
+		Timezone:              loc.String(),
+		TurnoverBufferMinutes: int(s.TurnoverBuffer.Minutes()),
+		From:                  from,
+		To:                    to,
+		Bookings:              converter.VenueBookingsToResponses(events, s.TurnoverBuffer, loc),
+	}, nil
+}
@@ -0,0 +1,19 @@
+package waitingroom
+
+import (
+	"context"
+
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+)
+
+type WaitingRoomService interface {
+	OpenWaitingRoom(ctx context.Context, request *model.OpenWaitingRoomRequest) (*model.WaitingRoomResponse, error)
+	CloseWaitingRoom(ctx context.Context, request *model.GetWaitingRoomRequest) error
+	GetWaitingRoom(ctx context.Context, request *model.GetWaitingRoomRequest) (*model.WaitingRoomResponse, error)
+	JoinQueue(ctx context.Context, request *model.GetWaitingRoomRequest) (*model.QueuePositionResponse, error)
+	GetQueuePosition(ctx context.Context, request *model.GetQueuePositionRequest) (*model.QueuePositionResponse, error)
+	ClaimAdmission(ctx context.Context, eventID uint, admissionToken string) error
+	ReleaseAdmission(ctx context.Context, eventID uint, admissionToken string)
+	ClaimAdmissions(ctx context.Context, eventIDs []uint, admissionTokens []model.EventAdmissionRequest) error
+	ReleaseAdmissions(ctx context.Context, eventIDs []uint, admissionTokens []model.EventAdmissionRequest)
+}
@@ -0,0 +1,367 @@
+package waitingroom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/repository/event"
+	"github.com/TrinityKnights/Backend/internal/repository/waitingroom"
+	"github.com/TrinityKnights/Backend/pkg/cache"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/jwt"
+	queue "github.com/TrinityKnights/Backend/pkg/waitingroom"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultAdmissionTTL = 10 * time.Minute
+	// QueueTokenTTL is how long a visitor can keep polling for their place in line
+	QueueTokenTTL = 6 * time.Hour
+	roomCacheTTL  = 30 * time.Second
+)
+
+type WaitingRoomServiceImpl struct {
+	DB                    *gorm.DB
+	Cache                 *cache.ImplCache
+	Log                   *logrus.Logger
+	Validate              *validator.Validate
+	WaitingRoomRepository waitingroom.WaitingRoomRepository
+	EventRepository       event.EventRepository
+	Queue                 queue.WaitingRoom
+	JWTService            jwt.JWTService
+	AdmissionTTL          time.Duration
+}
+
+func NewWaitingRoomServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, waitingRoomRepository waitingroom.WaitingRoomRepository, eventRepository event.EventRepository, waitingRoom queue.WaitingRoom, jwtService jwt.JWTService, admissionTTL time.Duration) *WaitingRoomServiceImpl {
+	if admissionTTL <= 0 {
+		admissionTTL = DefaultAdmissionTTL
+	}
+
+	return &WaitingRoomServiceImpl{
+		DB:                    db,
+		Cache:                 cacheImpl,
+		Log:                   log,
+		Validate:              validate,
+		WaitingRoomRepository: waitingRoomRepository,
+		EventRepository:       eventRepository,
+		Queue:                 waitingRoom,
+		JWTService:            jwtService,
+		AdmissionTTL:          admissionTTL,
+	}
+}
+
+func roomKey(eventID uint) string {
+	return fmt.Sprintf("waitingroom:event:%d", eventID)
+}
+
+func admissionToken(admissionTokens []model.EventAdmissionRequest, eventID uint) string {
+	for _, t := range admissionTokens {
+		if t.EventID == eventID {
+			return t.Token
+		}
+	}
+	return ""
+}
+
+func redeemedKey(queueToken string) string {
+	return fmt.Sprintf("waitingroom:redeemed:%s", queueToken)
+}
+
+// OpenWaitingRoom puts an event's sales behind a queue, or changes how fast an open queue moves.
+func (s *WaitingRoomServiceImpl) OpenWaitingRoom(ctx context.Context, request *model.OpenWaitingRoomRequest) (*model.WaitingRoomResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	db := s.DB.WithContext(ctx)
+
+	if err := s.EventRepository.GetByID(db, &entity.Event{}, request.EventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get event: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	room := &entity.WaitingRoom{
+		EventID:       request.EventID,
+		AdmissionRate: request.AdmissionRate,
+	}
+	if err := s.WaitingRoomRepository.Upsert(db, room); err != nil {
+		s.Log.Errorf("failed to save waiting room: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.Queue.Open(ctx, request.EventID, request.AdmissionRate); err != nil {
+		s.Log.Errorf("failed to open waiting room queue: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.Cache.Delete(roomKey(request.EventID)); err != nil {
+		s.Log.Errorf("failed to invalidate cache: %v", err)
+	}
+
+	return s.depth(ctx, room)
+}
+
+// CloseWaitingRoom lets everyone buy again, visitors still in line no longer need admission.
+func (s *WaitingRoomServiceImpl) CloseWaitingRoom(ctx context.Context, request *model.GetWaitingRoomRequest) error {
+	if err := s.Validate.Struct(request); err != nil {
+		return domainErrors.ErrValidation
+	}
+
+	deleted, err := s.WaitingRoomRepository.DeleteByEventID(s.DB.WithContext(ctx), request.EventID)
+	if err != nil {
+		s.Log.Errorf("failed to delete waiting room: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+	if deleted == 0 {
+		return domainErrors.ErrNotFound
+	}
+
+	if err := s.Queue.Close(ctx, request.EventID); err != nil {
+		s.Log.Errorf("failed to close waiting room queue: %v", err)
+	}
+
+	if err := s.Cache.Delete(roomKey(request.EventID)); err != nil {
+		s.Log.Errorf("failed to invalidate cache: %v", err)
+	}
+
+	return nil
+}
+
+// GetWaitingRoom reports the live depth of the event's queue.
+func (s *WaitingRoomServiceImpl) GetWaitingRoom(ctx context.Context, request *model.GetWaitingRoomRequest) (*model.WaitingRoomResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	room, err := s.getRoom(ctx, request.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if room == nil {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	return s.depth(ctx, room)
+}
+
+// JoinQueue hands the visitor a queue token and their place in line.
+func (s *WaitingRoomServiceImpl) JoinQueue(ctx context.Context, request *model.GetWaitingRoomRequest) (*model.QueuePositionResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	room, err := s.getRoom(ctx, request.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if room == nil {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	token := uuid.NewString()
+	position, err := s.Queue.Join(ctx, request.EventID, token, QueueTokenTTL)
+	if errors.Is(err, queue.ErrNotOpen) && s.reopen(ctx, room) {
+		position, err = s.Queue.Join(ctx, request.EventID, token, QueueTokenTTL)
+	}
+	if err != nil {
+		s.Log.Errorf("failed to join waiting room queue: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return s.toPositionResponse(request.EventID, token, position)
+}
+
+// GetQueuePosition is polled by visitors in line, once they are admitted it issues their
+// admission token.
+func (s *WaitingRoomServiceImpl) GetQueuePosition(ctx context.Context, request *model.GetQueuePositionRequest) (*model.QueuePositionResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	room, err := s.getRoom(ctx, request.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if room == nil {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	position, err := s.Queue.Status(ctx, request.EventID, request.Token)
+	if err != nil {
+		// A queue rebuilt after Redis lost its data no longer knows the token, the visitor rejoins
+		if errors.Is(err, queue.ErrUnknownToken) || errors.Is(err, queue.ErrNotOpen) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get queue position: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return s.toPositionResponse(request.EventID, request.Token, position)
+}
+
+// ClaimAdmission lets an order through when the event has no waiting room, or when the buyer
+// brings an admission token for the event that no other order has claimed. The claim is taken
+// atomically, so concurrent orders reusing one token cannot all get through. An order that fails
+// hands its claim back with ReleaseAdmission.
+func (s *WaitingRoomServiceImpl) ClaimAdmission(ctx context.Context, eventID uint, admissionToken string) error {
+	room, err := s.getRoom(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if room == nil {
+		return nil
+	}
+
+	if admissionToken == "" {
+		return domainErrors.ErrAdmissionRequired
+	}
+
+	claims, err := s.JWTService.ValidateAdmissionToken(admissionToken)
+	if err != nil || claims.EventID != eventID {
+		return domainErrors.ErrAdmissionRequired
+	}
+
+	// Admitted visitors can fetch a fresh token for as long as their queue token lives
+	claimed, err := s.Cache.Client().SetNX(ctx, redeemedKey(claims.ID), true, QueueTokenTTL).Result()
+	if err != nil {
+		s.Log.Errorf("failed to claim admission token: %v", err)
+		return nil
+	}
+	if !claimed {
+		return domainErrors.ErrAdmissionRequired
+	}
+
+	return nil
+}
+
+// ReleaseAdmission hands back the claim on an admission token whose order was not placed.
+func (s *WaitingRoomServiceImpl) ReleaseAdmission(ctx context.Context, eventID uint, admissionToken string) {
+	if admissionToken == "" {
+		return
+	}
+
+	claims, err := s.JWTService.ValidateAdmissionToken(admissionToken)
+	if err != nil || claims.EventID != eventID {
+		return
+	}
+
+	if err := s.Cache.Client().Del(ctx, redeemedKey(claims.ID)).Err(); err != nil {
+		s.Log.Errorf("failed to release admission token: %v", err)
+	}
+}
+
+// ClaimAdmissions claims admission to every event of a purchase that spans several events, each
+// with its own token. Either every claim is taken or none is.
+func (s *WaitingRoomServiceImpl) ClaimAdmissions(ctx context.Context, eventIDs []uint, admissionTokens []model.EventAdmissionRequest) error {
+	for i, eventID := range eventIDs {
+		if err := s.ClaimAdmission(ctx, eventID, admissionToken(admissionTokens, eventID)); err != nil {
+			s.ReleaseAdmissions(ctx, eventIDs[:i], admissionTokens)
+			return err
+		}
+	}
+	return nil
+}
+
+// ReleaseAdmissions hands back the admission claims of a purchase that was not placed.
+func (s *WaitingRoomServiceImpl) ReleaseAdmissions(ctx context.Context, eventIDs []uint, admissionTokens []model.EventAdmissionRequest) {
+	for _, eventID := range eventIDs {
+		s.ReleaseAdmission(ctx, eventID, admissionToken(admissionTokens, eventID))
+	}
+}
+
+// getRoom returns the event's waiting room, or nil when sales are not queued. It sits on the
+// checkout path, so the answer is cached for a short while.
+func (s *WaitingRoomServiceImpl) getRoom(ctx context.Context, eventID uint) (*entity.WaitingRoom, error) {
+	var room entity.WaitingRoom
+	err := s.Cache.Get(roomKey(eventID), &room)
+	if err == nil {
+		if room.AdmissionRate == 0 {
+			return nil, nil
+		}
+		return &room, nil
+	}
+	if !errors.Is(err, cache.ErrCacheMiss) {
+		s.Log.Errorf("failed to get cache: %v", err)
+	}
+
+	room = entity.WaitingRoom{}
+	if err := s.WaitingRoomRepository.GetByEventID(s.DB.WithContext(ctx), &room, eventID); err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.Log.Errorf("failed to get waiting room: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+		room = entity.WaitingRoom{EventID: eventID}
+	}
+
+	if err := s.Cache.Set(roomKey(eventID), room, roomCacheTTL); err != nil {
+		s.Log.Errorf("failed to set cache: %v", err)
+	}
+
+	if room.AdmissionRate == 0 {
+		return nil, nil
+	}
+	return &room, nil
+}
+
+// reopen restores a queue Redis lost, visitors start a fresh line at the stored rate.
+func (s *WaitingRoomServiceImpl) reopen(ctx context.Context, room *entity.WaitingRoom) bool {
+	if err := s.Queue.Open(ctx, room.EventID, room.AdmissionRate); err != nil {
+		s.Log.Errorf("failed to reopen waiting room queue: %v", err)
+		return false
+	}
+	return true
+}
+
+func (s *WaitingRoomServiceImpl) depth(ctx context.Context, room *entity.WaitingRoom) (*model.WaitingRoomResponse, error) {
+	depth, err := s.Queue.Depth(ctx, room.EventID)
+	if errors.Is(err, queue.ErrNotOpen) && s.reopen(ctx, room) {
+		depth, err = s.Queue.Depth(ctx, room.EventID)
+	}
+	if err != nil {
+		s.Log.Errorf("failed to get waiting room depth: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return &model.WaitingRoomResponse{
+		EventID:         room.EventID,
+		AdmissionRate:   depth.Rate,
+		Joined:          depth.Joined,
+		Admitted:        depth.Admitted,
+		Waiting:         depth.Waiting,
+		ClearsInSeconds: depth.Waiting * 60 / int64(max(depth.Rate, 1)),
+	}, nil
+}
+
+func (s *WaitingRoomServiceImpl) toPositionResponse(eventID uint, queueToken string, position *queue.Position) (*model.QueuePositionResponse, error) {
+	response := &model.QueuePositionResponse{
+		EventID:              eventID,
+		QueueToken:           queueToken,
+		Position:             position.Number,
+		Ahead:                position.Ahead,
+		EstimatedWaitSeconds: int64(position.Wait.Seconds()),
+		Admitted:             position.Admitted,
+	}
+
+	if position.Admitted {
+		token, err := s.JWTService.GenerateAdmissionToken(eventID, queueToken, s.AdmissionTTL)
+		if err != nil {
+			s.Log.Errorf("failed to generate admission token: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+		expiresAt := time.Now().Add(s.AdmissionTTL)
+		response.AdmissionToken = token
+		response.AdmissionExpiresAt = &expiresAt
+	}
+
+	return response, nil
+}
@@ -0,0 +1,19 @@
+package waitlist
+
+import (
+	"context"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+)
+
+type WaitlistService interface {
+	JoinWaitlist(ctx context.Context, request *model.JoinWaitlistRequest) (*model.WaitlistResponse, error)
+	LeaveWaitlist(ctx context.Context, request *model.GetWaitlistRequest) (*model.WaitlistResponse, error)
+	GetWaitlistByID(ctx context.Context, request *model.GetWaitlistRequest) (*model.WaitlistResponse, error)
+	GetWaitlists(ctx context.Context, request *model.WaitlistsRequest) (*model.Response[[]*model.WaitlistResponse], error)
+	GetOffer(ctx context.Context, request *model.GetWaitlistOfferRequest) (*model.WaitlistOfferResponse, error)
+	OfferReleased(ctx context.Context, eventID uint, ticketType string) error
+	OfferReleasedTickets(ctx context.Context, tickets []*entity.Ticket)
+	ExpireOffers(ctx context.Context) error
+}
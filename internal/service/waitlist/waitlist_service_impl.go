@@ -0,0 +1,497 @@
+package waitlist
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"html/template"
+	"time"
+
+	"github.com/TrinityKnights/Backend/internal/domain/entity"
+	"github.com/TrinityKnights/Backend/internal/domain/model"
+	"github.com/TrinityKnights/Backend/internal/domain/model/converter"
+	"github.com/TrinityKnights/Backend/internal/repository/ticket"
+	"github.com/TrinityKnights/Backend/internal/repository/waitlist"
+	"github.com/TrinityKnights/Backend/pkg/cache"
+	domainErrors "github.com/TrinityKnights/Backend/pkg/errors"
+	"github.com/TrinityKnights/Backend/pkg/gomail"
+	"github.com/TrinityKnights/Backend/pkg/helper"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//go:embed template/*.html
+var templateFS embed.FS
+
+const DefaultOfferTTL = 30 * time.Minute
+
+type WaitlistServiceImpl struct {
+	DB                 *gorm.DB
+	Cache              *cache.ImplCache
+	Log                *logrus.Logger
+	Validate           *validator.Validate
+	WaitlistRepository waitlist.WaitlistRepository
+	TicketRepository   ticket.TicketRepository
+	Gomail             *gomail.ImplGomail
+	OfferTTL           time.Duration
+	helper             *helper.ContextHelper
+}
+
+func NewWaitlistServiceImpl(db *gorm.DB, cacheImpl *cache.ImplCache, log *logrus.Logger, validate *validator.Validate, waitlistRepository waitlist.WaitlistRepository, ticketRepository ticket.TicketRepository, mail *gomail.ImplGomail, offerTTL time.Duration) *WaitlistServiceImpl {
+	if offerTTL <= 0 {
+		offerTTL = DefaultOfferTTL
+	}
+
+	return &WaitlistServiceImpl{
+		DB:                 db,
+		Cache:              cacheImpl,
+		Log:                log,
+		Validate:           validate,
+		WaitlistRepository: waitlistRepository,
+		TicketRepository:   ticketRepository,
+		Gomail:             mail,
+		OfferTTL:           offerTTL,
+		helper:             helper.NewContextHelper(),
+	}
+}
+
+func (s *WaitlistServiceImpl) JoinWaitlist(ctx context.Context, request *model.JoinWaitlistRequest) (*model.WaitlistResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	ticketType := helper.TicketUpper(request.Type)
+	if ticketType.Long == "" {
+		return nil, domainErrors.ErrValidation
+	}
+
+	quantity := request.Quantity
+	if quantity <= 0 {
+		quantity = 1
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	var event entity.Event
+	if err := tx.First(&event, request.EventID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get event: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	existing := &entity.WaitlistEntry{}
+	err = s.WaitlistRepository.GetActiveByUser(tx, existing, claims.UserID, event.ID, ticketType.Long)
+	if err == nil {
+		return nil, domainErrors.ErrDuplicateEntry
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.Log.Errorf("failed to check existing waitlist entry: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	// The waitlist is only for sold-out categories, otherwise buyers should order directly
+	available, err := s.TicketRepository.CountAvailable(tx, event.ID, ticketType.Long, time.Now())
+	if err != nil {
+		s.Log.Errorf("failed to count available tickets: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+	if available >= int64(quantity) {
+		return nil, domainErrors.ErrTicketsAvailable
+	}
+
+	data := &entity.WaitlistEntry{
+		EventID:  event.ID,
+		UserID:   claims.UserID,
+		Type:     ticketType.Long,
+		Quantity: quantity,
+		Status:   model.WaitlistStatusWaiting,
+	}
+
+	if err := s.WaitlistRepository.Create(tx, data); err != nil {
+		s.Log.Errorf("failed to create waitlist entry: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return s.toResponse(s.DB.WithContext(ctx), data)
+}
+
+func (s *WaitlistServiceImpl) LeaveWaitlist(ctx context.Context, request *model.GetWaitlistRequest) (*model.WaitlistResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	data := &entity.WaitlistEntry{}
+	if err := s.WaitlistRepository.GetByID(tx.Clauses(clause.Locking{Strength: "UPDATE"}), data, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get waitlist entry: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.helper.VerifyOwnership(ctx, data.UserID); err != nil {
+		return nil, domainErrors.ErrForbidden
+	}
+
+	if data.Status != model.WaitlistStatusWaiting && data.Status != model.WaitlistStatusOffered {
+		return nil, domainErrors.ErrBadRequest
+	}
+
+	wasOffered := data.Status == model.WaitlistStatusOffered
+	if wasOffered && data.OfferToken != nil {
+		if err := s.TicketRepository.ReleaseHold(tx, *data.OfferToken); err != nil {
+			s.Log.Errorf("failed to release offer hold: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+	}
+
+	data.Status = model.WaitlistStatusCancelled
+	if err := s.WaitlistRepository.Update(tx, data); err != nil {
+		s.Log.Errorf("failed to update waitlist entry: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	// Tickets held for a declined offer roll over to the next person in line
+	if wasOffered {
+		if err := s.OfferReleased(ctx, data.EventID, data.Type); err != nil {
+			s.Log.Errorf("failed to offer released tickets: %v", err)
+		}
+	}
+
+	return converter.WaitlistEntityToResponse(data), nil
+}
+
+func (s *WaitlistServiceImpl) GetWaitlistByID(ctx context.Context, request *model.GetWaitlistRequest) (*model.WaitlistResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	db := s.DB.WithContext(ctx)
+
+	data := &entity.WaitlistEntry{}
+	if err := s.WaitlistRepository.GetByID(db, data, request.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get waitlist entry: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.helper.VerifyOwnership(ctx, data.UserID); err != nil {
+		return nil, domainErrors.ErrForbidden
+	}
+
+	return s.toResponse(db, data)
+}
+
+func (s *WaitlistServiceImpl) GetWaitlists(ctx context.Context, request *model.WaitlistsRequest) (*model.Response[[]*model.WaitlistResponse], error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	claims, err := s.helper.GetJWTClaims(ctx)
+	if err != nil {
+		return nil, domainErrors.ErrUnauthorized
+	}
+
+	if request.Size <= 0 {
+		request.Size = 10
+	}
+	if request.Page <= 0 {
+		request.Page = 1
+	}
+
+	db := s.DB.WithContext(ctx)
+
+	var entries []entity.WaitlistEntry
+	totalItems, err := s.WaitlistRepository.GetPaginatedByUser(db, &entries, claims.UserID, request.Page, request.Size)
+	if err != nil {
+		s.Log.Errorf("failed to get waitlist entries: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if len(entries) == 0 {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	response := converter.WaitlistsToPaginatedResponse(entries, totalItems, request.Page, request.Size)
+	for i := range entries {
+		if entries[i].Status != model.WaitlistStatusWaiting {
+			continue
+		}
+		ahead, err := s.WaitlistRepository.CountAhead(db, &entries[i])
+		if err != nil {
+			s.Log.Errorf("failed to count waitlist position: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+		position := int(ahead) + 1
+		(*response.Data)[i].Position = &position
+	}
+
+	return response, nil
+}
+
+func (s *WaitlistServiceImpl) GetOffer(ctx context.Context, request *model.GetWaitlistOfferRequest) (*model.WaitlistOfferResponse, error) {
+	if err := s.Validate.Struct(request); err != nil {
+		return nil, domainErrors.ErrValidation
+	}
+
+	db := s.DB.WithContext(ctx)
+
+	data := &entity.WaitlistEntry{}
+	if err := s.WaitlistRepository.GetByOfferToken(db, data, request.Token); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrNotFound
+		}
+		s.Log.Errorf("failed to get waitlist offer: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := s.helper.VerifyOwnership(ctx, data.UserID); err != nil {
+		return nil, domainErrors.ErrForbidden
+	}
+
+	if data.Status != model.WaitlistStatusOffered || data.OfferExpiresAt == nil || data.OfferExpiresAt.Before(time.Now()) {
+		return nil, domainErrors.ErrOfferExpired
+	}
+
+	tickets, err := s.TicketRepository.FindByHoldToken(db, request.Token)
+	if err != nil {
+		s.Log.Errorf("failed to get offered tickets: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return converter.WaitlistOfferToResponse(data, tickets), nil
+}
+
+// OfferReleased hands freed inventory of an event category to the waitlist in queue order.
+// Each offer holds the tickets for OfferTTL so nobody else can buy them in the meantime.
+func (s *WaitlistServiceImpl) OfferReleased(ctx context.Context, eventID uint, ticketType string) error {
+	ticketType = helper.TicketUpper(ticketType).Long
+	if ticketType == "" {
+		return domainErrors.ErrBadRequest
+	}
+
+	for {
+		offered, err := s.offerNext(ctx, eventID, ticketType)
+		if err != nil {
+			return err
+		}
+		if offered == nil {
+			return nil
+		}
+
+		if err := s.sendOfferEmail(ctx, offered); err != nil {
+			s.Log.Errorf("failed to send waitlist offer email: %v", err)
+		}
+	}
+}
+
+// OfferReleasedTickets offers freed tickets to the waitlist of each event category they belong to.
+// Failures are logged, the tickets are on general sale either way.
+func (s *WaitlistServiceImpl) OfferReleasedTickets(ctx context.Context, tickets []*entity.Ticket) {
+	categories := make(map[category]bool)
+	for _, t := range tickets {
+		categories[category{eventID: t.EventID, ticketType: t.Type}] = true
+	}
+	s.offerCategories(ctx, categories)
+}
+
+// category is an event and ticket type, the unit waitlists are kept per.
+type category struct {
+	eventID    uint
+	ticketType string
+}
+
+// offerCategories runs OfferReleased once for each category.
+func (s *WaitlistServiceImpl) offerCategories(ctx context.Context, categories map[category]bool) {
+	for c := range categories {
+		if err := s.OfferReleased(ctx, c.eventID, c.ticketType); err != nil {
+			s.Log.Errorf("failed to offer released tickets to waitlist: %v", err)
+		}
+	}
+}
+
+// ExpireOffers closes offers that were not taken up and rolls their tickets to the next person
+func (s *WaitlistServiceImpl) ExpireOffers(ctx context.Context) error {
+	db := s.DB.WithContext(ctx)
+
+	entries, err := s.WaitlistRepository.GetExpiredOffers(db, time.Now())
+	if err != nil {
+		s.Log.Errorf("failed to get expired waitlist offers: %v", err)
+		return domainErrors.ErrInternalServer
+	}
+
+	released := make(map[category]bool)
+
+	for i := range entries {
+		entry := &entries[i]
+
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if entry.OfferToken != nil {
+				if err := s.TicketRepository.ReleaseHold(tx, *entry.OfferToken); err != nil {
+					return err
+				}
+			}
+
+			return tx.Model(&entity.WaitlistEntry{}).
+				Where("id = ? AND status = ?", entry.ID, model.WaitlistStatusOffered).
+				Update("status", model.WaitlistStatusExpired).Error
+		})
+		if err != nil {
+			s.Log.Errorf("failed to expire waitlist offer %d: %v", entry.ID, err)
+			continue
+		}
+
+		released[category{eventID: entry.EventID, ticketType: entry.Type}] = true
+	}
+
+	s.offerCategories(ctx, released)
+
+	return nil
+}
+
+// offerNext makes an offer to the head of the queue if enough tickets are free.
+// It returns nil when the queue is empty or the head cannot be served yet.
+func (s *WaitlistServiceImpl) offerNext(ctx context.Context, eventID uint, ticketType string) (*entity.WaitlistEntry, error) {
+	tx := s.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	entry := &entity.WaitlistEntry{}
+	if err := s.WaitlistRepository.GetNextWaiting(tx, entry, eventID, ticketType); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		s.Log.Errorf("failed to get next waitlist entry: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	now := time.Now()
+	tickets, err := s.TicketRepository.FindAvailableForHold(tx, eventID, ticketType, entry.Quantity, now)
+	if err != nil {
+		s.Log.Errorf("failed to find available tickets: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	// Serve the queue strictly in order, so wait until the head's whole request fits
+	if len(tickets) < entry.Quantity {
+		return nil, nil
+	}
+
+	ticketIDs := make([]string, len(tickets))
+	for i, t := range tickets {
+		ticketIDs[i] = t.ID
+	}
+
+	token := uuid.NewString()
+	expiresAt := now.Add(s.OfferTTL)
+	if err := s.TicketRepository.Hold(tx, ticketIDs, token, expiresAt); err != nil {
+		s.Log.Errorf("failed to hold tickets: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	entry.Status = model.WaitlistStatusOffered
+	entry.OfferToken = &token
+	entry.OfferExpiresAt = &expiresAt
+	if err := s.WaitlistRepository.Update(tx.Omit(clause.Associations), entry); err != nil {
+		s.Log.Errorf("failed to update waitlist entry: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.Log.Errorf("failed to commit transaction: %v", err)
+		return nil, domainErrors.ErrInternalServer
+	}
+
+	return entry, nil
+}
+
+func (s *WaitlistServiceImpl) sendOfferEmail(ctx context.Context, entry *entity.WaitlistEntry) error {
+	var event entity.Event
+	if err := s.DB.WithContext(ctx).First(&event, entry.EventID).Error; err != nil {
+		return err
+	}
+
+	var replaceEmail = struct {
+		Name      string
+		Quantity  int
+		Type      string
+		EventName string
+		ExpiresAt string
+		Token     string
+	}{
+		Name:      entry.User.Name,
+		Quantity:  entry.Quantity,
+		Type:      entry.Type,
+		EventName: event.Name,
+		ExpiresAt: helper.FormatDate(*entry.OfferExpiresAt),
+		Token:     *entry.OfferToken,
+	}
+
+	tmpl, err := template.ParseFS(templateFS, "template/waitlist-offer.html")
+	if err != nil {
+		return err
+	}
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, &replaceEmail); err != nil {
+		return err
+	}
+
+	return s.Gomail.SendEmail(&gomail.SendEmail{
+		EmailTo:   entry.User.Email,
+		EmailFrom: s.Gomail.GetFromEmail(),
+		Subject:   "[TrinityKnights] Your Waitlist Tickets Are Ready",
+		Body:      body,
+	})
+}
+
+func (s *WaitlistServiceImpl) toResponse(db *gorm.DB, entry *entity.WaitlistEntry) (*model.WaitlistResponse, error) {
+	response := converter.WaitlistEntityToResponse(entry)
+
+	switch entry.Status {
+	case model.WaitlistStatusWaiting:
+		ahead, err := s.WaitlistRepository.CountAhead(db, entry)
+		if err != nil {
+			s.Log.Errorf("failed to count waitlist position: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+		position := int(ahead) + 1
+		response.Position = &position
+	case model.WaitlistStatusOffered:
+		if entry.OfferToken == nil {
+			break
+		}
+		tickets, err := s.TicketRepository.FindByHoldToken(db, *entry.OfferToken)
+		if err != nil {
+			s.Log.Errorf("failed to get offered tickets: %v", err)
+			return nil, domainErrors.ErrInternalServer
+		}
+		response.Offer = converter.WaitlistOfferToResponse(entry, tickets)
+	}
+
+	return response, nil
+}
@@ -69,3 +69,8 @@ func (c *ImplCache) DeletePattern(pattern string) error {
 
 	return nil
 }
+
+// Client exposes the underlying connection for features that need more than key-value access.
+func (c *ImplCache) Client() *redis.Client {
+	return c.client
+}
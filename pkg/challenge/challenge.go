@@ -0,0 +1,17 @@
+package challenge
+
+import (
+	"context"
+	"time"
+)
+
+// Verifier checks a challenge response solved by the client, such as a CAPTCHA token.
+type Verifier interface {
+	Verify(ctx context.Context, token, remoteIP string) error
+}
+
+// Risk keeps short-lived counters of client behaviour used to decide who gets challenged.
+type Risk interface {
+	Hit(ctx context.Context, key string, window time.Duration) (int64, error)
+	Count(ctx context.Context, key string) (int64, error)
+}
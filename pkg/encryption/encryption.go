@@ -0,0 +1,8 @@
+package encryption
+
+// Encryption seals short secrets, such as TOTP seeds, before they are stored so that a copy of
+// the database alone does not reveal them.
+type Encryption interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(ciphertext string) (string, error)
+}
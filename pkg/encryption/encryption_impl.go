@@ -0,0 +1,80 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+// KeySize is the key length AES-256 needs.
+const KeySize = 32
+
+// prefix marks sealed values so that secrets stored before encryption can still be told apart.
+const prefix = "v1:"
+
+var (
+	ErrInvalidKey   = errors.New("encryption: key must be 32 bytes")
+	ErrNotEncrypted = errors.New("encryption: value is not encrypted")
+	ErrDecrypt      = errors.New("encryption: value cannot be decrypted")
+)
+
+var encoding = base64.RawStdEncoding
+
+type ImplEncryption struct {
+	aead cipher.AEAD
+}
+
+func NewEncryption(key []byte) (*ImplEncryption, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImplEncryption{
+		aead: aead,
+	}, nil
+}
+
+// Encrypt seals plaintext with AES-GCM under a random nonce, the result is safe to store as text.
+func (e *ImplEncryption) Encrypt(plaintext string) (string, error) {
+	nonce := make([]byte, e.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := e.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return prefix + encoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a value sealed by Encrypt. A value that was never sealed returns ErrNotEncrypted,
+// one that was altered or sealed under another key returns ErrDecrypt.
+func (e *ImplEncryption) Decrypt(ciphertext string) (string, error) {
+	encoded, ok := strings.CutPrefix(ciphertext, prefix)
+	if !ok {
+		return "", ErrNotEncrypted
+	}
+
+	sealed, err := encoding.DecodeString(encoded)
+	if err != nil || len(sealed) < e.aead.NonceSize() {
+		return "", ErrDecrypt
+	}
+
+	nonce, sealed := sealed[:e.aead.NonceSize()], sealed[e.aead.NonceSize():]
+	plaintext, err := e.aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return "", ErrDecrypt
+	}
+	return string(plaintext), nil
+}
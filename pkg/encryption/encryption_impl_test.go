@@ -0,0 +1,73 @@
+package encryption
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEncryption(t *testing.T, fill byte) *ImplEncryption {
+	e, err := NewEncryption(bytes.Repeat([]byte{fill}, KeySize))
+	require.NoError(t, err)
+	return e
+}
+
+func TestNewEncryption_InvalidKey(t *testing.T) {
+	for _, size := range []int{0, 16, 31, 33} {
+		_, err := NewEncryption(make([]byte, size))
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	}
+}
+
+func TestEncryption_RoundTrip(t *testing.T) {
+	e := newTestEncryption(t, 1)
+	secret := "JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
+
+	sealed, err := e.Encrypt(secret)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(sealed, prefix))
+	assert.NotContains(t, sealed, secret)
+	// A 160-bit secret has to fit the two_factor_secret column
+	assert.LessOrEqual(t, len(sealed), 128)
+
+	opened, err := e.Decrypt(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, secret, opened)
+
+	// Every seal uses a new nonce
+	again, err := e.Encrypt(secret)
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, again)
+}
+
+func TestEncryption_Decrypt(t *testing.T) {
+	e := newTestEncryption(t, 1)
+	sealed, err := e.Encrypt("JBSWY3DPEHPK3PXP")
+	require.NoError(t, err)
+
+	tampered := []byte(sealed)
+	tampered[len(tampered)-2] ^= 0x01
+
+	tests := []struct {
+		name        string
+		encryption  *ImplEncryption
+		value       string
+		expectedErr error
+	}{
+		{name: "Plaintext", encryption: e, value: "JBSWY3DPEHPK3PXP", expectedErr: ErrNotEncrypted},
+		{name: "Tampered", encryption: e, value: string(tampered), expectedErr: ErrDecrypt},
+		{name: "Wrong Key", encryption: newTestEncryption(t, 2), value: sealed, expectedErr: ErrDecrypt},
+		{name: "Truncated", encryption: e, value: prefix + "AAAA", expectedErr: ErrDecrypt},
+		{name: "Not Base64", encryption: e, value: prefix + "!!!", expectedErr: ErrDecrypt},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.encryption.Decrypt(tc.value)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -3,15 +3,35 @@ package errors
 import "errors"
 
 var (
-	ErrBadRequest         = errors.New("bad request")
-	ErrNotFound           = errors.New("not found")
-	ErrInternalServer     = errors.New("internal server error")
-	ErrUnauthorized       = errors.New("unauthorized")
-	ErrForbidden          = errors.New("forbidden")
-	ErrValidation         = errors.New("validation error")
-	ErrDuplicateEntry     = errors.New("duplicate entry")
-	ErrInvalidCredentials = errors.New("invalid credentials")
-	ErrSeatAlreadyTaken   = errors.New("seat is already taken")
-	ErrInvalidAmount      = errors.New("invalid payment amount")
-	ErrEmailAlreadyExists = errors.New("email already exists")
+	ErrBadRequest          = errors.New("bad request")
+	ErrNotFound            = errors.New("not found")
+	ErrInternalServer      = errors.New("internal server error")
+	ErrUnauthorized        = errors.New("unauthorized")
+	ErrForbidden           = errors.New("forbidden")
+	ErrValidation          = errors.New("validation error")
+	ErrDuplicateEntry      = errors.New("duplicate entry")
+	ErrInvalidCredentials  = errors.New("invalid credentials")
+	ErrSeatAlreadyTaken    = errors.New("seat is already taken")
+	ErrInvalidAmount       = errors.New("invalid payment amount")
+	ErrEmailAlreadyExists  = errors.New("email already exists")
+	ErrTicketsAvailable    = errors.New("tickets are still available")
+	ErrOfferExpired        = errors.New("offer has expired")
+	ErrEditWindowClosed    = errors.New("attendee details can no longer be changed")
+	ErrNotExchangeable     = errors.New("ticket cannot be exchanged")
+	ErrNotEnoughTickets    = errors.New("not enough tickets available")
+	ErrOutOfStock          = errors.New("product is out of stock")
+	ErrVoucherRedeemed     = errors.New("voucher has already been redeemed")
+	ErrOrderNotPaid        = errors.New("order has not been paid")
+	ErrAlreadyCheckedIn    = errors.New("ticket has already been checked in")
+	ErrEventHasSales       = errors.New("event already has tickets sold")
+	ErrInvalidStatusChange = errors.New("event status cannot be changed this way")
+	ErrEventNotOnSale      = errors.New("event is not on sale")
+	ErrPriceChanged        = errors.New("ticket price has changed, review the cart and try again")
+	ErrVenueConflict       = errors.New("venue is already booked at that time")
+	ErrCapacityExceeded    = errors.New("event capacity exceeded")
+	ErrAdmissionRequired   = errors.New("admission from the waiting room is required")
+	ErrTooManyAttempts     = errors.New("too many failed attempts, try again later")
+	ErrInvalidTwoFactor    = errors.New("invalid two-factor code")
+	ErrTwoFactorEnabled    = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorRequired   = errors.New("two-factor authentication is required for this role")
 )
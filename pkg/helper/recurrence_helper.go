@@ -0,0 +1,190 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recurrence is the subset of an iCalendar RRULE used for event series:
+// FREQ (DAILY, WEEKLY or MONTHLY), INTERVAL, BYDAY and one of UNTIL or COUNT.
+type Recurrence struct {
+	Freq     string
+	Interval int
+	ByDay    []time.Weekday
+	Until    *time.Time
+	Count    int
+}
+
+var ErrInvalidRecurrence = errors.New("invalid recurrence rule")
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// ParseRecurrence parses a rule such as "FREQ=WEEKLY;BYDAY=FR,SA;UNTIL=20241231".
+// Open-ended rules are rejected, every rule needs an UNTIL or a COUNT.
+func ParseRecurrence(rule string) (*Recurrence, error) {
+	rule = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(rule)), "RRULE:")
+	if rule == "" {
+		return nil, ErrInvalidRecurrence
+	}
+
+	r := &Recurrence{Interval: 1}
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidRecurrence, part)
+		}
+
+		switch key {
+		case "FREQ":
+			if value != "DAILY" && value != "WEEKLY" && value != "MONTHLY" {
+				return nil, fmt.Errorf("%w: unsupported frequency %s", ErrInvalidRecurrence, value)
+			}
+			r.Freq = value
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval < 1 {
+				return nil, fmt.Errorf("%w: interval %s", ErrInvalidRecurrence, value)
+			}
+			r.Interval = interval
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekday, ok := weekdays[day]
+				if !ok {
+					return nil, fmt.Errorf("%w: day %s", ErrInvalidRecurrence, day)
+				}
+				r.ByDay = append(r.ByDay, weekday)
+			}
+		case "UNTIL":
+			// Only the date part matters, occurrences share the series' time of day
+			if len(value) < 8 {
+				return nil, fmt.Errorf("%w: until %s", ErrInvalidRecurrence, value)
+			}
+			until, err := time.Parse("20060102", value[:8])
+			if err != nil {
+				return nil, fmt.Errorf("%w: until %s", ErrInvalidRecurrence, value)
+			}
+			r.Until = &until
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count < 1 {
+				return nil, fmt.Errorf("%w: count %s", ErrInvalidRecurrence, value)
+			}
+			r.Count = count
+		default:
+			return nil, fmt.Errorf("%w: unsupported part %s", ErrInvalidRecurrence, key)
+		}
+	}
+
+	if r.Freq == "" || (r.Until == nil && r.Count == 0) || (r.Until != nil && r.Count > 0) {
+		return nil, ErrInvalidRecurrence
+	}
+
+	if r.Freq == "MONTHLY" && len(r.ByDay) > 0 {
+		return nil, fmt.Errorf("%w: BYDAY is not supported with MONTHLY", ErrInvalidRecurrence)
+	}
+
+	return r, nil
+}
+
+// Dates lists the dates the rule produces from start, start included when it matches.
+// It fails rather than truncate when the rule would produce more than limit dates.
+func (r *Recurrence) Dates(start time.Time, limit int) ([]time.Time, error) {
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+
+	var until time.Time
+	if r.Until != nil {
+		until = time.Date(r.Until.Year(), r.Until.Month(), r.Until.Day(), 0, 0, 0, 0, start.Location())
+		if until.Before(start) {
+			return nil, ErrInvalidRecurrence
+		}
+	}
+
+	var dates []time.Time
+	add := func(date time.Time) (bool, error) {
+		if date.Before(start) {
+			return true, nil
+		}
+		if r.Until != nil && date.After(until) {
+			return false, nil
+		}
+		if len(dates) == limit {
+			return false, fmt.Errorf("%w: more than %d occurrences", ErrInvalidRecurrence, limit)
+		}
+		dates = append(dates, date)
+		return r.Count == 0 || len(dates) < r.Count, nil
+	}
+
+	switch r.Freq {
+	case "DAILY":
+		for date := start; ; date = date.AddDate(0, 0, r.Interval) {
+			if len(r.ByDay) > 0 && !r.hasDay(date.Weekday()) {
+				if r.Until != nil && date.After(until) {
+					return dates, nil
+				}
+				continue
+			}
+			more, err := add(date)
+			if err != nil || !more {
+				return dates, err
+			}
+		}
+	case "WEEKLY":
+		days := r.ByDay
+		if len(days) == 0 {
+			days = []time.Weekday{start.Weekday()}
+		}
+		// Weeks start on Monday, as in RRULE's default WKST
+		week := start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+		for ; ; week = week.AddDate(0, 0, 7*r.Interval) {
+			for offset := 0; offset < 7; offset++ {
+				date := week.AddDate(0, 0, offset)
+				if !containsDay(days, date.Weekday()) {
+					continue
+				}
+				more, err := add(date)
+				if err != nil || !more {
+					return dates, err
+				}
+			}
+		}
+	default:
+		for i := 0; ; i += r.Interval {
+			date := start.AddDate(0, i, 0)
+			// Months without the start's day are skipped rather than rolled over
+			if date.Day() != start.Day() {
+				if r.Until != nil && date.After(until) {
+					return dates, nil
+				}
+				continue
+			}
+			more, err := add(date)
+			if err != nil || !more {
+				return dates, err
+			}
+		}
+	}
+}
+
+func (r *Recurrence) hasDay(day time.Weekday) bool {
+	return containsDay(r.ByDay, day)
+}
+
+func containsDay(days []time.Weekday, day time.Weekday) bool {
+	for _, d := range days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
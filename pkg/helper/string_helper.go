@@ -2,7 +2,8 @@ package helper
 
 import (
 	"strings"
-	"time"
+
+	"github.com/google/uuid"
 )
 
 type TicketType struct {
@@ -42,6 +43,8 @@ func TicketUpper(s string) TicketType {
 	}
 }
 
-func FormatDate(date time.Time) string {
-	return date.UTC().Add(time.Hour * 7).Format(time.RFC3339)
+// NewTicketID returns a ticket ID carrying a full random UUID, short prefixes of one collide once
+// an event has a few thousand tickets.
+func NewTicketID() string {
+	return "T-" + strings.ReplaceAll(uuid.NewString(), "-", "")
 }
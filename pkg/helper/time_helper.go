@@ -0,0 +1,64 @@
+package helper
+
+import (
+	"errors"
+	"time"
+
+	// Embed the zone database so venue time zones resolve on hosts without tzdata
+	_ "time/tzdata"
+)
+
+// DefaultTimezone is the zone of venues created before time zones were stored, and the zone
+// times are shown in when no other zone applies.
+const DefaultTimezone = "Asia/Jakarta"
+
+var ErrInvalidTimezone = errors.New("invalid time zone")
+
+var defaultLocation = mustLoad(DefaultTimezone)
+
+// LoadTimezone resolves an IANA zone name such as "Asia/Makassar". The ambiguous "Local"
+// and empty names are rejected, a zone has to be named explicitly.
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, ErrInvalidTimezone
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrInvalidTimezone
+	}
+	return loc, nil
+}
+
+// LocationOrDefault resolves a stored zone name, falling back to the default zone when the
+// name is empty or unknown.
+func LocationOrDefault(name string) *time.Location {
+	loc, err := LoadTimezone(name)
+	if err != nil {
+		return defaultLocation
+	}
+	return loc
+}
+
+// InZone combines a calendar date and a wall-clock time as read in loc.
+func InZone(date, clock time.Time, loc *time.Location) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, loc)
+}
+
+// FormatDate formats a time as ISO-8601 with its offset in the default zone.
+func FormatDate(date time.Time) string {
+	return date.In(defaultLocation).Format(time.RFC3339)
+}
+
+// FormatDateIn formats a time as ISO-8601 with its offset in loc.
+func FormatDateIn(date time.Time, loc *time.Location) string {
+	return date.In(loc).Format(time.RFC3339)
+}
+
+func mustLoad(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
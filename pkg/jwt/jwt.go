@@ -1,7 +1,43 @@
 package jwt
 
+import "time"
+
+// TokenType tells apart tokens that are signed with the same keys but meant for different uses.
+type TokenType string
+
+const (
+	TokenTypeAccess  TokenType = "access"
+	TokenTypeRefresh TokenType = "refresh"
+	// TokenTypeChallenge proves the password of a user who still has to pass two-factor
+	// authentication. It grants no access by itself.
+	TokenTypeChallenge TokenType = "challenge"
+)
+
+// JWK is a public signing key in JSON Web Key form.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS is the set of public keys tokens may be verified with.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
 type JWTService interface {
-	GenerateAccessToken(userID, email, role string) (string, error)
-	GenerateRefreshToken(userID, email, role string) (string, error)
-	ValidateToken(tokenString string) (*JWTClaims, error)
+	GenerateAccessToken(userID, email, role, familyID string) (string, *JWTClaims, error)
+	GenerateRefreshToken(userID, email, role, familyID string) (string, *JWTClaims, error)
+	ValidateAccessToken(tokenString string) (*JWTClaims, error)
+	ValidateRefreshToken(tokenString string) (*JWTClaims, error)
+	GenerateChallengeToken(userID string) (string, *JWTClaims, error)
+	ValidateChallengeToken(tokenString string) (*JWTClaims, error)
+	GenerateAdmissionToken(eventID uint, queueToken string, expiry time.Duration) (string, error)
+	ValidateAdmissionToken(tokenString string) (*AdmissionClaims, error)
+	JWKS() *JWKS
 }
@@ -1,73 +1,265 @@
 package jwt
 
 import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
 	"errors"
-	"github.com/golang-jwt/jwt/v5"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
 	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
+// JWTConfig holds the signing setup. Without Keys, tokens are signed with Secret using HS256.
+// With Keys, tokens are signed by the key named KeyID using RS256 or EdDSA, depending on the key
+// type, and the other keys are kept to verify tokens signed before a rotation.
 type JWTConfig struct {
 	Secret        string
+	Keys          map[string]crypto.Signer
+	KeyID         string
+	Issuer        string
+	Audience      string
 	AccessExpiry  time.Duration
 	RefreshExpiry time.Duration
 }
 
+// JWTClaims identify a user. Every token has its own ID (jti) and carries the family of the
+// login it descends from, so a whole chain of refreshed tokens can be revoked at once.
 type JWTClaims struct {
-	UserID string `json:"user_id"`
-	Email  string `json:"email"`
-	Role   string `json:"role"`
+	UserID    string    `json:"user_id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	FamilyID  string    `json:"fid,omitempty"`
+	TokenType TokenType `json:"typ"`
+	jwt.RegisteredClaims
+}
+
+// challengeExpiry bounds how long a user may take to enter their second factor after their password.
+const challengeExpiry = 5 * time.Minute
+
+// AdmissionAudience marks tokens that let a visitor out of an event's waiting room. They are
+// signed with the same keys as user tokens, so each kind has to reject the other.
+const AdmissionAudience = "waiting-room"
+
+// AdmissionClaims admit the holder of a queue token to buy tickets for one event.
+type AdmissionClaims struct {
+	EventID uint `json:"event_id"`
 	jwt.RegisteredClaims
 }
 
 type JWTServiceImpl struct {
-	secretKey     []byte
+	method        jwt.SigningMethod
+	signingKey    interface{}
+	keyID         string
+	verifyKeys    map[string]interface{}
+	validMethods  []string
+	jwks          *JWKS
+	issuer        string
+	audience      string
 	accessExpiry  time.Duration
 	refreshExpiry time.Duration
 }
 
-func NewJWTService(config *JWTConfig) *JWTServiceImpl {
-	return &JWTServiceImpl{
-		secretKey:     []byte(config.Secret),
+func NewJWTService(config *JWTConfig) (*JWTServiceImpl, error) {
+	s := &JWTServiceImpl{
+		verifyKeys:    map[string]interface{}{},
+		jwks:          &JWKS{Keys: []JWK{}},
+		issuer:        config.Issuer,
+		audience:      config.Audience,
 		accessExpiry:  config.AccessExpiry,
 		refreshExpiry: config.RefreshExpiry,
 	}
+
+	if len(config.Keys) == 0 {
+		s.method = jwt.SigningMethodHS256
+		s.signingKey = []byte(config.Secret)
+		s.verifyKeys[""] = s.signingKey
+		s.validMethods = []string{s.method.Alg()}
+		return s, nil
+	}
+
+	signer, ok := config.Keys[config.KeyID]
+	if !ok {
+		return nil, fmt.Errorf("jwt: signing key %q not found", config.KeyID)
+	}
+
+	kids := make([]string, 0, len(config.Keys))
+	for kid := range config.Keys {
+		kids = append(kids, kid)
+	}
+	// The active key is listed first, the rest in a stable order
+	sort.Slice(kids, func(i, j int) bool {
+		if (kids[i] == config.KeyID) != (kids[j] == config.KeyID) {
+			return kids[i] == config.KeyID
+		}
+		return kids[i] < kids[j]
+	})
+
+	for _, kid := range kids {
+		key := config.Keys[kid]
+		method, jwk, err := describeKey(kid, key)
+		if err != nil {
+			return nil, err
+		}
+		s.verifyKeys[kid] = key.Public()
+		s.jwks.Keys = append(s.jwks.Keys, *jwk)
+		if !slices.Contains(s.validMethods, method.Alg()) {
+			s.validMethods = append(s.validMethods, method.Alg())
+		}
+	}
+
+	s.method, _, _ = describeKey(config.KeyID, signer)
+	s.signingKey = signer
+	s.keyID = config.KeyID
+
+	return s, nil
+}
+
+func describeKey(kid string, key crypto.Signer) (jwt.SigningMethod, *JWK, error) {
+	switch public := key.Public().(type) {
+	case *rsa.PublicKey:
+		return jwt.SigningMethodRS256, &JWK{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: jwt.SigningMethodRS256.Alg(),
+			N:   base64.RawURLEncoding.EncodeToString(public.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes()),
+		}, nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, &JWK{
+			Kty: "OKP",
+			Kid: kid,
+			Use: "sig",
+			Alg: jwt.SigningMethodEdDSA.Alg(),
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(public),
+		}, nil
+	default:
+		return nil, nil, fmt.Errorf("jwt: key %q has unsupported type %T", kid, public)
+	}
 }
 
-func (s *JWTServiceImpl) GenerateAccessToken(userID, email, role string) (string, error) {
-	return s.generateToken(userID, email, role, s.accessExpiry)
+func (s *JWTServiceImpl) GenerateAccessToken(userID, email, role, familyID string) (string, *JWTClaims, error) {
+	return s.generateToken(userID, email, role, familyID, TokenTypeAccess, s.accessExpiry)
 }
 
-func (s *JWTServiceImpl) GenerateRefreshToken(userID, email, role string) (string, error) {
-	return s.generateToken(userID, email, role, s.refreshExpiry)
+func (s *JWTServiceImpl) GenerateRefreshToken(userID, email, role, familyID string) (string, *JWTClaims, error) {
+	return s.generateToken(userID, email, role, familyID, TokenTypeRefresh, s.refreshExpiry)
 }
 
-func (s *JWTServiceImpl) ValidateToken(tokenString string) (*JWTClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		return s.secretKey, nil
-	})
+func (s *JWTServiceImpl) ValidateAccessToken(tokenString string) (*JWTClaims, error) {
+	return s.validateToken(tokenString, TokenTypeAccess)
+}
 
+func (s *JWTServiceImpl) ValidateRefreshToken(tokenString string) (*JWTClaims, error) {
+	return s.validateToken(tokenString, TokenTypeRefresh)
+}
+
+func (s *JWTServiceImpl) GenerateChallengeToken(userID string) (string, *JWTClaims, error) {
+	return s.generateToken(userID, "", "", "", TokenTypeChallenge, challengeExpiry)
+}
+
+func (s *JWTServiceImpl) ValidateChallengeToken(tokenString string) (*JWTClaims, error) {
+	return s.validateToken(tokenString, TokenTypeChallenge)
+}
+
+func (s *JWTServiceImpl) GenerateAdmissionToken(eventID uint, queueToken string, expiry time.Duration) (string, error) {
+	claims := &AdmissionClaims{
+		EventID: eventID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        queueToken,
+			Issuer:    s.issuer,
+			Audience:  jwt.ClaimStrings{AdmissionAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiry)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	return s.sign(claims)
+}
+
+func (s *JWTServiceImpl) ValidateAdmissionToken(tokenString string) (*AdmissionClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &AdmissionClaims{}, s.keyFunc, s.parserOptions(AdmissionAudience)...)
 	if err != nil {
 		return nil, err
 	}
 
-	if claims, ok := token.Claims.(*JWTClaims); ok && token.Valid {
+	if claims, ok := token.Claims.(*AdmissionClaims); ok && token.Valid {
 		return claims, nil
 	}
 
 	return nil, errors.New("invalid token")
 }
 
-func (s *JWTServiceImpl) generateToken(userID, email, role string, expiry time.Duration) (string, error) {
+func (s *JWTServiceImpl) JWKS() *JWKS {
+	return s.jwks
+}
+
+func (s *JWTServiceImpl) generateToken(userID, email, role, familyID string, tokenType TokenType, expiry time.Duration) (string, *JWTClaims, error) {
 	claims := &JWTClaims{
-		UserID: userID,
-		Email:  email,
-		Role:   role,
+		UserID:    userID,
+		Email:     email,
+		Role:      role,
+		FamilyID:  familyID,
+		TokenType: tokenType,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    s.issuer,
+			Audience:  jwt.ClaimStrings{s.audience},
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiry)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.secretKey)
+	signed, err := s.sign(claims)
+	if err != nil {
+		return "", nil, err
+	}
+	return signed, claims, nil
+}
+
+func (s *JWTServiceImpl) validateToken(tokenString string, tokenType TokenType) (*JWTClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, s.keyFunc, s.parserOptions(s.audience)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims, ok := token.Claims.(*JWTClaims); ok && token.Valid && claims.TokenType == tokenType {
+		return claims, nil
+	}
+
+	return nil, errors.New("invalid token")
+}
+
+func (s *JWTServiceImpl) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(s.method, claims)
+	if s.keyID != "" {
+		token.Header["kid"] = s.keyID
+	}
+	return token.SignedString(s.signingKey)
+}
+
+func (s *JWTServiceImpl) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.verifyKeys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	return key, nil
+}
+
+func (s *JWTServiceImpl) parserOptions(audience string) []jwt.ParserOption {
+	return []jwt.ParserOption{
+		jwt.WithValidMethods(s.validMethods),
+		jwt.WithIssuer(s.issuer),
+		jwt.WithAudience(audience),
+		jwt.WithExpirationRequired(),
+	}
 }
@@ -0,0 +1,227 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() *JWTConfig {
+	return &JWTConfig{
+		Secret:        "secret",
+		Issuer:        "trinityknights",
+		Audience:      "trinityknights-api",
+		AccessExpiry:  time.Hour,
+		RefreshExpiry: 24 * time.Hour,
+	}
+}
+
+func newTestService(t *testing.T, config *JWTConfig) *JWTServiceImpl {
+	s, err := NewJWTService(config)
+	require.NoError(t, err)
+	return s
+}
+
+func TestJWTService_TokenTypes(t *testing.T) {
+	s := newTestService(t, testConfig())
+
+	access, accessClaims, err := s.GenerateAccessToken("user-1", "alice@example.com", "user", "family-1")
+	require.NoError(t, err)
+	refresh, refreshClaims, err := s.GenerateRefreshToken("user-1", "alice@example.com", "user", "family-1")
+	require.NoError(t, err)
+	challenge, _, err := s.GenerateChallengeToken("user-1")
+	require.NoError(t, err)
+
+	assert.NotEqual(t, accessClaims.ID, refreshClaims.ID)
+
+	claims, err := s.ValidateAccessToken(access)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.UserID)
+	assert.Equal(t, "family-1", claims.FamilyID)
+	assert.Equal(t, TokenTypeAccess, claims.TokenType)
+
+	claims, err = s.ValidateRefreshToken(refresh)
+	require.NoError(t, err)
+	assert.Equal(t, refreshClaims.ID, claims.ID)
+
+	claims, err = s.ValidateChallengeToken(challenge)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.UserID)
+
+	// Each kind of token is only accepted where it is meant to be used
+	tests := []struct {
+		name     string
+		token    string
+		validate func(string) (*JWTClaims, error)
+	}{
+		{name: "Refresh As Access", token: refresh, validate: s.ValidateAccessToken},
+		{name: "Challenge As Access", token: challenge, validate: s.ValidateAccessToken},
+		{name: "Access As Refresh", token: access, validate: s.ValidateRefreshToken},
+		{name: "Challenge As Refresh", token: challenge, validate: s.ValidateRefreshToken},
+		{name: "Access As Challenge", token: access, validate: s.ValidateChallengeToken},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.validate(tc.token)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestJWTService_RejectsForeignTokens(t *testing.T) {
+	s := newTestService(t, testConfig())
+
+	otherIssuer := testConfig()
+	otherIssuer.Issuer = "someone-else"
+	otherAudience := testConfig()
+	otherAudience.Audience = "another-api"
+	otherSecret := testConfig()
+	otherSecret.Secret = "another-secret"
+
+	tests := []struct {
+		name   string
+		config *JWTConfig
+	}{
+		{name: "Other Issuer", config: otherIssuer},
+		{name: "Other Audience", config: otherAudience},
+		{name: "Other Secret", config: otherSecret},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			token, _, err := newTestService(t, tc.config).GenerateAccessToken("user-1", "alice@example.com", "user", "family-1")
+			require.NoError(t, err)
+
+			_, err = s.ValidateAccessToken(token)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestJWTService_RejectsUnsafeTokens(t *testing.T) {
+	s := newTestService(t, testConfig())
+
+	claims := func(expiresAt *jwt.NumericDate) *JWTClaims {
+		return &JWTClaims{
+			UserID:    "user-1",
+			TokenType: TokenTypeAccess,
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    "trinityknights",
+				Audience:  jwt.ClaimStrings{"trinityknights-api"},
+				ExpiresAt: expiresAt,
+			},
+		}
+	}
+
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, claims(jwt.NewNumericDate(time.Now().Add(time.Hour)))).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+	expired, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims(jwt.NewNumericDate(time.Now().Add(-time.Minute)))).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	noExpiry, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims(nil)).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "Algorithm None", token: unsigned},
+		{name: "Expired", token: expired},
+		{name: "Without Expiry", token: noExpiry},
+		{name: "Malformed", token: "not.a.token"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.ValidateAccessToken(tc.token)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestJWTService_AdmissionTokens(t *testing.T) {
+	s := newTestService(t, testConfig())
+
+	admission, err := s.GenerateAdmissionToken(7, "queue-token", time.Minute)
+	require.NoError(t, err)
+
+	claims, err := s.ValidateAdmissionToken(admission)
+	require.NoError(t, err)
+	assert.Equal(t, uint(7), claims.EventID)
+	assert.Equal(t, "queue-token", claims.ID)
+
+	// Admission and user tokens share keys but not audiences
+	_, err = s.ValidateAccessToken(admission)
+	assert.Error(t, err)
+
+	access, _, err := s.GenerateAccessToken("user-1", "alice@example.com", "user", "family-1")
+	require.NoError(t, err)
+	_, err = s.ValidateAdmissionToken(access)
+	assert.Error(t, err)
+}
+
+func TestJWTService_KeyRotation(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	before := testConfig()
+	before.Keys = map[string]crypto.Signer{"2024-01": rsaKey}
+	before.KeyID = "2024-01"
+	old := newTestService(t, before)
+
+	after := testConfig()
+	after.Keys = map[string]crypto.Signer{"2024-01": rsaKey, "2024-06": edKey}
+	after.KeyID = "2024-06"
+	rotated := newTestService(t, after)
+
+	// Tokens signed before the rotation stay valid, and new ones use the new key
+	token, _, err := old.GenerateAccessToken("user-1", "alice@example.com", "user", "family-1")
+	require.NoError(t, err)
+	_, err = rotated.ValidateAccessToken(token)
+	assert.NoError(t, err)
+
+	token, _, err = rotated.GenerateAccessToken("user-1", "alice@example.com", "user", "family-1")
+	require.NoError(t, err)
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &JWTClaims{})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-06", parsed.Header["kid"])
+	assert.Equal(t, jwt.SigningMethodEdDSA.Alg(), parsed.Method.Alg())
+
+	// The old service does not know the new key
+	_, err = old.ValidateAccessToken(token)
+	assert.Error(t, err)
+
+	// A shared secret token cannot pass for one signed by a key
+	hmacToken, _, err := newTestService(t, testConfig()).GenerateAccessToken("user-1", "alice@example.com", "user", "family-1")
+	require.NoError(t, err)
+	_, err = rotated.ValidateAccessToken(hmacToken)
+	assert.Error(t, err)
+
+	jwks := rotated.JWKS()
+	require.Len(t, jwks.Keys, 2)
+	assert.Equal(t, "2024-06", jwks.Keys[0].Kid)
+	assert.Equal(t, "OKP", jwks.Keys[0].Kty)
+	assert.Equal(t, "2024-01", jwks.Keys[1].Kid)
+	assert.Equal(t, "RSA", jwks.Keys[1].Kty)
+}
+
+func TestNewJWTService_UnknownKeyID(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	config := testConfig()
+	config.Keys = map[string]crypto.Signer{"2024-01": edKey}
+	config.KeyID = "2024-02"
+
+	_, err = NewJWTService(config)
+	assert.Error(t, err)
+}
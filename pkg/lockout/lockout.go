@@ -0,0 +1,22 @@
+package lockout
+
+import (
+	"context"
+	"time"
+)
+
+// Failure describes the state of a subject after a failed attempt.
+type Failure struct {
+	Count      int64
+	RetryAfter time.Duration
+	// Locked is set on the failure that turned the subject's state into a lock, including a
+	// re-lock after an earlier lock ran out within the same window
+	Locked bool
+}
+
+// Lockout slows down and then locks subjects, such as accounts or IPs, that keep failing.
+type Lockout interface {
+	Fail(ctx context.Context, subject string) (*Failure, error)
+	Locked(ctx context.Context, subject string) (time.Duration, error)
+	Reset(ctx context.Context, subject string) error
+}
@@ -0,0 +1,136 @@
+package lockout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	DefaultFreeAttempts = 3
+	DefaultBaseDelay    = time.Second
+	DefaultLockAttempts = 10
+	DefaultLockDuration = 30 * time.Minute
+)
+
+// Policy sets how quickly failures turn into delays and then into a lock. Each failure past
+// FreeAttempts blocks the subject for BaseDelay, doubling every time, until LockAttempts is
+// reached and the subject is locked for LockDuration. Failures are forgotten after Window
+// without a new one.
+type Policy struct {
+	FreeAttempts int64
+	BaseDelay    time.Duration
+	LockAttempts int64
+	LockDuration time.Duration
+	Window       time.Duration
+}
+
+func (p Policy) withDefaults() Policy {
+	if p.FreeAttempts <= 0 {
+		p.FreeAttempts = DefaultFreeAttempts
+	}
+	if p.BaseDelay <= 0 {
+		p.BaseDelay = DefaultBaseDelay
+	}
+	if p.LockAttempts <= p.FreeAttempts {
+		p.LockAttempts = max(DefaultLockAttempts, p.FreeAttempts+1)
+	}
+	if p.LockDuration <= 0 {
+		p.LockDuration = DefaultLockDuration
+	}
+	if p.Window < p.LockDuration {
+		p.Window = 2 * p.LockDuration
+	}
+	return p
+}
+
+type ImplLockout struct {
+	client *redis.Client
+	policy Policy
+}
+
+func NewLockout(client *redis.Client, policy Policy) *ImplLockout {
+	return &ImplLockout{
+		client: client,
+		policy: policy.withDefaults(),
+	}
+}
+
+// lockedValue marks a lock, as opposed to the short blocks of the delays leading up to it.
+const lockedValue = "locked"
+
+// failScript counts the failure and blocks the subject for the resulting delay. It reports 1 as
+// the third value when this failure is the one that locked the subject.
+// KEYS: failures, locked. ARGV: window, free attempts, base delay, lock attempts, lock duration, lock marker.
+var failScript = redis.NewScript(`
+local count = redis.call('INCR', KEYS[1])
+redis.call('PEXPIRE', KEYS[1], ARGV[1])
+
+local free = tonumber(ARGV[2])
+local lockAttempts = tonumber(ARGV[4])
+local lockDuration = tonumber(ARGV[5])
+
+local delay = 0
+if count >= lockAttempts then
+	delay = lockDuration
+elseif count > free then
+	delay = math.min(tonumber(ARGV[3]) * 2 ^ (count - free - 1), lockDuration)
+end
+
+local locked = 0
+if count >= lockAttempts then
+	if redis.call('GET', KEYS[2]) ~= ARGV[6] then
+		locked = 1
+	end
+	redis.call('SET', KEYS[2], ARGV[6], 'PX', math.floor(delay))
+elseif delay > 0 then
+	redis.call('SET', KEYS[2], count, 'PX', math.floor(delay))
+end
+return {count, math.floor(delay), locked}
+`)
+
+func keys(subject string) (failures, locked string) {
+	base := fmt.Sprintf("lockout:{%s}", subject)
+	return base + ":failures", base + ":locked"
+}
+
+func (l *ImplLockout) Fail(ctx context.Context, subject string) (*Failure, error) {
+	failures, locked := keys(subject)
+	result, err := failScript.Run(ctx, l.client, []string{failures, locked},
+		l.policy.Window.Milliseconds(),
+		l.policy.FreeAttempts,
+		l.policy.BaseDelay.Milliseconds(),
+		l.policy.LockAttempts,
+		l.policy.LockDuration.Milliseconds(),
+		lockedValue,
+	).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Failure{
+		Count:      result[0],
+		RetryAfter: time.Duration(result[1]) * time.Millisecond,
+		Locked:     result[2] == 1,
+	}, nil
+}
+
+func (l *ImplLockout) Locked(ctx context.Context, subject string) (time.Duration, error) {
+	_, locked := keys(subject)
+	ttl, err := l.client.PTTL(ctx, locked).Result()
+	if err != nil {
+		return 0, err
+	}
+	// PTTL reports missing keys as negative durations
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (l *ImplLockout) Reset(ctx context.Context, subject string) error {
+	failures, locked := keys(subject)
+	return l.client.Del(ctx, failures, locked).Err()
+}
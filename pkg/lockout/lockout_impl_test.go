@@ -0,0 +1,169 @@
+package lockout
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient connects to the Redis given in REDIS_TEST_ADDR, the failure counting runs as a
+// script there and cannot be exercised without it.
+func newTestClient(t *testing.T) *redis.Client {
+	addr := os.Getenv("REDIS_TEST_ADDR")
+	if addr == "" {
+		t.Skip("REDIS_TEST_ADDR is not set")
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		t.Skipf("redis is not reachable: %v", err)
+	}
+	t.Cleanup(func() {
+		client.Close()
+	})
+
+	return client
+}
+
+func TestPolicy_WithDefaults(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   Policy
+		expected Policy
+	}{
+		{
+			name:   "Empty Policy",
+			policy: Policy{},
+			expected: Policy{
+				FreeAttempts: DefaultFreeAttempts,
+				BaseDelay:    DefaultBaseDelay,
+				LockAttempts: DefaultLockAttempts,
+				LockDuration: DefaultLockDuration,
+				Window:       2 * DefaultLockDuration,
+			},
+		},
+		{
+			name:   "Lock Threshold Below Free Attempts",
+			policy: Policy{FreeAttempts: 12, LockAttempts: 5},
+			expected: Policy{
+				FreeAttempts: 12,
+				BaseDelay:    DefaultBaseDelay,
+				LockAttempts: 13,
+				LockDuration: DefaultLockDuration,
+				Window:       2 * DefaultLockDuration,
+			},
+		},
+		{
+			name:   "Window Shorter Than Lock",
+			policy: Policy{FreeAttempts: 1, BaseDelay: time.Millisecond, LockAttempts: 3, LockDuration: time.Minute, Window: time.Second},
+			expected: Policy{
+				FreeAttempts: 1,
+				BaseDelay:    time.Millisecond,
+				LockAttempts: 3,
+				LockDuration: time.Minute,
+				Window:       2 * time.Minute,
+			},
+		},
+		{
+			name:     "Complete Policy",
+			policy:   Policy{FreeAttempts: 2, BaseDelay: time.Second, LockAttempts: 5, LockDuration: time.Minute, Window: time.Hour},
+			expected: Policy{FreeAttempts: 2, BaseDelay: time.Second, LockAttempts: 5, LockDuration: time.Minute, Window: time.Hour},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.policy.withDefaults())
+		})
+	}
+}
+
+func TestLockout_Fail(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	l := NewLockout(client, Policy{
+		FreeAttempts: 2,
+		BaseDelay:    20 * time.Millisecond,
+		LockAttempts: 4,
+		LockDuration: 300 * time.Millisecond,
+		Window:       5 * time.Second,
+	})
+	subject := "test:" + uuid.NewString()
+	t.Cleanup(func() {
+		l.Reset(ctx, subject)
+	})
+
+	retryAfter, err := l.Locked(ctx, subject)
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter)
+
+	// Free attempts are not delayed
+	for i := int64(1); i <= 2; i++ {
+		failure, err := l.Fail(ctx, subject)
+		require.NoError(t, err)
+		assert.Equal(t, &Failure{Count: i}, failure)
+	}
+
+	// Then each failure blocks for the base delay, doubling every time
+	failure, err := l.Fail(ctx, subject)
+	require.NoError(t, err)
+	assert.Equal(t, &Failure{Count: 3, RetryAfter: 20 * time.Millisecond}, failure)
+
+	retryAfter, err = l.Locked(ctx, subject)
+	require.NoError(t, err)
+	assert.Positive(t, retryAfter)
+
+	// Reaching the lock threshold locks the subject and reports the lock once
+	failure, err = l.Fail(ctx, subject)
+	require.NoError(t, err)
+	assert.Equal(t, &Failure{Count: 4, RetryAfter: 300 * time.Millisecond, Locked: true}, failure)
+
+	failure, err = l.Fail(ctx, subject)
+	require.NoError(t, err)
+	assert.Equal(t, &Failure{Count: 5, RetryAfter: 300 * time.Millisecond}, failure)
+
+	// Failing again after the lock ran out, still within the window, locks the subject again
+	time.Sleep(350 * time.Millisecond)
+
+	retryAfter, err = l.Locked(ctx, subject)
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter)
+
+	failure, err = l.Fail(ctx, subject)
+	require.NoError(t, err)
+	assert.Equal(t, &Failure{Count: 6, RetryAfter: 300 * time.Millisecond, Locked: true}, failure)
+}
+
+func TestLockout_Reset(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	l := NewLockout(client, Policy{FreeAttempts: 1, LockAttempts: 2, LockDuration: time.Minute})
+	subject := "test:" + uuid.NewString()
+
+	for i := 0; i < 2; i++ {
+		_, err := l.Fail(ctx, subject)
+		require.NoError(t, err)
+	}
+
+	retryAfter, err := l.Locked(ctx, subject)
+	require.NoError(t, err)
+	assert.Positive(t, retryAfter)
+
+	require.NoError(t, l.Reset(ctx, subject))
+
+	retryAfter, err = l.Locked(ctx, subject)
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter)
+
+	failure, err := l.Fail(ctx, subject)
+	require.NoError(t, err)
+	assert.Equal(t, &Failure{Count: 1}, failure)
+}
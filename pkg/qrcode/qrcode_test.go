@@ -0,0 +1,265 @@
+package qrcode
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// decode reads text back from a symbol the way a scanner would: format information first, then
+// the unmasked codewords, checking every block's Reed-Solomon syndromes before parsing the data.
+func decode(q *QRCode) (string, error) {
+	version := (q.Size - 17) / 4
+	if version < minVersion || version > maxVersion || version*4+17 != q.Size {
+		return "", fmt.Errorf("unexpected size %d", q.Size)
+	}
+
+	// Both copies of the format information have to agree
+	var first, second int
+	for i := 0; i <= 5; i++ {
+		first |= bitAt(q, 8, i) << i
+	}
+	first |= bitAt(q, 8, 7)<<6 | bitAt(q, 8, 8)<<7 | bitAt(q, 7, 8)<<8
+	for i := 9; i < 15; i++ {
+		first |= bitAt(q, 14-i, 8) << i
+	}
+	for i := 0; i < 8; i++ {
+		second |= bitAt(q, q.Size-1-i, 8) << i
+	}
+	for i := 8; i < 15; i++ {
+		second |= bitAt(q, 8, q.Size-15+i) << i
+	}
+	if first != second {
+		return "", errors.New("format information copies differ")
+	}
+
+	format := first ^ 0x5412
+	if bchRemainder(format, 0x537, 10) != 0 {
+		return "", errors.New("format information is corrupt")
+	}
+	if level := format >> 13; level != formatLevelL {
+		return "", fmt.Errorf("unexpected error correction level %d", level)
+	}
+	mask := (format >> 10) & 7
+
+	if version >= 7 {
+		var bits int
+		for i := 0; i < 18; i++ {
+			bits |= bitAt(q, q.Size-11+i%3, i/3) << i
+		}
+		if bits>>12 != version || bchRemainder(bits, 0x1F25, 12) != 0 {
+			return "", errors.New("version information is corrupt")
+		}
+	}
+
+	// Unmask a copy that knows where the function patterns are
+	grid := newQRCode(version)
+	grid.drawFunctionPatterns(version)
+	for y := range grid.modules {
+		copy(grid.modules[y], q.modules[y])
+	}
+	grid.applyMask(mask)
+
+	raw := make([]byte, rawDataModules(version)/8)
+	i := 0
+	for right := q.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < q.Size; vert++ {
+			for j := 0; j < 2; j++ {
+				x, y := right-j, vert
+				if (right+1)&2 == 0 {
+					y = q.Size - 1 - vert
+				}
+				if !grid.function[y][x] && i < len(raw)*8 {
+					if grid.modules[y][x] {
+						raw[i>>3] |= 1 << (7 - i&7)
+					}
+					i++
+				}
+			}
+		}
+	}
+
+	// Undo the interleaving, short blocks have one data codeword less
+	blocks, eccLen := numBlocks[version], eccPerBlock[version]
+	shortBlocks := blocks - len(raw)%blocks
+	shortLen := len(raw) / blocks
+	split := make([][]byte, blocks)
+	k := 0
+	for i := 0; i <= shortLen; i++ {
+		for j := range split {
+			if i == shortLen-eccLen && j < shortBlocks {
+				continue
+			}
+			split[j] = append(split[j], raw[k])
+			k++
+		}
+	}
+
+	var data []byte
+	for _, block := range split {
+		for s := 0; s < eccLen; s++ {
+			if syndrome(block, s) != 0 {
+				return "", errors.New("codewords are corrupt")
+			}
+		}
+		data = append(data, block[:len(block)-eccLen]...)
+	}
+
+	// A byte mode segment: 0100, the count, then the bytes
+	reader := bitReader{data: data}
+	if mode := reader.read(4); mode != 0b0100 {
+		return "", fmt.Errorf("unexpected mode %04b", mode)
+	}
+	count := reader.read(countBits(version))
+	if 4+countBits(version)+count*8 > len(data)*8 {
+		return "", errors.New("character count overflows the data")
+	}
+	text := make([]byte, count)
+	for i := range text {
+		text[i] = byte(reader.read(8))
+	}
+	return string(text), nil
+}
+
+func bitAt(q *QRCode, x, y int) int {
+	if q.Dark(x, y) {
+		return 1
+	}
+	return 0
+}
+
+// bchRemainder divides value by the generator polynomial, a valid code word leaves nothing.
+func bchRemainder(value, generator, degree int) int {
+	for i := bitLength(value) - 1; i >= degree; i-- {
+		if (value>>i)&1 != 0 {
+			value ^= generator << (i - degree)
+		}
+	}
+	return value
+}
+
+func bitLength(value int) int {
+	n := 0
+	for ; value > 0; value >>= 1 {
+		n++
+	}
+	return n
+}
+
+// syndrome evaluates the block as a polynomial at the s-th power of the generator, which is zero
+// for every root of a valid Reed-Solomon code word.
+func syndrome(block []byte, s int) byte {
+	root := byte(1)
+	for i := 0; i < s; i++ {
+		root = gfMultiply(root, 0x02)
+	}
+	var result byte
+	for _, b := range block {
+		result = gfMultiply(result, root) ^ b
+	}
+	return result
+}
+
+type bitReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *bitReader) read(length int) int {
+	value := 0
+	for i := 0; i < length; i++ {
+		bit := (r.data[r.pos>>3] >> (7 - r.pos&7)) & 1
+		value = value<<1 | int(bit)
+		r.pos++
+	}
+	return value
+}
+
+func TestEncode_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		version int
+	}{
+		{name: "Empty", text: "", version: 1},
+		{name: "Short", text: "hello", version: 1},
+		{name: "Version 1 Capacity", text: strings.Repeat("a", 17), version: 1},
+		{name: "Version 2", text: strings.Repeat("a", 18), version: 2},
+		{name: "Otpauth URI", text: "otpauth://totp/TrinityKnights:user%40example.com?issuer=TrinityKnights&secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP", version: 6},
+		{name: "Two Blocks", text: strings.Repeat("0123456789", 20), version: 9},
+		{name: "Wide Character Count", text: strings.Repeat("0123456789", 30), version: 11},
+		{name: "Binary", text: string([]byte{0x00, 0xff, 0x10, 0x80, 0x7f}), version: 1},
+		{name: "Largest", text: strings.Repeat("x", dataCodewords(maxVersion)-3), version: maxVersion},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			q, err := Encode(tc.text)
+			require.NoError(t, err)
+			assert.Equal(t, tc.version*4+17, q.Size)
+
+			decoded, err := decode(q)
+			require.NoError(t, err)
+			assert.Equal(t, tc.text, decoded)
+		})
+	}
+}
+
+func TestEncode_DetectsCorruption(t *testing.T) {
+	q, err := Encode("otpauth://totp/TrinityKnights:alice")
+	require.NoError(t, err)
+
+	// Flip a data module, which the decoder has to notice rather than return other text
+	for y := q.Size - 1; y >= 0; y-- {
+		if !q.function[y][q.Size-1] {
+			q.modules[y][q.Size-1] = !q.modules[y][q.Size-1]
+			break
+		}
+	}
+
+	_, err = decode(q)
+	assert.Error(t, err)
+}
+
+func TestEncode_TooLong(t *testing.T) {
+	_, err := Encode(strings.Repeat("x", dataCodewords(maxVersion)-2))
+	assert.ErrorIs(t, err, ErrTooLong)
+}
+
+func TestPNG(t *testing.T) {
+	text := "otpauth://totp/TrinityKnights:alice?secret=JBSWY3DPEHPK3PXP"
+	scale := 4
+
+	data, err := PNG(text, scale)
+	require.NoError(t, err)
+
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	q, err := Encode(text)
+	require.NoError(t, err)
+
+	size := (q.Size + 2*quietZone) * scale
+	assert.Equal(t, size, img.Bounds().Dx())
+	assert.Equal(t, size, img.Bounds().Dy())
+
+	// Every module is drawn as a block of scale pixels inside the quiet zone
+	for y := 0; y < q.Size; y++ {
+		for x := 0; x < q.Size; x++ {
+			r, _, _, _ := img.At((x+quietZone)*scale+scale/2, (y+quietZone)*scale+scale/2).RGBA()
+			assert.Equal(t, q.Dark(x, y), r == 0, "module %d,%d", x, y)
+		}
+	}
+
+	r, _, _, _ := img.At(0, 0).RGBA()
+	assert.NotZero(t, r, "the quiet zone is light")
+}
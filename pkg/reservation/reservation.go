@@ -0,0 +1,35 @@
+package reservation
+
+import (
+	"context"
+	"time"
+)
+
+// Hold is a ticket reserved in Postgres for a holder, such as a waitlist offer or an exchange.
+type Hold struct {
+	TicketID string
+	Owner    string
+	Until    time.Time
+}
+
+// State is an event's seat inventory as Postgres sees it, used to rebuild the Redis view.
+type State struct {
+	Taken []string
+	Holds []Hold
+}
+
+// Reservation claims seats in Redis ahead of the database so that competing checkouts for the
+// same seats are turned away without queueing on row locks. Postgres stays the source of truth,
+// a claim only decides who gets to try. Timed-entry slots have no seats, their places are
+// claimed from a count of the places left instead.
+type Reservation interface {
+	Claim(ctx context.Context, eventID uint, ticketIDs []string, owner string, ttl time.Duration) error
+	Release(ctx context.Context, eventID uint, ticketIDs []string, owner string) error
+	Confirm(ctx context.Context, eventID uint, ticketIDs []string, owner string) error
+	Free(ctx context.Context, eventID uint, ticketIDs []string) error
+	TryLockSync(ctx context.Context, eventID uint, ttl time.Duration) (bool, error)
+	Sync(ctx context.Context, eventID uint, state *State) error
+	ClaimPlaces(ctx context.Context, slotID uint, quantity int) error
+	ReleasePlaces(ctx context.Context, slotID uint, quantity int) error
+	SyncPlaces(ctx context.Context, slotID uint, remaining int, ttl time.Duration) error
+}
@@ -0,0 +1,259 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type ImplReservation struct {
+	client *redis.Client
+}
+
+func NewReservation(client *redis.Client) *ImplReservation {
+	return &ImplReservation{
+		client: client,
+	}
+}
+
+var (
+	ErrClaimed   = errors.New("reservation: seat already claimed")
+	ErrNotSynced = errors.New("reservation: event not synced")
+)
+
+// All keys of an event share a hash tag so its scripts run against a single cluster slot.
+func syncedKey(eventID uint) string {
+	return fmt.Sprintf("reservation:{event:%d}:synced", eventID)
+}
+
+func syncingKey(eventID uint) string {
+	return fmt.Sprintf("reservation:{event:%d}:syncing", eventID)
+}
+
+func takenKey(eventID uint) string {
+	return fmt.Sprintf("reservation:{event:%d}:taken", eventID)
+}
+
+func claimKey(eventID uint, ticketID string) string {
+	return fmt.Sprintf("reservation:{event:%d}:claim:%s", eventID, ticketID)
+}
+
+func placesKey(slotID uint) string {
+	return fmt.Sprintf("reservation:{slot:%d}:places", slotID)
+}
+
+// claimScript claims every seat for the owner or none of them. It returns -1 when the event has
+// not been loaded from Postgres, which is the case after Redis lost its data.
+var claimScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return -1
+end
+for i = 3, #KEYS do
+	if redis.call('SISMEMBER', KEYS[2], ARGV[i]) == 1 then
+		return 0
+	end
+	local owner = redis.call('GET', KEYS[i])
+	if owner and owner ~= ARGV[1] then
+		return 0
+	end
+end
+for i = 3, #KEYS do
+	redis.call('SET', KEYS[i], ARGV[1], 'PX', ARGV[2])
+end
+return 1
+`)
+
+// releaseScript drops the owner's claims and leaves claims taken over by someone else alone.
+var releaseScript = redis.NewScript(`
+for i = 1, #KEYS do
+	if redis.call('GET', KEYS[i]) == ARGV[1] then
+		redis.call('DEL', KEYS[i])
+	end
+end
+return 1
+`)
+
+// confirmScript turns the owner's claims into taken seats once the order is committed.
+var confirmScript = redis.NewScript(`
+for i = 2, #KEYS do
+	redis.call('SADD', KEYS[1], ARGV[i])
+	if redis.call('GET', KEYS[i]) == ARGV[1] then
+		redis.call('DEL', KEYS[i])
+	end
+end
+return 1
+`)
+
+// freeScript puts seats that went back on sale, after a refund, an expired invoice or a released
+// allocation, out of the taken set.
+var freeScript = redis.NewScript(`
+for i = 1, #ARGV, 1000 do
+	redis.call('SREM', KEYS[1], unpack(ARGV, i, math.min(i + 999, #ARGV)))
+end
+return 1
+`)
+
+// claimPlacesScript takes places off a slot's remaining count. It returns -1 when the slot has not
+// been loaded from Postgres and 0 when too few places are left.
+var claimPlacesScript = redis.NewScript(`
+local remaining = redis.call('GET', KEYS[1])
+if not remaining then
+	return -1
+end
+if tonumber(remaining) < tonumber(ARGV[1]) then
+	return 0
+end
+redis.call('DECRBY', KEYS[1], ARGV[1])
+return 1
+`)
+
+// releasePlacesScript gives places back to a slot's remaining count. A slot that is not loaded is
+// left alone, it is read from Postgres again on the next claim.
+var releasePlacesScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('INCRBY', KEYS[1], ARGV[1])
+end
+return 1
+`)
+
+// syncScript replaces the event's taken seats with Postgres' list and mirrors its active holds
+// as claims that expire with them. Claims of checkouts still in flight are kept.
+var syncScript = redis.NewScript(`
+redis.call('DEL', KEYS[2])
+local taken = tonumber(ARGV[1])
+for i = 2, taken + 1, 1000 do
+	redis.call('SADD', KEYS[2], unpack(ARGV, i, math.min(i + 999, taken + 1)))
+end
+for i = 3, #KEYS do
+	local j = taken + 2 + (i - 3) * 2
+	redis.call('SET', KEYS[i], ARGV[j], 'PX', ARGV[j + 1])
+end
+redis.call('SET', KEYS[1], '1')
+return 1
+`)
+
+func claimKeys(eventID uint, ticketIDs []string) []string {
+	keys := make([]string, len(ticketIDs))
+	for i, id := range ticketIDs {
+		keys[i] = claimKey(eventID, id)
+	}
+	return keys
+}
+
+func stringArgs(values ...string) []interface{} {
+	args := make([]interface{}, len(values))
+	for i, v := range values {
+		args[i] = v
+	}
+	return args
+}
+
+// Claim reserves the seats for the owner until ttl passes. Seats the owner already claimed are
+// extended, which lets a holder check out their own held seats.
+func (r *ImplReservation) Claim(ctx context.Context, eventID uint, ticketIDs []string, owner string, ttl time.Duration) error {
+	if len(ticketIDs) == 0 {
+		return nil
+	}
+
+	keys := append([]string{syncedKey(eventID), takenKey(eventID)}, claimKeys(eventID, ticketIDs)...)
+	args := append([]interface{}{owner, ttl.Milliseconds()}, stringArgs(ticketIDs...)...)
+
+	result, err := claimScript.Run(ctx, r.client, keys, args...).Int()
+	if err != nil {
+		return err
+	}
+
+	switch result {
+	case -1:
+		return ErrNotSynced
+	case 0:
+		return ErrClaimed
+	default:
+		return nil
+	}
+}
+
+func (r *ImplReservation) Release(ctx context.Context, eventID uint, ticketIDs []string, owner string) error {
+	if len(ticketIDs) == 0 {
+		return nil
+	}
+	return releaseScript.Run(ctx, r.client, claimKeys(eventID, ticketIDs), owner).Err()
+}
+
+func (r *ImplReservation) Confirm(ctx context.Context, eventID uint, ticketIDs []string, owner string) error {
+	if len(ticketIDs) == 0 {
+		return nil
+	}
+
+	keys := append([]string{takenKey(eventID)}, claimKeys(eventID, ticketIDs)...)
+	args := append([]interface{}{owner}, stringArgs(ticketIDs...)...)
+
+	return confirmScript.Run(ctx, r.client, keys, args...).Err()
+}
+
+// Free puts the seats back on sale, claims on them are left to expire.
+func (r *ImplReservation) Free(ctx context.Context, eventID uint, ticketIDs []string) error {
+	if len(ticketIDs) == 0 {
+		return nil
+	}
+	return freeScript.Run(ctx, r.client, []string{takenKey(eventID)}, stringArgs(ticketIDs...)...).Err()
+}
+
+// TryLockSync lets a single caller rebuild an event that is missing from Redis, the others keep
+// going against Postgres until it is done.
+func (r *ImplReservation) TryLockSync(ctx context.Context, eventID uint, ttl time.Duration) (bool, error) {
+	return r.client.SetNX(ctx, syncingKey(eventID), "1", ttl).Result()
+}
+
+func (r *ImplReservation) Sync(ctx context.Context, eventID uint, state *State) error {
+	now := time.Now()
+
+	keys := []string{syncedKey(eventID), takenKey(eventID)}
+	args := append([]interface{}{len(state.Taken)}, stringArgs(state.Taken...)...)
+	for _, hold := range state.Holds {
+		ttl := hold.Until.Sub(now).Milliseconds()
+		if ttl <= 0 {
+			continue
+		}
+		keys = append(keys, claimKey(eventID, hold.TicketID))
+		args = append(args, hold.Owner, ttl)
+	}
+
+	if err := syncScript.Run(ctx, r.client, keys, args...).Err(); err != nil {
+		return err
+	}
+
+	return r.client.Del(ctx, syncingKey(eventID)).Err()
+}
+
+// ClaimPlaces takes places off the slot's remaining count. The places stay taken once the order is
+// committed, a failed checkout gives them back with ReleasePlaces.
+func (r *ImplReservation) ClaimPlaces(ctx context.Context, slotID uint, quantity int) error {
+	result, err := claimPlacesScript.Run(ctx, r.client, []string{placesKey(slotID)}, quantity).Int()
+	if err != nil {
+		return err
+	}
+
+	switch result {
+	case -1:
+		return ErrNotSynced
+	case 0:
+		return ErrClaimed
+	default:
+		return nil
+	}
+}
+
+func (r *ImplReservation) ReleasePlaces(ctx context.Context, slotID uint, quantity int) error {
+	return releasePlacesScript.Run(ctx, r.client, []string{placesKey(slotID)}, quantity).Err()
+}
+
+// SyncPlaces loads the slot's remaining places unless another caller already did. The count
+// expires after ttl so that places claimed by checkouts that never finished are read from
+// Postgres again.
+func (r *ImplReservation) SyncPlaces(ctx context.Context, slotID uint, remaining int, ttl time.Duration) error {
+	return r.client.SetNX(ctx, placesKey(slotID), remaining, ttl).Err()
+}
@@ -0,0 +1,129 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient connects to the Redis given in REDIS_TEST_ADDR, claims run as scripts there and
+// cannot be exercised without it.
+func newTestClient(t *testing.T) *redis.Client {
+	addr := os.Getenv("REDIS_TEST_ADDR")
+	if addr == "" {
+		t.Skip("REDIS_TEST_ADDR is not set")
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		t.Skipf("redis is not reachable: %v", err)
+	}
+	t.Cleanup(func() {
+		client.Close()
+	})
+
+	return client
+}
+
+// testEventID picks an event that no other run uses, and removes its keys once the test is done.
+func testEventID(t *testing.T, client *redis.Client) uint {
+	eventID := uint(time.Now().UnixNano() % 1_000_000_000)
+	t.Cleanup(func() {
+		ctx := context.Background()
+		keys, _ := client.Keys(ctx, fmt.Sprintf("reservation:{event:%d}:*", eventID)).Result()
+		if len(keys) > 0 {
+			client.Del(ctx, keys...)
+		}
+	})
+	return eventID
+}
+
+func TestKeys_ShareEventSlot(t *testing.T) {
+	tag := "{event:42}"
+	for _, key := range []string{syncedKey(42), syncingKey(42), takenKey(42), claimKey(42, "T-1")} {
+		assert.Contains(t, key, tag)
+	}
+	assert.Contains(t, placesKey(7), "{slot:7}")
+}
+
+func TestReservation_Claim(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	r := NewReservation(client)
+	eventID := testEventID(t, client)
+
+	// Nothing can be claimed before the event is loaded from Postgres
+	err := r.Claim(ctx, eventID, []string{"T-1"}, "alice", time.Minute)
+	assert.ErrorIs(t, err, ErrNotSynced)
+
+	require.NoError(t, r.Sync(ctx, eventID, &State{
+		Taken: []string{"T-9"},
+		Holds: []Hold{{TicketID: "T-8", Owner: "offer", Until: time.Now().Add(time.Minute)}},
+	}))
+
+	require.NoError(t, r.Claim(ctx, eventID, []string{"T-1", "T-2"}, "alice", time.Minute))
+
+	// The owner may claim their seats again, anybody else is turned away
+	assert.NoError(t, r.Claim(ctx, eventID, []string{"T-1"}, "alice", time.Minute))
+	assert.ErrorIs(t, r.Claim(ctx, eventID, []string{"T-2", "T-3"}, "bob", time.Minute), ErrClaimed)
+
+	// A failed claim takes none of its seats
+	assert.NoError(t, r.Claim(ctx, eventID, []string{"T-3"}, "carol", time.Minute))
+
+	// Taken seats and held seats cannot be claimed, except by the holder
+	assert.ErrorIs(t, r.Claim(ctx, eventID, []string{"T-9"}, "bob", time.Minute), ErrClaimed)
+	assert.ErrorIs(t, r.Claim(ctx, eventID, []string{"T-8"}, "bob", time.Minute), ErrClaimed)
+	assert.NoError(t, r.Claim(ctx, eventID, []string{"T-8"}, "offer", time.Minute))
+
+	// Released claims can be taken by someone else
+	require.NoError(t, r.Release(ctx, eventID, []string{"T-1", "T-2"}, "alice"))
+	assert.NoError(t, r.Claim(ctx, eventID, []string{"T-2"}, "bob", time.Minute))
+}
+
+func TestReservation_ConfirmAndFree(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	r := NewReservation(client)
+	eventID := testEventID(t, client)
+
+	require.NoError(t, r.Sync(ctx, eventID, &State{}))
+	require.NoError(t, r.Claim(ctx, eventID, []string{"T-1"}, "alice", time.Minute))
+	require.NoError(t, r.Confirm(ctx, eventID, []string{"T-1"}, "alice"))
+
+	// A confirmed seat is taken, even for the buyer
+	assert.ErrorIs(t, r.Claim(ctx, eventID, []string{"T-1"}, "alice", time.Minute), ErrClaimed)
+
+	// Once the order is refunded or expires the seat is on sale again
+	require.NoError(t, r.Free(ctx, eventID, []string{"T-1"}))
+	assert.NoError(t, r.Claim(ctx, eventID, []string{"T-1"}, "bob", time.Minute))
+}
+
+func TestReservation_ClaimPlaces(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	r := NewReservation(client)
+	slotID := uint(time.Now().UnixNano() % 1_000_000_000)
+	t.Cleanup(func() {
+		client.Del(context.Background(), placesKey(slotID))
+	})
+
+	assert.ErrorIs(t, r.ClaimPlaces(ctx, slotID, 1), ErrNotSynced)
+
+	require.NoError(t, r.SyncPlaces(ctx, slotID, 5, time.Minute))
+	// A second sync does not overwrite places claimed in the meantime
+	require.NoError(t, r.ClaimPlaces(ctx, slotID, 3))
+	require.NoError(t, r.SyncPlaces(ctx, slotID, 5, time.Minute))
+
+	assert.ErrorIs(t, r.ClaimPlaces(ctx, slotID, 3), ErrClaimed)
+	assert.NoError(t, r.ClaimPlaces(ctx, slotID, 2))
+
+	require.NoError(t, r.ReleasePlaces(ctx, slotID, 3))
+	assert.NoError(t, r.ClaimPlaces(ctx, slotID, 3))
+	assert.ErrorIs(t, r.ClaimPlaces(ctx, slotID, 1), ErrClaimed)
+}
@@ -2,9 +2,23 @@ package route
 
 import "github.com/labstack/echo/v4"
 
+// Challenge sets when a route asks the client to solve a bot challenge.
+type Challenge int
+
+const (
+	// ChallengeNever leaves the route open
+	ChallengeNever Challenge = iota
+	// ChallengeOnRisk challenges clients that look automated, such as after repeated failures
+	// or at a high request rate
+	ChallengeOnRisk
+	// ChallengeAlways challenges every request
+	ChallengeAlways
+)
+
 type Route struct {
-	Method  string
-	Path    string
-	Handler echo.HandlerFunc
-	Roles   []string
+	Method    string
+	Path      string
+	Handler   echo.HandlerFunc
+	Roles     []string
+	Challenge Challenge
 }
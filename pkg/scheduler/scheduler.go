@@ -0,0 +1,18 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+type Scheduler interface {
+	Register(job Job)
+	Start(ctx context.Context)
+	Stop()
+}
@@ -0,0 +1,63 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+type ImplScheduler struct {
+	log    *logrus.Logger
+	jobs   []Job
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewScheduler(log *logrus.Logger) *ImplScheduler {
+	return &ImplScheduler{
+		log: log,
+	}
+}
+
+// Register adds a job to the scheduler. Jobs registered after Start are ignored.
+func (s *ImplScheduler) Register(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Start runs every registered job on its own ticker until Stop is called
+func (s *ImplScheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.run(ctx, job)
+	}
+}
+
+// Stop cancels all running jobs and waits for the current runs to finish
+func (s *ImplScheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+func (s *ImplScheduler) run(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job.Run(ctx); err != nil {
+				s.log.Errorf("scheduler: job %s failed: %v", job.Name, err)
+			}
+		}
+	}
+}
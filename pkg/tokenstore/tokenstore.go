@@ -0,0 +1,18 @@
+package tokenstore
+
+import (
+	"context"
+	"time"
+)
+
+// TokenStore tracks refresh token families and revoked access tokens. A family starts at login
+// and only its latest refresh token (by jti) may be exchanged; presenting an older one means the
+// token leaked, so the whole family is revoked.
+type TokenStore interface {
+	Issue(ctx context.Context, userID, familyID, jti string, ttl time.Duration) error
+	Rotate(ctx context.Context, userID, familyID, currentJTI, nextJTI string, ttl time.Duration) error
+	RevokeFamily(ctx context.Context, userID, familyID string) error
+	RevokeUser(ctx context.Context, userID string) error
+	Deny(ctx context.Context, jti string, until time.Time) error
+	IsDenied(ctx context.Context, userID, familyID, jti string) (bool, error)
+}
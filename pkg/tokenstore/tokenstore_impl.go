@@ -0,0 +1,157 @@
+package tokenstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	ErrTokenReused  = errors.New("tokenstore: refresh token reused")
+	ErrTokenRevoked = errors.New("tokenstore: refresh token family revoked")
+)
+
+// ImplTokenStore keeps a user's keys under one hash tag so scripts can touch all of them.
+// Revoked families are remembered for as long as an access token of theirs can live.
+type ImplTokenStore struct {
+	client    *redis.Client
+	accessTTL time.Duration
+}
+
+func NewTokenStore(client *redis.Client, accessTTL time.Duration) *ImplTokenStore {
+	return &ImplTokenStore{
+		client:    client,
+		accessTTL: accessTTL,
+	}
+}
+
+// rotateScript swaps the family's current jti, or revokes the family when another jti is shown.
+// KEYS: family, families, revoked. ARGV: current jti, next jti, ttl, access ttl, family id.
+var rotateScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if not current then
+	return 0
+end
+if current ~= ARGV[1] then
+	redis.call('DEL', KEYS[1])
+	redis.call('SREM', KEYS[2], ARGV[5])
+	redis.call('SET', KEYS[3], 1, 'PX', ARGV[4])
+	return -1
+end
+redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+redis.call('PEXPIRE', KEYS[2], ARGV[3])
+return 1
+`)
+
+// revokeUserScript revokes every family of the user.
+// KEYS: families. ARGV: key prefix, access ttl.
+var revokeUserScript = redis.NewScript(`
+local families = redis.call('SMEMBERS', KEYS[1])
+for _, family in ipairs(families) do
+	redis.call('DEL', ARGV[1] .. 'family:' .. family)
+	redis.call('SET', ARGV[1] .. 'revoked:' .. family, 1, 'PX', ARGV[2])
+end
+redis.call('DEL', KEYS[1])
+return #families
+`)
+
+func userPrefix(userID string) string {
+	return fmt.Sprintf("token:{user:%s}:", userID)
+}
+
+func familyKey(userID, familyID string) string {
+	return userPrefix(userID) + "family:" + familyID
+}
+
+func familiesKey(userID string) string {
+	return userPrefix(userID) + "families"
+}
+
+func revokedKey(userID, familyID string) string {
+	return userPrefix(userID) + "revoked:" + familyID
+}
+
+func denyKey(jti string) string {
+	return fmt.Sprintf("token:deny:%s", jti)
+}
+
+func (s *ImplTokenStore) Issue(ctx context.Context, userID, familyID, jti string, ttl time.Duration) error {
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, familyKey(userID, familyID), jti, ttl)
+		pipe.SAdd(ctx, familiesKey(userID), familyID)
+		pipe.PExpire(ctx, familiesKey(userID), ttl)
+		return nil
+	})
+	return err
+}
+
+func (s *ImplTokenStore) Rotate(ctx context.Context, userID, familyID, currentJTI, nextJTI string, ttl time.Duration) error {
+	keys := []string{familyKey(userID, familyID), familiesKey(userID), revokedKey(userID, familyID)}
+	result, err := rotateScript.Run(ctx, s.client, keys, currentJTI, nextJTI, ttl.Milliseconds(), s.accessTTL.Milliseconds(), familyID).Int()
+	if err != nil {
+		return err
+	}
+
+	switch result {
+	case 0:
+		return ErrTokenRevoked
+	case -1:
+		return ErrTokenReused
+	default:
+		return nil
+	}
+}
+
+func (s *ImplTokenStore) RevokeFamily(ctx context.Context, userID, familyID string) error {
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, familyKey(userID, familyID))
+		pipe.SRem(ctx, familiesKey(userID), familyID)
+		pipe.Set(ctx, revokedKey(userID, familyID), 1, s.accessTTL)
+		return nil
+	})
+	return err
+}
+
+func (s *ImplTokenStore) RevokeUser(ctx context.Context, userID string) error {
+	return revokeUserScript.Run(ctx, s.client, []string{familiesKey(userID)}, userPrefix(userID), s.accessTTL.Milliseconds()).Err()
+}
+
+func (s *ImplTokenStore) Deny(ctx context.Context, jti string, until time.Time) error {
+	ttl := time.Until(until)
+	if ttl <= 0 {
+		return nil
+	}
+	return s.client.Set(ctx, denyKey(jti), 1, ttl).Err()
+}
+
+func (s *ImplTokenStore) IsDenied(ctx context.Context, userID, familyID, jti string) (bool, error) {
+	var keys []string
+	if jti != "" {
+		keys = append(keys, denyKey(jti))
+	}
+	if familyID != "" {
+		keys = append(keys, revokedKey(userID, familyID))
+	}
+	if len(keys) == 0 {
+		return false, nil
+	}
+
+	pipe := s.client.Pipeline()
+	results := make([]*redis.IntCmd, len(keys))
+	for i, key := range keys {
+		results[i] = pipe.Exists(ctx, key)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, err
+	}
+
+	for _, result := range results {
+		if result.Val() > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,169 @@
+package tokenstore
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient connects to the Redis given in REDIS_TEST_ADDR, rotation runs as a script there
+// and cannot be exercised without it.
+func newTestClient(t *testing.T) *redis.Client {
+	addr := os.Getenv("REDIS_TEST_ADDR")
+	if addr == "" {
+		t.Skip("REDIS_TEST_ADDR is not set")
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		t.Skipf("redis is not reachable: %v", err)
+	}
+	t.Cleanup(func() {
+		client.Close()
+	})
+
+	return client
+}
+
+// testUserID picks a user that no other run uses, and removes their keys once the test is done.
+func testUserID(t *testing.T, client *redis.Client) string {
+	userID := uuid.NewString()
+	t.Cleanup(func() {
+		ctx := context.Background()
+		keys, _ := client.Keys(ctx, userPrefix(userID)+"*").Result()
+		if len(keys) > 0 {
+			client.Del(ctx, keys...)
+		}
+	})
+	return userID
+}
+
+func TestKeys_ShareUserSlot(t *testing.T) {
+	tag := "{user:u-1}"
+	for _, key := range []string{familyKey("u-1", "f-1"), familiesKey("u-1"), revokedKey("u-1", "f-1")} {
+		assert.Contains(t, key, tag)
+	}
+}
+
+func TestTokenStore_Rotate(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	s := NewTokenStore(client, time.Minute)
+	userID := testUserID(t, client)
+
+	require.NoError(t, s.Issue(ctx, userID, "family-1", "jti-1", time.Hour))
+
+	denied, err := s.IsDenied(ctx, userID, "family-1", "access-1")
+	require.NoError(t, err)
+	assert.False(t, denied)
+
+	// Only the latest refresh token of a family can be exchanged
+	require.NoError(t, s.Rotate(ctx, userID, "family-1", "jti-1", "jti-2", time.Hour))
+	require.NoError(t, s.Rotate(ctx, userID, "family-1", "jti-2", "jti-3", time.Hour))
+}
+
+func TestTokenStore_RotateReuse(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	s := NewTokenStore(client, time.Minute)
+	userID := testUserID(t, client)
+
+	require.NoError(t, s.Issue(ctx, userID, "family-1", "jti-1", time.Hour))
+	require.NoError(t, s.Issue(ctx, userID, "family-2", "jti-a", time.Hour))
+	require.NoError(t, s.Rotate(ctx, userID, "family-1", "jti-1", "jti-2", time.Hour))
+
+	// Presenting the replaced token again means it leaked, which revokes the whole family
+	err := s.Rotate(ctx, userID, "family-1", "jti-1", "jti-3", time.Hour)
+	assert.ErrorIs(t, err, ErrTokenReused)
+
+	err = s.Rotate(ctx, userID, "family-1", "jti-2", "jti-3", time.Hour)
+	assert.ErrorIs(t, err, ErrTokenRevoked)
+
+	denied, err := s.IsDenied(ctx, userID, "family-1", "")
+	require.NoError(t, err)
+	assert.True(t, denied, "access tokens of the family are refused")
+
+	// Other logins of the user are left alone
+	require.NoError(t, s.Rotate(ctx, userID, "family-2", "jti-a", "jti-b", time.Hour))
+	denied, err = s.IsDenied(ctx, userID, "family-2", "")
+	require.NoError(t, err)
+	assert.False(t, denied)
+}
+
+func TestTokenStore_RotateUnknownFamily(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	s := NewTokenStore(client, time.Minute)
+	userID := testUserID(t, client)
+
+	err := s.Rotate(ctx, userID, "family-1", "jti-1", "jti-2", time.Hour)
+	assert.ErrorIs(t, err, ErrTokenRevoked)
+}
+
+func TestTokenStore_RevokeFamily(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	s := NewTokenStore(client, time.Minute)
+	userID := testUserID(t, client)
+
+	require.NoError(t, s.Issue(ctx, userID, "family-1", "jti-1", time.Hour))
+	require.NoError(t, s.RevokeFamily(ctx, userID, "family-1"))
+
+	err := s.Rotate(ctx, userID, "family-1", "jti-1", "jti-2", time.Hour)
+	assert.ErrorIs(t, err, ErrTokenRevoked)
+
+	denied, err := s.IsDenied(ctx, userID, "family-1", "")
+	require.NoError(t, err)
+	assert.True(t, denied)
+}
+
+func TestTokenStore_RevokeUser(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	s := NewTokenStore(client, time.Minute)
+	userID := testUserID(t, client)
+
+	require.NoError(t, s.Issue(ctx, userID, "family-1", "jti-1", time.Hour))
+	require.NoError(t, s.Issue(ctx, userID, "family-2", "jti-a", time.Hour))
+	require.NoError(t, s.RevokeUser(ctx, userID))
+
+	for family, jti := range map[string]string{"family-1": "jti-1", "family-2": "jti-a"} {
+		err := s.Rotate(ctx, userID, family, jti, "next", time.Hour)
+		assert.ErrorIs(t, err, ErrTokenRevoked)
+
+		denied, err := s.IsDenied(ctx, userID, family, "")
+		require.NoError(t, err)
+		assert.True(t, denied)
+	}
+}
+
+func TestTokenStore_Deny(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	s := NewTokenStore(client, time.Minute)
+	jti := uuid.NewString()
+	t.Cleanup(func() {
+		client.Del(context.Background(), denyKey(jti))
+	})
+
+	// A token that has already expired needs no entry
+	require.NoError(t, s.Deny(ctx, jti, time.Now().Add(-time.Second)))
+	denied, err := s.IsDenied(ctx, "", "", jti)
+	require.NoError(t, err)
+	assert.False(t, denied)
+
+	require.NoError(t, s.Deny(ctx, jti, time.Now().Add(time.Minute)))
+	denied, err = s.IsDenied(ctx, "", "", jti)
+	require.NoError(t, err)
+	assert.True(t, denied)
+
+	denied, err = s.IsDenied(ctx, "", "", "")
+	require.NoError(t, err)
+	assert.False(t, denied)
+}
@@ -0,0 +1,32 @@
+package waitingroom
+
+import (
+	"context"
+	"time"
+)
+
+// Position is where a queue token stands in its event's line.
+type Position struct {
+	Number   int64
+	Ahead    int64
+	Admitted bool
+	Wait     time.Duration
+}
+
+// Depth summarises an event's line, Admitted counts everyone let through so far.
+type Depth struct {
+	Rate     int
+	Joined   int64
+	Admitted int64
+	Waiting  int64
+}
+
+// WaitingRoom queues visitors per event in Redis and lets them through in arrival order at a fixed
+// rate per minute. Admission is worked out from the clock, so no worker has to move the line.
+type WaitingRoom interface {
+	Open(ctx context.Context, eventID uint, rate int) error
+	Close(ctx context.Context, eventID uint) error
+	Join(ctx context.Context, eventID uint, token string, ttl time.Duration) (*Position, error)
+	Status(ctx context.Context, eventID uint, token string) (*Position, error)
+	Depth(ctx context.Context, eventID uint) (*Depth, error)
+}
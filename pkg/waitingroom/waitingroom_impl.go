@@ -0,0 +1,202 @@
+package waitingroom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type ImplWaitingRoom struct {
+	client *redis.Client
+}
+
+func NewWaitingRoom(client *redis.Client) *ImplWaitingRoom {
+	return &ImplWaitingRoom{
+		client: client,
+	}
+}
+
+var (
+	ErrNotOpen      = errors.New("waitingroom: waiting room is not open")
+	ErrUnknownToken = errors.New("waitingroom: queue token not found")
+)
+
+// The line is a counter of numbers handed out plus an anchor, the number admitted at a point in
+// time. Everyone up to anchor_seq + rate * minutes since anchor_at is through.
+func stateKey(eventID uint) string {
+	return fmt.Sprintf("waitingroom:{event:%d}:state", eventID)
+}
+
+func tokenKey(eventID uint, token string) string {
+	return fmt.Sprintf("waitingroom:{event:%d}:token:%s", eventID, token)
+}
+
+// openScript sets the admission rate. Changing the rate of an open room re-anchors the line so
+// that visitors already admitted stay admitted.
+var openScript = redis.NewScript(`
+local now = tonumber(ARGV[2])
+local rate = tonumber(redis.call('HGET', KEYS[1], 'rate') or '0')
+if rate > 0 then
+	local seq = tonumber(redis.call('HGET', KEYS[1], 'seq'))
+	local anchorSeq = tonumber(redis.call('HGET', KEYS[1], 'anchor_seq'))
+	local anchorAt = tonumber(redis.call('HGET', KEYS[1], 'anchor_at'))
+	local admitted = math.min(anchorSeq + math.floor((now - anchorAt) * rate / 60000), seq)
+	redis.call('HSET', KEYS[1], 'rate', ARGV[1], 'anchor_seq', admitted, 'anchor_at', now)
+else
+	redis.call('HSET', KEYS[1], 'rate', ARGV[1], 'seq', 0, 'anchor_seq', 0, 'anchor_at', now)
+end
+return 1
+`)
+
+// joinScript hands out the next number. When nobody is waiting the line restarts at the newcomer,
+// otherwise a quiet spell would let the next crowd in all at once.
+var joinScript = redis.NewScript(`
+local rate = tonumber(redis.call('HGET', KEYS[1], 'rate') or '0')
+if rate <= 0 then
+	return {-1}
+end
+local now = tonumber(ARGV[1])
+local seq = tonumber(redis.call('HGET', KEYS[1], 'seq'))
+local anchorSeq = tonumber(redis.call('HGET', KEYS[1], 'anchor_seq'))
+local anchorAt = tonumber(redis.call('HGET', KEYS[1], 'anchor_at'))
+if anchorSeq + math.floor((now - anchorAt) * rate / 60000) >= seq then
+	anchorSeq = seq + 1
+	anchorAt = now
+	redis.call('HSET', KEYS[1], 'anchor_seq', anchorSeq, 'anchor_at', anchorAt)
+end
+seq = redis.call('HINCRBY', KEYS[1], 'seq', 1)
+redis.call('SET', KEYS[2], seq, 'PX', ARGV[2])
+return {seq, rate, seq, anchorSeq, anchorAt}
+`)
+
+// statusScript reads the line, and the number behind a token when one is given.
+var statusScript = redis.NewScript(`
+local rate = tonumber(redis.call('HGET', KEYS[1], 'rate') or '0')
+if rate <= 0 then
+	return {-1}
+end
+local number = 0
+if KEYS[2] then
+	number = tonumber(redis.call('GET', KEYS[2]) or '0')
+end
+return {number, rate, tonumber(redis.call('HGET', KEYS[1], 'seq')), tonumber(redis.call('HGET', KEYS[1], 'anchor_seq')), tonumber(redis.call('HGET', KEYS[1], 'anchor_at'))}
+`)
+
+type snapshot struct {
+	number    int64
+	rate      int64
+	seq       int64
+	anchorSeq int64
+	anchorAt  int64
+}
+
+func toSnapshot(values []interface{}) (*snapshot, error) {
+	if len(values) == 1 {
+		return nil, ErrNotOpen
+	}
+	if len(values) != 5 {
+		return nil, fmt.Errorf("waitingroom: unexpected reply of %d values", len(values))
+	}
+
+	numbers := make([]int64, len(values))
+	for i, v := range values {
+		n, ok := v.(int64)
+		if !ok {
+			return nil, fmt.Errorf("waitingroom: unexpected reply value %v", v)
+		}
+		numbers[i] = n
+	}
+
+	return &snapshot{
+		number:    numbers[0],
+		rate:      numbers[1],
+		seq:       numbers[2],
+		anchorSeq: numbers[3],
+		anchorAt:  numbers[4],
+	}, nil
+}
+
+func (s *snapshot) admitted(now time.Time) int64 {
+	admitted := s.anchorSeq + (now.UnixMilli()-s.anchorAt)*s.rate/60000
+	return min(admitted, s.seq)
+}
+
+func (s *snapshot) position(now time.Time) *Position {
+	position := &Position{Number: s.number}
+
+	ahead := s.number - s.admitted(now)
+	if ahead <= 0 {
+		position.Admitted = true
+		return position
+	}
+
+	// The number is reached once rate * minutes since the anchor covers the gap to it
+	admitAt := s.anchorAt + ((s.number-s.anchorSeq)*60000+s.rate-1)/s.rate
+	position.Ahead = ahead
+	position.Wait = max(time.Duration(admitAt-now.UnixMilli())*time.Millisecond, 0)
+	return position
+}
+
+func (r *ImplWaitingRoom) Open(ctx context.Context, eventID uint, rate int) error {
+	return openScript.Run(ctx, r.client, []string{stateKey(eventID)}, rate, time.Now().UnixMilli()).Err()
+}
+
+func (r *ImplWaitingRoom) Close(ctx context.Context, eventID uint) error {
+	return r.client.Del(ctx, stateKey(eventID)).Err()
+}
+
+// Join puts the token at the back of the line, it can be looked up until ttl passes.
+func (r *ImplWaitingRoom) Join(ctx context.Context, eventID uint, token string, ttl time.Duration) (*Position, error) {
+	now := time.Now()
+	values, err := joinScript.Run(ctx, r.client, []string{stateKey(eventID), tokenKey(eventID, token)}, now.UnixMilli(), ttl.Milliseconds()).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := toSnapshot(values)
+	if err != nil {
+		return nil, err
+	}
+	return s.position(now), nil
+}
+
+func (r *ImplWaitingRoom) Status(ctx context.Context, eventID uint, token string) (*Position, error) {
+	now := time.Now()
+	values, err := statusScript.Run(ctx, r.client, []string{stateKey(eventID), tokenKey(eventID, token)}).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := toSnapshot(values)
+	if err != nil {
+		return nil, err
+	}
+	if s.number == 0 {
+		return nil, ErrUnknownToken
+	}
+	return s.position(now), nil
+}
+
+func (r *ImplWaitingRoom) Depth(ctx context.Context, eventID uint) (*Depth, error) {
+	now := time.Now()
+	values, err := statusScript.Run(ctx, r.client, []string{stateKey(eventID)}).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := toSnapshot(values)
+	if err != nil {
+		return nil, err
+	}
+
+	admitted := max(s.admitted(now), 0)
+	return &Depth{
+		Rate:     int(s.rate),
+		Joined:   s.seq,
+		Admitted: admitted,
+		Waiting:  s.seq - admitted,
+	}, nil
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/delivery/http/handler/allocation/allocation_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/delivery/http/handler/allocation/allocation_handler.go -destination=test/mock/delivery/http/handler/allocation/allocation_handler_mock.go
+//
+
+// Package mock_allocation is a generated GoMock package.
+package mock_allocation
+
+import (
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAllocationHandler is a mock of AllocationHandler interface.
+type MockAllocationHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockAllocationHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockAllocationHandlerMockRecorder is the mock recorder for MockAllocationHandler.
+type MockAllocationHandlerMockRecorder struct {
+	mock *MockAllocationHandler
+}
+
+// NewMockAllocationHandler creates a new mock instance.
+func NewMockAllocationHandler(ctrl *gomock.Controller) *MockAllocationHandler {
+	mock := &MockAllocationHandler{ctrl: ctrl}
+	mock.recorder = &MockAllocationHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAllocationHandler) EXPECT() *MockAllocationHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateAllocation mocks base method.
+func (m *MockAllocationHandler) CreateAllocation(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAllocation", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAllocation indicates an expected call of CreateAllocation.
+func (mr *MockAllocationHandlerMockRecorder) CreateAllocation(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAllocation", reflect.TypeOf((*MockAllocationHandler)(nil).CreateAllocation), ctx)
+}
+
+// GetAllocationByID mocks base method.
+func (m *MockAllocationHandler) GetAllocationByID(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllocationByID", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAllocationByID indicates an expected call of GetAllocationByID.
+func (mr *MockAllocationHandlerMockRecorder) GetAllocationByID(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllocationByID", reflect.TypeOf((*MockAllocationHandler)(nil).GetAllocationByID), ctx)
+}
+
+// GetAllocations mocks base method.
+func (m *MockAllocationHandler) GetAllocations(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllocations", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAllocations indicates an expected call of GetAllocations.
+func (mr *MockAllocationHandlerMockRecorder) GetAllocations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllocations", reflect.TypeOf((*MockAllocationHandler)(nil).GetAllocations), ctx)
+}
+
+// IssueComps mocks base method.
+func (m *MockAllocationHandler) IssueComps(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueComps", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IssueComps indicates an expected call of IssueComps.
+func (mr *MockAllocationHandlerMockRecorder) IssueComps(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueComps", reflect.TypeOf((*MockAllocationHandler)(nil).IssueComps), ctx)
+}
+
+// ReleaseAllocation mocks base method.
+func (m *MockAllocationHandler) ReleaseAllocation(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseAllocation", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseAllocation indicates an expected call of ReleaseAllocation.
+func (mr *MockAllocationHandlerMockRecorder) ReleaseAllocation(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseAllocation", reflect.TypeOf((*MockAllocationHandler)(nil).ReleaseAllocation), ctx)
+}
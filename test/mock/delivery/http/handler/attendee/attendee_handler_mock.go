@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/delivery/http/handler/attendee/attendee_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/delivery/http/handler/attendee/attendee_handler.go -destination=test/mock/delivery/http/handler/attendee/attendee_handler_mock.go
+//
+
+// Package mock_attendee is a generated GoMock package.
+package mock_attendee
+
+import (
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttendeeHandler is a mock of AttendeeHandler interface.
+type MockAttendeeHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttendeeHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockAttendeeHandlerMockRecorder is the mock recorder for MockAttendeeHandler.
+type MockAttendeeHandlerMockRecorder struct {
+	mock *MockAttendeeHandler
+}
+
+// NewMockAttendeeHandler creates a new mock instance.
+func NewMockAttendeeHandler(ctrl *gomock.Controller) *MockAttendeeHandler {
+	mock := &MockAttendeeHandler{ctrl: ctrl}
+	mock.recorder = &MockAttendeeHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttendeeHandler) EXPECT() *MockAttendeeHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateQuestion mocks base method.
+func (m *MockAttendeeHandler) CreateQuestion(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQuestion", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateQuestion indicates an expected call of CreateQuestion.
+func (mr *MockAttendeeHandlerMockRecorder) CreateQuestion(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuestion", reflect.TypeOf((*MockAttendeeHandler)(nil).CreateQuestion), ctx)
+}
+
+// DeleteQuestion mocks base method.
+func (m *MockAttendeeHandler) DeleteQuestion(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuestion", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuestion indicates an expected call of DeleteQuestion.
+func (mr *MockAttendeeHandlerMockRecorder) DeleteQuestion(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuestion", reflect.TypeOf((*MockAttendeeHandler)(nil).DeleteQuestion), ctx)
+}
+
+// ExportAttendees mocks base method.
+func (m *MockAttendeeHandler) ExportAttendees(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportAttendees", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportAttendees indicates an expected call of ExportAttendees.
+func (mr *MockAttendeeHandlerMockRecorder) ExportAttendees(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAttendees", reflect.TypeOf((*MockAttendeeHandler)(nil).ExportAttendees), ctx)
+}
+
+// GetAttendees mocks base method.
+func (m *MockAttendeeHandler) GetAttendees(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendees", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAttendees indicates an expected call of GetAttendees.
+func (mr *MockAttendeeHandlerMockRecorder) GetAttendees(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendees", reflect.TypeOf((*MockAttendeeHandler)(nil).GetAttendees), ctx)
+}
+
+// GetQuestions mocks base method.
+func (m *MockAttendeeHandler) GetQuestions(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestions", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetQuestions indicates an expected call of GetQuestions.
+func (mr *MockAttendeeHandlerMockRecorder) GetQuestions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestions", reflect.TypeOf((*MockAttendeeHandler)(nil).GetQuestions), ctx)
+}
+
+// UpdateAttendee mocks base method.
+func (m *MockAttendeeHandler) UpdateAttendee(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendee", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttendee indicates an expected call of UpdateAttendee.
+func (mr *MockAttendeeHandlerMockRecorder) UpdateAttendee(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendee", reflect.TypeOf((*MockAttendeeHandler)(nil).UpdateAttendee), ctx)
+}
+
+// UpdateQuestion mocks base method.
+func (m *MockAttendeeHandler) UpdateQuestion(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQuestion", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateQuestion indicates an expected call of UpdateQuestion.
+func (mr *MockAttendeeHandlerMockRecorder) UpdateQuestion(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuestion", reflect.TypeOf((*MockAttendeeHandler)(nil).UpdateQuestion), ctx)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/delivery/http/handler/cart/cart_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/delivery/http/handler/cart/cart_handler.go -destination=test/mock/delivery/http/handler/cart/cart_handler_mock.go
+//
+
+// Package mock_cart is a generated GoMock package.
+package mock_cart
+
+import (
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCartHandler is a mock of CartHandler interface.
+type MockCartHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockCartHandlerMockRecorder is the mock recorder for MockCartHandler.
+type MockCartHandlerMockRecorder struct {
+	mock *MockCartHandler
+}
+
+// NewMockCartHandler creates a new mock instance.
+func NewMockCartHandler(ctrl *gomock.Controller) *MockCartHandler {
+	mock := &MockCartHandler{ctrl: ctrl}
+	mock.recorder = &MockCartHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCartHandler) EXPECT() *MockCartHandlerMockRecorder {
+	return m.recorder
+}
+
+// AddItem mocks base method.
+func (m *MockCartHandler) AddItem(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockCartHandlerMockRecorder) AddItem(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockCartHandler)(nil).AddItem), ctx)
+}
+
+// Checkout mocks base method.
+func (m *MockCartHandler) Checkout(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockCartHandlerMockRecorder) Checkout(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockCartHandler)(nil).Checkout), ctx)
+}
+
+// ClearCart mocks base method.
+func (m *MockCartHandler) ClearCart(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearCart", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearCart indicates an expected call of ClearCart.
+func (mr *MockCartHandlerMockRecorder) ClearCart(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearCart", reflect.TypeOf((*MockCartHandler)(nil).ClearCart), ctx)
+}
+
+// GetCart mocks base method.
+func (m *MockCartHandler) GetCart(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCart", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetCart indicates an expected call of GetCart.
+func (mr *MockCartHandlerMockRecorder) GetCart(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCart", reflect.TypeOf((*MockCartHandler)(nil).GetCart), ctx)
+}
+
+// RemoveItem mocks base method.
+func (m *MockCartHandler) RemoveItem(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockCartHandlerMockRecorder) RemoveItem(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockCartHandler)(nil).RemoveItem), ctx)
+}
@@ -82,6 +82,20 @@ func (mr *MockEventHandlerMockRecorder) GetEventByID(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventByID", reflect.TypeOf((*MockEventHandler)(nil).GetEventByID), ctx)
 }
 
+// GetUnpublishedEvents mocks base method.
+func (m *MockEventHandler) GetUnpublishedEvents(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnpublishedEvents", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetUnpublishedEvents indicates an expected call of GetUnpublishedEvents.
+func (mr *MockEventHandlerMockRecorder) GetUnpublishedEvents(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnpublishedEvents", reflect.TypeOf((*MockEventHandler)(nil).GetUnpublishedEvents), ctx)
+}
+
 // SearchEvents mocks base method.
 func (m *MockEventHandler) SearchEvents(ctx echo.Context) error {
 	m.ctrl.T.Helper()
@@ -109,3 +123,17 @@ func (mr *MockEventHandlerMockRecorder) UpdateEvent(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockEventHandler)(nil).UpdateEvent), ctx)
 }
+
+// UpdateEventStatus mocks base method.
+func (m *MockEventHandler) UpdateEventStatus(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEventStatus", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEventStatus indicates an expected call of UpdateEventStatus.
+func (mr *MockEventHandlerMockRecorder) UpdateEventStatus(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventStatus", reflect.TypeOf((*MockEventHandler)(nil).UpdateEventStatus), ctx)
+}
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/delivery/http/handler/exchange/exchange_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/delivery/http/handler/exchange/exchange_handler.go -destination=test/mock/delivery/http/handler/exchange/exchange_handler_mock.go
+//
+
+// Package mock_exchange is a generated GoMock package.
+package mock_exchange
+
+import (
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExchangeHandler is a mock of ExchangeHandler interface.
+type MockExchangeHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockExchangeHandlerMockRecorder is the mock recorder for MockExchangeHandler.
+type MockExchangeHandlerMockRecorder struct {
+	mock *MockExchangeHandler
+}
+
+// NewMockExchangeHandler creates a new mock instance.
+func NewMockExchangeHandler(ctrl *gomock.Controller) *MockExchangeHandler {
+	mock := &MockExchangeHandler{ctrl: ctrl}
+	mock.recorder = &MockExchangeHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeHandler) EXPECT() *MockExchangeHandlerMockRecorder {
+	return m.recorder
+}
+
+// ExchangeTicket mocks base method.
+func (m *MockExchangeHandler) ExchangeTicket(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExchangeTicket", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExchangeTicket indicates an expected call of ExchangeTicket.
+func (mr *MockExchangeHandlerMockRecorder) ExchangeTicket(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeTicket", reflect.TypeOf((*MockExchangeHandler)(nil).ExchangeTicket), ctx)
+}
+
+// GetExchangeByID mocks base method.
+func (m *MockExchangeHandler) GetExchangeByID(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeByID", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetExchangeByID indicates an expected call of GetExchangeByID.
+func (mr *MockExchangeHandlerMockRecorder) GetExchangeByID(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeByID", reflect.TypeOf((*MockExchangeHandler)(nil).GetExchangeByID), ctx)
+}
+
+// GetExchanges mocks base method.
+func (m *MockExchangeHandler) GetExchanges(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchanges", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetExchanges indicates an expected call of GetExchanges.
+func (mr *MockExchangeHandlerMockRecorder) GetExchanges(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchanges", reflect.TypeOf((*MockExchangeHandler)(nil).GetExchanges), ctx)
+}
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/delivery/http/handler/group/group_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/delivery/http/handler/group/group_handler.go -destination=test/mock/delivery/http/handler/group/group_handler_mock.go
+//
+
+// Package mock_group is a generated GoMock package.
+package mock_group
+
+import (
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGroupHandler is a mock of GroupHandler interface.
+type MockGroupHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockGroupHandlerMockRecorder is the mock recorder for MockGroupHandler.
+type MockGroupHandlerMockRecorder struct {
+	mock *MockGroupHandler
+}
+
+// NewMockGroupHandler creates a new mock instance.
+func NewMockGroupHandler(ctrl *gomock.Controller) *MockGroupHandler {
+	mock := &MockGroupHandler{ctrl: ctrl}
+	mock.recorder = &MockGroupHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupHandler) EXPECT() *MockGroupHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateGroupBooking mocks base method.
+func (m *MockGroupHandler) CreateGroupBooking(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupBooking", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGroupBooking indicates an expected call of CreateGroupBooking.
+func (mr *MockGroupHandlerMockRecorder) CreateGroupBooking(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupBooking", reflect.TypeOf((*MockGroupHandler)(nil).CreateGroupBooking), ctx)
+}
+
+// GetGroupBookingByID mocks base method.
+func (m *MockGroupHandler) GetGroupBookingByID(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupBookingByID", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetGroupBookingByID indicates an expected call of GetGroupBookingByID.
+func (mr *MockGroupHandlerMockRecorder) GetGroupBookingByID(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupBookingByID", reflect.TypeOf((*MockGroupHandler)(nil).GetGroupBookingByID), ctx)
+}
+
+// GetGroupBookings mocks base method.
+func (m *MockGroupHandler) GetGroupBookings(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupBookings", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetGroupBookings indicates an expected call of GetGroupBookings.
+func (mr *MockGroupHandlerMockRecorder) GetGroupBookings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupBookings", reflect.TypeOf((*MockGroupHandler)(nil).GetGroupBookings), ctx)
+}
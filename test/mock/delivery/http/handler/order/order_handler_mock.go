@@ -40,6 +40,20 @@ func (m *MockOrderHandler) EXPECT() *MockOrderHandlerMockRecorder {
 	return m.recorder
 }
 
+// CreateGuestOrder mocks base method.
+func (m *MockOrderHandler) CreateGuestOrder(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGuestOrder", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGuestOrder indicates an expected call of CreateGuestOrder.
+func (mr *MockOrderHandlerMockRecorder) CreateGuestOrder(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuestOrder", reflect.TypeOf((*MockOrderHandler)(nil).CreateGuestOrder), ctx)
+}
+
 // CreateOrder mocks base method.
 func (m *MockOrderHandler) CreateOrder(ctx echo.Context) error {
 	m.ctrl.T.Helper()
@@ -68,6 +82,20 @@ func (mr *MockOrderHandlerMockRecorder) GetAllOrders(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllOrders", reflect.TypeOf((*MockOrderHandler)(nil).GetAllOrders), ctx)
 }
 
+// GetGuestOrder mocks base method.
+func (m *MockOrderHandler) GetGuestOrder(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuestOrder", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetGuestOrder indicates an expected call of GetGuestOrder.
+func (mr *MockOrderHandlerMockRecorder) GetGuestOrder(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestOrder", reflect.TypeOf((*MockOrderHandler)(nil).GetGuestOrder), ctx)
+}
+
 // GetOrderByID mocks base method.
 func (m *MockOrderHandler) GetOrderByID(ctx echo.Context) error {
 	m.ctrl.T.Helper()
@@ -81,3 +109,17 @@ func (mr *MockOrderHandlerMockRecorder) GetOrderByID(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderByID", reflect.TypeOf((*MockOrderHandler)(nil).GetOrderByID), ctx)
 }
+
+// RequestGuestOrderLink mocks base method.
+func (m *MockOrderHandler) RequestGuestOrderLink(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestGuestOrderLink", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestGuestOrderLink indicates an expected call of RequestGuestOrderLink.
+func (mr *MockOrderHandlerMockRecorder) RequestGuestOrderLink(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestGuestOrderLink", reflect.TypeOf((*MockOrderHandler)(nil).RequestGuestOrderLink), ctx)
+}
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/delivery/http/handler/pass/pass_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/delivery/http/handler/pass/pass_handler.go -destination=test/mock/delivery/http/handler/pass/pass_handler_mock.go
+//
+
+// Package mock_pass is a generated GoMock package.
+package mock_pass
+
+import (
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPassHandler is a mock of PassHandler interface.
+type MockPassHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockPassHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockPassHandlerMockRecorder is the mock recorder for MockPassHandler.
+type MockPassHandlerMockRecorder struct {
+	mock *MockPassHandler
+}
+
+// NewMockPassHandler creates a new mock instance.
+func NewMockPassHandler(ctrl *gomock.Controller) *MockPassHandler {
+	mock := &MockPassHandler{ctrl: ctrl}
+	mock.recorder = &MockPassHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPassHandler) EXPECT() *MockPassHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreatePass mocks base method.
+func (m *MockPassHandler) CreatePass(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePass", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePass indicates an expected call of CreatePass.
+func (mr *MockPassHandlerMockRecorder) CreatePass(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePass", reflect.TypeOf((*MockPassHandler)(nil).CreatePass), ctx)
+}
+
+// DeletePass mocks base method.
+func (m *MockPassHandler) DeletePass(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePass", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePass indicates an expected call of DeletePass.
+func (mr *MockPassHandlerMockRecorder) DeletePass(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePass", reflect.TypeOf((*MockPassHandler)(nil).DeletePass), ctx)
+}
+
+// GetPassByID mocks base method.
+func (m *MockPassHandler) GetPassByID(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPassByID", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetPassByID indicates an expected call of GetPassByID.
+func (mr *MockPassHandlerMockRecorder) GetPassByID(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPassByID", reflect.TypeOf((*MockPassHandler)(nil).GetPassByID), ctx)
+}
+
+// GetPasses mocks base method.
+func (m *MockPassHandler) GetPasses(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPasses", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetPasses indicates an expected call of GetPasses.
+func (mr *MockPassHandlerMockRecorder) GetPasses(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasses", reflect.TypeOf((*MockPassHandler)(nil).GetPasses), ctx)
+}
+
+// OrderPass mocks base method.
+func (m *MockPassHandler) OrderPass(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderPass", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OrderPass indicates an expected call of OrderPass.
+func (mr *MockPassHandlerMockRecorder) OrderPass(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderPass", reflect.TypeOf((*MockPassHandler)(nil).OrderPass), ctx)
+}
+
+// ScanPass mocks base method.
+func (m *MockPassHandler) ScanPass(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanPass", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScanPass indicates an expected call of ScanPass.
+func (mr *MockPassHandlerMockRecorder) ScanPass(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanPass", reflect.TypeOf((*MockPassHandler)(nil).ScanPass), ctx)
+}
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/delivery/http/handler/product/product_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/delivery/http/handler/product/product_handler.go -destination=test/mock/delivery/http/handler/product/product_handler_mock.go
+//
+
+// Package mock_product is a generated GoMock package.
+package mock_product
+
+import (
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProductHandler is a mock of ProductHandler interface.
+type MockProductHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockProductHandlerMockRecorder is the mock recorder for MockProductHandler.
+type MockProductHandlerMockRecorder struct {
+	mock *MockProductHandler
+}
+
+// NewMockProductHandler creates a new mock instance.
+func NewMockProductHandler(ctrl *gomock.Controller) *MockProductHandler {
+	mock := &MockProductHandler{ctrl: ctrl}
+	mock.recorder = &MockProductHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductHandler) EXPECT() *MockProductHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateProduct mocks base method.
+func (m *MockProductHandler) CreateProduct(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProduct", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateProduct indicates an expected call of CreateProduct.
+func (mr *MockProductHandlerMockRecorder) CreateProduct(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProduct", reflect.TypeOf((*MockProductHandler)(nil).CreateProduct), ctx)
+}
+
+// DeleteProduct mocks base method.
+func (m *MockProductHandler) DeleteProduct(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProduct", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProduct indicates an expected call of DeleteProduct.
+func (mr *MockProductHandlerMockRecorder) DeleteProduct(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockProductHandler)(nil).DeleteProduct), ctx)
+}
+
+// GetProducts mocks base method.
+func (m *MockProductHandler) GetProducts(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProducts", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetProducts indicates an expected call of GetProducts.
+func (mr *MockProductHandlerMockRecorder) GetProducts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducts", reflect.TypeOf((*MockProductHandler)(nil).GetProducts), ctx)
+}
+
+// GetVoucher mocks base method.
+func (m *MockProductHandler) GetVoucher(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVoucher", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetVoucher indicates an expected call of GetVoucher.
+func (mr *MockProductHandlerMockRecorder) GetVoucher(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVoucher", reflect.TypeOf((*MockProductHandler)(nil).GetVoucher), ctx)
+}
+
+// RedeemVoucher mocks base method.
+func (m *MockProductHandler) RedeemVoucher(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemVoucher", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedeemVoucher indicates an expected call of RedeemVoucher.
+func (mr *MockProductHandlerMockRecorder) RedeemVoucher(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemVoucher", reflect.TypeOf((*MockProductHandler)(nil).RedeemVoucher), ctx)
+}
+
+// UpdateProduct mocks base method.
+func (m *MockProductHandler) UpdateProduct(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProduct", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProduct indicates an expected call of UpdateProduct.
+func (mr *MockProductHandlerMockRecorder) UpdateProduct(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockProductHandler)(nil).UpdateProduct), ctx)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/delivery/http/handler/series/series_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/delivery/http/handler/series/series_handler.go -destination=test/mock/delivery/http/handler/series/series_handler_mock.go
+//
+
+// Package mock_series is a generated GoMock package.
+package mock_series
+
+import (
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSeriesHandler is a mock of SeriesHandler interface.
+type MockSeriesHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeriesHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockSeriesHandlerMockRecorder is the mock recorder for MockSeriesHandler.
+type MockSeriesHandlerMockRecorder struct {
+	mock *MockSeriesHandler
+}
+
+// NewMockSeriesHandler creates a new mock instance.
+func NewMockSeriesHandler(ctrl *gomock.Controller) *MockSeriesHandler {
+	mock := &MockSeriesHandler{ctrl: ctrl}
+	mock.recorder = &MockSeriesHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeriesHandler) EXPECT() *MockSeriesHandlerMockRecorder {
+	return m.recorder
+}
+
+// AddException mocks base method.
+func (m *MockSeriesHandler) AddException(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddException", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddException indicates an expected call of AddException.
+func (mr *MockSeriesHandlerMockRecorder) AddException(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddException", reflect.TypeOf((*MockSeriesHandler)(nil).AddException), ctx)
+}
+
+// AddTicketTemplate mocks base method.
+func (m *MockSeriesHandler) AddTicketTemplate(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTicketTemplate", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTicketTemplate indicates an expected call of AddTicketTemplate.
+func (mr *MockSeriesHandlerMockRecorder) AddTicketTemplate(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTicketTemplate", reflect.TypeOf((*MockSeriesHandler)(nil).AddTicketTemplate), ctx)
+}
+
+// CreateSeries mocks base method.
+func (m *MockSeriesHandler) CreateSeries(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSeries", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSeries indicates an expected call of CreateSeries.
+func (mr *MockSeriesHandlerMockRecorder) CreateSeries(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSeries", reflect.TypeOf((*MockSeriesHandler)(nil).CreateSeries), ctx)
+}
+
+// GetSeriesByID mocks base method.
+func (m *MockSeriesHandler) GetSeriesByID(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeriesByID", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetSeriesByID indicates an expected call of GetSeriesByID.
+func (mr *MockSeriesHandlerMockRecorder) GetSeriesByID(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeriesByID", reflect.TypeOf((*MockSeriesHandler)(nil).GetSeriesByID), ctx)
+}
+
+// UpdateOccurrence mocks base method.
+func (m *MockSeriesHandler) UpdateOccurrence(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOccurrence", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOccurrence indicates an expected call of UpdateOccurrence.
+func (mr *MockSeriesHandlerMockRecorder) UpdateOccurrence(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOccurrence", reflect.TypeOf((*MockSeriesHandler)(nil).UpdateOccurrence), ctx)
+}
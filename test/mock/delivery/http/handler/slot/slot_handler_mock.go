@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/delivery/http/handler/slot/slot_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/delivery/http/handler/slot/slot_handler.go -destination=test/mock/delivery/http/handler/slot/slot_handler_mock.go
+//
+
+// Package mock_slot is a generated GoMock package.
+package mock_slot
+
+import (
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSlotHandler is a mock of SlotHandler interface.
+type MockSlotHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlotHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockSlotHandlerMockRecorder is the mock recorder for MockSlotHandler.
+type MockSlotHandlerMockRecorder struct {
+	mock *MockSlotHandler
+}
+
+// NewMockSlotHandler creates a new mock instance.
+func NewMockSlotHandler(ctrl *gomock.Controller) *MockSlotHandler {
+	mock := &MockSlotHandler{ctrl: ctrl}
+	mock.recorder = &MockSlotHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlotHandler) EXPECT() *MockSlotHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateSlotTemplate mocks base method.
+func (m *MockSlotHandler) CreateSlotTemplate(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSlotTemplate", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSlotTemplate indicates an expected call of CreateSlotTemplate.
+func (mr *MockSlotHandlerMockRecorder) CreateSlotTemplate(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSlotTemplate", reflect.TypeOf((*MockSlotHandler)(nil).CreateSlotTemplate), ctx)
+}
+
+// GenerateSlots mocks base method.
+func (m *MockSlotHandler) GenerateSlots(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSlots", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateSlots indicates an expected call of GenerateSlots.
+func (mr *MockSlotHandlerMockRecorder) GenerateSlots(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSlots", reflect.TypeOf((*MockSlotHandler)(nil).GenerateSlots), ctx)
+}
+
+// GetSlotTemplates mocks base method.
+func (m *MockSlotHandler) GetSlotTemplates(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlotTemplates", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetSlotTemplates indicates an expected call of GetSlotTemplates.
+func (mr *MockSlotHandlerMockRecorder) GetSlotTemplates(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlotTemplates", reflect.TypeOf((*MockSlotHandler)(nil).GetSlotTemplates), ctx)
+}
+
+// GetSlots mocks base method.
+func (m *MockSlotHandler) GetSlots(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlots", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetSlots indicates an expected call of GetSlots.
+func (mr *MockSlotHandlerMockRecorder) GetSlots(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlots", reflect.TypeOf((*MockSlotHandler)(nil).GetSlots), ctx)
+}
+
+// OrderSlot mocks base method.
+func (m *MockSlotHandler) OrderSlot(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderSlot", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OrderSlot indicates an expected call of OrderSlot.
+func (mr *MockSlotHandlerMockRecorder) OrderSlot(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderSlot", reflect.TypeOf((*MockSlotHandler)(nil).OrderSlot), ctx)
+}
@@ -68,6 +68,34 @@ func (mr *MockTicketHandlerMockRecorder) GetAllTickets(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTickets", reflect.TypeOf((*MockTicketHandler)(nil).GetAllTickets), ctx)
 }
 
+// GetEventAvailability mocks base method.
+func (m *MockTicketHandler) GetEventAvailability(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventAvailability", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetEventAvailability indicates an expected call of GetEventAvailability.
+func (mr *MockTicketHandlerMockRecorder) GetEventAvailability(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventAvailability", reflect.TypeOf((*MockTicketHandler)(nil).GetEventAvailability), ctx)
+}
+
+// GetEventCapacity mocks base method.
+func (m *MockTicketHandler) GetEventCapacity(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventCapacity", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetEventCapacity indicates an expected call of GetEventCapacity.
+func (mr *MockTicketHandlerMockRecorder) GetEventCapacity(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventCapacity", reflect.TypeOf((*MockTicketHandler)(nil).GetEventCapacity), ctx)
+}
+
 // GetTicketByID mocks base method.
 func (m *MockTicketHandler) GetTicketByID(ctx echo.Context) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/delivery/http/handler/waitingroom/waitingroom_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/delivery/http/handler/waitingroom/waitingroom_handler.go -destination=test/mock/delivery/http/handler/waitingroom/waitingroom_handler_mock.go
+//
+
+// Package mock_waitingroom is a generated GoMock package.
+package mock_waitingroom
+
+import (
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWaitingRoomHandler is a mock of WaitingRoomHandler interface.
+type MockWaitingRoomHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitingRoomHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockWaitingRoomHandlerMockRecorder is the mock recorder for MockWaitingRoomHandler.
+type MockWaitingRoomHandlerMockRecorder struct {
+	mock *MockWaitingRoomHandler
+}
+
+// NewMockWaitingRoomHandler creates a new mock instance.
+func NewMockWaitingRoomHandler(ctrl *gomock.Controller) *MockWaitingRoomHandler {
+	mock := &MockWaitingRoomHandler{ctrl: ctrl}
+	mock.recorder = &MockWaitingRoomHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitingRoomHandler) EXPECT() *MockWaitingRoomHandlerMockRecorder {
+	return m.recorder
+}
+
+// CloseWaitingRoom mocks base method.
+func (m *MockWaitingRoomHandler) CloseWaitingRoom(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseWaitingRoom", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseWaitingRoom indicates an expected call of CloseWaitingRoom.
+func (mr *MockWaitingRoomHandlerMockRecorder) CloseWaitingRoom(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseWaitingRoom", reflect.TypeOf((*MockWaitingRoomHandler)(nil).CloseWaitingRoom), ctx)
+}
+
+// GetQueuePosition mocks base method.
+func (m *MockWaitingRoomHandler) GetQueuePosition(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueuePosition", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetQueuePosition indicates an expected call of GetQueuePosition.
+func (mr *MockWaitingRoomHandlerMockRecorder) GetQueuePosition(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueuePosition", reflect.TypeOf((*MockWaitingRoomHandler)(nil).GetQueuePosition), ctx)
+}
+
+// GetWaitingRoom mocks base method.
+func (m *MockWaitingRoomHandler) GetWaitingRoom(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitingRoom", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetWaitingRoom indicates an expected call of GetWaitingRoom.
+func (mr *MockWaitingRoomHandlerMockRecorder) GetWaitingRoom(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitingRoom", reflect.TypeOf((*MockWaitingRoomHandler)(nil).GetWaitingRoom), ctx)
+}
+
+// JoinQueue mocks base method.
+func (m *MockWaitingRoomHandler) JoinQueue(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinQueue", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JoinQueue indicates an expected call of JoinQueue.
+func (mr *MockWaitingRoomHandlerMockRecorder) JoinQueue(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinQueue", reflect.TypeOf((*MockWaitingRoomHandler)(nil).JoinQueue), ctx)
+}
+
+// OpenWaitingRoom mocks base method.
+func (m *MockWaitingRoomHandler) OpenWaitingRoom(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenWaitingRoom", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OpenWaitingRoom indicates an expected call of OpenWaitingRoom.
+func (mr *MockWaitingRoomHandlerMockRecorder) OpenWaitingRoom(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenWaitingRoom", reflect.TypeOf((*MockWaitingRoomHandler)(nil).OpenWaitingRoom), ctx)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/delivery/http/handler/waitlist/waitlist_handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/delivery/http/handler/waitlist/waitlist_handler.go -destination=test/mock/delivery/http/handler/waitlist/waitlist_handler_mock.go
+//
+
+// Package mock_waitlist is a generated GoMock package.
+package mock_waitlist
+
+import (
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWaitlistHandler is a mock of WaitlistHandler interface.
+type MockWaitlistHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitlistHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockWaitlistHandlerMockRecorder is the mock recorder for MockWaitlistHandler.
+type MockWaitlistHandlerMockRecorder struct {
+	mock *MockWaitlistHandler
+}
+
+// NewMockWaitlistHandler creates a new mock instance.
+func NewMockWaitlistHandler(ctrl *gomock.Controller) *MockWaitlistHandler {
+	mock := &MockWaitlistHandler{ctrl: ctrl}
+	mock.recorder = &MockWaitlistHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitlistHandler) EXPECT() *MockWaitlistHandlerMockRecorder {
+	return m.recorder
+}
+
+// GetOffer mocks base method.
+func (m *MockWaitlistHandler) GetOffer(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOffer", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetOffer indicates an expected call of GetOffer.
+func (mr *MockWaitlistHandlerMockRecorder) GetOffer(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOffer", reflect.TypeOf((*MockWaitlistHandler)(nil).GetOffer), ctx)
+}
+
+// GetWaitlistByID mocks base method.
+func (m *MockWaitlistHandler) GetWaitlistByID(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitlistByID", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetWaitlistByID indicates an expected call of GetWaitlistByID.
+func (mr *MockWaitlistHandlerMockRecorder) GetWaitlistByID(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlistByID", reflect.TypeOf((*MockWaitlistHandler)(nil).GetWaitlistByID), ctx)
+}
+
+// GetWaitlists mocks base method.
+func (m *MockWaitlistHandler) GetWaitlists(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitlists", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetWaitlists indicates an expected call of GetWaitlists.
+func (mr *MockWaitlistHandlerMockRecorder) GetWaitlists(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlists", reflect.TypeOf((*MockWaitlistHandler)(nil).GetWaitlists), ctx)
+}
+
+// JoinWaitlist mocks base method.
+func (m *MockWaitlistHandler) JoinWaitlist(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinWaitlist", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JoinWaitlist indicates an expected call of JoinWaitlist.
+func (mr *MockWaitlistHandlerMockRecorder) JoinWaitlist(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinWaitlist", reflect.TypeOf((*MockWaitlistHandler)(nil).JoinWaitlist), ctx)
+}
+
+// LeaveWaitlist mocks base method.
+func (m *MockWaitlistHandler) LeaveWaitlist(ctx echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveWaitlist", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveWaitlist indicates an expected call of LeaveWaitlist.
+func (mr *MockWaitlistHandlerMockRecorder) LeaveWaitlist(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveWaitlist", reflect.TypeOf((*MockWaitlistHandler)(nil).LeaveWaitlist), ctx)
+}
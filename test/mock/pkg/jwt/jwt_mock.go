@@ -11,6 +11,7 @@ package mock_jwt
 
 import (
 	reflect "reflect"
+	time "time"
 
 	jwt "github.com/TrinityKnights/Backend/pkg/jwt"
 	gomock "go.uber.org/mock/gomock"
@@ -41,46 +42,138 @@ func (m *MockJWTService) EXPECT() *MockJWTServiceMockRecorder {
 }
 
 // GenerateAccessToken mocks base method.
-func (m *MockJWTService) GenerateAccessToken(userID, email, role string) (string, error) {
+func (m *MockJWTService) GenerateAccessToken(userID, email, role, familyID string) (string, *jwt.JWTClaims, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateAccessToken", userID, email, role)
+	ret := m.ctrl.Call(m, "GenerateAccessToken", userID, email, role, familyID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*jwt.JWTClaims)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateAccessToken indicates an expected call of GenerateAccessToken.
+func (mr *MockJWTServiceMockRecorder) GenerateAccessToken(userID, email, role, familyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAccessToken", reflect.TypeOf((*MockJWTService)(nil).GenerateAccessToken), userID, email, role, familyID)
+}
+
+// GenerateAdmissionToken mocks base method.
+func (m *MockJWTService) GenerateAdmissionToken(eventID uint, queueToken string, expiry time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateAdmissionToken", eventID, queueToken, expiry)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GenerateAccessToken indicates an expected call of GenerateAccessToken.
-func (mr *MockJWTServiceMockRecorder) GenerateAccessToken(userID, email, role any) *gomock.Call {
+// GenerateAdmissionToken indicates an expected call of GenerateAdmissionToken.
+func (mr *MockJWTServiceMockRecorder) GenerateAdmissionToken(eventID, queueToken, expiry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAdmissionToken", reflect.TypeOf((*MockJWTService)(nil).GenerateAdmissionToken), eventID, queueToken, expiry)
+}
+
+// GenerateChallengeToken mocks base method.
+func (m *MockJWTService) GenerateChallengeToken(userID string) (string, *jwt.JWTClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateChallengeToken", userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*jwt.JWTClaims)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateChallengeToken indicates an expected call of GenerateChallengeToken.
+func (mr *MockJWTServiceMockRecorder) GenerateChallengeToken(userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAccessToken", reflect.TypeOf((*MockJWTService)(nil).GenerateAccessToken), userID, email, role)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateChallengeToken", reflect.TypeOf((*MockJWTService)(nil).GenerateChallengeToken), userID)
 }
 
 // GenerateRefreshToken mocks base method.
-func (m *MockJWTService) GenerateRefreshToken(userID, email, role string) (string, error) {
+func (m *MockJWTService) GenerateRefreshToken(userID, email, role, familyID string) (string, *jwt.JWTClaims, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateRefreshToken", userID, email, role)
+	ret := m.ctrl.Call(m, "GenerateRefreshToken", userID, email, role, familyID)
 	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*jwt.JWTClaims)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateRefreshToken indicates an expected call of GenerateRefreshToken.
+func (mr *MockJWTServiceMockRecorder) GenerateRefreshToken(userID, email, role, familyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateRefreshToken", reflect.TypeOf((*MockJWTService)(nil).GenerateRefreshToken), userID, email, role, familyID)
+}
+
+// JWKS mocks base method.
+func (m *MockJWTService) JWKS() *jwt.JWKS {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS")
+	ret0, _ := ret[0].(*jwt.JWKS)
+	return ret0
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockJWTServiceMockRecorder) JWKS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockJWTService)(nil).JWKS))
+}
+
+// ValidateAccessToken mocks base method.
+func (m *MockJWTService) ValidateAccessToken(tokenString string) (*jwt.JWTClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAccessToken", tokenString)
+	ret0, _ := ret[0].(*jwt.JWTClaims)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GenerateRefreshToken indicates an expected call of GenerateRefreshToken.
-func (mr *MockJWTServiceMockRecorder) GenerateRefreshToken(userID, email, role any) *gomock.Call {
+// ValidateAccessToken indicates an expected call of ValidateAccessToken.
+func (mr *MockJWTServiceMockRecorder) ValidateAccessToken(tokenString any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAccessToken", reflect.TypeOf((*MockJWTService)(nil).ValidateAccessToken), tokenString)
+}
+
+// ValidateAdmissionToken mocks base method.
+func (m *MockJWTService) ValidateAdmissionToken(tokenString string) (*jwt.AdmissionClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAdmissionToken", tokenString)
+	ret0, _ := ret[0].(*jwt.AdmissionClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateAdmissionToken indicates an expected call of ValidateAdmissionToken.
+func (mr *MockJWTServiceMockRecorder) ValidateAdmissionToken(tokenString any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAdmissionToken", reflect.TypeOf((*MockJWTService)(nil).ValidateAdmissionToken), tokenString)
+}
+
+// ValidateChallengeToken mocks base method.
+func (m *MockJWTService) ValidateChallengeToken(tokenString string) (*jwt.JWTClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateChallengeToken", tokenString)
+	ret0, _ := ret[0].(*jwt.JWTClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateChallengeToken indicates an expected call of ValidateChallengeToken.
+func (mr *MockJWTServiceMockRecorder) ValidateChallengeToken(tokenString any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateRefreshToken", reflect.TypeOf((*MockJWTService)(nil).GenerateRefreshToken), userID, email, role)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateChallengeToken", reflect.TypeOf((*MockJWTService)(nil).ValidateChallengeToken), tokenString)
 }
 
-// ValidateToken mocks base method.
-func (m *MockJWTService) ValidateToken(tokenString string) (*jwt.JWTClaims, error) {
+// ValidateRefreshToken mocks base method.
+func (m *MockJWTService) ValidateRefreshToken(tokenString string) (*jwt.JWTClaims, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateToken", tokenString)
+	ret := m.ctrl.Call(m, "ValidateRefreshToken", tokenString)
 	ret0, _ := ret[0].(*jwt.JWTClaims)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ValidateToken indicates an expected call of ValidateToken.
-func (mr *MockJWTServiceMockRecorder) ValidateToken(tokenString any) *gomock.Call {
+// ValidateRefreshToken indicates an expected call of ValidateRefreshToken.
+func (mr *MockJWTServiceMockRecorder) ValidateRefreshToken(tokenString any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateToken", reflect.TypeOf((*MockJWTService)(nil).ValidateToken), tokenString)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateRefreshToken", reflect.TypeOf((*MockJWTService)(nil).ValidateRefreshToken), tokenString)
 }
@@ -0,0 +1,170 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./pkg/reservation/reservation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./pkg/reservation/reservation.go -destination=test/mock/./pkg/reservation/reservation_mock.go
+//
+
+// Package mock_reservation is a generated GoMock package.
+package mock_reservation
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	reservation "github.com/TrinityKnights/Backend/pkg/reservation"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReservation is a mock of Reservation interface.
+type MockReservation struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationMockRecorder
+	isgomock struct{}
+}
+
+// MockReservationMockRecorder is the mock recorder for MockReservation.
+type MockReservationMockRecorder struct {
+	mock *MockReservation
+}
+
+// NewMockReservation creates a new mock instance.
+func NewMockReservation(ctrl *gomock.Controller) *MockReservation {
+	mock := &MockReservation{ctrl: ctrl}
+	mock.recorder = &MockReservationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservation) EXPECT() *MockReservationMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockReservation) Claim(ctx context.Context, eventID uint, ticketIDs []string, owner string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, eventID, ticketIDs, owner, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockReservationMockRecorder) Claim(ctx, eventID, ticketIDs, owner, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockReservation)(nil).Claim), ctx, eventID, ticketIDs, owner, ttl)
+}
+
+// ClaimPlaces mocks base method.
+func (m *MockReservation) ClaimPlaces(ctx context.Context, slotID uint, quantity int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPlaces", ctx, slotID, quantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimPlaces indicates an expected call of ClaimPlaces.
+func (mr *MockReservationMockRecorder) ClaimPlaces(ctx, slotID, quantity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPlaces", reflect.TypeOf((*MockReservation)(nil).ClaimPlaces), ctx, slotID, quantity)
+}
+
+// Confirm mocks base method.
+func (m *MockReservation) Confirm(ctx context.Context, eventID uint, ticketIDs []string, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", ctx, eventID, ticketIDs, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockReservationMockRecorder) Confirm(ctx, eventID, ticketIDs, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockReservation)(nil).Confirm), ctx, eventID, ticketIDs, owner)
+}
+
+// Free mocks base method.
+func (m *MockReservation) Free(ctx context.Context, eventID uint, ticketIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Free", ctx, eventID, ticketIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Free indicates an expected call of Free.
+func (mr *MockReservationMockRecorder) Free(ctx, eventID, ticketIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Free", reflect.TypeOf((*MockReservation)(nil).Free), ctx, eventID, ticketIDs)
+}
+
+// Release mocks base method.
+func (m *MockReservation) Release(ctx context.Context, eventID uint, ticketIDs []string, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, eventID, ticketIDs, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockReservationMockRecorder) Release(ctx, eventID, ticketIDs, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockReservation)(nil).Release), ctx, eventID, ticketIDs, owner)
+}
+
+// ReleasePlaces mocks base method.
+func (m *MockReservation) ReleasePlaces(ctx context.Context, slotID uint, quantity int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleasePlaces", ctx, slotID, quantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleasePlaces indicates an expected call of ReleasePlaces.
+func (mr *MockReservationMockRecorder) ReleasePlaces(ctx, slotID, quantity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePlaces", reflect.TypeOf((*MockReservation)(nil).ReleasePlaces), ctx, slotID, quantity)
+}
+
+// Sync mocks base method.
+func (m *MockReservation) Sync(ctx context.Context, eventID uint, state *reservation.State) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sync", ctx, eventID, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockReservationMockRecorder) Sync(ctx, eventID, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockReservation)(nil).Sync), ctx, eventID, state)
+}
+
+// SyncPlaces mocks base method.
+func (m *MockReservation) SyncPlaces(ctx context.Context, slotID uint, remaining int, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncPlaces", ctx, slotID, remaining, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncPlaces indicates an expected call of SyncPlaces.
+func (mr *MockReservationMockRecorder) SyncPlaces(ctx, slotID, remaining, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncPlaces", reflect.TypeOf((*MockReservation)(nil).SyncPlaces), ctx, slotID, remaining, ttl)
+}
+
+// TryLockSync mocks base method.
+func (m *MockReservation) TryLockSync(ctx context.Context, eventID uint, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TryLockSync", ctx, eventID, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TryLockSync indicates an expected call of TryLockSync.
+func (mr *MockReservationMockRecorder) TryLockSync(ctx, eventID, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryLockSync", reflect.TypeOf((*MockReservation)(nil).TryLockSync), ctx, eventID, ttl)
+}
@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./pkg/scheduler/scheduler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./pkg/scheduler/scheduler.go -destination=test/mock/./pkg/scheduler/scheduler_mock.go
+//
+
+// Package mock_scheduler is a generated GoMock package.
+package mock_scheduler
+
+import (
+	context "context"
+	reflect "reflect"
+
+	scheduler "github.com/TrinityKnights/Backend/pkg/scheduler"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockScheduler is a mock of Scheduler interface.
+type MockScheduler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchedulerMockRecorder
+	isgomock struct{}
+}
+
+// MockSchedulerMockRecorder is the mock recorder for MockScheduler.
+type MockSchedulerMockRecorder struct {
+	mock *MockScheduler
+}
+
+// NewMockScheduler creates a new mock instance.
+func NewMockScheduler(ctrl *gomock.Controller) *MockScheduler {
+	mock := &MockScheduler{ctrl: ctrl}
+	mock.recorder = &MockSchedulerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduler) EXPECT() *MockSchedulerMockRecorder {
+	return m.recorder
+}
+
+// Register mocks base method.
+func (m *MockScheduler) Register(job scheduler.Job) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Register", job)
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockSchedulerMockRecorder) Register(job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockScheduler)(nil).Register), job)
+}
+
+// Start mocks base method.
+func (m *MockScheduler) Start(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start", ctx)
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockSchedulerMockRecorder) Start(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockScheduler)(nil).Start), ctx)
+}
+
+// Stop mocks base method.
+func (m *MockScheduler) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockSchedulerMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockScheduler)(nil).Stop))
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./pkg/tokenstore/tokenstore.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./pkg/tokenstore/tokenstore.go -destination=test/mock/./pkg/tokenstore/tokenstore_mock.go
+//
+
+// Package mock_tokenstore is a generated GoMock package.
+package mock_tokenstore
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTokenStore is a mock of TokenStore interface.
+type MockTokenStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockTokenStoreMockRecorder is the mock recorder for MockTokenStore.
+type MockTokenStoreMockRecorder struct {
+	mock *MockTokenStore
+}
+
+// NewMockTokenStore creates a new mock instance.
+func NewMockTokenStore(ctrl *gomock.Controller) *MockTokenStore {
+	mock := &MockTokenStore{ctrl: ctrl}
+	mock.recorder = &MockTokenStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenStore) EXPECT() *MockTokenStoreMockRecorder {
+	return m.recorder
+}
+
+// Deny mocks base method.
+func (m *MockTokenStore) Deny(ctx context.Context, jti string, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deny", ctx, jti, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deny indicates an expected call of Deny.
+func (mr *MockTokenStoreMockRecorder) Deny(ctx, jti, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deny", reflect.TypeOf((*MockTokenStore)(nil).Deny), ctx, jti, until)
+}
+
+// IsDenied mocks base method.
+func (m *MockTokenStore) IsDenied(ctx context.Context, userID, familyID, jti string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDenied", ctx, userID, familyID, jti)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsDenied indicates an expected call of IsDenied.
+func (mr *MockTokenStoreMockRecorder) IsDenied(ctx, userID, familyID, jti any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDenied", reflect.TypeOf((*MockTokenStore)(nil).IsDenied), ctx, userID, familyID, jti)
+}
+
+// Issue mocks base method.
+func (m *MockTokenStore) Issue(ctx context.Context, userID, familyID, jti string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, userID, familyID, jti, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockTokenStoreMockRecorder) Issue(ctx, userID, familyID, jti, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockTokenStore)(nil).Issue), ctx, userID, familyID, jti, ttl)
+}
+
+// RevokeFamily mocks base method.
+func (m *MockTokenStore) RevokeFamily(ctx context.Context, userID, familyID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFamily", ctx, userID, familyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeFamily indicates an expected call of RevokeFamily.
+func (mr *MockTokenStoreMockRecorder) RevokeFamily(ctx, userID, familyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFamily", reflect.TypeOf((*MockTokenStore)(nil).RevokeFamily), ctx, userID, familyID)
+}
+
+// RevokeUser mocks base method.
+func (m *MockTokenStore) RevokeUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUser indicates an expected call of RevokeUser.
+func (mr *MockTokenStoreMockRecorder) RevokeUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUser", reflect.TypeOf((*MockTokenStore)(nil).RevokeUser), ctx, userID)
+}
+
+// Rotate mocks base method.
+func (m *MockTokenStore) Rotate(ctx context.Context, userID, familyID, currentJTI, nextJTI string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, userID, familyID, currentJTI, nextJTI, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockTokenStoreMockRecorder) Rotate(ctx, userID, familyID, currentJTI, nextJTI, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockTokenStore)(nil).Rotate), ctx, userID, familyID, currentJTI, nextJTI, ttl)
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./pkg/waitingroom/waitingroom.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./pkg/waitingroom/waitingroom.go -destination=test/mock/./pkg/waitingroom/waitingroom_mock.go
+//
+
+// Package mock_waitingroom is a generated GoMock package.
+package mock_waitingroom
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	waitingroom "github.com/TrinityKnights/Backend/pkg/waitingroom"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWaitingRoom is a mock of WaitingRoom interface.
+type MockWaitingRoom struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitingRoomMockRecorder
+	isgomock struct{}
+}
+
+// MockWaitingRoomMockRecorder is the mock recorder for MockWaitingRoom.
+type MockWaitingRoomMockRecorder struct {
+	mock *MockWaitingRoom
+}
+
+// NewMockWaitingRoom creates a new mock instance.
+func NewMockWaitingRoom(ctrl *gomock.Controller) *MockWaitingRoom {
+	mock := &MockWaitingRoom{ctrl: ctrl}
+	mock.recorder = &MockWaitingRoomMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitingRoom) EXPECT() *MockWaitingRoomMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockWaitingRoom) Close(ctx context.Context, eventID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockWaitingRoomMockRecorder) Close(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockWaitingRoom)(nil).Close), ctx, eventID)
+}
+
+// Depth mocks base method.
+func (m *MockWaitingRoom) Depth(ctx context.Context, eventID uint) (*waitingroom.Depth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Depth", ctx, eventID)
+	ret0, _ := ret[0].(*waitingroom.Depth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Depth indicates an expected call of Depth.
+func (mr *MockWaitingRoomMockRecorder) Depth(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Depth", reflect.TypeOf((*MockWaitingRoom)(nil).Depth), ctx, eventID)
+}
+
+// Join mocks base method.
+func (m *MockWaitingRoom) Join(ctx context.Context, eventID uint, token string, ttl time.Duration) (*waitingroom.Position, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Join", ctx, eventID, token, ttl)
+	ret0, _ := ret[0].(*waitingroom.Position)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Join indicates an expected call of Join.
+func (mr *MockWaitingRoomMockRecorder) Join(ctx, eventID, token, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Join", reflect.TypeOf((*MockWaitingRoom)(nil).Join), ctx, eventID, token, ttl)
+}
+
+// Open mocks base method.
+func (m *MockWaitingRoom) Open(ctx context.Context, eventID uint, rate int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ctx, eventID, rate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockWaitingRoomMockRecorder) Open(ctx, eventID, rate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockWaitingRoom)(nil).Open), ctx, eventID, rate)
+}
+
+// Status mocks base method.
+func (m *MockWaitingRoom) Status(ctx context.Context, eventID uint, token string) (*waitingroom.Position, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", ctx, eventID, token)
+	ret0, _ := ret[0].(*waitingroom.Position)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockWaitingRoomMockRecorder) Status(ctx, eventID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockWaitingRoom)(nil).Status), ctx, eventID, token)
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/allocation/allocation_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/repository/allocation/allocation_repository.go -destination=test/mock/repository/allocation/allocation_repository_mock.go
+//
+
+// Package mock_allocation is a generated GoMock package.
+package mock_allocation
+
+import (
+	reflect "reflect"
+
+	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockAllocationRepository is a mock of AllocationRepository interface.
+type MockAllocationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAllocationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAllocationRepositoryMockRecorder is the mock recorder for MockAllocationRepository.
+type MockAllocationRepositoryMockRecorder struct {
+	mock *MockAllocationRepository
+}
+
+// NewMockAllocationRepository creates a new mock instance.
+func NewMockAllocationRepository(ctrl *gomock.Controller) *MockAllocationRepository {
+	mock := &MockAllocationRepository{ctrl: ctrl}
+	mock.recorder = &MockAllocationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAllocationRepository) EXPECT() *MockAllocationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountIssued mocks base method.
+func (m *MockAllocationRepository) CountIssued(db *gorm.DB, allocationIDs []uint) (map[uint]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountIssued", db, allocationIDs)
+	ret0, _ := ret[0].(map[uint]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountIssued indicates an expected call of CountIssued.
+func (mr *MockAllocationRepositoryMockRecorder) CountIssued(db, allocationIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountIssued", reflect.TypeOf((*MockAllocationRepository)(nil).CountIssued), db, allocationIDs)
+}
+
+// Create mocks base method.
+func (m *MockAllocationRepository) Create(db *gorm.DB, entity *entity.Allocation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAllocationRepositoryMockRecorder) Create(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAllocationRepository)(nil).Create), db, entity)
+}
+
+// Delete mocks base method.
+func (m *MockAllocationRepository) Delete(db *gorm.DB, entity *entity.Allocation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAllocationRepositoryMockRecorder) Delete(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAllocationRepository)(nil).Delete), db, entity)
+}
+
+// GetByEventID mocks base method.
+func (m *MockAllocationRepository) GetByEventID(db *gorm.DB, allocations *[]entity.Allocation, eventID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEventID", db, allocations, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByEventID indicates an expected call of GetByEventID.
+func (mr *MockAllocationRepositoryMockRecorder) GetByEventID(db, allocations, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEventID", reflect.TypeOf((*MockAllocationRepository)(nil).GetByEventID), db, allocations, eventID)
+}
+
+// GetByID mocks base method.
+func (m *MockAllocationRepository) GetByID(db *gorm.DB, allocation *entity.Allocation, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", db, allocation, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockAllocationRepositoryMockRecorder) GetByID(db, allocation, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAllocationRepository)(nil).GetByID), db, allocation, id)
+}
+
+// Update mocks base method.
+func (m *MockAllocationRepository) Update(db *gorm.DB, entity *entity.Allocation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAllocationRepositoryMockRecorder) Update(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAllocationRepository)(nil).Update), db, entity)
+}
@@ -0,0 +1,169 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/attendee/attendee_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/repository/attendee/attendee_repository.go -destination=test/mock/repository/attendee/attendee_repository_mock.go
+//
+
+// Package mock_attendee is a generated GoMock package.
+package mock_attendee
+
+import (
+	reflect "reflect"
+
+	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockAttendeeRepository is a mock of AttendeeRepository interface.
+type MockAttendeeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttendeeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAttendeeRepositoryMockRecorder is the mock recorder for MockAttendeeRepository.
+type MockAttendeeRepositoryMockRecorder struct {
+	mock *MockAttendeeRepository
+}
+
+// NewMockAttendeeRepository creates a new mock instance.
+func NewMockAttendeeRepository(ctrl *gomock.Controller) *MockAttendeeRepository {
+	mock := &MockAttendeeRepository{ctrl: ctrl}
+	mock.recorder = &MockAttendeeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttendeeRepository) EXPECT() *MockAttendeeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAttendeeRepository) Create(db *gorm.DB, entity *entity.AttendeeQuestion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAttendeeRepositoryMockRecorder) Create(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAttendeeRepository)(nil).Create), db, entity)
+}
+
+// Delete mocks base method.
+func (m *MockAttendeeRepository) Delete(db *gorm.DB, entity *entity.AttendeeQuestion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttendeeRepositoryMockRecorder) Delete(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttendeeRepository)(nil).Delete), db, entity)
+}
+
+// GetAllByEvent mocks base method.
+func (m *MockAttendeeRepository) GetAllByEvent(db *gorm.DB, tickets *[]*entity.Ticket, eventID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByEvent", db, tickets, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAllByEvent indicates an expected call of GetAllByEvent.
+func (mr *MockAttendeeRepositoryMockRecorder) GetAllByEvent(db, tickets, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByEvent", reflect.TypeOf((*MockAttendeeRepository)(nil).GetAllByEvent), db, tickets, eventID)
+}
+
+// GetPaginatedByEvent mocks base method.
+func (m *MockAttendeeRepository) GetPaginatedByEvent(db *gorm.DB, tickets *[]*entity.Ticket, eventID uint, page, size int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaginatedByEvent", db, tickets, eventID, page, size)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaginatedByEvent indicates an expected call of GetPaginatedByEvent.
+func (mr *MockAttendeeRepositoryMockRecorder) GetPaginatedByEvent(db, tickets, eventID, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginatedByEvent", reflect.TypeOf((*MockAttendeeRepository)(nil).GetPaginatedByEvent), db, tickets, eventID, page, size)
+}
+
+// GetQuestionByID mocks base method.
+func (m *MockAttendeeRepository) GetQuestionByID(db *gorm.DB, question *entity.AttendeeQuestion, eventID, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestionByID", db, question, eventID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetQuestionByID indicates an expected call of GetQuestionByID.
+func (mr *MockAttendeeRepositoryMockRecorder) GetQuestionByID(db, question, eventID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestionByID", reflect.TypeOf((*MockAttendeeRepository)(nil).GetQuestionByID), db, question, eventID, id)
+}
+
+// GetQuestionsByEventID mocks base method.
+func (m *MockAttendeeRepository) GetQuestionsByEventID(db *gorm.DB, questions *[]entity.AttendeeQuestion, eventID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestionsByEventID", db, questions, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetQuestionsByEventID indicates an expected call of GetQuestionsByEventID.
+func (mr *MockAttendeeRepositoryMockRecorder) GetQuestionsByEventID(db, questions, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestionsByEventID", reflect.TypeOf((*MockAttendeeRepository)(nil).GetQuestionsByEventID), db, questions, eventID)
+}
+
+// ReplaceAnswers mocks base method.
+func (m *MockAttendeeRepository) ReplaceAnswers(db *gorm.DB, ticketID string, answers []entity.AttendeeAnswer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceAnswers", db, ticketID, answers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceAnswers indicates an expected call of ReplaceAnswers.
+func (mr *MockAttendeeRepositoryMockRecorder) ReplaceAnswers(db, ticketID, answers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceAnswers", reflect.TypeOf((*MockAttendeeRepository)(nil).ReplaceAnswers), db, ticketID, answers)
+}
+
+// Update mocks base method.
+func (m *MockAttendeeRepository) Update(db *gorm.DB, entity *entity.AttendeeQuestion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAttendeeRepositoryMockRecorder) Update(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAttendeeRepository)(nil).Update), db, entity)
+}
+
+// UpdateTicketAttendee mocks base method.
+func (m *MockAttendeeRepository) UpdateTicketAttendee(db *gorm.DB, ticketID, name string, email *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTicketAttendee", db, ticketID, name, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTicketAttendee indicates an expected call of UpdateTicketAttendee.
+func (mr *MockAttendeeRepositoryMockRecorder) UpdateTicketAttendee(db, ticketID, name, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicketAttendee", reflect.TypeOf((*MockAttendeeRepository)(nil).UpdateTicketAttendee), db, ticketID, name, email)
+}
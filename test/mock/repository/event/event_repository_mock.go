@@ -11,6 +11,7 @@ package mock_event
 
 import (
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
 	model "github.com/TrinityKnights/Backend/internal/domain/model"
@@ -70,6 +71,21 @@ func (mr *MockEventRepositoryMockRecorder) Delete(db, entity any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEventRepository)(nil).Delete), db, entity)
 }
 
+// FindOnSaleIDs mocks base method.
+func (m *MockEventRepository) FindOnSaleIDs(db *gorm.DB, now time.Time) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOnSaleIDs", db, now)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOnSaleIDs indicates an expected call of FindOnSaleIDs.
+func (mr *MockEventRepositoryMockRecorder) FindOnSaleIDs(db, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOnSaleIDs", reflect.TypeOf((*MockEventRepository)(nil).FindOnSaleIDs), db, now)
+}
+
 // GetByID mocks base method.
 func (m *MockEventRepository) GetByID(db *gorm.DB, event *entity.Event, id uint) error {
 	m.ctrl.T.Helper()
@@ -84,6 +100,49 @@ func (mr *MockEventRepositoryMockRecorder) GetByID(db, event, id any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockEventRepository)(nil).GetByID), db, event, id)
 }
 
+// GetByOrderID mocks base method.
+func (m *MockEventRepository) GetByOrderID(db *gorm.DB, events *[]entity.Event, orderID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOrderID", db, events, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByOrderID indicates an expected call of GetByOrderID.
+func (mr *MockEventRepositoryMockRecorder) GetByOrderID(db, events, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOrderID", reflect.TypeOf((*MockEventRepository)(nil).GetByOrderID), db, events, orderID)
+}
+
+// GetHolders mocks base method.
+func (m *MockEventRepository) GetHolders(db *gorm.DB, eventID uint) ([]model.EventHolder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHolders", db, eventID)
+	ret0, _ := ret[0].([]model.EventHolder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHolders indicates an expected call of GetHolders.
+func (mr *MockEventRepositoryMockRecorder) GetHolders(db, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHolders", reflect.TypeOf((*MockEventRepository)(nil).GetHolders), db, eventID)
+}
+
+// GetOverlapping mocks base method.
+func (m *MockEventRepository) GetOverlapping(db *gorm.DB, events *[]entity.Event, venueID, excludeID uint, startsAt, endsAt time.Time, buffer time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverlapping", db, events, venueID, excludeID, startsAt, endsAt, buffer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetOverlapping indicates an expected call of GetOverlapping.
+func (mr *MockEventRepositoryMockRecorder) GetOverlapping(db, events, venueID, excludeID, startsAt, endsAt, buffer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverlapping", reflect.TypeOf((*MockEventRepository)(nil).GetOverlapping), db, events, venueID, excludeID, startsAt, endsAt, buffer)
+}
+
 // GetPaginated mocks base method.
 func (m *MockEventRepository) GetPaginated(db *gorm.DB, events *[]entity.Event, opts *model.EventQueryOptions) (int64, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +158,65 @@ func (mr *MockEventRepositoryMockRecorder) GetPaginated(db, events, opts any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginated", reflect.TypeOf((*MockEventRepository)(nil).GetPaginated), db, events, opts)
 }
 
+// GetVenueTimezone mocks base method.
+func (m *MockEventRepository) GetVenueTimezone(db *gorm.DB, venueID uint) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVenueTimezone", db, venueID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVenueTimezone indicates an expected call of GetVenueTimezone.
+func (mr *MockEventRepositoryMockRecorder) GetVenueTimezone(db, venueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVenueTimezone", reflect.TypeOf((*MockEventRepository)(nil).GetVenueTimezone), db, venueID)
+}
+
+// LockVenue mocks base method.
+func (m *MockEventRepository) LockVenue(db *gorm.DB, venueID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockVenue", db, venueID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockVenue indicates an expected call of LockVenue.
+func (mr *MockEventRepositoryMockRecorder) LockVenue(db, venueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockVenue", reflect.TypeOf((*MockEventRepository)(nil).LockVenue), db, venueID)
+}
+
+// MarkOrdersRefundEligible mocks base method.
+func (m *MockEventRepository) MarkOrdersRefundEligible(db *gorm.DB, eventID uint, at time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOrdersRefundEligible", db, eventID, at)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkOrdersRefundEligible indicates an expected call of MarkOrdersRefundEligible.
+func (mr *MockEventRepositoryMockRecorder) MarkOrdersRefundEligible(db, eventID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOrdersRefundEligible", reflect.TypeOf((*MockEventRepository)(nil).MarkOrdersRefundEligible), db, eventID, at)
+}
+
+// PublishDue mocks base method.
+func (m *MockEventRepository) PublishDue(db *gorm.DB, now time.Time) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishDue", db, now)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishDue indicates an expected call of PublishDue.
+func (mr *MockEventRepositoryMockRecorder) PublishDue(db, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishDue", reflect.TypeOf((*MockEventRepository)(nil).PublishDue), db, now)
+}
+
 // Update mocks base method.
 func (m *MockEventRepository) Update(db *gorm.DB, entity *entity.Event) error {
 	m.ctrl.T.Helper()
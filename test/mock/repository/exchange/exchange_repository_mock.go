@@ -0,0 +1,183 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/exchange/exchange_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/repository/exchange/exchange_repository.go -destination=test/mock/repository/exchange/exchange_repository_mock.go
+//
+
+// Package mock_exchange is a generated GoMock package.
+package mock_exchange
+
+import (
+	reflect "reflect"
+
+	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockExchangeRepository is a mock of ExchangeRepository interface.
+type MockExchangeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockExchangeRepositoryMockRecorder is the mock recorder for MockExchangeRepository.
+type MockExchangeRepositoryMockRecorder struct {
+	mock *MockExchangeRepository
+}
+
+// NewMockExchangeRepository creates a new mock instance.
+func NewMockExchangeRepository(ctrl *gomock.Controller) *MockExchangeRepository {
+	mock := &MockExchangeRepository{ctrl: ctrl}
+	mock.recorder = &MockExchangeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRepository) EXPECT() *MockExchangeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockExchangeRepository) Complete(db *gorm.DB, exchange *entity.TicketExchange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", db, exchange)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockExchangeRepositoryMockRecorder) Complete(db, exchange any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockExchangeRepository)(nil).Complete), db, exchange)
+}
+
+// Create mocks base method.
+func (m *MockExchangeRepository) Create(db *gorm.DB, entity *entity.TicketExchange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExchangeRepositoryMockRecorder) Create(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExchangeRepository)(nil).Create), db, entity)
+}
+
+// CreateCredit mocks base method.
+func (m *MockExchangeRepository) CreateCredit(db *gorm.DB, credit *entity.UserCredit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCredit", db, credit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCredit indicates an expected call of CreateCredit.
+func (mr *MockExchangeRepositoryMockRecorder) CreateCredit(db, credit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCredit", reflect.TypeOf((*MockExchangeRepository)(nil).CreateCredit), db, credit)
+}
+
+// Delete mocks base method.
+func (m *MockExchangeRepository) Delete(db *gorm.DB, entity *entity.TicketExchange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExchangeRepositoryMockRecorder) Delete(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExchangeRepository)(nil).Delete), db, entity)
+}
+
+// Expire mocks base method.
+func (m *MockExchangeRepository) Expire(db *gorm.DB, exchange *entity.TicketExchange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", db, exchange)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Expire indicates an expected call of Expire.
+func (mr *MockExchangeRepositoryMockRecorder) Expire(db, exchange any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockExchangeRepository)(nil).Expire), db, exchange)
+}
+
+// Fail mocks base method.
+func (m *MockExchangeRepository) Fail(db *gorm.DB, exchange *entity.TicketExchange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fail", db, exchange)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Fail indicates an expected call of Fail.
+func (mr *MockExchangeRepositoryMockRecorder) Fail(db, exchange any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fail", reflect.TypeOf((*MockExchangeRepository)(nil).Fail), db, exchange)
+}
+
+// GetByID mocks base method.
+func (m *MockExchangeRepository) GetByID(db *gorm.DB, exchange *entity.TicketExchange, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", db, exchange, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockExchangeRepositoryMockRecorder) GetByID(db, exchange, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockExchangeRepository)(nil).GetByID), db, exchange, id)
+}
+
+// GetPaginated mocks base method.
+func (m *MockExchangeRepository) GetPaginated(db *gorm.DB, exchanges *[]entity.TicketExchange, userID string, page, size int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaginated", db, exchanges, userID, page, size)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaginated indicates an expected call of GetPaginated.
+func (mr *MockExchangeRepositoryMockRecorder) GetPaginated(db, exchanges, userID, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginated", reflect.TypeOf((*MockExchangeRepository)(nil).GetPaginated), db, exchanges, userID, page, size)
+}
+
+// GetPendingByTicketID mocks base method.
+func (m *MockExchangeRepository) GetPendingByTicketID(db *gorm.DB, exchange *entity.TicketExchange, ticketID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingByTicketID", db, exchange, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetPendingByTicketID indicates an expected call of GetPendingByTicketID.
+func (mr *MockExchangeRepositoryMockRecorder) GetPendingByTicketID(db, exchange, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingByTicketID", reflect.TypeOf((*MockExchangeRepository)(nil).GetPendingByTicketID), db, exchange, ticketID)
+}
+
+// Update mocks base method.
+func (m *MockExchangeRepository) Update(db *gorm.DB, entity *entity.TicketExchange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockExchangeRepositoryMockRecorder) Update(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockExchangeRepository)(nil).Update), db, entity)
+}
@@ -0,0 +1,187 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/group/group_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/repository/group/group_repository.go -destination=test/mock/repository/group/group_repository_mock.go
+//
+
+// Package mock_group is a generated GoMock package.
+package mock_group
+
+import (
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
+	model "github.com/TrinityKnights/Backend/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockGroupRepository is a mock of GroupRepository interface.
+type MockGroupRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGroupRepositoryMockRecorder is the mock recorder for MockGroupRepository.
+type MockGroupRepositoryMockRecorder struct {
+	mock *MockGroupRepository
+}
+
+// NewMockGroupRepository creates a new mock instance.
+func NewMockGroupRepository(ctrl *gomock.Controller) *MockGroupRepository {
+	mock := &MockGroupRepository{ctrl: ctrl}
+	mock.recorder = &MockGroupRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupRepository) EXPECT() *MockGroupRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockGroupRepository) Create(db *gorm.DB, entity *entity.GroupBooking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockGroupRepositoryMockRecorder) Create(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGroupRepository)(nil).Create), db, entity)
+}
+
+// CreateShare mocks base method.
+func (m *MockGroupRepository) CreateShare(db *gorm.DB, share *entity.GroupShare) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShare", db, share)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateShare indicates an expected call of CreateShare.
+func (mr *MockGroupRepositoryMockRecorder) CreateShare(db, share any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShare", reflect.TypeOf((*MockGroupRepository)(nil).CreateShare), db, share)
+}
+
+// Delete mocks base method.
+func (m *MockGroupRepository) Delete(db *gorm.DB, entity *entity.GroupBooking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGroupRepositoryMockRecorder) Delete(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGroupRepository)(nil).Delete), db, entity)
+}
+
+// Finalize mocks base method.
+func (m *MockGroupRepository) Finalize(db *gorm.DB, groupID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Finalize", db, groupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Finalize indicates an expected call of Finalize.
+func (mr *MockGroupRepositoryMockRecorder) Finalize(db, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finalize", reflect.TypeOf((*MockGroupRepository)(nil).Finalize), db, groupID)
+}
+
+// GetByID mocks base method.
+func (m *MockGroupRepository) GetByID(db *gorm.DB, group *entity.GroupBooking, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", db, group, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockGroupRepositoryMockRecorder) GetByID(db, group, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGroupRepository)(nil).GetByID), db, group, id)
+}
+
+// GetPaginated mocks base method.
+func (m *MockGroupRepository) GetPaginated(db *gorm.DB, groups *[]entity.GroupBooking, userID, email string, page, size int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaginated", db, groups, userID, email, page, size)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaginated indicates an expected call of GetPaginated.
+func (mr *MockGroupRepositoryMockRecorder) GetPaginated(db, groups, userID, email, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginated", reflect.TypeOf((*MockGroupRepository)(nil).GetPaginated), db, groups, userID, email, page, size)
+}
+
+// GetPastDeadline mocks base method.
+func (m *MockGroupRepository) GetPastDeadline(db *gorm.DB, now time.Time) ([]entity.GroupBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPastDeadline", db, now)
+	ret0, _ := ret[0].([]entity.GroupBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPastDeadline indicates an expected call of GetPastDeadline.
+func (mr *MockGroupRepositoryMockRecorder) GetPastDeadline(db, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPastDeadline", reflect.TypeOf((*MockGroupRepository)(nil).GetPastDeadline), db, now)
+}
+
+// LockInvitedShares mocks base method.
+func (m *MockGroupRepository) LockInvitedShares(db *gorm.DB, groupID uint) ([]entity.GroupShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockInvitedShares", db, groupID)
+	ret0, _ := ret[0].([]entity.GroupShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockInvitedShares indicates an expected call of LockInvitedShares.
+func (mr *MockGroupRepositoryMockRecorder) LockInvitedShares(db, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockInvitedShares", reflect.TypeOf((*MockGroupRepository)(nil).LockInvitedShares), db, groupID)
+}
+
+// SettleShare mocks base method.
+func (m *MockGroupRepository) SettleShare(db *gorm.DB, orderID uint, status model.GroupShareStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettleShare", db, orderID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SettleShare indicates an expected call of SettleShare.
+func (mr *MockGroupRepositoryMockRecorder) SettleShare(db, orderID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleShare", reflect.TypeOf((*MockGroupRepository)(nil).SettleShare), db, orderID, status)
+}
+
+// Update mocks base method.
+func (m *MockGroupRepository) Update(db *gorm.DB, entity *entity.GroupBooking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockGroupRepositoryMockRecorder) Update(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockGroupRepository)(nil).Update), db, entity)
+}
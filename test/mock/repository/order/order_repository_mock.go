@@ -11,6 +11,7 @@ package mock_order
 
 import (
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,21 @@ func (m *MockOrderRepository) EXPECT() *MockOrderRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AttachGuestOrders mocks base method.
+func (m *MockOrderRepository) AttachGuestOrders(db *gorm.DB, userID, email string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachGuestOrders", db, userID, email)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachGuestOrders indicates an expected call of AttachGuestOrders.
+func (mr *MockOrderRepositoryMockRecorder) AttachGuestOrders(db, userID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachGuestOrders", reflect.TypeOf((*MockOrderRepository)(nil).AttachGuestOrders), db, userID, email)
+}
+
 // Create mocks base method.
 func (m *MockOrderRepository) Create(db *gorm.DB, entity *entity.Order) error {
 	m.ctrl.T.Helper()
@@ -111,6 +127,20 @@ func (mr *MockOrderRepositoryMockRecorder) GetByIDWithDetails(db, order, id any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDWithDetails", reflect.TypeOf((*MockOrderRepository)(nil).GetByIDWithDetails), db, order, id)
 }
 
+// GetGuestOrderByCode mocks base method.
+func (m *MockOrderRepository) GetGuestOrderByCode(db *gorm.DB, order *entity.Order, code, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuestOrderByCode", db, order, code, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetGuestOrderByCode indicates an expected call of GetGuestOrderByCode.
+func (mr *MockOrderRepositoryMockRecorder) GetGuestOrderByCode(db, order, code, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestOrderByCode", reflect.TypeOf((*MockOrderRepository)(nil).GetGuestOrderByCode), db, order, code, email)
+}
+
 // GetPaginatedOrders mocks base method.
 func (m *MockOrderRepository) GetPaginatedOrders(db *gorm.DB, orders *[]entity.Order, page, size int, sort, order string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -126,6 +156,20 @@ func (mr *MockOrderRepositoryMockRecorder) GetPaginatedOrders(db, orders, page,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginatedOrders", reflect.TypeOf((*MockOrderRepository)(nil).GetPaginatedOrders), db, orders, page, size, sort, order)
 }
 
+// MarkRefundEligible mocks base method.
+func (m *MockOrderRepository) MarkRefundEligible(db *gorm.DB, orderID uint, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRefundEligible", db, orderID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRefundEligible indicates an expected call of MarkRefundEligible.
+func (mr *MockOrderRepositoryMockRecorder) MarkRefundEligible(db, orderID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRefundEligible", reflect.TypeOf((*MockOrderRepository)(nil).MarkRefundEligible), db, orderID, at)
+}
+
 // Update mocks base method.
 func (m *MockOrderRepository) Update(db *gorm.DB, entity *entity.Order) error {
 	m.ctrl.T.Helper()
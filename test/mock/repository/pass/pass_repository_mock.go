@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/pass/pass_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/repository/pass/pass_repository.go -destination=test/mock/repository/pass/pass_repository_mock.go
+//
+
+// Package mock_pass is a generated GoMock package.
+package mock_pass
+
+import (
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockPassRepository is a mock of PassRepository interface.
+type MockPassRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPassRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPassRepositoryMockRecorder is the mock recorder for MockPassRepository.
+type MockPassRepositoryMockRecorder struct {
+	mock *MockPassRepository
+}
+
+// NewMockPassRepository creates a new mock instance.
+func NewMockPassRepository(ctrl *gomock.Controller) *MockPassRepository {
+	mock := &MockPassRepository{ctrl: ctrl}
+	mock.recorder = &MockPassRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPassRepository) EXPECT() *MockPassRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPassRepository) Create(db *gorm.DB, entity *entity.Pass) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPassRepositoryMockRecorder) Create(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPassRepository)(nil).Create), db, entity)
+}
+
+// Delete mocks base method.
+func (m *MockPassRepository) Delete(db *gorm.DB, entity *entity.Pass) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPassRepositoryMockRecorder) Delete(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPassRepository)(nil).Delete), db, entity)
+}
+
+// GetAll mocks base method.
+func (m *MockPassRepository) GetAll(db *gorm.DB, passes *[]entity.Pass) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", db, passes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockPassRepositoryMockRecorder) GetAll(db, passes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPassRepository)(nil).GetAll), db, passes)
+}
+
+// GetByID mocks base method.
+func (m *MockPassRepository) GetByID(db *gorm.DB, pass *entity.Pass, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", db, pass, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockPassRepositoryMockRecorder) GetByID(db, pass, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPassRepository)(nil).GetByID), db, pass, id)
+}
+
+// GetCoveredEvents mocks base method.
+func (m *MockPassRepository) GetCoveredEvents(db *gorm.DB, pass *entity.Pass, from time.Time) ([]entity.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoveredEvents", db, pass, from)
+	ret0, _ := ret[0].([]entity.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoveredEvents indicates an expected call of GetCoveredEvents.
+func (mr *MockPassRepositoryMockRecorder) GetCoveredEvents(db, pass, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoveredEvents", reflect.TypeOf((*MockPassRepository)(nil).GetCoveredEvents), db, pass, from)
+}
+
+// GetOrderByCode mocks base method.
+func (m *MockPassRepository) GetOrderByCode(db *gorm.DB, order *entity.Order, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderByCode", db, order, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetOrderByCode indicates an expected call of GetOrderByCode.
+func (mr *MockPassRepositoryMockRecorder) GetOrderByCode(db, order, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderByCode", reflect.TypeOf((*MockPassRepository)(nil).GetOrderByCode), db, order, code)
+}
+
+// GetOrderTicket mocks base method.
+func (m *MockPassRepository) GetOrderTicket(db *gorm.DB, ticket *entity.Ticket, orderID, eventID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderTicket", db, ticket, orderID, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetOrderTicket indicates an expected call of GetOrderTicket.
+func (mr *MockPassRepositoryMockRecorder) GetOrderTicket(db, ticket, orderID, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderTicket", reflect.TypeOf((*MockPassRepository)(nil).GetOrderTicket), db, ticket, orderID, eventID)
+}
+
+// Update mocks base method.
+func (m *MockPassRepository) Update(db *gorm.DB, entity *entity.Pass) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPassRepositoryMockRecorder) Update(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPassRepository)(nil).Update), db, entity)
+}
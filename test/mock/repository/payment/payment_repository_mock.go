@@ -10,7 +10,6 @@
 package mock_payment
 
 import (
-	context "context"
 	reflect "reflect"
 
 	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
@@ -59,30 +58,30 @@ func (mr *MockPaymentRepositoryMockRecorder) Find(db, filter any) *gomock.Call {
 }
 
 // GetByTransactionID mocks base method.
-func (m *MockPaymentRepository) GetByTransactionID(ctx context.Context, transactionID string) (*entity.Payment, error) {
+func (m *MockPaymentRepository) GetByTransactionID(db *gorm.DB, transactionID string) (*entity.Payment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByTransactionID", ctx, transactionID)
+	ret := m.ctrl.Call(m, "GetByTransactionID", db, transactionID)
 	ret0, _ := ret[0].(*entity.Payment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByTransactionID indicates an expected call of GetByTransactionID.
-func (mr *MockPaymentRepositoryMockRecorder) GetByTransactionID(ctx, transactionID any) *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) GetByTransactionID(db, transactionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTransactionID", reflect.TypeOf((*MockPaymentRepository)(nil).GetByTransactionID), ctx, transactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTransactionID", reflect.TypeOf((*MockPaymentRepository)(nil).GetByTransactionID), db, transactionID)
 }
 
 // UpdatePaymentStatus mocks base method.
-func (m *MockPaymentRepository) UpdatePaymentStatus(ctx context.Context, payment *model.PaymentUpdateRequest) error {
+func (m *MockPaymentRepository) UpdatePaymentStatus(db *gorm.DB, payment *model.PaymentUpdateRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePaymentStatus", ctx, payment)
+	ret := m.ctrl.Call(m, "UpdatePaymentStatus", db, payment)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdatePaymentStatus indicates an expected call of UpdatePaymentStatus.
-func (mr *MockPaymentRepositoryMockRecorder) UpdatePaymentStatus(ctx, payment any) *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) UpdatePaymentStatus(db, payment any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentStatus", reflect.TypeOf((*MockPaymentRepository)(nil).UpdatePaymentStatus), ctx, payment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentStatus", reflect.TypeOf((*MockPaymentRepository)(nil).UpdatePaymentStatus), db, payment)
 }
@@ -0,0 +1,184 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/product/product_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/repository/product/product_repository.go -destination=test/mock/repository/product/product_repository_mock.go
+//
+
+// Package mock_product is a generated GoMock package.
+package mock_product
+
+import (
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockProductRepository is a mock of ProductRepository interface.
+type MockProductRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockProductRepositoryMockRecorder is the mock recorder for MockProductRepository.
+type MockProductRepositoryMockRecorder struct {
+	mock *MockProductRepository
+}
+
+// NewMockProductRepository creates a new mock instance.
+func NewMockProductRepository(ctrl *gomock.Controller) *MockProductRepository {
+	mock := &MockProductRepository{ctrl: ctrl}
+	mock.recorder = &MockProductRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductRepository) EXPECT() *MockProductRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockProductRepository) Create(db *gorm.DB, entity *entity.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProductRepositoryMockRecorder) Create(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProductRepository)(nil).Create), db, entity)
+}
+
+// DecrementStock mocks base method.
+func (m *MockProductRepository) DecrementStock(db *gorm.DB, id uint, quantity int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecrementStock", db, id, quantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DecrementStock indicates an expected call of DecrementStock.
+func (mr *MockProductRepositoryMockRecorder) DecrementStock(db, id, quantity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecrementStock", reflect.TypeOf((*MockProductRepository)(nil).DecrementStock), db, id, quantity)
+}
+
+// Delete mocks base method.
+func (m *MockProductRepository) Delete(db *gorm.DB, entity *entity.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProductRepositoryMockRecorder) Delete(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProductRepository)(nil).Delete), db, entity)
+}
+
+// GetByEventID mocks base method.
+func (m *MockProductRepository) GetByEventID(db *gorm.DB, products *[]entity.Product, eventID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEventID", db, products, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByEventID indicates an expected call of GetByEventID.
+func (mr *MockProductRepositoryMockRecorder) GetByEventID(db, products, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEventID", reflect.TypeOf((*MockProductRepository)(nil).GetByEventID), db, products, eventID)
+}
+
+// GetByID mocks base method.
+func (m *MockProductRepository) GetByID(db *gorm.DB, product *entity.Product, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", db, product, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockProductRepositoryMockRecorder) GetByID(db, product, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockProductRepository)(nil).GetByID), db, product, id)
+}
+
+// GetVoucherByCode mocks base method.
+func (m *MockProductRepository) GetVoucherByCode(db *gorm.DB, voucher *entity.Voucher, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVoucherByCode", db, voucher, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetVoucherByCode indicates an expected call of GetVoucherByCode.
+func (mr *MockProductRepositoryMockRecorder) GetVoucherByCode(db, voucher, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVoucherByCode", reflect.TypeOf((*MockProductRepository)(nil).GetVoucherByCode), db, voucher, code)
+}
+
+// LockByIDs mocks base method.
+func (m *MockProductRepository) LockByIDs(db *gorm.DB, eventID uint, ids []uint) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockByIDs", db, eventID, ids)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockByIDs indicates an expected call of LockByIDs.
+func (mr *MockProductRepositoryMockRecorder) LockByIDs(db, eventID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockByIDs", reflect.TypeOf((*MockProductRepository)(nil).LockByIDs), db, eventID, ids)
+}
+
+// RedeemVoucher mocks base method.
+func (m *MockProductRepository) RedeemVoucher(db *gorm.DB, id uint, redeemedBy string, redeemedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemVoucher", db, id, redeemedBy, redeemedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedeemVoucher indicates an expected call of RedeemVoucher.
+func (mr *MockProductRepositoryMockRecorder) RedeemVoucher(db, id, redeemedBy, redeemedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemVoucher", reflect.TypeOf((*MockProductRepository)(nil).RedeemVoucher), db, id, redeemedBy, redeemedAt)
+}
+
+// RestockByOrderID mocks base method.
+func (m *MockProductRepository) RestockByOrderID(db *gorm.DB, orderID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestockByOrderID", db, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestockByOrderID indicates an expected call of RestockByOrderID.
+func (mr *MockProductRepositoryMockRecorder) RestockByOrderID(db, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestockByOrderID", reflect.TypeOf((*MockProductRepository)(nil).RestockByOrderID), db, orderID)
+}
+
+// Update mocks base method.
+func (m *MockProductRepository) Update(db *gorm.DB, entity *entity.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProductRepositoryMockRecorder) Update(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProductRepository)(nil).Update), db, entity)
+}
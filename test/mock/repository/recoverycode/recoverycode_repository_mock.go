@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/recoverycode/recoverycode_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/repository/recoverycode/recoverycode_repository.go -destination=test/mock/repository/recoverycode/recoverycode_repository_mock.go
+//
+
+// Package mock_recoverycode is a generated GoMock package.
+package mock_recoverycode
+
+import (
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockRecoveryCodeRepository is a mock of RecoveryCodeRepository interface.
+type MockRecoveryCodeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecoveryCodeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRecoveryCodeRepositoryMockRecorder is the mock recorder for MockRecoveryCodeRepository.
+type MockRecoveryCodeRepositoryMockRecorder struct {
+	mock *MockRecoveryCodeRepository
+}
+
+// NewMockRecoveryCodeRepository creates a new mock instance.
+func NewMockRecoveryCodeRepository(ctrl *gomock.Controller) *MockRecoveryCodeRepository {
+	mock := &MockRecoveryCodeRepository{ctrl: ctrl}
+	mock.recorder = &MockRecoveryCodeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecoveryCodeRepository) EXPECT() *MockRecoveryCodeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRecoveryCodeRepository) Create(db *gorm.DB, entity *entity.RecoveryCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRecoveryCodeRepositoryMockRecorder) Create(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRecoveryCodeRepository)(nil).Create), db, entity)
+}
+
+// Delete mocks base method.
+func (m *MockRecoveryCodeRepository) Delete(db *gorm.DB, entity *entity.RecoveryCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRecoveryCodeRepositoryMockRecorder) Delete(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRecoveryCodeRepository)(nil).Delete), db, entity)
+}
+
+// DeleteByUserID mocks base method.
+func (m *MockRecoveryCodeRepository) DeleteByUserID(db *gorm.DB, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserID", db, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserID indicates an expected call of DeleteByUserID.
+func (mr *MockRecoveryCodeRepositoryMockRecorder) DeleteByUserID(db, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserID", reflect.TypeOf((*MockRecoveryCodeRepository)(nil).DeleteByUserID), db, userID)
+}
+
+// Redeem mocks base method.
+func (m *MockRecoveryCodeRepository) Redeem(db *gorm.DB, userID, hash string, at time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", db, userID, hash, at)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockRecoveryCodeRepositoryMockRecorder) Redeem(db, userID, hash, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockRecoveryCodeRepository)(nil).Redeem), db, userID, hash, at)
+}
+
+// ReplaceForUser mocks base method.
+func (m *MockRecoveryCodeRepository) ReplaceForUser(db *gorm.DB, userID string, hashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceForUser", db, userID, hashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceForUser indicates an expected call of ReplaceForUser.
+func (mr *MockRecoveryCodeRepositoryMockRecorder) ReplaceForUser(db, userID, hashes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceForUser", reflect.TypeOf((*MockRecoveryCodeRepository)(nil).ReplaceForUser), db, userID, hashes)
+}
+
+// Update mocks base method.
+func (m *MockRecoveryCodeRepository) Update(db *gorm.DB, entity *entity.RecoveryCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRecoveryCodeRepositoryMockRecorder) Update(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRecoveryCodeRepository)(nil).Update), db, entity)
+}
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/rolepolicy/rolepolicy_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/repository/rolepolicy/rolepolicy_repository.go -destination=test/mock/repository/rolepolicy/rolepolicy_repository_mock.go
+//
+
+// Package mock_rolepolicy is a generated GoMock package.
+package mock_rolepolicy
+
+import (
+	reflect "reflect"
+
+	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockRolePolicyRepository is a mock of RolePolicyRepository interface.
+type MockRolePolicyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRolePolicyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRolePolicyRepositoryMockRecorder is the mock recorder for MockRolePolicyRepository.
+type MockRolePolicyRepositoryMockRecorder struct {
+	mock *MockRolePolicyRepository
+}
+
+// NewMockRolePolicyRepository creates a new mock instance.
+func NewMockRolePolicyRepository(ctrl *gomock.Controller) *MockRolePolicyRepository {
+	mock := &MockRolePolicyRepository{ctrl: ctrl}
+	mock.recorder = &MockRolePolicyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRolePolicyRepository) EXPECT() *MockRolePolicyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRolePolicyRepository) Create(db *gorm.DB, entity *entity.RolePolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRolePolicyRepositoryMockRecorder) Create(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRolePolicyRepository)(nil).Create), db, entity)
+}
+
+// Delete mocks base method.
+func (m *MockRolePolicyRepository) Delete(db *gorm.DB, entity *entity.RolePolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRolePolicyRepositoryMockRecorder) Delete(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRolePolicyRepository)(nil).Delete), db, entity)
+}
+
+// GetByRole mocks base method.
+func (m *MockRolePolicyRepository) GetByRole(db *gorm.DB, policy *entity.RolePolicy, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByRole", db, policy, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByRole indicates an expected call of GetByRole.
+func (mr *MockRolePolicyRepositoryMockRecorder) GetByRole(db, policy, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByRole", reflect.TypeOf((*MockRolePolicyRepository)(nil).GetByRole), db, policy, role)
+}
+
+// Update mocks base method.
+func (m *MockRolePolicyRepository) Update(db *gorm.DB, entity *entity.RolePolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRolePolicyRepositoryMockRecorder) Update(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRolePolicyRepository)(nil).Update), db, entity)
+}
@@ -0,0 +1,212 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/series/series_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/repository/series/series_repository.go -destination=test/mock/repository/series/series_repository_mock.go
+//
+
+// Package mock_series is a generated GoMock package.
+package mock_series
+
+import (
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockSeriesRepository is a mock of SeriesRepository interface.
+type MockSeriesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeriesRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSeriesRepositoryMockRecorder is the mock recorder for MockSeriesRepository.
+type MockSeriesRepositoryMockRecorder struct {
+	mock *MockSeriesRepository
+}
+
+// NewMockSeriesRepository creates a new mock instance.
+func NewMockSeriesRepository(ctrl *gomock.Controller) *MockSeriesRepository {
+	mock := &MockSeriesRepository{ctrl: ctrl}
+	mock.recorder = &MockSeriesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeriesRepository) EXPECT() *MockSeriesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountSold mocks base method.
+func (m *MockSeriesRepository) CountSold(db *gorm.DB, eventID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSold", db, eventID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSold indicates an expected call of CountSold.
+func (mr *MockSeriesRepositoryMockRecorder) CountSold(db, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSold", reflect.TypeOf((*MockSeriesRepository)(nil).CountSold), db, eventID)
+}
+
+// Create mocks base method.
+func (m *MockSeriesRepository) Create(db *gorm.DB, entity *entity.EventSeries) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSeriesRepositoryMockRecorder) Create(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSeriesRepository)(nil).Create), db, entity)
+}
+
+// CreateException mocks base method.
+func (m *MockSeriesRepository) CreateException(db *gorm.DB, exception *entity.SeriesException) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateException", db, exception)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateException indicates an expected call of CreateException.
+func (mr *MockSeriesRepositoryMockRecorder) CreateException(db, exception any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateException", reflect.TypeOf((*MockSeriesRepository)(nil).CreateException), db, exception)
+}
+
+// CreateTicketTemplate mocks base method.
+func (m *MockSeriesRepository) CreateTicketTemplate(db *gorm.DB, template *entity.SeriesTicketTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketTemplate", db, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTicketTemplate indicates an expected call of CreateTicketTemplate.
+func (mr *MockSeriesRepositoryMockRecorder) CreateTicketTemplate(db, template any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketTemplate", reflect.TypeOf((*MockSeriesRepository)(nil).CreateTicketTemplate), db, template)
+}
+
+// Delete mocks base method.
+func (m *MockSeriesRepository) Delete(db *gorm.DB, entity *entity.EventSeries) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSeriesRepositoryMockRecorder) Delete(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSeriesRepository)(nil).Delete), db, entity)
+}
+
+// DeleteException mocks base method.
+func (m *MockSeriesRepository) DeleteException(db *gorm.DB, seriesID uint, date time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteException", db, seriesID, date)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteException indicates an expected call of DeleteException.
+func (mr *MockSeriesRepositoryMockRecorder) DeleteException(db, seriesID, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteException", reflect.TypeOf((*MockSeriesRepository)(nil).DeleteException), db, seriesID, date)
+}
+
+// DeleteUnsoldTickets mocks base method.
+func (m *MockSeriesRepository) DeleteUnsoldTickets(db *gorm.DB, eventID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnsoldTickets", db, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUnsoldTickets indicates an expected call of DeleteUnsoldTickets.
+func (mr *MockSeriesRepositoryMockRecorder) DeleteUnsoldTickets(db, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnsoldTickets", reflect.TypeOf((*MockSeriesRepository)(nil).DeleteUnsoldTickets), db, eventID)
+}
+
+// GetByID mocks base method.
+func (m *MockSeriesRepository) GetByID(db *gorm.DB, series *entity.EventSeries, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", db, series, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSeriesRepositoryMockRecorder) GetByID(db, series, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSeriesRepository)(nil).GetByID), db, series, id)
+}
+
+// GetOccurrenceByDate mocks base method.
+func (m *MockSeriesRepository) GetOccurrenceByDate(db *gorm.DB, event *entity.Event, seriesID uint, date time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOccurrenceByDate", db, event, seriesID, date)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetOccurrenceByDate indicates an expected call of GetOccurrenceByDate.
+func (mr *MockSeriesRepositoryMockRecorder) GetOccurrenceByDate(db, event, seriesID, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOccurrenceByDate", reflect.TypeOf((*MockSeriesRepository)(nil).GetOccurrenceByDate), db, event, seriesID, date)
+}
+
+// GetOccurrences mocks base method.
+func (m *MockSeriesRepository) GetOccurrences(db *gorm.DB, events *[]entity.Event, seriesID uint, from time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOccurrences", db, events, seriesID, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetOccurrences indicates an expected call of GetOccurrences.
+func (mr *MockSeriesRepositoryMockRecorder) GetOccurrences(db, events, seriesID, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOccurrences", reflect.TypeOf((*MockSeriesRepository)(nil).GetOccurrences), db, events, seriesID, from)
+}
+
+// Update mocks base method.
+func (m *MockSeriesRepository) Update(db *gorm.DB, entity *entity.EventSeries) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSeriesRepositoryMockRecorder) Update(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSeriesRepository)(nil).Update), db, entity)
+}
+
+// UpdateOccurrences mocks base method.
+func (m *MockSeriesRepository) UpdateOccurrences(db *gorm.DB, seriesID uint, from time.Time, updates map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOccurrences", db, seriesID, from, updates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOccurrences indicates an expected call of UpdateOccurrences.
+func (mr *MockSeriesRepositoryMockRecorder) UpdateOccurrences(db, seriesID, from, updates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOccurrences", reflect.TypeOf((*MockSeriesRepository)(nil).UpdateOccurrences), db, seriesID, from, updates)
+}
@@ -0,0 +1,227 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/slot/slot_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/repository/slot/slot_repository.go -destination=test/mock/repository/slot/slot_repository_mock.go
+//
+
+// Package mock_slot is a generated GoMock package.
+package mock_slot
+
+import (
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockSlotRepository is a mock of SlotRepository interface.
+type MockSlotRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlotRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSlotRepositoryMockRecorder is the mock recorder for MockSlotRepository.
+type MockSlotRepositoryMockRecorder struct {
+	mock *MockSlotRepository
+}
+
+// NewMockSlotRepository creates a new mock instance.
+func NewMockSlotRepository(ctrl *gomock.Controller) *MockSlotRepository {
+	mock := &MockSlotRepository{ctrl: ctrl}
+	mock.recorder = &MockSlotRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlotRepository) EXPECT() *MockSlotRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSlotRepository) Create(db *gorm.DB, entity *entity.TimeSlot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSlotRepositoryMockRecorder) Create(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSlotRepository)(nil).Create), db, entity)
+}
+
+// CreateBatch mocks base method.
+func (m *MockSlotRepository) CreateBatch(db *gorm.DB, slots []*entity.TimeSlot) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", db, slots)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockSlotRepositoryMockRecorder) CreateBatch(db, slots any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockSlotRepository)(nil).CreateBatch), db, slots)
+}
+
+// CreateBooking mocks base method.
+func (m *MockSlotRepository) CreateBooking(db *gorm.DB, booking *entity.SlotBooking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBooking", db, booking)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBooking indicates an expected call of CreateBooking.
+func (mr *MockSlotRepositoryMockRecorder) CreateBooking(db, booking any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBooking", reflect.TypeOf((*MockSlotRepository)(nil).CreateBooking), db, booking)
+}
+
+// CreateTemplate mocks base method.
+func (m *MockSlotRepository) CreateTemplate(db *gorm.DB, template *entity.SlotTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplate", db, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTemplate indicates an expected call of CreateTemplate.
+func (mr *MockSlotRepositoryMockRecorder) CreateTemplate(db, template any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockSlotRepository)(nil).CreateTemplate), db, template)
+}
+
+// Delete mocks base method.
+func (m *MockSlotRepository) Delete(db *gorm.DB, entity *entity.TimeSlot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSlotRepositoryMockRecorder) Delete(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSlotRepository)(nil).Delete), db, entity)
+}
+
+// GetBookingsByOrderID mocks base method.
+func (m *MockSlotRepository) GetBookingsByOrderID(db *gorm.DB, orderID uint) ([]*entity.SlotBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingsByOrderID", db, orderID)
+	ret0, _ := ret[0].([]*entity.SlotBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingsByOrderID indicates an expected call of GetBookingsByOrderID.
+func (mr *MockSlotRepositoryMockRecorder) GetBookingsByOrderID(db, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingsByOrderID", reflect.TypeOf((*MockSlotRepository)(nil).GetBookingsByOrderID), db, orderID)
+}
+
+// GetByEventID mocks base method.
+func (m *MockSlotRepository) GetByEventID(db *gorm.DB, slots *[]entity.TimeSlot, eventID uint, from, to time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEventID", db, slots, eventID, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByEventID indicates an expected call of GetByEventID.
+func (mr *MockSlotRepositoryMockRecorder) GetByEventID(db, slots, eventID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEventID", reflect.TypeOf((*MockSlotRepository)(nil).GetByEventID), db, slots, eventID, from, to)
+}
+
+// GetByID mocks base method.
+func (m *MockSlotRepository) GetByID(db *gorm.DB, slot *entity.TimeSlot, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", db, slot, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSlotRepositoryMockRecorder) GetByID(db, slot, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSlotRepository)(nil).GetByID), db, slot, id)
+}
+
+// GetTemplateByID mocks base method.
+func (m *MockSlotRepository) GetTemplateByID(db *gorm.DB, template *entity.SlotTemplate, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateByID", db, template, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetTemplateByID indicates an expected call of GetTemplateByID.
+func (mr *MockSlotRepositoryMockRecorder) GetTemplateByID(db, template, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByID", reflect.TypeOf((*MockSlotRepository)(nil).GetTemplateByID), db, template, id)
+}
+
+// GetTemplatesByEventID mocks base method.
+func (m *MockSlotRepository) GetTemplatesByEventID(db *gorm.DB, templates *[]entity.SlotTemplate, eventID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplatesByEventID", db, templates, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetTemplatesByEventID indicates an expected call of GetTemplatesByEventID.
+func (mr *MockSlotRepositoryMockRecorder) GetTemplatesByEventID(db, templates, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplatesByEventID", reflect.TypeOf((*MockSlotRepository)(nil).GetTemplatesByEventID), db, templates, eventID)
+}
+
+// ReleaseByOrderID mocks base method.
+func (m *MockSlotRepository) ReleaseByOrderID(db *gorm.DB, orderID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseByOrderID", db, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseByOrderID indicates an expected call of ReleaseByOrderID.
+func (mr *MockSlotRepositoryMockRecorder) ReleaseByOrderID(db, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseByOrderID", reflect.TypeOf((*MockSlotRepository)(nil).ReleaseByOrderID), db, orderID)
+}
+
+// Reserve mocks base method.
+func (m *MockSlotRepository) Reserve(db *gorm.DB, id uint, quantity int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", db, id, quantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockSlotRepositoryMockRecorder) Reserve(db, id, quantity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockSlotRepository)(nil).Reserve), db, id, quantity)
+}
+
+// Update mocks base method.
+func (m *MockSlotRepository) Update(db *gorm.DB, entity *entity.TimeSlot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSlotRepositoryMockRecorder) Update(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSlotRepository)(nil).Update), db, entity)
+}
@@ -11,6 +11,7 @@ package mock_ticket
 
 import (
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
 	model "github.com/TrinityKnights/Backend/internal/domain/model"
@@ -42,6 +43,64 @@ func (m *MockTicketRepository) EXPECT() *MockTicketRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AssignToOrder mocks base method.
+func (m *MockTicketRepository) AssignToOrder(db *gorm.DB, ticketIDs []string, orderID uint, attendeeName, attendeeEmail *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignToOrder", db, ticketIDs, orderID, attendeeName, attendeeEmail)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignToOrder indicates an expected call of AssignToOrder.
+func (mr *MockTicketRepositoryMockRecorder) AssignToOrder(db, ticketIDs, orderID, attendeeName, attendeeEmail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignToOrder", reflect.TypeOf((*MockTicketRepository)(nil).AssignToOrder), db, ticketIDs, orderID, attendeeName, attendeeEmail)
+}
+
+// CheckIn mocks base method.
+func (m *MockTicketRepository) CheckIn(db *gorm.DB, ticketID string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIn", db, ticketID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckIn indicates an expected call of CheckIn.
+func (mr *MockTicketRepositoryMockRecorder) CheckIn(db, ticketID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockTicketRepository)(nil).CheckIn), db, ticketID, at)
+}
+
+// CountAvailable mocks base method.
+func (m *MockTicketRepository) CountAvailable(db *gorm.DB, eventID uint, ticketType string, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAvailable", db, eventID, ticketType, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAvailable indicates an expected call of CountAvailable.
+func (mr *MockTicketRepositoryMockRecorder) CountAvailable(db, eventID, ticketType, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAvailable", reflect.TypeOf((*MockTicketRepository)(nil).CountAvailable), db, eventID, ticketType, now)
+}
+
+// CountByCategory mocks base method.
+func (m *MockTicketRepository) CountByCategory(db *gorm.DB, eventID uint) ([]*model.TicketCategoryCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByCategory", db, eventID)
+	ret0, _ := ret[0].([]*model.TicketCategoryCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByCategory indicates an expected call of CountByCategory.
+func (mr *MockTicketRepositoryMockRecorder) CountByCategory(db, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByCategory", reflect.TypeOf((*MockTicketRepository)(nil).CountByCategory), db, eventID)
+}
+
 // Create mocks base method.
 func (m *MockTicketRepository) Create(db *gorm.DB, entity *entity.Ticket) error {
 	m.ctrl.T.Helper()
@@ -99,19 +158,180 @@ func (mr *MockTicketRepositoryMockRecorder) Find(db, filter any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockTicketRepository)(nil).Find), db, filter)
 }
 
-// GetLastTicketNumber mocks base method.
-func (m *MockTicketRepository) GetLastTicketNumber(db *gorm.DB, eventID uint, ticketType string) (*entity.Ticket, error) {
+// FindAvailableForHold mocks base method.
+func (m *MockTicketRepository) FindAvailableForHold(db *gorm.DB, eventID uint, ticketType string, limit int, now time.Time) ([]*entity.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAvailableForHold", db, eventID, ticketType, limit, now)
+	ret0, _ := ret[0].([]*entity.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAvailableForHold indicates an expected call of FindAvailableForHold.
+func (mr *MockTicketRepositoryMockRecorder) FindAvailableForHold(db, eventID, ticketType, limit, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAvailableForHold", reflect.TypeOf((*MockTicketRepository)(nil).FindAvailableForHold), db, eventID, ticketType, limit, now)
+}
+
+// FindByHoldToken mocks base method.
+func (m *MockTicketRepository) FindByHoldToken(db *gorm.DB, token string) ([]*entity.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHoldToken", db, token)
+	ret0, _ := ret[0].([]*entity.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHoldToken indicates an expected call of FindByHoldToken.
+func (mr *MockTicketRepositoryMockRecorder) FindByHoldToken(db, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHoldToken", reflect.TypeOf((*MockTicketRepository)(nil).FindByHoldToken), db, token)
+}
+
+// FindReserved mocks base method.
+func (m *MockTicketRepository) FindReserved(db *gorm.DB, eventID uint, now time.Time) ([]*entity.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReserved", db, eventID, now)
+	ret0, _ := ret[0].([]*entity.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReserved indicates an expected call of FindReserved.
+func (mr *MockTicketRepositoryMockRecorder) FindReserved(db, eventID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReserved", reflect.TypeOf((*MockTicketRepository)(nil).FindReserved), db, eventID, now)
+}
+
+// FindUnissuedByAllocation mocks base method.
+func (m *MockTicketRepository) FindUnissuedByAllocation(db *gorm.DB, allocationID uint, limit int) ([]*entity.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUnissuedByAllocation", db, allocationID, limit)
+	ret0, _ := ret[0].([]*entity.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUnissuedByAllocation indicates an expected call of FindUnissuedByAllocation.
+func (mr *MockTicketRepositoryMockRecorder) FindUnissuedByAllocation(db, allocationID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUnissuedByAllocation", reflect.TypeOf((*MockTicketRepository)(nil).FindUnissuedByAllocation), db, allocationID, limit)
+}
+
+// GetCapacity mocks base method.
+func (m *MockTicketRepository) GetCapacity(db *gorm.DB, eventID uint) (*model.EventCapacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCapacity", db, eventID)
+	ret0, _ := ret[0].(*model.EventCapacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCapacity indicates an expected call of GetCapacity.
+func (mr *MockTicketRepositoryMockRecorder) GetCapacity(db, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapacity", reflect.TypeOf((*MockTicketRepository)(nil).GetCapacity), db, eventID)
+}
+
+// GetCounters mocks base method.
+func (m *MockTicketRepository) GetCounters(db *gorm.DB, eventID uint) ([]*entity.EventTicketCounter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCounters", db, eventID)
+	ret0, _ := ret[0].([]*entity.EventTicketCounter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCounters indicates an expected call of GetCounters.
+func (mr *MockTicketRepositoryMockRecorder) GetCounters(db, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounters", reflect.TypeOf((*MockTicketRepository)(nil).GetCounters), db, eventID)
+}
+
+// Hold mocks base method.
+func (m *MockTicketRepository) Hold(db *gorm.DB, ticketIDs []string, token string, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hold", db, ticketIDs, token, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Hold indicates an expected call of Hold.
+func (mr *MockTicketRepositoryMockRecorder) Hold(db, ticketIDs, token, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hold", reflect.TypeOf((*MockTicketRepository)(nil).Hold), db, ticketIDs, token, until)
+}
+
+// ReleaseByOrderID mocks base method.
+func (m *MockTicketRepository) ReleaseByOrderID(db *gorm.DB, orderID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseByOrderID", db, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseByOrderID indicates an expected call of ReleaseByOrderID.
+func (mr *MockTicketRepositoryMockRecorder) ReleaseByOrderID(db, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseByOrderID", reflect.TypeOf((*MockTicketRepository)(nil).ReleaseByOrderID), db, orderID)
+}
+
+// ReleaseExpiredHolds mocks base method.
+func (m *MockTicketRepository) ReleaseExpiredHolds(db *gorm.DB, now time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLastTicketNumber", db, eventID, ticketType)
-	ret0, _ := ret[0].(*entity.Ticket)
+	ret := m.ctrl.Call(m, "ReleaseExpiredHolds", db, now)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLastTicketNumber indicates an expected call of GetLastTicketNumber.
-func (mr *MockTicketRepositoryMockRecorder) GetLastTicketNumber(db, eventID, ticketType any) *gomock.Call {
+// ReleaseExpiredHolds indicates an expected call of ReleaseExpiredHolds.
+func (mr *MockTicketRepositoryMockRecorder) ReleaseExpiredHolds(db, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseExpiredHolds", reflect.TypeOf((*MockTicketRepository)(nil).ReleaseExpiredHolds), db, now)
+}
+
+// ReleaseHold mocks base method.
+func (m *MockTicketRepository) ReleaseHold(db *gorm.DB, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseHold", db, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseHold indicates an expected call of ReleaseHold.
+func (mr *MockTicketRepositoryMockRecorder) ReleaseHold(db, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHold", reflect.TypeOf((*MockTicketRepository)(nil).ReleaseHold), db, token)
+}
+
+// ReserveSeatNumbers mocks base method.
+func (m *MockTicketRepository) ReserveSeatNumbers(db *gorm.DB, eventID uint, ticketType string, count int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveSeatNumbers", db, eventID, ticketType, count)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveSeatNumbers indicates an expected call of ReserveSeatNumbers.
+func (mr *MockTicketRepositoryMockRecorder) ReserveSeatNumbers(db, eventID, ticketType, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveSeatNumbers", reflect.TypeOf((*MockTicketRepository)(nil).ReserveSeatNumbers), db, eventID, ticketType, count)
+}
+
+// SetAllocation mocks base method.
+func (m *MockTicketRepository) SetAllocation(db *gorm.DB, ticketIDs []string, allocationID *uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAllocation", db, ticketIDs, allocationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAllocation indicates an expected call of SetAllocation.
+func (mr *MockTicketRepositoryMockRecorder) SetAllocation(db, ticketIDs, allocationID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastTicketNumber", reflect.TypeOf((*MockTicketRepository)(nil).GetLastTicketNumber), db, eventID, ticketType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllocation", reflect.TypeOf((*MockTicketRepository)(nil).SetAllocation), db, ticketIDs, allocationID)
 }
 
 // Update mocks base method.
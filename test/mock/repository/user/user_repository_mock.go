@@ -84,6 +84,21 @@ func (mr *MockUserRepositoryMockRecorder) Delete(db, entity any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserRepository)(nil).Delete), db, entity)
 }
 
+// FindIDsWithoutTwoFactor mocks base method.
+func (m *MockUserRepository) FindIDsWithoutTwoFactor(db *gorm.DB, role string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIDsWithoutTwoFactor", db, role)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIDsWithoutTwoFactor indicates an expected call of FindIDsWithoutTwoFactor.
+func (mr *MockUserRepositoryMockRecorder) FindIDsWithoutTwoFactor(db, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIDsWithoutTwoFactor", reflect.TypeOf((*MockUserRepository)(nil).FindIDsWithoutTwoFactor), db, role)
+}
+
 // GetByEmail mocks base method.
 func (m *MockUserRepository) GetByEmail(db *gorm.DB, user *entity.User, email string) error {
 	m.ctrl.T.Helper()
@@ -167,3 +182,18 @@ func (mr *MockUserRepositoryMockRecorder) Update(db, entity any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepository)(nil).Update), db, entity)
 }
+
+// UseTwoFactorStep mocks base method.
+func (m *MockUserRepository) UseTwoFactorStep(db *gorm.DB, id string, step int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTwoFactorStep", db, id, step)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseTwoFactorStep indicates an expected call of UseTwoFactorStep.
+func (mr *MockUserRepositoryMockRecorder) UseTwoFactorStep(db, id, step any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTwoFactorStep", reflect.TypeOf((*MockUserRepository)(nil).UseTwoFactorStep), db, id, step)
+}
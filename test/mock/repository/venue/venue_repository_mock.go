@@ -11,6 +11,7 @@ package mock_venue
 
 import (
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
 	model "github.com/TrinityKnights/Backend/internal/domain/model"
@@ -70,6 +71,20 @@ func (mr *MockVenueRepositoryMockRecorder) Delete(db, entity any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVenueRepository)(nil).Delete), db, entity)
 }
 
+// GetBookings mocks base method.
+func (m *MockVenueRepository) GetBookings(db *gorm.DB, events *[]entity.Event, venueID uint, from, to time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookings", db, events, venueID, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetBookings indicates an expected call of GetBookings.
+func (mr *MockVenueRepositoryMockRecorder) GetBookings(db, events, venueID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookings", reflect.TypeOf((*MockVenueRepository)(nil).GetBookings), db, events, venueID, from, to)
+}
+
 // GetByID mocks base method.
 func (m *MockVenueRepository) GetByID(db *gorm.DB, venue *entity.Venue, id uint) error {
 	m.ctrl.T.Helper()
@@ -99,6 +114,21 @@ func (mr *MockVenueRepositoryMockRecorder) GetPaginated(db, venues, opts any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginated", reflect.TypeOf((*MockVenueRepository)(nil).GetPaginated), db, venues, opts)
 }
 
+// RezoneEvents mocks base method.
+func (m *MockVenueRepository) RezoneEvents(db *gorm.DB, venueID uint, timezone string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RezoneEvents", db, venueID, timezone)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RezoneEvents indicates an expected call of RezoneEvents.
+func (mr *MockVenueRepositoryMockRecorder) RezoneEvents(db, venueID, timezone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RezoneEvents", reflect.TypeOf((*MockVenueRepository)(nil).RezoneEvents), db, venueID, timezone)
+}
+
 // Update mocks base method.
 func (m *MockVenueRepository) Update(db *gorm.DB, entity *entity.Venue) error {
 	m.ctrl.T.Helper()
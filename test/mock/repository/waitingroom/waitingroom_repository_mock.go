@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/waitingroom/waitingroom_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/repository/waitingroom/waitingroom_repository.go -destination=test/mock/repository/waitingroom/waitingroom_repository_mock.go
+//
+
+// Package mock_waitingroom is a generated GoMock package.
+package mock_waitingroom
+
+import (
+	reflect "reflect"
+
+	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockWaitingRoomRepository is a mock of WaitingRoomRepository interface.
+type MockWaitingRoomRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitingRoomRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWaitingRoomRepositoryMockRecorder is the mock recorder for MockWaitingRoomRepository.
+type MockWaitingRoomRepositoryMockRecorder struct {
+	mock *MockWaitingRoomRepository
+}
+
+// NewMockWaitingRoomRepository creates a new mock instance.
+func NewMockWaitingRoomRepository(ctrl *gomock.Controller) *MockWaitingRoomRepository {
+	mock := &MockWaitingRoomRepository{ctrl: ctrl}
+	mock.recorder = &MockWaitingRoomRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitingRoomRepository) EXPECT() *MockWaitingRoomRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWaitingRoomRepository) Create(db *gorm.DB, entity *entity.WaitingRoom) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWaitingRoomRepositoryMockRecorder) Create(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWaitingRoomRepository)(nil).Create), db, entity)
+}
+
+// Delete mocks base method.
+func (m *MockWaitingRoomRepository) Delete(db *gorm.DB, entity *entity.WaitingRoom) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWaitingRoomRepositoryMockRecorder) Delete(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWaitingRoomRepository)(nil).Delete), db, entity)
+}
+
+// DeleteByEventID mocks base method.
+func (m *MockWaitingRoomRepository) DeleteByEventID(db *gorm.DB, eventID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByEventID", db, eventID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByEventID indicates an expected call of DeleteByEventID.
+func (mr *MockWaitingRoomRepositoryMockRecorder) DeleteByEventID(db, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByEventID", reflect.TypeOf((*MockWaitingRoomRepository)(nil).DeleteByEventID), db, eventID)
+}
+
+// GetByEventID mocks base method.
+func (m *MockWaitingRoomRepository) GetByEventID(db *gorm.DB, room *entity.WaitingRoom, eventID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEventID", db, room, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByEventID indicates an expected call of GetByEventID.
+func (mr *MockWaitingRoomRepositoryMockRecorder) GetByEventID(db, room, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEventID", reflect.TypeOf((*MockWaitingRoomRepository)(nil).GetByEventID), db, room, eventID)
+}
+
+// Update mocks base method.
+func (m *MockWaitingRoomRepository) Update(db *gorm.DB, entity *entity.WaitingRoom) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWaitingRoomRepositoryMockRecorder) Update(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWaitingRoomRepository)(nil).Update), db, entity)
+}
+
+// Upsert mocks base method.
+func (m *MockWaitingRoomRepository) Upsert(db *gorm.DB, room *entity.WaitingRoom) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", db, room)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockWaitingRoomRepositoryMockRecorder) Upsert(db, room any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockWaitingRoomRepository)(nil).Upsert), db, room)
+}
@@ -0,0 +1,186 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/waitlist/waitlist_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/repository/waitlist/waitlist_repository.go -destination=test/mock/repository/waitlist/waitlist_repository_mock.go
+//
+
+// Package mock_waitlist is a generated GoMock package.
+package mock_waitlist
+
+import (
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockWaitlistRepository is a mock of WaitlistRepository interface.
+type MockWaitlistRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitlistRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWaitlistRepositoryMockRecorder is the mock recorder for MockWaitlistRepository.
+type MockWaitlistRepositoryMockRecorder struct {
+	mock *MockWaitlistRepository
+}
+
+// NewMockWaitlistRepository creates a new mock instance.
+func NewMockWaitlistRepository(ctrl *gomock.Controller) *MockWaitlistRepository {
+	mock := &MockWaitlistRepository{ctrl: ctrl}
+	mock.recorder = &MockWaitlistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitlistRepository) EXPECT() *MockWaitlistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountAhead mocks base method.
+func (m *MockWaitlistRepository) CountAhead(db *gorm.DB, entry *entity.WaitlistEntry) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAhead", db, entry)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAhead indicates an expected call of CountAhead.
+func (mr *MockWaitlistRepositoryMockRecorder) CountAhead(db, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAhead", reflect.TypeOf((*MockWaitlistRepository)(nil).CountAhead), db, entry)
+}
+
+// Create mocks base method.
+func (m *MockWaitlistRepository) Create(db *gorm.DB, entity *entity.WaitlistEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWaitlistRepositoryMockRecorder) Create(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWaitlistRepository)(nil).Create), db, entity)
+}
+
+// Delete mocks base method.
+func (m *MockWaitlistRepository) Delete(db *gorm.DB, entity *entity.WaitlistEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWaitlistRepositoryMockRecorder) Delete(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWaitlistRepository)(nil).Delete), db, entity)
+}
+
+// GetActiveByUser mocks base method.
+func (m *MockWaitlistRepository) GetActiveByUser(db *gorm.DB, entry *entity.WaitlistEntry, userID string, eventID uint, ticketType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveByUser", db, entry, userID, eventID, ticketType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetActiveByUser indicates an expected call of GetActiveByUser.
+func (mr *MockWaitlistRepositoryMockRecorder) GetActiveByUser(db, entry, userID, eventID, ticketType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveByUser", reflect.TypeOf((*MockWaitlistRepository)(nil).GetActiveByUser), db, entry, userID, eventID, ticketType)
+}
+
+// GetByID mocks base method.
+func (m *MockWaitlistRepository) GetByID(db *gorm.DB, entry *entity.WaitlistEntry, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", db, entry, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockWaitlistRepositoryMockRecorder) GetByID(db, entry, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockWaitlistRepository)(nil).GetByID), db, entry, id)
+}
+
+// GetByOfferToken mocks base method.
+func (m *MockWaitlistRepository) GetByOfferToken(db *gorm.DB, entry *entity.WaitlistEntry, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOfferToken", db, entry, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetByOfferToken indicates an expected call of GetByOfferToken.
+func (mr *MockWaitlistRepositoryMockRecorder) GetByOfferToken(db, entry, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOfferToken", reflect.TypeOf((*MockWaitlistRepository)(nil).GetByOfferToken), db, entry, token)
+}
+
+// GetExpiredOffers mocks base method.
+func (m *MockWaitlistRepository) GetExpiredOffers(db *gorm.DB, now time.Time) ([]entity.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredOffers", db, now)
+	ret0, _ := ret[0].([]entity.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredOffers indicates an expected call of GetExpiredOffers.
+func (mr *MockWaitlistRepositoryMockRecorder) GetExpiredOffers(db, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredOffers", reflect.TypeOf((*MockWaitlistRepository)(nil).GetExpiredOffers), db, now)
+}
+
+// GetNextWaiting mocks base method.
+func (m *MockWaitlistRepository) GetNextWaiting(db *gorm.DB, entry *entity.WaitlistEntry, eventID uint, ticketType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextWaiting", db, entry, eventID, ticketType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetNextWaiting indicates an expected call of GetNextWaiting.
+func (mr *MockWaitlistRepositoryMockRecorder) GetNextWaiting(db, entry, eventID, ticketType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextWaiting", reflect.TypeOf((*MockWaitlistRepository)(nil).GetNextWaiting), db, entry, eventID, ticketType)
+}
+
+// GetPaginatedByUser mocks base method.
+func (m *MockWaitlistRepository) GetPaginatedByUser(db *gorm.DB, entries *[]entity.WaitlistEntry, userID string, page, size int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaginatedByUser", db, entries, userID, page, size)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaginatedByUser indicates an expected call of GetPaginatedByUser.
+func (mr *MockWaitlistRepositoryMockRecorder) GetPaginatedByUser(db, entries, userID, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginatedByUser", reflect.TypeOf((*MockWaitlistRepository)(nil).GetPaginatedByUser), db, entries, userID, page, size)
+}
+
+// Update mocks base method.
+func (m *MockWaitlistRepository) Update(db *gorm.DB, entity *entity.WaitlistEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", db, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWaitlistRepositoryMockRecorder) Update(db, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWaitlistRepository)(nil).Update), db, entity)
+}
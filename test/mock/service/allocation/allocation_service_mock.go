@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/service/allocation/allocation_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/service/allocation/allocation_service.go -destination=test/mock/service/allocation/allocation_service_mock.go
+//
+
+// Package mock_allocation is a generated GoMock package.
+package mock_allocation
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TrinityKnights/Backend/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAllocationService is a mock of AllocationService interface.
+type MockAllocationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAllocationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAllocationServiceMockRecorder is the mock recorder for MockAllocationService.
+type MockAllocationServiceMockRecorder struct {
+	mock *MockAllocationService
+}
+
+// NewMockAllocationService creates a new mock instance.
+func NewMockAllocationService(ctrl *gomock.Controller) *MockAllocationService {
+	mock := &MockAllocationService{ctrl: ctrl}
+	mock.recorder = &MockAllocationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAllocationService) EXPECT() *MockAllocationServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateAllocation mocks base method.
+func (m *MockAllocationService) CreateAllocation(ctx context.Context, request *model.CreateAllocationRequest) (*model.AllocationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAllocation", ctx, request)
+	ret0, _ := ret[0].(*model.AllocationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAllocation indicates an expected call of CreateAllocation.
+func (mr *MockAllocationServiceMockRecorder) CreateAllocation(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAllocation", reflect.TypeOf((*MockAllocationService)(nil).CreateAllocation), ctx, request)
+}
+
+// GetAllocationByID mocks base method.
+func (m *MockAllocationService) GetAllocationByID(ctx context.Context, request *model.GetAllocationRequest) (*model.AllocationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllocationByID", ctx, request)
+	ret0, _ := ret[0].(*model.AllocationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllocationByID indicates an expected call of GetAllocationByID.
+func (mr *MockAllocationServiceMockRecorder) GetAllocationByID(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllocationByID", reflect.TypeOf((*MockAllocationService)(nil).GetAllocationByID), ctx, request)
+}
+
+// GetAllocations mocks base method.
+func (m *MockAllocationService) GetAllocations(ctx context.Context, request *model.AllocationsRequest) ([]*model.AllocationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllocations", ctx, request)
+	ret0, _ := ret[0].([]*model.AllocationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllocations indicates an expected call of GetAllocations.
+func (mr *MockAllocationServiceMockRecorder) GetAllocations(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllocations", reflect.TypeOf((*MockAllocationService)(nil).GetAllocations), ctx, request)
+}
+
+// IssueComps mocks base method.
+func (m *MockAllocationService) IssueComps(ctx context.Context, request *model.IssueCompsRequest) ([]*model.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueComps", ctx, request)
+	ret0, _ := ret[0].([]*model.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueComps indicates an expected call of IssueComps.
+func (mr *MockAllocationServiceMockRecorder) IssueComps(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueComps", reflect.TypeOf((*MockAllocationService)(nil).IssueComps), ctx, request)
+}
+
+// ReleaseAllocation mocks base method.
+func (m *MockAllocationService) ReleaseAllocation(ctx context.Context, request *model.ReleaseAllocationRequest) (*model.AllocationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseAllocation", ctx, request)
+	ret0, _ := ret[0].(*model.AllocationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseAllocation indicates an expected call of ReleaseAllocation.
+func (mr *MockAllocationServiceMockRecorder) ReleaseAllocation(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseAllocation", reflect.TypeOf((*MockAllocationService)(nil).ReleaseAllocation), ctx, request)
+}
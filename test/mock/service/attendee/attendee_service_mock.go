@@ -0,0 +1,161 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/service/attendee/attendee_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/service/attendee/attendee_service.go -destination=test/mock/service/attendee/attendee_service_mock.go
+//
+
+// Package mock_attendee is a generated GoMock package.
+package mock_attendee
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TrinityKnights/Backend/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockAttendeeService is a mock of AttendeeService interface.
+type MockAttendeeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttendeeServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAttendeeServiceMockRecorder is the mock recorder for MockAttendeeService.
+type MockAttendeeServiceMockRecorder struct {
+	mock *MockAttendeeService
+}
+
+// NewMockAttendeeService creates a new mock instance.
+func NewMockAttendeeService(ctrl *gomock.Controller) *MockAttendeeService {
+	mock := &MockAttendeeService{ctrl: ctrl}
+	mock.recorder = &MockAttendeeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttendeeService) EXPECT() *MockAttendeeServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateQuestion mocks base method.
+func (m *MockAttendeeService) CreateQuestion(ctx context.Context, request *model.CreateAttendeeQuestionRequest) (*model.AttendeeQuestionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQuestion", ctx, request)
+	ret0, _ := ret[0].(*model.AttendeeQuestionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQuestion indicates an expected call of CreateQuestion.
+func (mr *MockAttendeeServiceMockRecorder) CreateQuestion(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuestion", reflect.TypeOf((*MockAttendeeService)(nil).CreateQuestion), ctx, request)
+}
+
+// DeleteQuestion mocks base method.
+func (m *MockAttendeeService) DeleteQuestion(ctx context.Context, request *model.DeleteAttendeeQuestionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuestion", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuestion indicates an expected call of DeleteQuestion.
+func (mr *MockAttendeeServiceMockRecorder) DeleteQuestion(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuestion", reflect.TypeOf((*MockAttendeeService)(nil).DeleteQuestion), ctx, request)
+}
+
+// ExportAttendees mocks base method.
+func (m *MockAttendeeService) ExportAttendees(ctx context.Context, request *model.ExportAttendeesRequest) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportAttendees", ctx, request)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportAttendees indicates an expected call of ExportAttendees.
+func (mr *MockAttendeeServiceMockRecorder) ExportAttendees(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAttendees", reflect.TypeOf((*MockAttendeeService)(nil).ExportAttendees), ctx, request)
+}
+
+// GetAttendees mocks base method.
+func (m *MockAttendeeService) GetAttendees(ctx context.Context, request *model.AttendeesRequest) (*model.Response[[]*model.TicketResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendees", ctx, request)
+	ret0, _ := ret[0].(*model.Response[[]*model.TicketResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendees indicates an expected call of GetAttendees.
+func (mr *MockAttendeeServiceMockRecorder) GetAttendees(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendees", reflect.TypeOf((*MockAttendeeService)(nil).GetAttendees), ctx, request)
+}
+
+// GetQuestions mocks base method.
+func (m *MockAttendeeService) GetQuestions(ctx context.Context, request *model.GetAttendeeQuestionsRequest) ([]*model.AttendeeQuestionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestions", ctx, request)
+	ret0, _ := ret[0].([]*model.AttendeeQuestionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuestions indicates an expected call of GetQuestions.
+func (mr *MockAttendeeServiceMockRecorder) GetQuestions(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestions", reflect.TypeOf((*MockAttendeeService)(nil).GetQuestions), ctx, request)
+}
+
+// SaveAttendees mocks base method.
+func (m *MockAttendeeService) SaveAttendees(ctx context.Context, tx *gorm.DB, eventID, orderID uint, requests []model.AttendeeRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAttendees", ctx, tx, eventID, orderID, requests)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAttendees indicates an expected call of SaveAttendees.
+func (mr *MockAttendeeServiceMockRecorder) SaveAttendees(ctx, tx, eventID, orderID, requests any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAttendees", reflect.TypeOf((*MockAttendeeService)(nil).SaveAttendees), ctx, tx, eventID, orderID, requests)
+}
+
+// UpdateAttendee mocks base method.
+func (m *MockAttendeeService) UpdateAttendee(ctx context.Context, request *model.UpdateAttendeeRequest) (*model.TicketResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendee", ctx, request)
+	ret0, _ := ret[0].(*model.TicketResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAttendee indicates an expected call of UpdateAttendee.
+func (mr *MockAttendeeServiceMockRecorder) UpdateAttendee(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendee", reflect.TypeOf((*MockAttendeeService)(nil).UpdateAttendee), ctx, request)
+}
+
+// UpdateQuestion mocks base method.
+func (m *MockAttendeeService) UpdateQuestion(ctx context.Context, request *model.UpdateAttendeeQuestionRequest) (*model.AttendeeQuestionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQuestion", ctx, request)
+	ret0, _ := ret[0].(*model.AttendeeQuestionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateQuestion indicates an expected call of UpdateQuestion.
+func (mr *MockAttendeeServiceMockRecorder) UpdateQuestion(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuestion", reflect.TypeOf((*MockAttendeeService)(nil).UpdateQuestion), ctx, request)
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/service/cart/cart_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/service/cart/cart_service.go -destination=test/mock/service/cart/cart_service_mock.go
+//
+
+// Package mock_cart is a generated GoMock package.
+package mock_cart
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TrinityKnights/Backend/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCartService is a mock of CartService interface.
+type MockCartService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCartServiceMockRecorder is the mock recorder for MockCartService.
+type MockCartServiceMockRecorder struct {
+	mock *MockCartService
+}
+
+// NewMockCartService creates a new mock instance.
+func NewMockCartService(ctrl *gomock.Controller) *MockCartService {
+	mock := &MockCartService{ctrl: ctrl}
+	mock.recorder = &MockCartServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCartService) EXPECT() *MockCartServiceMockRecorder {
+	return m.recorder
+}
+
+// AddItem mocks base method.
+func (m *MockCartService) AddItem(ctx context.Context, request *model.AddCartItemRequest) (*model.CartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", ctx, request)
+	ret0, _ := ret[0].(*model.CartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockCartServiceMockRecorder) AddItem(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockCartService)(nil).AddItem), ctx, request)
+}
+
+// Checkout mocks base method.
+func (m *MockCartService) Checkout(ctx context.Context, request *model.CheckoutCartRequest) (*model.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", ctx, request)
+	ret0, _ := ret[0].(*model.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockCartServiceMockRecorder) Checkout(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockCartService)(nil).Checkout), ctx, request)
+}
+
+// ClearCart mocks base method.
+func (m *MockCartService) ClearCart(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearCart", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearCart indicates an expected call of ClearCart.
+func (mr *MockCartServiceMockRecorder) ClearCart(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearCart", reflect.TypeOf((*MockCartService)(nil).ClearCart), ctx)
+}
+
+// GetCart mocks base method.
+func (m *MockCartService) GetCart(ctx context.Context) (*model.CartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCart", ctx)
+	ret0, _ := ret[0].(*model.CartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCart indicates an expected call of GetCart.
+func (mr *MockCartServiceMockRecorder) GetCart(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCart", reflect.TypeOf((*MockCartService)(nil).GetCart), ctx)
+}
+
+// RemoveItem mocks base method.
+func (m *MockCartService) RemoveItem(ctx context.Context, request *model.RemoveCartItemRequest) (*model.CartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", ctx, request)
+	ret0, _ := ret[0].(*model.CartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockCartServiceMockRecorder) RemoveItem(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockCartService)(nil).RemoveItem), ctx, request)
+}
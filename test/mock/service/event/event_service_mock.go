@@ -13,8 +13,10 @@ import (
 	context "context"
 	reflect "reflect"
 
+	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
 	model "github.com/TrinityKnights/Backend/internal/domain/model"
 	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
 )
 
 // MockEventService is a mock of EventService interface.
@@ -41,6 +43,20 @@ func (m *MockEventService) EXPECT() *MockEventServiceMockRecorder {
 	return m.recorder
 }
 
+// CheckOverlap mocks base method.
+func (m *MockEventService) CheckOverlap(ctx context.Context, tx *gorm.DB, data *entity.Event, override bool, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckOverlap", ctx, tx, data, override, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckOverlap indicates an expected call of CheckOverlap.
+func (mr *MockEventServiceMockRecorder) CheckOverlap(ctx, tx, data, override, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckOverlap", reflect.TypeOf((*MockEventService)(nil).CheckOverlap), ctx, tx, data, override, reason)
+}
+
 // CreateEvent mocks base method.
 func (m *MockEventService) CreateEvent(ctx context.Context, request *model.CreateEventRequest) (*model.EventResponse, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +102,35 @@ func (mr *MockEventServiceMockRecorder) GetEvents(ctx, request any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockEventService)(nil).GetEvents), ctx, request)
 }
 
+// GetUnpublishedEvents mocks base method.
+func (m *MockEventService) GetUnpublishedEvents(ctx context.Context, request *model.EventsRequest) (*model.Response[[]*model.EventResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnpublishedEvents", ctx, request)
+	ret0, _ := ret[0].(*model.Response[[]*model.EventResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnpublishedEvents indicates an expected call of GetUnpublishedEvents.
+func (mr *MockEventServiceMockRecorder) GetUnpublishedEvents(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnpublishedEvents", reflect.TypeOf((*MockEventService)(nil).GetUnpublishedEvents), ctx, request)
+}
+
+// PublishScheduled mocks base method.
+func (m *MockEventService) PublishScheduled(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishScheduled", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishScheduled indicates an expected call of PublishScheduled.
+func (mr *MockEventServiceMockRecorder) PublishScheduled(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduled", reflect.TypeOf((*MockEventService)(nil).PublishScheduled), ctx)
+}
+
 // SearchEvents mocks base method.
 func (m *MockEventService) SearchEvents(ctx context.Context, request *model.EventSearchRequest) (*model.Response[[]*model.EventResponse], error) {
 	m.ctrl.T.Helper()
@@ -115,3 +160,18 @@ func (mr *MockEventServiceMockRecorder) UpdateEvent(ctx, request any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockEventService)(nil).UpdateEvent), ctx, request)
 }
+
+// UpdateEventStatus mocks base method.
+func (m *MockEventService) UpdateEventStatus(ctx context.Context, request *model.UpdateEventStatusRequest) (*model.EventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEventStatus", ctx, request)
+	ret0, _ := ret[0].(*model.EventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEventStatus indicates an expected call of UpdateEventStatus.
+func (mr *MockEventServiceMockRecorder) UpdateEventStatus(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventStatus", reflect.TypeOf((*MockEventService)(nil).UpdateEventStatus), ctx, request)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/service/exchange/exchange_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/service/exchange/exchange_service.go -destination=test/mock/service/exchange/exchange_service_mock.go
+//
+
+// Package mock_exchange is a generated GoMock package.
+package mock_exchange
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TrinityKnights/Backend/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExchangeService is a mock of ExchangeService interface.
+type MockExchangeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockExchangeServiceMockRecorder is the mock recorder for MockExchangeService.
+type MockExchangeServiceMockRecorder struct {
+	mock *MockExchangeService
+}
+
+// NewMockExchangeService creates a new mock instance.
+func NewMockExchangeService(ctrl *gomock.Controller) *MockExchangeService {
+	mock := &MockExchangeService{ctrl: ctrl}
+	mock.recorder = &MockExchangeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeService) EXPECT() *MockExchangeServiceMockRecorder {
+	return m.recorder
+}
+
+// ExchangeTicket mocks base method.
+func (m *MockExchangeService) ExchangeTicket(ctx context.Context, request *model.ExchangeTicketRequest) (*model.TicketExchangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExchangeTicket", ctx, request)
+	ret0, _ := ret[0].(*model.TicketExchangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExchangeTicket indicates an expected call of ExchangeTicket.
+func (mr *MockExchangeServiceMockRecorder) ExchangeTicket(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeTicket", reflect.TypeOf((*MockExchangeService)(nil).ExchangeTicket), ctx, request)
+}
+
+// GetExchangeByID mocks base method.
+func (m *MockExchangeService) GetExchangeByID(ctx context.Context, request *model.GetTicketExchangeRequest) (*model.TicketExchangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeByID", ctx, request)
+	ret0, _ := ret[0].(*model.TicketExchangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeByID indicates an expected call of GetExchangeByID.
+func (mr *MockExchangeServiceMockRecorder) GetExchangeByID(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeByID", reflect.TypeOf((*MockExchangeService)(nil).GetExchangeByID), ctx, request)
+}
+
+// GetExchanges mocks base method.
+func (m *MockExchangeService) GetExchanges(ctx context.Context, request *model.TicketExchangesRequest) (*model.Response[[]*model.TicketExchangeResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchanges", ctx, request)
+	ret0, _ := ret[0].(*model.Response[[]*model.TicketExchangeResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchanges indicates an expected call of GetExchanges.
+func (mr *MockExchangeServiceMockRecorder) GetExchanges(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchanges", reflect.TypeOf((*MockExchangeService)(nil).GetExchanges), ctx, request)
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/service/group/group_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/service/group/group_service.go -destination=test/mock/service/group/group_service_mock.go
+//
+
+// Package mock_group is a generated GoMock package.
+package mock_group
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TrinityKnights/Backend/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGroupService is a mock of GroupService interface.
+type MockGroupService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockGroupServiceMockRecorder is the mock recorder for MockGroupService.
+type MockGroupServiceMockRecorder struct {
+	mock *MockGroupService
+}
+
+// NewMockGroupService creates a new mock instance.
+func NewMockGroupService(ctrl *gomock.Controller) *MockGroupService {
+	mock := &MockGroupService{ctrl: ctrl}
+	mock.recorder = &MockGroupServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupService) EXPECT() *MockGroupServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateGroupBooking mocks base method.
+func (m *MockGroupService) CreateGroupBooking(ctx context.Context, request *model.CreateGroupBookingRequest) (*model.GroupBookingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupBooking", ctx, request)
+	ret0, _ := ret[0].(*model.GroupBookingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroupBooking indicates an expected call of CreateGroupBooking.
+func (mr *MockGroupServiceMockRecorder) CreateGroupBooking(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupBooking", reflect.TypeOf((*MockGroupService)(nil).CreateGroupBooking), ctx, request)
+}
+
+// GetGroupBookingByID mocks base method.
+func (m *MockGroupService) GetGroupBookingByID(ctx context.Context, request *model.GetGroupBookingRequest) (*model.GroupBookingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupBookingByID", ctx, request)
+	ret0, _ := ret[0].(*model.GroupBookingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupBookingByID indicates an expected call of GetGroupBookingByID.
+func (mr *MockGroupServiceMockRecorder) GetGroupBookingByID(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupBookingByID", reflect.TypeOf((*MockGroupService)(nil).GetGroupBookingByID), ctx, request)
+}
+
+// GetGroupBookings mocks base method.
+func (m *MockGroupService) GetGroupBookings(ctx context.Context, request *model.GroupBookingsRequest) (*model.Response[[]*model.GroupBookingResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupBookings", ctx, request)
+	ret0, _ := ret[0].(*model.Response[[]*model.GroupBookingResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupBookings indicates an expected call of GetGroupBookings.
+func (mr *MockGroupServiceMockRecorder) GetGroupBookings(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupBookings", reflect.TypeOf((*MockGroupService)(nil).GetGroupBookings), ctx, request)
+}
+
+// ReleaseUnpaidShares mocks base method.
+func (m *MockGroupService) ReleaseUnpaidShares(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseUnpaidShares", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseUnpaidShares indicates an expected call of ReleaseUnpaidShares.
+func (mr *MockGroupServiceMockRecorder) ReleaseUnpaidShares(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseUnpaidShares", reflect.TypeOf((*MockGroupService)(nil).ReleaseUnpaidShares), ctx)
+}
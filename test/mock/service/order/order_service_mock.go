@@ -41,6 +41,21 @@ func (m *MockOrderService) EXPECT() *MockOrderServiceMockRecorder {
 	return m.recorder
 }
 
+// CreateGuestOrder mocks base method.
+func (m *MockOrderService) CreateGuestOrder(ctx context.Context, request *model.GuestOrderRequest) (*model.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGuestOrder", ctx, request)
+	ret0, _ := ret[0].(*model.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGuestOrder indicates an expected call of CreateGuestOrder.
+func (mr *MockOrderServiceMockRecorder) CreateGuestOrder(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuestOrder", reflect.TypeOf((*MockOrderService)(nil).CreateGuestOrder), ctx, request)
+}
+
 // CreateOrder mocks base method.
 func (m *MockOrderService) CreateOrder(ctx context.Context, request *model.OrderTicketRequest) (*model.OrderResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +71,21 @@ func (mr *MockOrderServiceMockRecorder) CreateOrder(ctx, request any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockOrderService)(nil).CreateOrder), ctx, request)
 }
 
+// GetGuestOrder mocks base method.
+func (m *MockOrderService) GetGuestOrder(ctx context.Context, request *model.GetGuestOrderRequest) (*model.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuestOrder", ctx, request)
+	ret0, _ := ret[0].(*model.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGuestOrder indicates an expected call of GetGuestOrder.
+func (mr *MockOrderServiceMockRecorder) GetGuestOrder(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestOrder", reflect.TypeOf((*MockOrderService)(nil).GetGuestOrder), ctx, request)
+}
+
 // GetOrderByID mocks base method.
 func (m *MockOrderService) GetOrderByID(ctx context.Context, request *model.GetOrderRequest) (*model.OrderResponse, error) {
 	m.ctrl.T.Helper()
@@ -85,3 +115,18 @@ func (mr *MockOrderServiceMockRecorder) GetOrders(ctx, request any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockOrderService)(nil).GetOrders), ctx, request)
 }
+
+// RequestGuestOrderLink mocks base method.
+func (m *MockOrderService) RequestGuestOrderLink(ctx context.Context, request *model.GuestOrderLookupRequest) (*model.VerifyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestGuestOrderLink", ctx, request)
+	ret0, _ := ret[0].(*model.VerifyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestGuestOrderLink indicates an expected call of RequestGuestOrderLink.
+func (mr *MockOrderServiceMockRecorder) RequestGuestOrderLink(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestGuestOrderLink", reflect.TypeOf((*MockOrderService)(nil).RequestGuestOrderLink), ctx, request)
+}
@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/service/pass/pass_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/service/pass/pass_service.go -destination=test/mock/service/pass/pass_service_mock.go
+//
+
+// Package mock_pass is a generated GoMock package.
+package mock_pass
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TrinityKnights/Backend/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPassService is a mock of PassService interface.
+type MockPassService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPassServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPassServiceMockRecorder is the mock recorder for MockPassService.
+type MockPassServiceMockRecorder struct {
+	mock *MockPassService
+}
+
+// NewMockPassService creates a new mock instance.
+func NewMockPassService(ctrl *gomock.Controller) *MockPassService {
+	mock := &MockPassService{ctrl: ctrl}
+	mock.recorder = &MockPassServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPassService) EXPECT() *MockPassServiceMockRecorder {
+	return m.recorder
+}
+
+// CreatePass mocks base method.
+func (m *MockPassService) CreatePass(ctx context.Context, request *model.CreatePassRequest) (*model.PassResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePass", ctx, request)
+	ret0, _ := ret[0].(*model.PassResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePass indicates an expected call of CreatePass.
+func (mr *MockPassServiceMockRecorder) CreatePass(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePass", reflect.TypeOf((*MockPassService)(nil).CreatePass), ctx, request)
+}
+
+// DeletePass mocks base method.
+func (m *MockPassService) DeletePass(ctx context.Context, request *model.DeletePassRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePass", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePass indicates an expected call of DeletePass.
+func (mr *MockPassServiceMockRecorder) DeletePass(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePass", reflect.TypeOf((*MockPassService)(nil).DeletePass), ctx, request)
+}
+
+// GetPassByID mocks base method.
+func (m *MockPassService) GetPassByID(ctx context.Context, request *model.GetPassRequest) (*model.PassResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPassByID", ctx, request)
+	ret0, _ := ret[0].(*model.PassResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPassByID indicates an expected call of GetPassByID.
+func (mr *MockPassServiceMockRecorder) GetPassByID(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPassByID", reflect.TypeOf((*MockPassService)(nil).GetPassByID), ctx, request)
+}
+
+// GetPasses mocks base method.
+func (m *MockPassService) GetPasses(ctx context.Context) ([]*model.PassResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPasses", ctx)
+	ret0, _ := ret[0].([]*model.PassResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPasses indicates an expected call of GetPasses.
+func (mr *MockPassServiceMockRecorder) GetPasses(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasses", reflect.TypeOf((*MockPassService)(nil).GetPasses), ctx)
+}
+
+// OrderPass mocks base method.
+func (m *MockPassService) OrderPass(ctx context.Context, request *model.OrderPassRequest) (*model.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderPass", ctx, request)
+	ret0, _ := ret[0].(*model.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrderPass indicates an expected call of OrderPass.
+func (mr *MockPassServiceMockRecorder) OrderPass(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderPass", reflect.TypeOf((*MockPassService)(nil).OrderPass), ctx, request)
+}
+
+// ScanPass mocks base method.
+func (m *MockPassService) ScanPass(ctx context.Context, request *model.ScanPassRequest) (*model.PassScanResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanPass", ctx, request)
+	ret0, _ := ret[0].(*model.PassScanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanPass indicates an expected call of ScanPass.
+func (mr *MockPassServiceMockRecorder) ScanPass(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanPass", reflect.TypeOf((*MockPassService)(nil).ScanPass), ctx, request)
+}
@@ -0,0 +1,148 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/service/product/product_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/service/product/product_service.go -destination=test/mock/service/product/product_service_mock.go
+//
+
+// Package mock_product is a generated GoMock package.
+package mock_product
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
+	model "github.com/TrinityKnights/Backend/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockProductService is a mock of ProductService interface.
+type MockProductService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProductServiceMockRecorder is the mock recorder for MockProductService.
+type MockProductServiceMockRecorder struct {
+	mock *MockProductService
+}
+
+// NewMockProductService creates a new mock instance.
+func NewMockProductService(ctrl *gomock.Controller) *MockProductService {
+	mock := &MockProductService{ctrl: ctrl}
+	mock.recorder = &MockProductServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductService) EXPECT() *MockProductServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateProduct mocks base method.
+func (m *MockProductService) CreateProduct(ctx context.Context, request *model.CreateProductRequest) (*model.ProductResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProduct", ctx, request)
+	ret0, _ := ret[0].(*model.ProductResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProduct indicates an expected call of CreateProduct.
+func (mr *MockProductServiceMockRecorder) CreateProduct(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProduct", reflect.TypeOf((*MockProductService)(nil).CreateProduct), ctx, request)
+}
+
+// DeleteProduct mocks base method.
+func (m *MockProductService) DeleteProduct(ctx context.Context, request *model.DeleteProductRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProduct", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProduct indicates an expected call of DeleteProduct.
+func (mr *MockProductServiceMockRecorder) DeleteProduct(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockProductService)(nil).DeleteProduct), ctx, request)
+}
+
+// GetProducts mocks base method.
+func (m *MockProductService) GetProducts(ctx context.Context, request *model.ProductsRequest) ([]*model.ProductResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProducts", ctx, request)
+	ret0, _ := ret[0].([]*model.ProductResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProducts indicates an expected call of GetProducts.
+func (mr *MockProductServiceMockRecorder) GetProducts(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducts", reflect.TypeOf((*MockProductService)(nil).GetProducts), ctx, request)
+}
+
+// GetVoucher mocks base method.
+func (m *MockProductService) GetVoucher(ctx context.Context, request *model.GetVoucherRequest) (*model.VoucherResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVoucher", ctx, request)
+	ret0, _ := ret[0].(*model.VoucherResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVoucher indicates an expected call of GetVoucher.
+func (mr *MockProductServiceMockRecorder) GetVoucher(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVoucher", reflect.TypeOf((*MockProductService)(nil).GetVoucher), ctx, request)
+}
+
+// RedeemVoucher mocks base method.
+func (m *MockProductService) RedeemVoucher(ctx context.Context, request *model.GetVoucherRequest) (*model.VoucherResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemVoucher", ctx, request)
+	ret0, _ := ret[0].(*model.VoucherResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeemVoucher indicates an expected call of RedeemVoucher.
+func (mr *MockProductServiceMockRecorder) RedeemVoucher(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemVoucher", reflect.TypeOf((*MockProductService)(nil).RedeemVoucher), ctx, request)
+}
+
+// ReserveProducts mocks base method.
+func (m *MockProductService) ReserveProducts(ctx context.Context, tx *gorm.DB, eventID uint, requests []model.OrderProductRequest) ([]entity.OrderItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveProducts", ctx, tx, eventID, requests)
+	ret0, _ := ret[0].([]entity.OrderItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveProducts indicates an expected call of ReserveProducts.
+func (mr *MockProductServiceMockRecorder) ReserveProducts(ctx, tx, eventID, requests any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveProducts", reflect.TypeOf((*MockProductService)(nil).ReserveProducts), ctx, tx, eventID, requests)
+}
+
+// UpdateProduct mocks base method.
+func (m *MockProductService) UpdateProduct(ctx context.Context, request *model.UpdateProductRequest) (*model.ProductResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProduct", ctx, request)
+	ret0, _ := ret[0].(*model.ProductResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProduct indicates an expected call of UpdateProduct.
+func (mr *MockProductServiceMockRecorder) UpdateProduct(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockProductService)(nil).UpdateProduct), ctx, request)
+}
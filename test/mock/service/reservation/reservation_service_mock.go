@@ -0,0 +1,170 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/service/reservation/reservation_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/service/reservation/reservation_service.go -destination=test/mock/service/reservation/reservation_service_mock.go
+//
+
+// Package mock_reservation is a generated GoMock package.
+package mock_reservation
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReservationService is a mock of ReservationService interface.
+type MockReservationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockReservationServiceMockRecorder is the mock recorder for MockReservationService.
+type MockReservationServiceMockRecorder struct {
+	mock *MockReservationService
+}
+
+// NewMockReservationService creates a new mock instance.
+func NewMockReservationService(ctrl *gomock.Controller) *MockReservationService {
+	mock := &MockReservationService{ctrl: ctrl}
+	mock.recorder = &MockReservationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservationService) EXPECT() *MockReservationServiceMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockReservationService) Claim(ctx context.Context, eventID uint, ticketIDs []string, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, eventID, ticketIDs, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockReservationServiceMockRecorder) Claim(ctx, eventID, ticketIDs, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockReservationService)(nil).Claim), ctx, eventID, ticketIDs, owner)
+}
+
+// ClaimPlaces mocks base method.
+func (m *MockReservationService) ClaimPlaces(ctx context.Context, slotID uint, quantity int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPlaces", ctx, slotID, quantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimPlaces indicates an expected call of ClaimPlaces.
+func (mr *MockReservationServiceMockRecorder) ClaimPlaces(ctx, slotID, quantity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPlaces", reflect.TypeOf((*MockReservationService)(nil).ClaimPlaces), ctx, slotID, quantity)
+}
+
+// ClaimSeats mocks base method.
+func (m *MockReservationService) ClaimSeats(ctx context.Context, seats map[uint][]string, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimSeats", ctx, seats, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimSeats indicates an expected call of ClaimSeats.
+func (mr *MockReservationServiceMockRecorder) ClaimSeats(ctx, seats, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimSeats", reflect.TypeOf((*MockReservationService)(nil).ClaimSeats), ctx, seats, owner)
+}
+
+// Confirm mocks base method.
+func (m *MockReservationService) Confirm(ctx context.Context, eventID uint, ticketIDs []string, owner string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Confirm", ctx, eventID, ticketIDs, owner)
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockReservationServiceMockRecorder) Confirm(ctx, eventID, ticketIDs, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockReservationService)(nil).Confirm), ctx, eventID, ticketIDs, owner)
+}
+
+// ConfirmSeats mocks base method.
+func (m *MockReservationService) ConfirmSeats(ctx context.Context, seats map[uint][]string, owner string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ConfirmSeats", ctx, seats, owner)
+}
+
+// ConfirmSeats indicates an expected call of ConfirmSeats.
+func (mr *MockReservationServiceMockRecorder) ConfirmSeats(ctx, seats, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmSeats", reflect.TypeOf((*MockReservationService)(nil).ConfirmSeats), ctx, seats, owner)
+}
+
+// Free mocks base method.
+func (m *MockReservationService) Free(ctx context.Context, tickets []*entity.Ticket) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Free", ctx, tickets)
+}
+
+// Free indicates an expected call of Free.
+func (mr *MockReservationServiceMockRecorder) Free(ctx, tickets any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Free", reflect.TypeOf((*MockReservationService)(nil).Free), ctx, tickets)
+}
+
+// Reconcile mocks base method.
+func (m *MockReservationService) Reconcile(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockReservationServiceMockRecorder) Reconcile(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockReservationService)(nil).Reconcile), ctx)
+}
+
+// Release mocks base method.
+func (m *MockReservationService) Release(ctx context.Context, eventID uint, ticketIDs []string, owner string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Release", ctx, eventID, ticketIDs, owner)
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockReservationServiceMockRecorder) Release(ctx, eventID, ticketIDs, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockReservationService)(nil).Release), ctx, eventID, ticketIDs, owner)
+}
+
+// ReleasePlaces mocks base method.
+func (m *MockReservationService) ReleasePlaces(ctx context.Context, slotID uint, quantity int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleasePlaces", ctx, slotID, quantity)
+}
+
+// ReleasePlaces indicates an expected call of ReleasePlaces.
+func (mr *MockReservationServiceMockRecorder) ReleasePlaces(ctx, slotID, quantity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePlaces", reflect.TypeOf((*MockReservationService)(nil).ReleasePlaces), ctx, slotID, quantity)
+}
+
+// ReleaseSeats mocks base method.
+func (m *MockReservationService) ReleaseSeats(ctx context.Context, seats map[uint][]string, owner string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleaseSeats", ctx, seats, owner)
+}
+
+// ReleaseSeats indicates an expected call of ReleaseSeats.
+func (mr *MockReservationServiceMockRecorder) ReleaseSeats(ctx, seats, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSeats", reflect.TypeOf((*MockReservationService)(nil).ReleaseSeats), ctx, seats, owner)
+}
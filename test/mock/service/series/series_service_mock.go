@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/service/series/series_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/service/series/series_service.go -destination=test/mock/service/series/series_service_mock.go
+//
+
+// Package mock_series is a generated GoMock package.
+package mock_series
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TrinityKnights/Backend/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSeriesService is a mock of SeriesService interface.
+type MockSeriesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeriesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSeriesServiceMockRecorder is the mock recorder for MockSeriesService.
+type MockSeriesServiceMockRecorder struct {
+	mock *MockSeriesService
+}
+
+// NewMockSeriesService creates a new mock instance.
+func NewMockSeriesService(ctrl *gomock.Controller) *MockSeriesService {
+	mock := &MockSeriesService{ctrl: ctrl}
+	mock.recorder = &MockSeriesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeriesService) EXPECT() *MockSeriesServiceMockRecorder {
+	return m.recorder
+}
+
+// AddException mocks base method.
+func (m *MockSeriesService) AddException(ctx context.Context, request *model.AddSeriesExceptionRequest) (*model.SeriesExceptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddException", ctx, request)
+	ret0, _ := ret[0].(*model.SeriesExceptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddException indicates an expected call of AddException.
+func (mr *MockSeriesServiceMockRecorder) AddException(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddException", reflect.TypeOf((*MockSeriesService)(nil).AddException), ctx, request)
+}
+
+// AddTicketTemplate mocks base method.
+func (m *MockSeriesService) AddTicketTemplate(ctx context.Context, request *model.AddSeriesTicketTemplateRequest) (*model.SeriesTicketTemplateCopyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTicketTemplate", ctx, request)
+	ret0, _ := ret[0].(*model.SeriesTicketTemplateCopyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTicketTemplate indicates an expected call of AddTicketTemplate.
+func (mr *MockSeriesServiceMockRecorder) AddTicketTemplate(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTicketTemplate", reflect.TypeOf((*MockSeriesService)(nil).AddTicketTemplate), ctx, request)
+}
+
+// CreateSeries mocks base method.
+func (m *MockSeriesService) CreateSeries(ctx context.Context, request *model.CreateSeriesRequest) (*model.SeriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSeries", ctx, request)
+	ret0, _ := ret[0].(*model.SeriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSeries indicates an expected call of CreateSeries.
+func (mr *MockSeriesServiceMockRecorder) CreateSeries(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSeries", reflect.TypeOf((*MockSeriesService)(nil).CreateSeries), ctx, request)
+}
+
+// GetSeriesByID mocks base method.
+func (m *MockSeriesService) GetSeriesByID(ctx context.Context, request *model.GetSeriesRequest) (*model.SeriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeriesByID", ctx, request)
+	ret0, _ := ret[0].(*model.SeriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeriesByID indicates an expected call of GetSeriesByID.
+func (mr *MockSeriesServiceMockRecorder) GetSeriesByID(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeriesByID", reflect.TypeOf((*MockSeriesService)(nil).GetSeriesByID), ctx, request)
+}
+
+// UpdateOccurrence mocks base method.
+func (m *MockSeriesService) UpdateOccurrence(ctx context.Context, request *model.UpdateOccurrenceRequest) (*model.SeriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOccurrence", ctx, request)
+	ret0, _ := ret[0].(*model.SeriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOccurrence indicates an expected call of UpdateOccurrence.
+func (mr *MockSeriesServiceMockRecorder) UpdateOccurrence(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOccurrence", reflect.TypeOf((*MockSeriesService)(nil).UpdateOccurrence), ctx, request)
+}
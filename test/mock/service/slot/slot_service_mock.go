@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/service/slot/slot_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/service/slot/slot_service.go -destination=test/mock/service/slot/slot_service_mock.go
+//
+
+// Package mock_slot is a generated GoMock package.
+package mock_slot
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TrinityKnights/Backend/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSlotService is a mock of SlotService interface.
+type MockSlotService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlotServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSlotServiceMockRecorder is the mock recorder for MockSlotService.
+type MockSlotServiceMockRecorder struct {
+	mock *MockSlotService
+}
+
+// NewMockSlotService creates a new mock instance.
+func NewMockSlotService(ctrl *gomock.Controller) *MockSlotService {
+	mock := &MockSlotService{ctrl: ctrl}
+	mock.recorder = &MockSlotServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlotService) EXPECT() *MockSlotServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateSlotTemplate mocks base method.
+func (m *MockSlotService) CreateSlotTemplate(ctx context.Context, request *model.CreateSlotTemplateRequest) (*model.SlotTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSlotTemplate", ctx, request)
+	ret0, _ := ret[0].(*model.SlotTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSlotTemplate indicates an expected call of CreateSlotTemplate.
+func (mr *MockSlotServiceMockRecorder) CreateSlotTemplate(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSlotTemplate", reflect.TypeOf((*MockSlotService)(nil).CreateSlotTemplate), ctx, request)
+}
+
+// GenerateSlots mocks base method.
+func (m *MockSlotService) GenerateSlots(ctx context.Context, request *model.GenerateSlotsRequest) (*model.GenerateSlotsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSlots", ctx, request)
+	ret0, _ := ret[0].(*model.GenerateSlotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSlots indicates an expected call of GenerateSlots.
+func (mr *MockSlotServiceMockRecorder) GenerateSlots(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSlots", reflect.TypeOf((*MockSlotService)(nil).GenerateSlots), ctx, request)
+}
+
+// GetSlotTemplates mocks base method.
+func (m *MockSlotService) GetSlotTemplates(ctx context.Context, request *model.SlotTemplatesRequest) ([]*model.SlotTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlotTemplates", ctx, request)
+	ret0, _ := ret[0].([]*model.SlotTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSlotTemplates indicates an expected call of GetSlotTemplates.
+func (mr *MockSlotServiceMockRecorder) GetSlotTemplates(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlotTemplates", reflect.TypeOf((*MockSlotService)(nil).GetSlotTemplates), ctx, request)
+}
+
+// GetSlots mocks base method.
+func (m *MockSlotService) GetSlots(ctx context.Context, request *model.SlotsRequest) ([]*model.SlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlots", ctx, request)
+	ret0, _ := ret[0].([]*model.SlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSlots indicates an expected call of GetSlots.
+func (mr *MockSlotServiceMockRecorder) GetSlots(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlots", reflect.TypeOf((*MockSlotService)(nil).GetSlots), ctx, request)
+}
+
+// OrderSlot mocks base method.
+func (m *MockSlotService) OrderSlot(ctx context.Context, request *model.OrderSlotRequest) (*model.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderSlot", ctx, request)
+	ret0, _ := ret[0].(*model.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrderSlot indicates an expected call of OrderSlot.
+func (mr *MockSlotServiceMockRecorder) OrderSlot(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderSlot", reflect.TypeOf((*MockSlotService)(nil).OrderSlot), ctx, request)
+}
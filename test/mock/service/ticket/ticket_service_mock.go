@@ -56,6 +56,36 @@ func (mr *MockTicketServiceMockRecorder) CreateTicket(ctx, request any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*MockTicketService)(nil).CreateTicket), ctx, request)
 }
 
+// GetEventAvailability mocks base method.
+func (m *MockTicketService) GetEventAvailability(ctx context.Context, request *model.GetEventAvailabilityRequest) (*model.EventAvailabilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventAvailability", ctx, request)
+	ret0, _ := ret[0].(*model.EventAvailabilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventAvailability indicates an expected call of GetEventAvailability.
+func (mr *MockTicketServiceMockRecorder) GetEventAvailability(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventAvailability", reflect.TypeOf((*MockTicketService)(nil).GetEventAvailability), ctx, request)
+}
+
+// GetEventCapacity mocks base method.
+func (m *MockTicketService) GetEventCapacity(ctx context.Context, request *model.GetEventCapacityRequest) (*model.EventCapacityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventCapacity", ctx, request)
+	ret0, _ := ret[0].(*model.EventCapacityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventCapacity indicates an expected call of GetEventCapacity.
+func (mr *MockTicketServiceMockRecorder) GetEventCapacity(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventCapacity", reflect.TypeOf((*MockTicketService)(nil).GetEventCapacity), ctx, request)
+}
+
 // GetTicketByID mocks base method.
 func (m *MockTicketService) GetTicketByID(ctx context.Context, request *model.GetTicketRequest) (*model.TicketResponse, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +116,20 @@ func (mr *MockTicketServiceMockRecorder) GetTickets(ctx, request any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTickets", reflect.TypeOf((*MockTicketService)(nil).GetTickets), ctx, request)
 }
 
+// ReleaseExpiredHolds mocks base method.
+func (m *MockTicketService) ReleaseExpiredHolds(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseExpiredHolds", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseExpiredHolds indicates an expected call of ReleaseExpiredHolds.
+func (mr *MockTicketServiceMockRecorder) ReleaseExpiredHolds(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseExpiredHolds", reflect.TypeOf((*MockTicketService)(nil).ReleaseExpiredHolds), ctx)
+}
+
 // SearchTickets mocks base method.
 func (m *MockTicketService) SearchTickets(ctx context.Context, request *model.TicketSearchRequest) (*model.Response[[]*model.TicketResponse], error) {
 	m.ctrl.T.Helper()
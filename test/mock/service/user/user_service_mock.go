@@ -41,6 +41,96 @@ func (m *MockUserService) EXPECT() *MockUserServiceMockRecorder {
 	return m.recorder
 }
 
+// ConfirmTwoFactor mocks base method.
+func (m *MockUserService) ConfirmTwoFactor(ctx context.Context, request *model.TwoFactorCodeRequest) (*model.RecoveryCodesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTwoFactor", ctx, request)
+	ret0, _ := ret[0].(*model.RecoveryCodesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmTwoFactor indicates an expected call of ConfirmTwoFactor.
+func (mr *MockUserServiceMockRecorder) ConfirmTwoFactor(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTwoFactor", reflect.TypeOf((*MockUserService)(nil).ConfirmTwoFactor), ctx, request)
+}
+
+// DisableTwoFactor mocks base method.
+func (m *MockUserService) DisableTwoFactor(ctx context.Context, request *model.TwoFactorCodeRequest) (*model.VerifyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTwoFactor", ctx, request)
+	ret0, _ := ret[0].(*model.VerifyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableTwoFactor indicates an expected call of DisableTwoFactor.
+func (mr *MockUserServiceMockRecorder) DisableTwoFactor(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTwoFactor", reflect.TypeOf((*MockUserService)(nil).DisableTwoFactor), ctx, request)
+}
+
+// EnrolTwoFactor mocks base method.
+func (m *MockUserService) EnrolTwoFactor(ctx context.Context) (*model.EnrolTwoFactorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrolTwoFactor", ctx)
+	ret0, _ := ret[0].(*model.EnrolTwoFactorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrolTwoFactor indicates an expected call of EnrolTwoFactor.
+func (mr *MockUserServiceMockRecorder) EnrolTwoFactor(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrolTwoFactor", reflect.TypeOf((*MockUserService)(nil).EnrolTwoFactor), ctx)
+}
+
+// GetRolePolicy mocks base method.
+func (m *MockUserService) GetRolePolicy(ctx context.Context, request *model.RolePolicyRequest) (*model.RolePolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRolePolicy", ctx, request)
+	ret0, _ := ret[0].(*model.RolePolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRolePolicy indicates an expected call of GetRolePolicy.
+func (mr *MockUserServiceMockRecorder) GetRolePolicy(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRolePolicy", reflect.TypeOf((*MockUserService)(nil).GetRolePolicy), ctx, request)
+}
+
+// GetSessions mocks base method.
+func (m *MockUserService) GetSessions(ctx context.Context) ([]*model.SessionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessions", ctx)
+	ret0, _ := ret[0].([]*model.SessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessions indicates an expected call of GetSessions.
+func (mr *MockUserServiceMockRecorder) GetSessions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockUserService)(nil).GetSessions), ctx)
+}
+
+// GetUserSessions mocks base method.
+func (m *MockUserService) GetUserSessions(ctx context.Context, request *model.GetUserSessionsRequest) ([]*model.SessionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSessions", ctx, request)
+	ret0, _ := ret[0].([]*model.SessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSessions indicates an expected call of GetUserSessions.
+func (mr *MockUserServiceMockRecorder) GetUserSessions(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSessions", reflect.TypeOf((*MockUserService)(nil).GetUserSessions), ctx, request)
+}
+
 // Login mocks base method.
 func (m *MockUserService) Login(ctx context.Context, request *model.LoginRequest) (*model.TokenResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +146,81 @@ func (mr *MockUserServiceMockRecorder) Login(ctx, request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUserService)(nil).Login), ctx, request)
 }
 
+// LoginConfirmTwoFactor mocks base method.
+func (m *MockUserService) LoginConfirmTwoFactor(ctx context.Context, request *model.LoginConfirmTwoFactorRequest) (*model.TokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginConfirmTwoFactor", ctx, request)
+	ret0, _ := ret[0].(*model.TokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginConfirmTwoFactor indicates an expected call of LoginConfirmTwoFactor.
+func (mr *MockUserServiceMockRecorder) LoginConfirmTwoFactor(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginConfirmTwoFactor", reflect.TypeOf((*MockUserService)(nil).LoginConfirmTwoFactor), ctx, request)
+}
+
+// LoginEnrolTwoFactor mocks base method.
+func (m *MockUserService) LoginEnrolTwoFactor(ctx context.Context, request *model.LoginEnrolTwoFactorRequest) (*model.EnrolTwoFactorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginEnrolTwoFactor", ctx, request)
+	ret0, _ := ret[0].(*model.EnrolTwoFactorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginEnrolTwoFactor indicates an expected call of LoginEnrolTwoFactor.
+func (mr *MockUserServiceMockRecorder) LoginEnrolTwoFactor(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginEnrolTwoFactor", reflect.TypeOf((*MockUserService)(nil).LoginEnrolTwoFactor), ctx, request)
+}
+
+// LoginTwoFactor mocks base method.
+func (m *MockUserService) LoginTwoFactor(ctx context.Context, request *model.LoginTwoFactorRequest) (*model.TokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginTwoFactor", ctx, request)
+	ret0, _ := ret[0].(*model.TokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginTwoFactor indicates an expected call of LoginTwoFactor.
+func (mr *MockUserServiceMockRecorder) LoginTwoFactor(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginTwoFactor", reflect.TypeOf((*MockUserService)(nil).LoginTwoFactor), ctx, request)
+}
+
+// Logout mocks base method.
+func (m *MockUserService) Logout(ctx context.Context) (*model.VerifyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx)
+	ret0, _ := ret[0].(*model.VerifyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockUserServiceMockRecorder) Logout(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockUserService)(nil).Logout), ctx)
+}
+
+// LogoutAll mocks base method.
+func (m *MockUserService) LogoutAll(ctx context.Context) (*model.VerifyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogoutAll", ctx)
+	ret0, _ := ret[0].(*model.VerifyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogoutAll indicates an expected call of LogoutAll.
+func (mr *MockUserServiceMockRecorder) LogoutAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogoutAll", reflect.TypeOf((*MockUserService)(nil).LogoutAll), ctx)
+}
+
 // Profile mocks base method.
 func (m *MockUserService) Profile(ctx context.Context) (*model.UserResponse, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +251,21 @@ func (mr *MockUserServiceMockRecorder) RefreshToken(ctx, request any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockUserService)(nil).RefreshToken), ctx, request)
 }
 
+// RegenerateRecoveryCodes mocks base method.
+func (m *MockUserService) RegenerateRecoveryCodes(ctx context.Context, request *model.TwoFactorCodeRequest) (*model.RecoveryCodesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateRecoveryCodes", ctx, request)
+	ret0, _ := ret[0].(*model.RecoveryCodesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateRecoveryCodes indicates an expected call of RegenerateRecoveryCodes.
+func (mr *MockUserServiceMockRecorder) RegenerateRecoveryCodes(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateRecoveryCodes", reflect.TypeOf((*MockUserService)(nil).RegenerateRecoveryCodes), ctx, request)
+}
+
 // Register mocks base method.
 func (m *MockUserService) Register(ctx context.Context, request *model.RegisterRequest) (*model.UserResponse, error) {
 	m.ctrl.T.Helper()
@@ -131,6 +311,36 @@ func (mr *MockUserServiceMockRecorder) ResetPassword(ctx, request any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUserService)(nil).ResetPassword), ctx, request)
 }
 
+// RevokeSession mocks base method.
+func (m *MockUserService) RevokeSession(ctx context.Context, request *model.RevokeSessionRequest) (*model.VerifyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, request)
+	ret0, _ := ret[0].(*model.VerifyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockUserServiceMockRecorder) RevokeSession(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockUserService)(nil).RevokeSession), ctx, request)
+}
+
+// Unlock mocks base method.
+func (m *MockUserService) Unlock(ctx context.Context, request *model.UnlockUserRequest) (*model.VerifyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", ctx, request)
+	ret0, _ := ret[0].(*model.VerifyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unlock indicates an expected call of Unlock.
+func (mr *MockUserServiceMockRecorder) Unlock(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockUserService)(nil).Unlock), ctx, request)
+}
+
 // Update mocks base method.
 func (m *MockUserService) Update(ctx context.Context, request *model.UpdateUserRequest) (*model.UserResponse, error) {
 	m.ctrl.T.Helper()
@@ -146,6 +356,21 @@ func (mr *MockUserServiceMockRecorder) Update(ctx, request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserService)(nil).Update), ctx, request)
 }
 
+// UpdateRolePolicy mocks base method.
+func (m *MockUserService) UpdateRolePolicy(ctx context.Context, request *model.UpdateRolePolicyRequest) (*model.RolePolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRolePolicy", ctx, request)
+	ret0, _ := ret[0].(*model.RolePolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRolePolicy indicates an expected call of UpdateRolePolicy.
+func (mr *MockUserServiceMockRecorder) UpdateRolePolicy(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRolePolicy", reflect.TypeOf((*MockUserService)(nil).UpdateRolePolicy), ctx, request)
+}
+
 // VerifyEmail mocks base method.
 func (m *MockUserService) VerifyEmail(ctx context.Context, request *model.VerifyRequest) (*model.VerifyResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +56,21 @@ func (mr *MockVenueServiceMockRecorder) CreateVenue(ctx, request any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVenue", reflect.TypeOf((*MockVenueService)(nil).CreateVenue), ctx, request)
 }
 
+// GetCalendar mocks base method.
+func (m *MockVenueService) GetCalendar(ctx context.Context, request *model.VenueCalendarRequest) (*model.VenueCalendarResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendar", ctx, request)
+	ret0, _ := ret[0].(*model.VenueCalendarResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendar indicates an expected call of GetCalendar.
+func (mr *MockVenueServiceMockRecorder) GetCalendar(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendar", reflect.TypeOf((*MockVenueService)(nil).GetCalendar), ctx, request)
+}
+
 // GetVenueByID mocks base method.
 func (m *MockVenueService) GetVenueByID(ctx context.Context, request *model.GetVenueRequest) (*model.VenueResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/service/waitingroom/waitingroom_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/service/waitingroom/waitingroom_service.go -destination=test/mock/service/waitingroom/waitingroom_service_mock.go
+//
+
+// Package mock_waitingroom is a generated GoMock package.
+package mock_waitingroom
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TrinityKnights/Backend/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWaitingRoomService is a mock of WaitingRoomService interface.
+type MockWaitingRoomService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitingRoomServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWaitingRoomServiceMockRecorder is the mock recorder for MockWaitingRoomService.
+type MockWaitingRoomServiceMockRecorder struct {
+	mock *MockWaitingRoomService
+}
+
+// NewMockWaitingRoomService creates a new mock instance.
+func NewMockWaitingRoomService(ctrl *gomock.Controller) *MockWaitingRoomService {
+	mock := &MockWaitingRoomService{ctrl: ctrl}
+	mock.recorder = &MockWaitingRoomServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitingRoomService) EXPECT() *MockWaitingRoomServiceMockRecorder {
+	return m.recorder
+}
+
+// ClaimAdmission mocks base method.
+func (m *MockWaitingRoomService) ClaimAdmission(ctx context.Context, eventID uint, admissionToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimAdmission", ctx, eventID, admissionToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimAdmission indicates an expected call of ClaimAdmission.
+func (mr *MockWaitingRoomServiceMockRecorder) ClaimAdmission(ctx, eventID, admissionToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimAdmission", reflect.TypeOf((*MockWaitingRoomService)(nil).ClaimAdmission), ctx, eventID, admissionToken)
+}
+
+// ClaimAdmissions mocks base method.
+func (m *MockWaitingRoomService) ClaimAdmissions(ctx context.Context, eventIDs []uint, admissionTokens []model.EventAdmissionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimAdmissions", ctx, eventIDs, admissionTokens)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimAdmissions indicates an expected call of ClaimAdmissions.
+func (mr *MockWaitingRoomServiceMockRecorder) ClaimAdmissions(ctx, eventIDs, admissionTokens any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimAdmissions", reflect.TypeOf((*MockWaitingRoomService)(nil).ClaimAdmissions), ctx, eventIDs, admissionTokens)
+}
+
+// CloseWaitingRoom mocks base method.
+func (m *MockWaitingRoomService) CloseWaitingRoom(ctx context.Context, request *model.GetWaitingRoomRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseWaitingRoom", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseWaitingRoom indicates an expected call of CloseWaitingRoom.
+func (mr *MockWaitingRoomServiceMockRecorder) CloseWaitingRoom(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseWaitingRoom", reflect.TypeOf((*MockWaitingRoomService)(nil).CloseWaitingRoom), ctx, request)
+}
+
+// GetQueuePosition mocks base method.
+func (m *MockWaitingRoomService) GetQueuePosition(ctx context.Context, request *model.GetQueuePositionRequest) (*model.QueuePositionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueuePosition", ctx, request)
+	ret0, _ := ret[0].(*model.QueuePositionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueuePosition indicates an expected call of GetQueuePosition.
+func (mr *MockWaitingRoomServiceMockRecorder) GetQueuePosition(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueuePosition", reflect.TypeOf((*MockWaitingRoomService)(nil).GetQueuePosition), ctx, request)
+}
+
+// GetWaitingRoom mocks base method.
+func (m *MockWaitingRoomService) GetWaitingRoom(ctx context.Context, request *model.GetWaitingRoomRequest) (*model.WaitingRoomResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitingRoom", ctx, request)
+	ret0, _ := ret[0].(*model.WaitingRoomResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaitingRoom indicates an expected call of GetWaitingRoom.
+func (mr *MockWaitingRoomServiceMockRecorder) GetWaitingRoom(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitingRoom", reflect.TypeOf((*MockWaitingRoomService)(nil).GetWaitingRoom), ctx, request)
+}
+
+// JoinQueue mocks base method.
+func (m *MockWaitingRoomService) JoinQueue(ctx context.Context, request *model.GetWaitingRoomRequest) (*model.QueuePositionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinQueue", ctx, request)
+	ret0, _ := ret[0].(*model.QueuePositionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinQueue indicates an expected call of JoinQueue.
+func (mr *MockWaitingRoomServiceMockRecorder) JoinQueue(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinQueue", reflect.TypeOf((*MockWaitingRoomService)(nil).JoinQueue), ctx, request)
+}
+
+// OpenWaitingRoom mocks base method.
+func (m *MockWaitingRoomService) OpenWaitingRoom(ctx context.Context, request *model.OpenWaitingRoomRequest) (*model.WaitingRoomResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenWaitingRoom", ctx, request)
+	ret0, _ := ret[0].(*model.WaitingRoomResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenWaitingRoom indicates an expected call of OpenWaitingRoom.
+func (mr *MockWaitingRoomServiceMockRecorder) OpenWaitingRoom(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenWaitingRoom", reflect.TypeOf((*MockWaitingRoomService)(nil).OpenWaitingRoom), ctx, request)
+}
+
+// ReleaseAdmission mocks base method.
+func (m *MockWaitingRoomService) ReleaseAdmission(ctx context.Context, eventID uint, admissionToken string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleaseAdmission", ctx, eventID, admissionToken)
+}
+
+// ReleaseAdmission indicates an expected call of ReleaseAdmission.
+func (mr *MockWaitingRoomServiceMockRecorder) ReleaseAdmission(ctx, eventID, admissionToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseAdmission", reflect.TypeOf((*MockWaitingRoomService)(nil).ReleaseAdmission), ctx, eventID, admissionToken)
+}
+
+// ReleaseAdmissions mocks base method.
+func (m *MockWaitingRoomService) ReleaseAdmissions(ctx context.Context, eventIDs []uint, admissionTokens []model.EventAdmissionRequest) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleaseAdmissions", ctx, eventIDs, admissionTokens)
+}
+
+// ReleaseAdmissions indicates an expected call of ReleaseAdmissions.
+func (mr *MockWaitingRoomServiceMockRecorder) ReleaseAdmissions(ctx, eventIDs, admissionTokens any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseAdmissions", reflect.TypeOf((*MockWaitingRoomService)(nil).ReleaseAdmissions), ctx, eventIDs, admissionTokens)
+}
@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/service/waitlist/waitlist_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/service/waitlist/waitlist_service.go -destination=test/mock/service/waitlist/waitlist_service_mock.go
+//
+
+// Package mock_waitlist is a generated GoMock package.
+package mock_waitlist
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/TrinityKnights/Backend/internal/domain/entity"
+	model "github.com/TrinityKnights/Backend/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWaitlistService is a mock of WaitlistService interface.
+type MockWaitlistService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitlistServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWaitlistServiceMockRecorder is the mock recorder for MockWaitlistService.
+type MockWaitlistServiceMockRecorder struct {
+	mock *MockWaitlistService
+}
+
+// NewMockWaitlistService creates a new mock instance.
+func NewMockWaitlistService(ctrl *gomock.Controller) *MockWaitlistService {
+	mock := &MockWaitlistService{ctrl: ctrl}
+	mock.recorder = &MockWaitlistServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitlistService) EXPECT() *MockWaitlistServiceMockRecorder {
+	return m.recorder
+}
+
+// ExpireOffers mocks base method.
+func (m *MockWaitlistService) ExpireOffers(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireOffers", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireOffers indicates an expected call of ExpireOffers.
+func (mr *MockWaitlistServiceMockRecorder) ExpireOffers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireOffers", reflect.TypeOf((*MockWaitlistService)(nil).ExpireOffers), ctx)
+}
+
+// GetOffer mocks base method.
+func (m *MockWaitlistService) GetOffer(ctx context.Context, request *model.GetWaitlistOfferRequest) (*model.WaitlistOfferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOffer", ctx, request)
+	ret0, _ := ret[0].(*model.WaitlistOfferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOffer indicates an expected call of GetOffer.
+func (mr *MockWaitlistServiceMockRecorder) GetOffer(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOffer", reflect.TypeOf((*MockWaitlistService)(nil).GetOffer), ctx, request)
+}
+
+// GetWaitlistByID mocks base method.
+func (m *MockWaitlistService) GetWaitlistByID(ctx context.Context, request *model.GetWaitlistRequest) (*model.WaitlistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitlistByID", ctx, request)
+	ret0, _ := ret[0].(*model.WaitlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaitlistByID indicates an expected call of GetWaitlistByID.
+func (mr *MockWaitlistServiceMockRecorder) GetWaitlistByID(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlistByID", reflect.TypeOf((*MockWaitlistService)(nil).GetWaitlistByID), ctx, request)
+}
+
+// GetWaitlists mocks base method.
+func (m *MockWaitlistService) GetWaitlists(ctx context.Context, request *model.WaitlistsRequest) (*model.Response[[]*model.WaitlistResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitlists", ctx, request)
+	ret0, _ := ret[0].(*model.Response[[]*model.WaitlistResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaitlists indicates an expected call of GetWaitlists.
+func (mr *MockWaitlistServiceMockRecorder) GetWaitlists(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlists", reflect.TypeOf((*MockWaitlistService)(nil).GetWaitlists), ctx, request)
+}
+
+// JoinWaitlist mocks base method.
+func (m *MockWaitlistService) JoinWaitlist(ctx context.Context, request *model.JoinWaitlistRequest) (*model.WaitlistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinWaitlist", ctx, request)
+	ret0, _ := ret[0].(*model.WaitlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinWaitlist indicates an expected call of JoinWaitlist.
+func (mr *MockWaitlistServiceMockRecorder) JoinWaitlist(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinWaitlist", reflect.TypeOf((*MockWaitlistService)(nil).JoinWaitlist), ctx, request)
+}
+
+// LeaveWaitlist mocks base method.
+func (m *MockWaitlistService) LeaveWaitlist(ctx context.Context, request *model.GetWaitlistRequest) (*model.WaitlistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveWaitlist", ctx, request)
+	ret0, _ := ret[0].(*model.WaitlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveWaitlist indicates an expected call of LeaveWaitlist.
+func (mr *MockWaitlistServiceMockRecorder) LeaveWaitlist(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveWaitlist", reflect.TypeOf((*MockWaitlistService)(nil).LeaveWaitlist), ctx, request)
+}
+
+// OfferReleased mocks base method.
+func (m *MockWaitlistService) OfferReleased(ctx context.Context, eventID uint, ticketType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferReleased", ctx, eventID, ticketType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OfferReleased indicates an expected call of OfferReleased.
+func (mr *MockWaitlistServiceMockRecorder) OfferReleased(ctx, eventID, ticketType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferReleased", reflect.TypeOf((*MockWaitlistService)(nil).OfferReleased), ctx, eventID, ticketType)
+}
+
+// OfferReleasedTickets mocks base method.
+func (m *MockWaitlistService) OfferReleasedTickets(ctx context.Context, tickets []*entity.Ticket) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OfferReleasedTickets", ctx, tickets)
+}
+
+// OfferReleasedTickets indicates an expected call of OfferReleasedTickets.
+func (mr *MockWaitlistServiceMockRecorder) OfferReleasedTickets(ctx, tickets any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferReleasedTickets", reflect.TypeOf((*MockWaitlistService)(nil).OfferReleasedTickets), ctx, tickets)
+}